@@ -36,6 +36,8 @@ const (
 	AppStatus_APP_STATUS_PENDING AppStatus = 2
 	// The App has all the badges revoked
 	AppStatus_APP_STATUS_REVOKED AppStatus = 3
+	// The App has an active badge suspended
+	AppStatus_APP_STATUS_SUSPENDED AppStatus = 4
 )
 
 // Enum value maps for AppStatus.
@@ -45,12 +47,14 @@ var (
 		1: "APP_STATUS_ACTIVE",
 		2: "APP_STATUS_PENDING",
 		3: "APP_STATUS_REVOKED",
+		4: "APP_STATUS_SUSPENDED",
 	}
 	AppStatus_value = map[string]int32{
 		"APP_STATUS_UNSPECIFIED": 0,
 		"APP_STATUS_ACTIVE":      1,
 		"APP_STATUS_PENDING":     2,
 		"APP_STATUS_REVOKED":     3,
+		"APP_STATUS_SUSPENDED":   4,
 	}
 )
 
@@ -156,7 +160,9 @@ type App struct {
 	// The status of the App
 	Status *AppStatus `protobuf:"varint,7,opt,name=status,proto3,enum=agntcy.identity.service.v1alpha1.AppStatus,oneof" json:"status,omitempty"`
 	// CreatedAt records the timestamp of when the App was initially created
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	// Whether the App must use DPoP sender-constrained sessions.
+	RequireDpop   *bool `protobuf:"varint,9,opt,name=require_dpop,json=requireDpop,proto3,oneof" json:"require_dpop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,11 +253,18 @@ func (x *App) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *App) GetRequireDpop() bool {
+	if x != nil && x.RequireDpop != nil {
+		return *x.RequireDpop
+	}
+	return false
+}
+
 var File_agntcy_identity_service_v1alpha1_app_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_service_v1alpha1_app_proto_rawDesc = "" +
 	"\n" +
-	"*agntcy/identity/service/v1alpha1/app.proto\x12 agntcy.identity.service.v1alpha1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8d\x04\n" +
+	"*agntcy/identity/service/v1alpha1/app.proto\x12 agntcy.identity.service.v1alpha1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\x04\n" +
 	"\x03App\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03H\x00R\x02id\x88\x01\x01\x12\x1c\n" +
 	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02H\x01R\x04name\x88\x01\x01\x12*\n" +
@@ -261,7 +274,8 @@ const file_agntcy_identity_service_v1alpha1_app_proto_rawDesc = "" +
 	"\aapi_key\x18\x06 \x01(\tB\x03\xe0A\x03H\x05R\x06apiKey\x88\x01\x01\x12M\n" +
 	"\x06status\x18\a \x01(\x0e2+.agntcy.identity.service.v1alpha1.AppStatusB\x03\xe0A\x03H\x06R\x06status\x88\x01\x01\x12C\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\aR\tcreatedAt\x88\x01\x01B\x05\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03H\aR\tcreatedAt\x88\x01\x01\x12+\n" +
+	"\frequire_dpop\x18\t \x01(\bB\x03\xe0A\x01H\bR\vrequireDpop\x88\x01\x01B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\a\n" +
@@ -270,12 +284,14 @@ const file_agntcy_identity_service_v1alpha1_app_proto_rawDesc = "" +
 	"\n" +
 	"\b_api_keyB\t\n" +
 	"\a_statusB\r\n" +
-	"\v_created_at*n\n" +
+	"\v_created_atB\x0f\n" +
+	"\r_require_dpop*\x88\x01\n" +
 	"\tAppStatus\x12\x1a\n" +
 	"\x16APP_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11APP_STATUS_ACTIVE\x10\x01\x12\x16\n" +
 	"\x12APP_STATUS_PENDING\x10\x02\x12\x16\n" +
-	"\x12APP_STATUS_REVOKED\x10\x03*m\n" +
+	"\x12APP_STATUS_REVOKED\x10\x03\x12\x18\n" +
+	"\x14APP_STATUS_SUSPENDED\x10\x04*m\n" +
 	"\aAppType\x12\x18\n" +
 	"\x14APP_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12APP_TYPE_AGENT_A2A\x10\x01\x12\x17\n" +
@@ -15,6 +15,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -80,9 +81,18 @@ type AuthorizeRequest struct {
 	ToolName *string `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
 	// The User context in the form of an id or access token.
 	// Mandatory for User Approval Flows.
-	UserToken     *string `protobuf:"bytes,3,opt,name=user_token,json=userToken,proto3,oneof" json:"user_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UserToken *string `protobuf:"bytes,3,opt,name=user_token,json=userToken,proto3,oneof" json:"user_token,omitempty"`
+	// A DPoP proof for this request, binding the session to the key of the proof.
+	// Mandatory for the Apps requiring DPoP.
+	DpopProof *string `protobuf:"bytes,4,opt,name=dpop_proof,json=dpopProof,proto3,oneof" json:"dpop_proof,omitempty"`
+	// A PKCE code challenge (RFC 7636) binding the authorization code
+	// to a code verifier known only to the caller.
+	CodeChallenge *string `protobuf:"bytes,5,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge,omitempty"`
+	// The method of the code challenge, either S256 or plain.
+	// Defaults to plain.
+	CodeChallengeMethod *string `protobuf:"bytes,6,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *AuthorizeRequest) Reset() {
@@ -136,6 +146,27 @@ func (x *AuthorizeRequest) GetUserToken() string {
 	return ""
 }
 
+func (x *AuthorizeRequest) GetDpopProof() string {
+	if x != nil && x.DpopProof != nil {
+		return *x.DpopProof
+	}
+	return ""
+}
+
+func (x *AuthorizeRequest) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *AuthorizeRequest) GetCodeChallengeMethod() string {
+	if x != nil && x.CodeChallengeMethod != nil {
+		return *x.CodeChallengeMethod
+	}
+	return ""
+}
+
 type AuthorizeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// If authorization is successful, return a code to be used for
@@ -186,8 +217,14 @@ type TokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Pass the code received from the authorization endpoint.
 	AuthorizationCode string `protobuf:"bytes,1,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// A DPoP proof for this request, binding the access token to the key of the proof.
+	// Mandatory when a DPoP proof was provided to the authorization endpoint.
+	DpopProof *string `protobuf:"bytes,2,opt,name=dpop_proof,json=dpopProof,proto3,oneof" json:"dpop_proof,omitempty"`
+	// The PKCE code verifier matching the code challenge.
+	// Mandatory when a code challenge was provided to the authorization endpoint.
+	CodeVerifier  *string `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3,oneof" json:"code_verifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TokenRequest) Reset() {
@@ -227,10 +264,26 @@ func (x *TokenRequest) GetAuthorizationCode() string {
 	return ""
 }
 
+func (x *TokenRequest) GetDpopProof() string {
+	if x != nil && x.DpopProof != nil {
+		return *x.DpopProof
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
 type TokenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The access token issued to the Agent or MCP Server.
-	AccessToken   string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The type of the access token, either Bearer or DPoP.
+	TokenType     string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -272,19 +325,108 @@ func (x *TokenResponse) GetAccessToken() string {
 	return ""
 }
 
+func (x *TokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+// A DPoP proof forwarded by a resource server, along with
+// the HTTP request it was received with.
+type DPoPProof struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The DPoP proof, as received in the DPoP header. The proofs carry the nonces
+	// issued by the Identity Service in the DPoP-Nonce header of its responses.
+	Proof string `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// The HTTP method of the request.
+	HttpMethod string `protobuf:"bytes,2,opt,name=http_method,json=httpMethod,proto3" json:"http_method,omitempty"`
+	// The HTTP URL of the request.
+	HttpUrl       string `protobuf:"bytes,3,opt,name=http_url,json=httpUrl,proto3" json:"http_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DPoPProof) Reset() {
+	*x = DPoPProof{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DPoPProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DPoPProof) ProtoMessage() {}
+
+func (x *DPoPProof) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DPoPProof.ProtoReflect.Descriptor instead.
+func (*DPoPProof) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DPoPProof) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+func (x *DPoPProof) GetHttpMethod() string {
+	if x != nil {
+		return x.HttpMethod
+	}
+	return ""
+}
+
+func (x *DPoPProof) GetHttpUrl() string {
+	if x != nil {
+		return x.HttpUrl
+	}
+	return ""
+}
+
 type ExtAuthzRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The access token to be authorized.
 	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
 	// The tool name that will be invoked
-	ToolName      *string `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	ToolName *string `protobuf:"bytes,2,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	// The A2A skill ID that will be invoked.
+	// Cannot be combined with tool_name.
+	SkillId *string `protobuf:"bytes,3,opt,name=skill_id,json=skillId,proto3,oneof" json:"skill_id,omitempty"`
+	// Issue a signed receipt of the authorization.
+	IssueReceipt *bool `protobuf:"varint,4,opt,name=issue_receipt,json=issueReceipt,proto3,oneof" json:"issue_receipt,omitempty"`
+	// The forwarded request body, hashed into the receipt when issued.
+	Body *string `protobuf:"bytes,5,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// The transaction token received from the upstream call, if any.
+	// The transaction is validated and propagated to the response.
+	TransactionToken *string `protobuf:"bytes,6,opt,name=transaction_token,json=transactionToken,proto3,oneof" json:"transaction_token,omitempty"`
+	// The purpose of a new transaction.
+	// Starts a transaction when no transaction token is provided.
+	TransactionPurpose *string `protobuf:"bytes,7,opt,name=transaction_purpose,json=transactionPurpose,proto3,oneof" json:"transaction_purpose,omitempty"`
+	// The DPoP proof of the caller.
+	// Mandatory when the access token is bound to a DPoP key.
+	Dpop          *DPoPProof `protobuf:"bytes,8,opt,name=dpop,proto3,oneof" json:"dpop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExtAuthzRequest) Reset() {
 	*x = ExtAuthzRequest{}
-	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[5]
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +438,7 @@ func (x *ExtAuthzRequest) String() string {
 func (*ExtAuthzRequest) ProtoMessage() {}
 
 func (x *ExtAuthzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[5]
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +451,7 @@ func (x *ExtAuthzRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtAuthzRequest.ProtoReflect.Descriptor instead.
 func (*ExtAuthzRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{5}
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExtAuthzRequest) GetAccessToken() string {
@@ -326,35 +468,230 @@ func (x *ExtAuthzRequest) GetToolName() string {
 	return ""
 }
 
-type ApproveTokenRequest struct {
+func (x *ExtAuthzRequest) GetSkillId() string {
+	if x != nil && x.SkillId != nil {
+		return *x.SkillId
+	}
+	return ""
+}
+
+func (x *ExtAuthzRequest) GetIssueReceipt() bool {
+	if x != nil && x.IssueReceipt != nil {
+		return *x.IssueReceipt
+	}
+	return false
+}
+
+func (x *ExtAuthzRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *ExtAuthzRequest) GetTransactionToken() string {
+	if x != nil && x.TransactionToken != nil {
+		return *x.TransactionToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzRequest) GetTransactionPurpose() string {
+	if x != nil && x.TransactionPurpose != nil {
+		return *x.TransactionPurpose
+	}
+	return ""
+}
+
+func (x *ExtAuthzRequest) GetDpop() *DPoPProof {
+	if x != nil {
+		return x.Dpop
+	}
+	return nil
+}
+
+type ExtAuthzResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The device id used to handle the approval requestion
-	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	// The session id related to the token that needs to be approved
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// The OTP sent to the device related to the request
-	Otp string `protobuf:"bytes,3,opt,name=otp,proto3" json:"otp,omitempty"`
-	// The action made by the user (true: allow the token, false: deny the token)
-	Approve       bool `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+	// The ID of the calling application.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The name of the calling application.
+	AppName *string `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3,oneof" json:"app_name,omitempty"`
+	// The type of the calling application.
+	AppType AppType `protobuf:"varint,3,opt,name=app_type,json=appType,proto3,enum=agntcy.identity.service.v1alpha1.AppType" json:"app_type,omitempty"`
+	// The resolver metadata ID (DID) of the calling application.
+	ResolverMetadataId string `protobuf:"bytes,4,opt,name=resolver_metadata_id,json=resolverMetadataId,proto3" json:"resolver_metadata_id,omitempty"`
+	// The ID of the current badge of the calling application.
+	BadgeId *string `protobuf:"bytes,5,opt,name=badge_id,json=badgeId,proto3,oneof" json:"badge_id,omitempty"`
+	// The ID of the end user on behalf of whom the call is made.
+	UserId *string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// The ID of the session the access token belongs to.
+	SessionId string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The ID of the policy rule that granted access, if any.
+	RuleId *string `protobuf:"bytes,8,opt,name=rule_id,json=ruleId,proto3,oneof" json:"rule_id,omitempty"`
+	// The signed receipt of the authorization, when requested.
+	Receipt *Receipt `protobuf:"bytes,9,opt,name=receipt,proto3,oneof" json:"receipt,omitempty"`
+	// The ID of the transaction the call is part of, if any.
+	TransactionId *string `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// The transaction token to propagate to the downstream calls, if any.
+	TransactionToken *string `protobuf:"bytes,11,opt,name=transaction_token,json=transactionToken,proto3,oneof" json:"transaction_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExtAuthzResponse) Reset() {
+	*x = ExtAuthzResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzResponse) ProtoMessage() {}
+
+func (x *ExtAuthzResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzResponse.ProtoReflect.Descriptor instead.
+func (*ExtAuthzResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExtAuthzResponse) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetAppName() string {
+	if x != nil && x.AppName != nil {
+		return *x.AppName
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetAppType() AppType {
+	if x != nil {
+		return x.AppType
+	}
+	return AppType_APP_TYPE_UNSPECIFIED
+}
+
+func (x *ExtAuthzResponse) GetResolverMetadataId() string {
+	if x != nil {
+		return x.ResolverMetadataId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetBadgeId() string {
+	if x != nil && x.BadgeId != nil {
+		return *x.BadgeId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetRuleId() string {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ExtAuthzResponse) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *ExtAuthzResponse) GetTransactionToken() string {
+	if x != nil && x.TransactionToken != nil {
+		return *x.TransactionToken
+	}
+	return ""
+}
+
+// A signed proof that a caller was authorized to invoke
+// a tool of a callee at a specific time.
+type Receipt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique identifier for the Receipt.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the calling application.
+	CallerAppId string `protobuf:"bytes,2,opt,name=caller_app_id,json=callerAppId,proto3" json:"caller_app_id,omitempty"`
+	// The DID of the calling application.
+	CallerDid string `protobuf:"bytes,3,opt,name=caller_did,json=callerDid,proto3" json:"caller_did,omitempty"`
+	// The ID of the called application.
+	CalleeAppId string `protobuf:"bytes,4,opt,name=callee_app_id,json=calleeAppId,proto3" json:"callee_app_id,omitempty"`
+	// The DID of the called application.
+	CalleeDid string `protobuf:"bytes,5,opt,name=callee_did,json=calleeDid,proto3" json:"callee_did,omitempty"`
+	// The tool that the caller was authorized to invoke.
+	ToolName string `protobuf:"bytes,6,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	// The ID of the policy rule that granted access.
+	RuleId string `protobuf:"bytes,7,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// The ID of the device OTP used to approve the call, if any.
+	ApprovalId *string `protobuf:"bytes,8,opt,name=approval_id,json=approvalId,proto3,oneof" json:"approval_id,omitempty"`
+	// The base64url encoded SHA-256 hash of the request body, if any.
+	BodyHash *string `protobuf:"bytes,9,opt,name=body_hash,json=bodyHash,proto3,oneof" json:"body_hash,omitempty"`
+	// The JWT of the Receipt signed with the issuer key.
+	Token string `protobuf:"bytes,10,opt,name=token,proto3" json:"token,omitempty"`
+	// The creation time of the Receipt.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The expiration time of the Receipt.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveTokenRequest) Reset() {
-	*x = ApproveTokenRequest{}
-	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[6]
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveTokenRequest) String() string {
+func (x *Receipt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveTokenRequest) ProtoMessage() {}
+func (*Receipt) ProtoMessage() {}
 
-func (x *ApproveTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[6]
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,78 +702,1552 @@ func (x *ApproveTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveTokenRequest.ProtoReflect.Descriptor instead.
-func (*ApproveTokenRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ApproveTokenRequest) GetDeviceId() string {
+func (x *Receipt) GetId() string {
 	if x != nil {
-		return x.DeviceId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ApproveTokenRequest) GetSessionId() string {
+func (x *Receipt) GetCallerAppId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CallerAppId
 	}
 	return ""
 }
 
-func (x *ApproveTokenRequest) GetOtp() string {
+func (x *Receipt) GetCallerDid() string {
 	if x != nil {
-		return x.Otp
+		return x.CallerDid
 	}
 	return ""
 }
 
-func (x *ApproveTokenRequest) GetApprove() bool {
+func (x *Receipt) GetCalleeAppId() string {
 	if x != nil {
-		return x.Approve
+		return x.CalleeAppId
 	}
-	return false
+	return ""
 }
 
-var File_agntcy_identity_service_v1alpha1_auth_service_proto protoreflect.FileDescriptor
+func (x *Receipt) GetCalleeDid() string {
+	if x != nil {
+		return x.CalleeDid
+	}
+	return ""
+}
 
-const file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDesc = "" +
-	"\n" +
-	"3agntcy/identity/service/v1alpha1/auth_service.proto\x12 agntcy.identity.service.v1alpha1\x1a*agntcy/identity/service/v1alpha1/app.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"J\n" +
-	"\x0fAppInfoResponse\x127\n" +
-	"\x03app\x18\x01 \x01(\v2%.agntcy.identity.service.v1alpha1.AppR\x03app\"\xc5\x01\n" +
-	"\x10AuthorizeRequest\x125\n" +
-	"\x14resolver_metadata_id\x18\x01 \x01(\tH\x00R\x12resolverMetadataId\x88\x01\x01\x12 \n" +
-	"\ttool_name\x18\x02 \x01(\tH\x01R\btoolName\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"user_token\x18\x03 \x01(\tH\x02R\tuserToken\x88\x01\x01B\x17\n" +
-	"\x15_resolver_metadata_idB\f\n" +
-	"\n" +
-	"_tool_nameB\r\n" +
-	"\v_user_token\"B\n" +
-	"\x11AuthorizeResponse\x12-\n" +
-	"\x12authorization_code\x18\x01 \x01(\tR\x11authorizationCode\"=\n" +
-	"\fTokenRequest\x12-\n" +
-	"\x12authorization_code\x18\x01 \x01(\tR\x11authorizationCode\"2\n" +
-	"\rTokenResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"d\n" +
-	"\x0fExtAuthzRequest\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\ttool_name\x18\x02 \x01(\tH\x00R\btoolName\x88\x01\x01B\f\n" +
-	"\n" +
-	"_tool_name\"}\n" +
-	"\x13ApproveTokenRequest\x12\x1b\n" +
-	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x10\n" +
-	"\x03otp\x18\x03 \x01(\tR\x03otp\x12\x18\n" +
-	"\aapprove\x18\x04 \x01(\bR\aapprove2\xd2\a\n" +
-	"\vAuthService\x12\x8f\x01\n" +
-	"\aAppInfo\x12\x16.google.protobuf.Empty\x1a1.agntcy.identity.service.v1alpha1.AppInfoResponse\"9\x92A\x17\x12\fGet App Info*\aAppInfo\x82\xd3\xe4\x93\x02\x19\x12\x17/v1alpha1/auth/app_info\x12\xd8\x01\n" +
-	"\tAuthorize\x122.agntcy.identity.service.v1alpha1.AuthorizeRequest\x1a3.agntcy.identity.service.v1alpha1.AuthorizeResponse\"b\x92A<\x12/Authorize a request from an Agent or MCP Server*\tAuthorize\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1alpha1/auth/authorize\x12\xc4\x01\n" +
-	"\x05Token\x12..agntcy.identity.service.v1alpha1.TokenRequest\x1a/.agntcy.identity.service.v1alpha1.TokenResponse\"Z\x92A8\x12(Request token for an Agent or MCP Server*\fRequestToken\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/auth/token\x12\xaf\x01\n" +
-	"\bExtAuthz\x121.agntcy.identity.service.v1alpha1.ExtAuthzRequest\x1a\x16.google.protobuf.Empty\"X\x92A2\x12&Handle external authorization requests*\bExtAuthz\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1alpha1/auth/ext_authz\x12\xd1\x01\n" +
-	"\fApproveToken\x125.agntcy.identity.service.v1alpha1.ApproveTokenRequest\x1a\x16.google.protobuf.Empty\"r\x92AH\x128Handle manual approval of external authorization requets*\fApproveToken\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1alpha1/auth/approve_token\x1a\t\x92A\x06\n" +
+func (x *Receipt) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *Receipt) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *Receipt) GetApprovalId() string {
+	if x != nil && x.ApprovalId != nil {
+		return *x.ApprovalId
+	}
+	return ""
+}
+
+func (x *Receipt) GetBodyHash() string {
+	if x != nil && x.BodyHash != nil {
+		return *x.BodyHash
+	}
+	return ""
+}
+
+func (x *Receipt) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Receipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetReceiptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the receipt.
+	ReceiptId     string `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetReceiptRequest) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+type IntrospectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token to introspect.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectRequest) Reset() {
+	*x = IntrospectRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectRequest) ProtoMessage() {}
+
+func (x *IntrospectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IntrospectRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// The state of an access token, as defined by RFC 7662.
+// Only the active field is set for inactive tokens.
+type IntrospectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the access token is active.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// The ID of the application the access token was issued to.
+	ClientId *string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	// The tool the access token is restricted to, if any.
+	Scope *string `protobuf:"bytes,3,opt,name=scope,proto3,oneof" json:"scope,omitempty"`
+	// The type of the access token, either Bearer or DPoP.
+	TokenType *string `protobuf:"bytes,4,opt,name=token_type,json=tokenType,proto3,oneof" json:"token_type,omitempty"`
+	// The expiration time of the access token, in seconds since the epoch.
+	Exp *int64 `protobuf:"varint,5,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
+	// The issuance time of the access token, in seconds since the epoch.
+	Iat *int64 `protobuf:"varint,6,opt,name=iat,proto3,oneof" json:"iat,omitempty"`
+	// The ID of the user on behalf of whom the access token was issued, if any.
+	Sub *string `protobuf:"bytes,7,opt,name=sub,proto3,oneof" json:"sub,omitempty"`
+	// The ID of the application the access token is restricted to, if any.
+	Aud *string `protobuf:"bytes,8,opt,name=aud,proto3,oneof" json:"aud,omitempty"`
+	// The ID of the session of the access token.
+	Jti *string `protobuf:"bytes,9,opt,name=jti,proto3,oneof" json:"jti,omitempty"`
+	// The JWK SHA-256 thumbprint of the DPoP key the access token is bound to, if any.
+	DpopJkt       *string `protobuf:"bytes,10,opt,name=dpop_jkt,json=dpopJkt,proto3,oneof" json:"dpop_jkt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectResponse) Reset() {
+	*x = IntrospectResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectResponse) ProtoMessage() {}
+
+func (x *IntrospectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IntrospectResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectResponse) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetTokenType() string {
+	if x != nil && x.TokenType != nil {
+		return *x.TokenType
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetExp() int64 {
+	if x != nil && x.Exp != nil {
+		return *x.Exp
+	}
+	return 0
+}
+
+func (x *IntrospectResponse) GetIat() int64 {
+	if x != nil && x.Iat != nil {
+		return *x.Iat
+	}
+	return 0
+}
+
+func (x *IntrospectResponse) GetSub() string {
+	if x != nil && x.Sub != nil {
+		return *x.Sub
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetAud() string {
+	if x != nil && x.Aud != nil {
+		return *x.Aud
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetJti() string {
+	if x != nil && x.Jti != nil {
+		return *x.Jti
+	}
+	return ""
+}
+
+func (x *IntrospectResponse) GetDpopJkt() string {
+	if x != nil && x.DpopJkt != nil {
+		return *x.DpopJkt
+	}
+	return ""
+}
+
+type RevokeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token to revoke.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRequest) Reset() {
+	*x = RevokeRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRequest) ProtoMessage() {}
+
+func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// A session created by the authorization endpoint.
+// The tokens of the session are redacted.
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique identifier for the Session.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the App owning the Session.
+	OwnerAppId string `protobuf:"bytes,2,opt,name=owner_app_id,json=ownerAppId,proto3" json:"owner_app_id,omitempty"`
+	// The ID of the App the Session is restricted to, if any.
+	AppId *string `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3,oneof" json:"app_id,omitempty"`
+	// The tool the Session is restricted to, if any.
+	ToolName *string `protobuf:"bytes,4,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	// The ID of the user on behalf of whom the Session was created, if any.
+	UserId *string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// The redacted access token of the Session, once issued.
+	AccessToken *string `protobuf:"bytes,6,opt,name=access_token,json=accessToken,proto3,oneof" json:"access_token,omitempty"`
+	// The redacted authorization code of the Session.
+	AuthorizationCode *string `protobuf:"bytes,7,opt,name=authorization_code,json=authorizationCode,proto3,oneof" json:"authorization_code,omitempty"`
+	// The creation time of the Session.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The expiration time of the Session, if any.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	// The ID of the last transaction the Session took part in, if any.
+	TransactionId *string `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// The JWK SHA-256 thumbprint of the DPoP key the Session is bound to, if any.
+	DpopJkt *string `protobuf:"bytes,11,opt,name=dpop_jkt,json=dpopJkt,proto3,oneof" json:"dpop_jkt,omitempty"`
+	// Whether the Session has not expired yet.
+	Active        bool `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetOwnerAppId() string {
+	if x != nil {
+		return x.OwnerAppId
+	}
+	return ""
+}
+
+func (x *Session) GetAppId() string {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return ""
+}
+
+func (x *Session) GetToolName() string {
+	if x != nil && x.ToolName != nil {
+		return *x.ToolName
+	}
+	return ""
+}
+
+func (x *Session) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Session) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *Session) GetAuthorizationCode() string {
+	if x != nil && x.AuthorizationCode != nil {
+		return *x.AuthorizationCode
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *Session) GetDpopJkt() string {
+	if x != nil && x.DpopJkt != nil {
+		return *x.DpopJkt
+	}
+	return ""
+}
+
+func (x *Session) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current page of the pagination
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// The page size of the pagination
+	Size *int32 `protobuf:"varint,2,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	// The ID of the App owning the sessions
+	OwnerAppId *string `protobuf:"bytes,3,opt,name=owner_app_id,json=ownerAppId,proto3,oneof" json:"owner_app_id,omitempty"`
+	// The ID of the App the sessions are restricted to
+	AppId *string `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3,oneof" json:"app_id,omitempty"`
+	// The tool the sessions are restricted to
+	ToolName *string `protobuf:"bytes,5,opt,name=tool_name,json=toolName,proto3,oneof" json:"tool_name,omitempty"`
+	// The ID of the user on behalf of whom the sessions were created
+	UserId *string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// Only return the active (true) or the expired (false) sessions
+	Active        *bool `protobuf:"varint,7,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSessionsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetOwnerAppId() string {
+	if x != nil && x.OwnerAppId != nil {
+		return *x.OwnerAppId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetAppId() string {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetToolName() string {
+	if x != nil && x.ToolName != nil {
+		return *x.ToolName
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A list of Sessions.
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// Pagination response.
+	Pagination    *PagedResponse `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetPagination() *PagedResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the session.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the session.
+	SessionId     string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeAllSessionsForAppRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the App.
+	AppId         string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsForAppRequest) Reset() {
+	*x = RevokeAllSessionsForAppRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsForAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsForAppRequest) ProtoMessage() {}
+
+func (x *RevokeAllSessionsForAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsForAppRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsForAppRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeAllSessionsForAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type RevokeAllSessionsForAppResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of revoked sessions.
+	RevokedSessions int32 `protobuf:"varint,1,opt,name=revoked_sessions,json=revokedSessions,proto3" json:"revoked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevokeAllSessionsForAppResponse) Reset() {
+	*x = RevokeAllSessionsForAppResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllSessionsForAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllSessionsForAppResponse) ProtoMessage() {}
+
+func (x *RevokeAllSessionsForAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllSessionsForAppResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllSessionsForAppResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeAllSessionsForAppResponse) GetRevokedSessions() int32 {
+	if x != nil {
+		return x.RevokedSessions
+	}
+	return 0
+}
+
+type ExtAuthzMcpRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token to be authorized.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The forwarded request body containing one or more MCP JSON-RPC messages,
+	// either as a JSON document (single message or batch) or as an SSE stream.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// The content type of the forwarded request body.
+	ContentType *string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	// The transaction token received from the upstream call, if any.
+	// The transaction is validated and propagated to the response.
+	TransactionToken *string `protobuf:"bytes,4,opt,name=transaction_token,json=transactionToken,proto3,oneof" json:"transaction_token,omitempty"`
+	// The DPoP proof of the caller.
+	// Mandatory when the access token is bound to a DPoP key.
+	Dpop *DPoPProof `protobuf:"bytes,5,opt,name=dpop,proto3,oneof" json:"dpop,omitempty"`
+	// Issue a signed receipt of the authorization of each tool call (or skill invocation),
+	// the body being hashed into the receipts. The response carries the last receipt.
+	IssueReceipt  *bool `protobuf:"varint,6,opt,name=issue_receipt,json=issueReceipt,proto3,oneof" json:"issue_receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzMcpRequest) Reset() {
+	*x = ExtAuthzMcpRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzMcpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzMcpRequest) ProtoMessage() {}
+
+func (x *ExtAuthzMcpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzMcpRequest.ProtoReflect.Descriptor instead.
+func (*ExtAuthzMcpRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExtAuthzMcpRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzMcpRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ExtAuthzMcpRequest) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ExtAuthzMcpRequest) GetTransactionToken() string {
+	if x != nil && x.TransactionToken != nil {
+		return *x.TransactionToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzMcpRequest) GetDpop() *DPoPProof {
+	if x != nil {
+		return x.Dpop
+	}
+	return nil
+}
+
+func (x *ExtAuthzMcpRequest) GetIssueReceipt() bool {
+	if x != nil && x.IssueReceipt != nil {
+		return *x.IssueReceipt
+	}
+	return false
+}
+
+type ExtAuthzMcpToolsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token of the caller.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The names of the tools exposed by the MCP Server.
+	ToolNames     []string `protobuf:"bytes,2,rep,name=tool_names,json=toolNames,proto3" json:"tool_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzMcpToolsRequest) Reset() {
+	*x = ExtAuthzMcpToolsRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzMcpToolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzMcpToolsRequest) ProtoMessage() {}
+
+func (x *ExtAuthzMcpToolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzMcpToolsRequest.ProtoReflect.Descriptor instead.
+func (*ExtAuthzMcpToolsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExtAuthzMcpToolsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzMcpToolsRequest) GetToolNames() []string {
+	if x != nil {
+		return x.ToolNames
+	}
+	return nil
+}
+
+type ExtAuthzMcpToolsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the tools the caller is allowed to invoke.
+	ToolNames     []string `protobuf:"bytes,1,rep,name=tool_names,json=toolNames,proto3" json:"tool_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzMcpToolsResponse) Reset() {
+	*x = ExtAuthzMcpToolsResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzMcpToolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzMcpToolsResponse) ProtoMessage() {}
+
+func (x *ExtAuthzMcpToolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzMcpToolsResponse.ProtoReflect.Descriptor instead.
+func (*ExtAuthzMcpToolsResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExtAuthzMcpToolsResponse) GetToolNames() []string {
+	if x != nil {
+		return x.ToolNames
+	}
+	return nil
+}
+
+type ExtAuthzA2ARequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token to be authorized.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The forwarded request body containing one or more A2A JSON-RPC messages.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// The content type of the forwarded request body.
+	ContentType *string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	// The transaction token received from the upstream call, if any.
+	// The transaction is validated and propagated to the response.
+	TransactionToken *string `protobuf:"bytes,4,opt,name=transaction_token,json=transactionToken,proto3,oneof" json:"transaction_token,omitempty"`
+	// The DPoP proof of the caller.
+	// Mandatory when the access token is bound to a DPoP key.
+	Dpop *DPoPProof `protobuf:"bytes,5,opt,name=dpop,proto3,oneof" json:"dpop,omitempty"`
+	// Issue a signed receipt of the authorization of each tool call (or skill invocation),
+	// the body being hashed into the receipts. The response carries the last receipt.
+	IssueReceipt  *bool `protobuf:"varint,6,opt,name=issue_receipt,json=issueReceipt,proto3,oneof" json:"issue_receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzA2ARequest) Reset() {
+	*x = ExtAuthzA2ARequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzA2ARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzA2ARequest) ProtoMessage() {}
+
+func (x *ExtAuthzA2ARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzA2ARequest.ProtoReflect.Descriptor instead.
+func (*ExtAuthzA2ARequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExtAuthzA2ARequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzA2ARequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ExtAuthzA2ARequest) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ExtAuthzA2ARequest) GetTransactionToken() string {
+	if x != nil && x.TransactionToken != nil {
+		return *x.TransactionToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzA2ARequest) GetDpop() *DPoPProof {
+	if x != nil {
+		return x.Dpop
+	}
+	return nil
+}
+
+func (x *ExtAuthzA2ARequest) GetIssueReceipt() bool {
+	if x != nil && x.IssueReceipt != nil {
+		return *x.IssueReceipt
+	}
+	return false
+}
+
+type ExtAuthzA2ASkillsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token of the caller.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The ids of the skills listed in the agent card.
+	SkillIds      []string `protobuf:"bytes,2,rep,name=skill_ids,json=skillIds,proto3" json:"skill_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzA2ASkillsRequest) Reset() {
+	*x = ExtAuthzA2ASkillsRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzA2ASkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzA2ASkillsRequest) ProtoMessage() {}
+
+func (x *ExtAuthzA2ASkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzA2ASkillsRequest.ProtoReflect.Descriptor instead.
+func (*ExtAuthzA2ASkillsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExtAuthzA2ASkillsRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ExtAuthzA2ASkillsRequest) GetSkillIds() []string {
+	if x != nil {
+		return x.SkillIds
+	}
+	return nil
+}
+
+type ExtAuthzA2ASkillsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ids of the skills the caller is allowed to use.
+	SkillIds      []string `protobuf:"bytes,1,rep,name=skill_ids,json=skillIds,proto3" json:"skill_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtAuthzA2ASkillsResponse) Reset() {
+	*x = ExtAuthzA2ASkillsResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtAuthzA2ASkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtAuthzA2ASkillsResponse) ProtoMessage() {}
+
+func (x *ExtAuthzA2ASkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtAuthzA2ASkillsResponse.ProtoReflect.Descriptor instead.
+func (*ExtAuthzA2ASkillsResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExtAuthzA2ASkillsResponse) GetSkillIds() []string {
+	if x != nil {
+		return x.SkillIds
+	}
+	return nil
+}
+
+type ApproveTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The device id used to handle the approval requestion
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// The session id related to the token that needs to be approved
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The OTP sent to the device related to the request
+	Otp string `protobuf:"bytes,3,opt,name=otp,proto3" json:"otp,omitempty"`
+	// The action made by the user (true: allow the token, false: deny the token)
+	Approve       bool `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTokenRequest) Reset() {
+	*x = ApproveTokenRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTokenRequest) ProtoMessage() {}
+
+func (x *ApproveTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTokenRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTokenRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApproveTokenRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+var File_agntcy_identity_service_v1alpha1_auth_service_proto protoreflect.FileDescriptor
+
+const file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDesc = "" +
+	"\n" +
+	"3agntcy/identity/service/v1alpha1/auth_service.proto\x12 agntcy.identity.service.v1alpha1\x1a*agntcy/identity/service/v1alpha1/app.proto\x1a1agntcy/identity/service/v1alpha1/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"J\n" +
+	"\x0fAppInfoResponse\x127\n" +
+	"\x03app\x18\x01 \x01(\v2%.agntcy.identity.service.v1alpha1.AppR\x03app\"\x8a\x03\n" +
+	"\x10AuthorizeRequest\x125\n" +
+	"\x14resolver_metadata_id\x18\x01 \x01(\tH\x00R\x12resolverMetadataId\x88\x01\x01\x12 \n" +
+	"\ttool_name\x18\x02 \x01(\tH\x01R\btoolName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_token\x18\x03 \x01(\tH\x02R\tuserToken\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"dpop_proof\x18\x04 \x01(\tH\x03R\tdpopProof\x88\x01\x01\x12*\n" +
+	"\x0ecode_challenge\x18\x05 \x01(\tH\x04R\rcodeChallenge\x88\x01\x01\x127\n" +
+	"\x15code_challenge_method\x18\x06 \x01(\tH\x05R\x13codeChallengeMethod\x88\x01\x01B\x17\n" +
+	"\x15_resolver_metadata_idB\f\n" +
+	"\n" +
+	"_tool_nameB\r\n" +
+	"\v_user_tokenB\r\n" +
+	"\v_dpop_proofB\x11\n" +
+	"\x0f_code_challengeB\x18\n" +
+	"\x16_code_challenge_method\"B\n" +
+	"\x11AuthorizeResponse\x12-\n" +
+	"\x12authorization_code\x18\x01 \x01(\tR\x11authorizationCode\"\xac\x01\n" +
+	"\fTokenRequest\x12-\n" +
+	"\x12authorization_code\x18\x01 \x01(\tR\x11authorizationCode\x12\"\n" +
+	"\n" +
+	"dpop_proof\x18\x02 \x01(\tH\x00R\tdpopProof\x88\x01\x01\x12(\n" +
+	"\rcode_verifier\x18\x03 \x01(\tH\x01R\fcodeVerifier\x88\x01\x01B\r\n" +
+	"\v_dpop_proofB\x10\n" +
+	"\x0e_code_verifier\"Q\n" +
+	"\rTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\"]\n" +
+	"\tDPoPProof\x12\x14\n" +
+	"\x05proof\x18\x01 \x01(\tR\x05proof\x12\x1f\n" +
+	"\vhttp_method\x18\x02 \x01(\tR\n" +
+	"httpMethod\x12\x19\n" +
+	"\bhttp_url\x18\x03 \x01(\tR\ahttpUrl\"\xd4\x03\n" +
+	"\x0fExtAuthzRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
+	"\ttool_name\x18\x02 \x01(\tH\x00R\btoolName\x88\x01\x01\x12\x1e\n" +
+	"\bskill_id\x18\x03 \x01(\tH\x01R\askillId\x88\x01\x01\x12(\n" +
+	"\rissue_receipt\x18\x04 \x01(\bH\x02R\fissueReceipt\x88\x01\x01\x12\x17\n" +
+	"\x04body\x18\x05 \x01(\tH\x03R\x04body\x88\x01\x01\x120\n" +
+	"\x11transaction_token\x18\x06 \x01(\tH\x04R\x10transactionToken\x88\x01\x01\x124\n" +
+	"\x13transaction_purpose\x18\a \x01(\tH\x05R\x12transactionPurpose\x88\x01\x01\x12D\n" +
+	"\x04dpop\x18\b \x01(\v2+.agntcy.identity.service.v1alpha1.DPoPProofH\x06R\x04dpop\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tool_nameB\v\n" +
+	"\t_skill_idB\x10\n" +
+	"\x0e_issue_receiptB\a\n" +
+	"\x05_bodyB\x14\n" +
+	"\x12_transaction_tokenB\x16\n" +
+	"\x14_transaction_purposeB\a\n" +
+	"\x05_dpop\"\xcb\x04\n" +
+	"\x10ExtAuthzResponse\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1e\n" +
+	"\bapp_name\x18\x02 \x01(\tH\x00R\aappName\x88\x01\x01\x12D\n" +
+	"\bapp_type\x18\x03 \x01(\x0e2).agntcy.identity.service.v1alpha1.AppTypeR\aappType\x120\n" +
+	"\x14resolver_metadata_id\x18\x04 \x01(\tR\x12resolverMetadataId\x12\x1e\n" +
+	"\bbadge_id\x18\x05 \x01(\tH\x01R\abadgeId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x06 \x01(\tH\x02R\x06userId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tR\tsessionId\x12\x1c\n" +
+	"\arule_id\x18\b \x01(\tH\x03R\x06ruleId\x88\x01\x01\x12H\n" +
+	"\areceipt\x18\t \x01(\v2).agntcy.identity.service.v1alpha1.ReceiptH\x04R\areceipt\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tH\x05R\rtransactionId\x88\x01\x01\x120\n" +
+	"\x11transaction_token\x18\v \x01(\tH\x06R\x10transactionToken\x88\x01\x01B\v\n" +
+	"\t_app_nameB\v\n" +
+	"\t_badge_idB\n" +
+	"\n" +
+	"\b_user_idB\n" +
+	"\n" +
+	"\b_rule_idB\n" +
+	"\n" +
+	"\b_receiptB\x11\n" +
+	"\x0f_transaction_idB\x14\n" +
+	"\x12_transaction_token\"\xc7\x03\n" +
+	"\aReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rcaller_app_id\x18\x02 \x01(\tR\vcallerAppId\x12\x1d\n" +
+	"\n" +
+	"caller_did\x18\x03 \x01(\tR\tcallerDid\x12\"\n" +
+	"\rcallee_app_id\x18\x04 \x01(\tR\vcalleeAppId\x12\x1d\n" +
+	"\n" +
+	"callee_did\x18\x05 \x01(\tR\tcalleeDid\x12\x1b\n" +
+	"\ttool_name\x18\x06 \x01(\tR\btoolName\x12\x17\n" +
+	"\arule_id\x18\a \x01(\tR\x06ruleId\x12$\n" +
+	"\vapproval_id\x18\b \x01(\tH\x00R\n" +
+	"approvalId\x88\x01\x01\x12 \n" +
+	"\tbody_hash\x18\t \x01(\tH\x01R\bbodyHash\x88\x01\x01\x12\x14\n" +
+	"\x05token\x18\n" +
+	" \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtB\x0e\n" +
+	"\f_approval_idB\f\n" +
+	"\n" +
+	"_body_hash\"2\n" +
+	"\x11GetReceiptRequest\x12\x1d\n" +
+	"\n" +
+	"receipt_id\x18\x01 \x01(\tR\treceiptId\")\n" +
+	"\x11IntrospectRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xfc\x02\n" +
+	"\x12IntrospectResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x19\n" +
+	"\x05scope\x18\x03 \x01(\tH\x01R\x05scope\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"token_type\x18\x04 \x01(\tH\x02R\ttokenType\x88\x01\x01\x12\x15\n" +
+	"\x03exp\x18\x05 \x01(\x03H\x03R\x03exp\x88\x01\x01\x12\x15\n" +
+	"\x03iat\x18\x06 \x01(\x03H\x04R\x03iat\x88\x01\x01\x12\x15\n" +
+	"\x03sub\x18\a \x01(\tH\x05R\x03sub\x88\x01\x01\x12\x15\n" +
+	"\x03aud\x18\b \x01(\tH\x06R\x03aud\x88\x01\x01\x12\x15\n" +
+	"\x03jti\x18\t \x01(\tH\aR\x03jti\x88\x01\x01\x12\x1e\n" +
+	"\bdpop_jkt\x18\n" +
+	" \x01(\tH\bR\adpopJkt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\b\n" +
+	"\x06_scopeB\r\n" +
+	"\v_token_typeB\x06\n" +
+	"\x04_expB\x06\n" +
+	"\x04_iatB\x06\n" +
+	"\x04_subB\x06\n" +
+	"\x04_audB\x06\n" +
+	"\x04_jtiB\v\n" +
+	"\t_dpop_jkt\"%\n" +
+	"\rRevokeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xce\x04\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\fowner_app_id\x18\x02 \x01(\tR\n" +
+	"ownerAppId\x12\x1a\n" +
+	"\x06app_id\x18\x03 \x01(\tH\x00R\x05appId\x88\x01\x01\x12 \n" +
+	"\ttool_name\x18\x04 \x01(\tH\x01R\btoolName\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x05 \x01(\tH\x02R\x06userId\x88\x01\x01\x12&\n" +
+	"\faccess_token\x18\x06 \x01(\tH\x03R\vaccessToken\x88\x01\x01\x122\n" +
+	"\x12authorization_code\x18\a \x01(\tH\x04R\x11authorizationCode\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x05R\texpiresAt\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\tH\x06R\rtransactionId\x88\x01\x01\x12\x1e\n" +
+	"\bdpop_jkt\x18\v \x01(\tH\aR\adpopJkt\x88\x01\x01\x12\x16\n" +
+	"\x06active\x18\f \x01(\bR\x06activeB\t\n" +
+	"\a_app_idB\f\n" +
+	"\n" +
+	"_tool_nameB\n" +
+	"\n" +
+	"\b_user_idB\x0f\n" +
+	"\r_access_tokenB\x15\n" +
+	"\x13_authorization_codeB\r\n" +
+	"\v_expires_atB\x11\n" +
+	"\x0f_transaction_idB\v\n" +
+	"\t_dpop_jkt\"\xba\x02\n" +
+	"\x13ListSessionsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x05H\x01R\x04size\x88\x01\x01\x12%\n" +
+	"\fowner_app_id\x18\x03 \x01(\tH\x02R\n" +
+	"ownerAppId\x88\x01\x01\x12\x1a\n" +
+	"\x06app_id\x18\x04 \x01(\tH\x03R\x05appId\x88\x01\x01\x12 \n" +
+	"\ttool_name\x18\x05 \x01(\tH\x04R\btoolName\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x06 \x01(\tH\x05R\x06userId\x88\x01\x01\x12\x1b\n" +
+	"\x06active\x18\a \x01(\bH\x06R\x06active\x88\x01\x01B\a\n" +
+	"\x05_pageB\a\n" +
+	"\x05_sizeB\x0f\n" +
+	"\r_owner_app_idB\t\n" +
+	"\a_app_idB\f\n" +
+	"\n" +
+	"_tool_nameB\n" +
+	"\n" +
+	"\b_user_idB\t\n" +
+	"\a_active\"\xc2\x01\n" +
+	"\x14ListSessionsResponse\x12E\n" +
+	"\bsessions\x18\x01 \x03(\v2).agntcy.identity.service.v1alpha1.SessionR\bsessions\x12T\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2/.agntcy.identity.service.v1alpha1.PagedResponseH\x00R\n" +
+	"pagination\x88\x01\x01B\r\n" +
+	"\v_pagination\"2\n" +
+	"\x11GetSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"7\n" +
+	"\x1eRevokeAllSessionsForAppRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"L\n" +
+	"\x1fRevokeAllSessionsForAppResponse\x12)\n" +
+	"\x10revoked_sessions\x18\x01 \x01(\x05R\x0frevokedSessions\"\xd7\x02\n" +
+	"\x12ExtAuthzMcpRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12&\n" +
+	"\fcontent_type\x18\x03 \x01(\tH\x00R\vcontentType\x88\x01\x01\x120\n" +
+	"\x11transaction_token\x18\x04 \x01(\tH\x01R\x10transactionToken\x88\x01\x01\x12D\n" +
+	"\x04dpop\x18\x05 \x01(\v2+.agntcy.identity.service.v1alpha1.DPoPProofH\x02R\x04dpop\x88\x01\x01\x12(\n" +
+	"\rissue_receipt\x18\x06 \x01(\bH\x03R\fissueReceipt\x88\x01\x01B\x0f\n" +
+	"\r_content_typeB\x14\n" +
+	"\x12_transaction_tokenB\a\n" +
+	"\x05_dpopB\x10\n" +
+	"\x0e_issue_receipt\"[\n" +
+	"\x17ExtAuthzMcpToolsRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"tool_names\x18\x02 \x03(\tR\ttoolNames\"9\n" +
+	"\x18ExtAuthzMcpToolsResponse\x12\x1d\n" +
+	"\n" +
+	"tool_names\x18\x01 \x03(\tR\ttoolNames\"\xd7\x02\n" +
+	"\x12ExtAuthzA2ARequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12&\n" +
+	"\fcontent_type\x18\x03 \x01(\tH\x00R\vcontentType\x88\x01\x01\x120\n" +
+	"\x11transaction_token\x18\x04 \x01(\tH\x01R\x10transactionToken\x88\x01\x01\x12D\n" +
+	"\x04dpop\x18\x05 \x01(\v2+.agntcy.identity.service.v1alpha1.DPoPProofH\x02R\x04dpop\x88\x01\x01\x12(\n" +
+	"\rissue_receipt\x18\x06 \x01(\bH\x03R\fissueReceipt\x88\x01\x01B\x0f\n" +
+	"\r_content_typeB\x14\n" +
+	"\x12_transaction_tokenB\a\n" +
+	"\x05_dpopB\x10\n" +
+	"\x0e_issue_receipt\"Z\n" +
+	"\x18ExtAuthzA2ASkillsRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1b\n" +
+	"\tskill_ids\x18\x02 \x03(\tR\bskillIds\"8\n" +
+	"\x19ExtAuthzA2ASkillsResponse\x12\x1b\n" +
+	"\tskill_ids\x18\x01 \x03(\tR\bskillIds\"}\n" +
+	"\x13ApproveTokenRequest\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x10\n" +
+	"\x03otp\x18\x03 \x01(\tR\x03otp\x12\x18\n" +
+	"\aapprove\x18\x04 \x01(\bR\aapprove2\xa8\x1b\n" +
+	"\vAuthService\x12\x8f\x01\n" +
+	"\aAppInfo\x12\x16.google.protobuf.Empty\x1a1.agntcy.identity.service.v1alpha1.AppInfoResponse\"9\x92A\x17\x12\fGet App Info*\aAppInfo\x82\xd3\xe4\x93\x02\x19\x12\x17/v1alpha1/auth/app_info\x12\xd8\x01\n" +
+	"\tAuthorize\x122.agntcy.identity.service.v1alpha1.AuthorizeRequest\x1a3.agntcy.identity.service.v1alpha1.AuthorizeResponse\"b\x92A<\x12/Authorize a request from an Agent or MCP Server*\tAuthorize\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1alpha1/auth/authorize\x12\xc4\x01\n" +
+	"\x05Token\x12..agntcy.identity.service.v1alpha1.TokenRequest\x1a/.agntcy.identity.service.v1alpha1.TokenResponse\"Z\x92A8\x12(Request token for an Agent or MCP Server*\fRequestToken\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1alpha1/auth/token\x12\xcb\x01\n" +
+	"\bExtAuthz\x121.agntcy.identity.service.v1alpha1.ExtAuthzRequest\x1a2.agntcy.identity.service.v1alpha1.ExtAuthzResponse\"X\x92A2\x12&Handle external authorization requests*\bExtAuthz\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1alpha1/auth/ext_authz\x12\xe8\x01\n" +
+	"\vExtAuthzMcp\x124.agntcy.identity.service.v1alpha1.ExtAuthzMcpRequest\x1a2.agntcy.identity.service.v1alpha1.ExtAuthzResponse\"o\x92AE\x126Handle external authorization requests for MCP Servers*\vExtAuthzMcp\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1alpha1/auth/ext_authz/mcp\x12\x89\x02\n" +
+	"\x10ExtAuthzMcpTools\x129.agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsRequest\x1a:.agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsResponse\"~\x92AN\x12:Filter the tools of an MCP Server down to the allowed ones*\x10ExtAuthzMcpTools\x82\xd3\xe4\x93\x02':\x01*\"\"/v1alpha1/auth/ext_authz/mcp/tools\x12\xe7\x01\n" +
+	"\vExtAuthzA2A\x124.agntcy.identity.service.v1alpha1.ExtAuthzA2ARequest\x1a2.agntcy.identity.service.v1alpha1.ExtAuthzResponse\"n\x92AD\x125Handle external authorization requests for A2A agents*\vExtAuthzA2A\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1alpha1/auth/ext_authz/a2a\x12\x8f\x02\n" +
+	"\x11ExtAuthzA2ASkills\x12:.agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsRequest\x1a;.agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsResponse\"\x80\x01\x92AO\x12:Filter the skills of an A2A agent down to the allowed ones*\x11ExtAuthzA2ASkills\x82\xd3\xe4\x93\x02(:\x01*\"#/v1alpha1/auth/ext_authz/a2a/skills\x12\xd1\x01\n" +
+	"\fApproveToken\x125.agntcy.identity.service.v1alpha1.ApproveTokenRequest\x1a\x16.google.protobuf.Empty\"r\x92AH\x128Handle manual approval of external authorization requets*\fApproveToken\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1alpha1/auth/approve_token\x12\xdd\x01\n" +
+	"\n" +
+	"GetReceipt\x123.agntcy.identity.service.v1alpha1.GetReceiptRequest\x1a).agntcy.identity.service.v1alpha1.Receipt\"o\x92A@\x122Get a receipt issued by the external authorization*\n" +
+	"GetReceipt\x82\xd3\xe4\x93\x02&\x12$/v1alpha1/auth/receipts/{receipt_id}\x12\xc8\x01\n" +
+	"\n" +
+	"Introspect\x123.agntcy.identity.service.v1alpha1.IntrospectRequest\x1a4.agntcy.identity.service.v1alpha1.IntrospectResponse\"O\x92A(\x12\x1aIntrospect an access token*\n" +
+	"Introspect\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1alpha1/auth/introspect\x12\x96\x01\n" +
+	"\x06Revoke\x12/.agntcy.identity.service.v1alpha1.RevokeRequest\x1a\x16.google.protobuf.Empty\"C\x92A \x12\x16Revoke an access token*\x06Revoke\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1alpha1/auth/revoke\x12\xd0\x01\n" +
+	"\fListSessions\x125.agntcy.identity.service.v1alpha1.ListSessionsRequest\x1a6.agntcy.identity.service.v1alpha1.ListSessionsResponse\"Q\x92A/\x12\x1fList the sessions of the tenant*\fListSessions\x82\xd3\xe4\x93\x02\x19\x12\x17/v1alpha1/auth/sessions\x12\xc6\x01\n" +
+	"\n" +
+	"GetSession\x123.agntcy.identity.service.v1alpha1.GetSessionRequest\x1a).agntcy.identity.service.v1alpha1.Session\"X\x92A)\x12\x1bGet a session of the tenant*\n" +
+	"GetSession\x82\xd3\xe4\x93\x02&\x12$/v1alpha1/auth/sessions/{session_id}\x12\xc6\x01\n" +
+	"\rRevokeSession\x126.agntcy.identity.service.v1alpha1.RevokeSessionRequest\x1a\x16.google.protobuf.Empty\"e\x92A/\x12\x1eRevoke a session of the tenant*\rRevokeSession\x82\xd3\xe4\x93\x02-\"+/v1alpha1/auth/sessions/{session_id}/revoke\x12\x9b\x02\n" +
+	"\x17RevokeAllSessionsForApp\x12@.agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppRequest\x1aA.agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppResponse\"{\x92AI\x12.Revoke all the active sessions owned by an App*\x17RevokeAllSessionsForApp\x82\xd3\xe4\x93\x02)\"'/v1alpha1/apps/{app_id}/sessions/revoke\x1a\t\x92A\x06\n" +
 	"\x04AuthBhZfgithub.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1;identity_service_sdk_gob\x06proto3"
 
 var (
@@ -451,35 +2262,91 @@ func file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescGZIP() []by
 	return file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDescData
 }
 
-var file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_agntcy_identity_service_v1alpha1_auth_service_proto_goTypes = []any{
-	(*AppInfoResponse)(nil),     // 0: agntcy.identity.service.v1alpha1.AppInfoResponse
-	(*AuthorizeRequest)(nil),    // 1: agntcy.identity.service.v1alpha1.AuthorizeRequest
-	(*AuthorizeResponse)(nil),   // 2: agntcy.identity.service.v1alpha1.AuthorizeResponse
-	(*TokenRequest)(nil),        // 3: agntcy.identity.service.v1alpha1.TokenRequest
-	(*TokenResponse)(nil),       // 4: agntcy.identity.service.v1alpha1.TokenResponse
-	(*ExtAuthzRequest)(nil),     // 5: agntcy.identity.service.v1alpha1.ExtAuthzRequest
-	(*ApproveTokenRequest)(nil), // 6: agntcy.identity.service.v1alpha1.ApproveTokenRequest
-	(*App)(nil),                 // 7: agntcy.identity.service.v1alpha1.App
-	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
+	(*AppInfoResponse)(nil),                 // 0: agntcy.identity.service.v1alpha1.AppInfoResponse
+	(*AuthorizeRequest)(nil),                // 1: agntcy.identity.service.v1alpha1.AuthorizeRequest
+	(*AuthorizeResponse)(nil),               // 2: agntcy.identity.service.v1alpha1.AuthorizeResponse
+	(*TokenRequest)(nil),                    // 3: agntcy.identity.service.v1alpha1.TokenRequest
+	(*TokenResponse)(nil),                   // 4: agntcy.identity.service.v1alpha1.TokenResponse
+	(*DPoPProof)(nil),                       // 5: agntcy.identity.service.v1alpha1.DPoPProof
+	(*ExtAuthzRequest)(nil),                 // 6: agntcy.identity.service.v1alpha1.ExtAuthzRequest
+	(*ExtAuthzResponse)(nil),                // 7: agntcy.identity.service.v1alpha1.ExtAuthzResponse
+	(*Receipt)(nil),                         // 8: agntcy.identity.service.v1alpha1.Receipt
+	(*GetReceiptRequest)(nil),               // 9: agntcy.identity.service.v1alpha1.GetReceiptRequest
+	(*IntrospectRequest)(nil),               // 10: agntcy.identity.service.v1alpha1.IntrospectRequest
+	(*IntrospectResponse)(nil),              // 11: agntcy.identity.service.v1alpha1.IntrospectResponse
+	(*RevokeRequest)(nil),                   // 12: agntcy.identity.service.v1alpha1.RevokeRequest
+	(*Session)(nil),                         // 13: agntcy.identity.service.v1alpha1.Session
+	(*ListSessionsRequest)(nil),             // 14: agntcy.identity.service.v1alpha1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 15: agntcy.identity.service.v1alpha1.ListSessionsResponse
+	(*GetSessionRequest)(nil),               // 16: agntcy.identity.service.v1alpha1.GetSessionRequest
+	(*RevokeSessionRequest)(nil),            // 17: agntcy.identity.service.v1alpha1.RevokeSessionRequest
+	(*RevokeAllSessionsForAppRequest)(nil),  // 18: agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppRequest
+	(*RevokeAllSessionsForAppResponse)(nil), // 19: agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppResponse
+	(*ExtAuthzMcpRequest)(nil),              // 20: agntcy.identity.service.v1alpha1.ExtAuthzMcpRequest
+	(*ExtAuthzMcpToolsRequest)(nil),         // 21: agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsRequest
+	(*ExtAuthzMcpToolsResponse)(nil),        // 22: agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsResponse
+	(*ExtAuthzA2ARequest)(nil),              // 23: agntcy.identity.service.v1alpha1.ExtAuthzA2ARequest
+	(*ExtAuthzA2ASkillsRequest)(nil),        // 24: agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsRequest
+	(*ExtAuthzA2ASkillsResponse)(nil),       // 25: agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsResponse
+	(*ApproveTokenRequest)(nil),             // 26: agntcy.identity.service.v1alpha1.ApproveTokenRequest
+	(*App)(nil),                             // 27: agntcy.identity.service.v1alpha1.App
+	(AppType)(0),                            // 28: agntcy.identity.service.v1alpha1.AppType
+	(*timestamppb.Timestamp)(nil),           // 29: google.protobuf.Timestamp
+	(*PagedResponse)(nil),                   // 30: agntcy.identity.service.v1alpha1.PagedResponse
+	(*emptypb.Empty)(nil),                   // 31: google.protobuf.Empty
 }
 var file_agntcy_identity_service_v1alpha1_auth_service_proto_depIdxs = []int32{
-	7, // 0: agntcy.identity.service.v1alpha1.AppInfoResponse.app:type_name -> agntcy.identity.service.v1alpha1.App
-	8, // 1: agntcy.identity.service.v1alpha1.AuthService.AppInfo:input_type -> google.protobuf.Empty
-	1, // 2: agntcy.identity.service.v1alpha1.AuthService.Authorize:input_type -> agntcy.identity.service.v1alpha1.AuthorizeRequest
-	3, // 3: agntcy.identity.service.v1alpha1.AuthService.Token:input_type -> agntcy.identity.service.v1alpha1.TokenRequest
-	5, // 4: agntcy.identity.service.v1alpha1.AuthService.ExtAuthz:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzRequest
-	6, // 5: agntcy.identity.service.v1alpha1.AuthService.ApproveToken:input_type -> agntcy.identity.service.v1alpha1.ApproveTokenRequest
-	0, // 6: agntcy.identity.service.v1alpha1.AuthService.AppInfo:output_type -> agntcy.identity.service.v1alpha1.AppInfoResponse
-	2, // 7: agntcy.identity.service.v1alpha1.AuthService.Authorize:output_type -> agntcy.identity.service.v1alpha1.AuthorizeResponse
-	4, // 8: agntcy.identity.service.v1alpha1.AuthService.Token:output_type -> agntcy.identity.service.v1alpha1.TokenResponse
-	8, // 9: agntcy.identity.service.v1alpha1.AuthService.ExtAuthz:output_type -> google.protobuf.Empty
-	8, // 10: agntcy.identity.service.v1alpha1.AuthService.ApproveToken:output_type -> google.protobuf.Empty
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	27, // 0: agntcy.identity.service.v1alpha1.AppInfoResponse.app:type_name -> agntcy.identity.service.v1alpha1.App
+	5,  // 1: agntcy.identity.service.v1alpha1.ExtAuthzRequest.dpop:type_name -> agntcy.identity.service.v1alpha1.DPoPProof
+	28, // 2: agntcy.identity.service.v1alpha1.ExtAuthzResponse.app_type:type_name -> agntcy.identity.service.v1alpha1.AppType
+	8,  // 3: agntcy.identity.service.v1alpha1.ExtAuthzResponse.receipt:type_name -> agntcy.identity.service.v1alpha1.Receipt
+	29, // 4: agntcy.identity.service.v1alpha1.Receipt.created_at:type_name -> google.protobuf.Timestamp
+	29, // 5: agntcy.identity.service.v1alpha1.Receipt.expires_at:type_name -> google.protobuf.Timestamp
+	29, // 6: agntcy.identity.service.v1alpha1.Session.created_at:type_name -> google.protobuf.Timestamp
+	29, // 7: agntcy.identity.service.v1alpha1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 8: agntcy.identity.service.v1alpha1.ListSessionsResponse.sessions:type_name -> agntcy.identity.service.v1alpha1.Session
+	30, // 9: agntcy.identity.service.v1alpha1.ListSessionsResponse.pagination:type_name -> agntcy.identity.service.v1alpha1.PagedResponse
+	5,  // 10: agntcy.identity.service.v1alpha1.ExtAuthzMcpRequest.dpop:type_name -> agntcy.identity.service.v1alpha1.DPoPProof
+	5,  // 11: agntcy.identity.service.v1alpha1.ExtAuthzA2ARequest.dpop:type_name -> agntcy.identity.service.v1alpha1.DPoPProof
+	31, // 12: agntcy.identity.service.v1alpha1.AuthService.AppInfo:input_type -> google.protobuf.Empty
+	1,  // 13: agntcy.identity.service.v1alpha1.AuthService.Authorize:input_type -> agntcy.identity.service.v1alpha1.AuthorizeRequest
+	3,  // 14: agntcy.identity.service.v1alpha1.AuthService.Token:input_type -> agntcy.identity.service.v1alpha1.TokenRequest
+	6,  // 15: agntcy.identity.service.v1alpha1.AuthService.ExtAuthz:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzRequest
+	20, // 16: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzMcp:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzMcpRequest
+	21, // 17: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzMcpTools:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsRequest
+	23, // 18: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzA2A:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzA2ARequest
+	24, // 19: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzA2ASkills:input_type -> agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsRequest
+	26, // 20: agntcy.identity.service.v1alpha1.AuthService.ApproveToken:input_type -> agntcy.identity.service.v1alpha1.ApproveTokenRequest
+	9,  // 21: agntcy.identity.service.v1alpha1.AuthService.GetReceipt:input_type -> agntcy.identity.service.v1alpha1.GetReceiptRequest
+	10, // 22: agntcy.identity.service.v1alpha1.AuthService.Introspect:input_type -> agntcy.identity.service.v1alpha1.IntrospectRequest
+	12, // 23: agntcy.identity.service.v1alpha1.AuthService.Revoke:input_type -> agntcy.identity.service.v1alpha1.RevokeRequest
+	14, // 24: agntcy.identity.service.v1alpha1.AuthService.ListSessions:input_type -> agntcy.identity.service.v1alpha1.ListSessionsRequest
+	16, // 25: agntcy.identity.service.v1alpha1.AuthService.GetSession:input_type -> agntcy.identity.service.v1alpha1.GetSessionRequest
+	17, // 26: agntcy.identity.service.v1alpha1.AuthService.RevokeSession:input_type -> agntcy.identity.service.v1alpha1.RevokeSessionRequest
+	18, // 27: agntcy.identity.service.v1alpha1.AuthService.RevokeAllSessionsForApp:input_type -> agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppRequest
+	0,  // 28: agntcy.identity.service.v1alpha1.AuthService.AppInfo:output_type -> agntcy.identity.service.v1alpha1.AppInfoResponse
+	2,  // 29: agntcy.identity.service.v1alpha1.AuthService.Authorize:output_type -> agntcy.identity.service.v1alpha1.AuthorizeResponse
+	4,  // 30: agntcy.identity.service.v1alpha1.AuthService.Token:output_type -> agntcy.identity.service.v1alpha1.TokenResponse
+	7,  // 31: agntcy.identity.service.v1alpha1.AuthService.ExtAuthz:output_type -> agntcy.identity.service.v1alpha1.ExtAuthzResponse
+	7,  // 32: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzMcp:output_type -> agntcy.identity.service.v1alpha1.ExtAuthzResponse
+	22, // 33: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzMcpTools:output_type -> agntcy.identity.service.v1alpha1.ExtAuthzMcpToolsResponse
+	7,  // 34: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzA2A:output_type -> agntcy.identity.service.v1alpha1.ExtAuthzResponse
+	25, // 35: agntcy.identity.service.v1alpha1.AuthService.ExtAuthzA2ASkills:output_type -> agntcy.identity.service.v1alpha1.ExtAuthzA2ASkillsResponse
+	31, // 36: agntcy.identity.service.v1alpha1.AuthService.ApproveToken:output_type -> google.protobuf.Empty
+	8,  // 37: agntcy.identity.service.v1alpha1.AuthService.GetReceipt:output_type -> agntcy.identity.service.v1alpha1.Receipt
+	11, // 38: agntcy.identity.service.v1alpha1.AuthService.Introspect:output_type -> agntcy.identity.service.v1alpha1.IntrospectResponse
+	31, // 39: agntcy.identity.service.v1alpha1.AuthService.Revoke:output_type -> google.protobuf.Empty
+	15, // 40: agntcy.identity.service.v1alpha1.AuthService.ListSessions:output_type -> agntcy.identity.service.v1alpha1.ListSessionsResponse
+	13, // 41: agntcy.identity.service.v1alpha1.AuthService.GetSession:output_type -> agntcy.identity.service.v1alpha1.Session
+	31, // 42: agntcy.identity.service.v1alpha1.AuthService.RevokeSession:output_type -> google.protobuf.Empty
+	19, // 43: agntcy.identity.service.v1alpha1.AuthService.RevokeAllSessionsForApp:output_type -> agntcy.identity.service.v1alpha1.RevokeAllSessionsForAppResponse
+	28, // [28:44] is the sub-list for method output_type
+	12, // [12:28] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_service_v1alpha1_auth_service_proto_init() }
@@ -488,15 +2355,25 @@ func file_agntcy_identity_service_v1alpha1_auth_service_proto_init() {
 		return
 	}
 	file_agntcy_identity_service_v1alpha1_app_proto_init()
+	file_agntcy_identity_service_v1alpha1_pagination_proto_init()
 	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[1].OneofWrappers = []any{}
-	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[6].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_auth_service_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDesc), len(file_agntcy_identity_service_v1alpha1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,11 +23,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_AppInfo_FullMethodName      = "/agntcy.identity.service.v1alpha1.AuthService/AppInfo"
-	AuthService_Authorize_FullMethodName    = "/agntcy.identity.service.v1alpha1.AuthService/Authorize"
-	AuthService_Token_FullMethodName        = "/agntcy.identity.service.v1alpha1.AuthService/Token"
-	AuthService_ExtAuthz_FullMethodName     = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthz"
-	AuthService_ApproveToken_FullMethodName = "/agntcy.identity.service.v1alpha1.AuthService/ApproveToken"
+	AuthService_AppInfo_FullMethodName                 = "/agntcy.identity.service.v1alpha1.AuthService/AppInfo"
+	AuthService_Authorize_FullMethodName               = "/agntcy.identity.service.v1alpha1.AuthService/Authorize"
+	AuthService_Token_FullMethodName                   = "/agntcy.identity.service.v1alpha1.AuthService/Token"
+	AuthService_ExtAuthz_FullMethodName                = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthz"
+	AuthService_ExtAuthzMcp_FullMethodName             = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthzMcp"
+	AuthService_ExtAuthzMcpTools_FullMethodName        = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthzMcpTools"
+	AuthService_ExtAuthzA2A_FullMethodName             = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthzA2A"
+	AuthService_ExtAuthzA2ASkills_FullMethodName       = "/agntcy.identity.service.v1alpha1.AuthService/ExtAuthzA2ASkills"
+	AuthService_ApproveToken_FullMethodName            = "/agntcy.identity.service.v1alpha1.AuthService/ApproveToken"
+	AuthService_GetReceipt_FullMethodName              = "/agntcy.identity.service.v1alpha1.AuthService/GetReceipt"
+	AuthService_Introspect_FullMethodName              = "/agntcy.identity.service.v1alpha1.AuthService/Introspect"
+	AuthService_Revoke_FullMethodName                  = "/agntcy.identity.service.v1alpha1.AuthService/Revoke"
+	AuthService_ListSessions_FullMethodName            = "/agntcy.identity.service.v1alpha1.AuthService/ListSessions"
+	AuthService_GetSession_FullMethodName              = "/agntcy.identity.service.v1alpha1.AuthService/GetSession"
+	AuthService_RevokeSession_FullMethodName           = "/agntcy.identity.service.v1alpha1.AuthService/RevokeSession"
+	AuthService_RevokeAllSessionsForApp_FullMethodName = "/agntcy.identity.service.v1alpha1.AuthService/RevokeAllSessionsForApp"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -43,9 +54,37 @@ type AuthServiceClient interface {
 	// Request token for an Agent or MCP Server
 	Token(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
 	// Handle external authorization requests
-	ExtAuthz(ctx context.Context, in *ExtAuthzRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExtAuthz(ctx context.Context, in *ExtAuthzRequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error)
+	// Handle external authorization requests forwarded by HTTP proxies
+	// in front of MCP Servers. The tool names are extracted from the
+	// JSON-RPC messages in the forwarded request body.
+	ExtAuthzMcp(ctx context.Context, in *ExtAuthzMcpRequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error)
+	// Filter the tools of an MCP Server down to the ones
+	// the caller is allowed to invoke
+	ExtAuthzMcpTools(ctx context.Context, in *ExtAuthzMcpToolsRequest, opts ...grpc.CallOption) (*ExtAuthzMcpToolsResponse, error)
+	// Handle external authorization requests forwarded by gateways
+	// in front of A2A agents. The requested skills are extracted from the
+	// JSON-RPC messages in the forwarded request body.
+	ExtAuthzA2A(ctx context.Context, in *ExtAuthzA2ARequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error)
+	// Filter the skills of an A2A agent down to the ones
+	// the caller is allowed to use
+	ExtAuthzA2ASkills(ctx context.Context, in *ExtAuthzA2ASkillsRequest, opts ...grpc.CallOption) (*ExtAuthzA2ASkillsResponse, error)
 	// Handle manual approval of external authorization requets
 	ApproveToken(ctx context.Context, in *ApproveTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get a receipt issued by the external authorization
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*Receipt, error)
+	// Introspect an access token, as defined by RFC 7662
+	Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error)
+	// Revoke an access token, as defined by RFC 7009
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the sessions of the tenant
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Get a session of the tenant
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error)
+	// Revoke a session of the tenant
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Revoke all the active sessions owned by an App
+	RevokeAllSessionsForApp(ctx context.Context, in *RevokeAllSessionsForAppRequest, opts ...grpc.CallOption) (*RevokeAllSessionsForAppResponse, error)
 }
 
 type authServiceClient struct {
@@ -86,9 +125,9 @@ func (c *authServiceClient) Token(ctx context.Context, in *TokenRequest, opts ..
 	return out, nil
 }
 
-func (c *authServiceClient) ExtAuthz(ctx context.Context, in *ExtAuthzRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *authServiceClient) ExtAuthz(ctx context.Context, in *ExtAuthzRequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(ExtAuthzResponse)
 	err := c.cc.Invoke(ctx, AuthService_ExtAuthz_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -96,6 +135,46 @@ func (c *authServiceClient) ExtAuthz(ctx context.Context, in *ExtAuthzRequest, o
 	return out, nil
 }
 
+func (c *authServiceClient) ExtAuthzMcp(ctx context.Context, in *ExtAuthzMcpRequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtAuthzResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExtAuthzMcp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExtAuthzMcpTools(ctx context.Context, in *ExtAuthzMcpToolsRequest, opts ...grpc.CallOption) (*ExtAuthzMcpToolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtAuthzMcpToolsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExtAuthzMcpTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExtAuthzA2A(ctx context.Context, in *ExtAuthzA2ARequest, opts ...grpc.CallOption) (*ExtAuthzResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtAuthzResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExtAuthzA2A_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExtAuthzA2ASkills(ctx context.Context, in *ExtAuthzA2ASkillsRequest, opts ...grpc.CallOption) (*ExtAuthzA2ASkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtAuthzA2ASkillsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExtAuthzA2ASkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) ApproveToken(ctx context.Context, in *ApproveTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -106,6 +185,76 @@ func (c *authServiceClient) ApproveToken(ctx context.Context, in *ApproveTokenRe
 	return out, nil
 }
 
+func (c *authServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*Receipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Receipt)
+	err := c.cc.Invoke(ctx, AuthService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Introspect(ctx context.Context, in *IntrospectRequest, opts ...grpc.CallOption) (*IntrospectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectResponse)
+	err := c.cc.Invoke(ctx, AuthService_Introspect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Session)
+	err := c.cc.Invoke(ctx, AuthService_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAllSessionsForApp(ctx context.Context, in *RevokeAllSessionsForAppRequest, opts ...grpc.CallOption) (*RevokeAllSessionsForAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllSessionsForAppResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAllSessionsForApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations should embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -119,9 +268,37 @@ type AuthServiceServer interface {
 	// Request token for an Agent or MCP Server
 	Token(context.Context, *TokenRequest) (*TokenResponse, error)
 	// Handle external authorization requests
-	ExtAuthz(context.Context, *ExtAuthzRequest) (*emptypb.Empty, error)
+	ExtAuthz(context.Context, *ExtAuthzRequest) (*ExtAuthzResponse, error)
+	// Handle external authorization requests forwarded by HTTP proxies
+	// in front of MCP Servers. The tool names are extracted from the
+	// JSON-RPC messages in the forwarded request body.
+	ExtAuthzMcp(context.Context, *ExtAuthzMcpRequest) (*ExtAuthzResponse, error)
+	// Filter the tools of an MCP Server down to the ones
+	// the caller is allowed to invoke
+	ExtAuthzMcpTools(context.Context, *ExtAuthzMcpToolsRequest) (*ExtAuthzMcpToolsResponse, error)
+	// Handle external authorization requests forwarded by gateways
+	// in front of A2A agents. The requested skills are extracted from the
+	// JSON-RPC messages in the forwarded request body.
+	ExtAuthzA2A(context.Context, *ExtAuthzA2ARequest) (*ExtAuthzResponse, error)
+	// Filter the skills of an A2A agent down to the ones
+	// the caller is allowed to use
+	ExtAuthzA2ASkills(context.Context, *ExtAuthzA2ASkillsRequest) (*ExtAuthzA2ASkillsResponse, error)
 	// Handle manual approval of external authorization requets
 	ApproveToken(context.Context, *ApproveTokenRequest) (*emptypb.Empty, error)
+	// Get a receipt issued by the external authorization
+	GetReceipt(context.Context, *GetReceiptRequest) (*Receipt, error)
+	// Introspect an access token, as defined by RFC 7662
+	Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error)
+	// Revoke an access token, as defined by RFC 7009
+	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
+	// List the sessions of the tenant
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Get a session of the tenant
+	GetSession(context.Context, *GetSessionRequest) (*Session, error)
+	// Revoke a session of the tenant
+	RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error)
+	// Revoke all the active sessions owned by an App
+	RevokeAllSessionsForApp(context.Context, *RevokeAllSessionsForAppRequest) (*RevokeAllSessionsForAppResponse, error)
 }
 
 // UnimplementedAuthServiceServer should be embedded to have
@@ -140,12 +317,45 @@ func (UnimplementedAuthServiceServer) Authorize(context.Context, *AuthorizeReque
 func (UnimplementedAuthServiceServer) Token(context.Context, *TokenRequest) (*TokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Token not implemented")
 }
-func (UnimplementedAuthServiceServer) ExtAuthz(context.Context, *ExtAuthzRequest) (*emptypb.Empty, error) {
+func (UnimplementedAuthServiceServer) ExtAuthz(context.Context, *ExtAuthzRequest) (*ExtAuthzResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExtAuthz not implemented")
 }
+func (UnimplementedAuthServiceServer) ExtAuthzMcp(context.Context, *ExtAuthzMcpRequest) (*ExtAuthzResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtAuthzMcp not implemented")
+}
+func (UnimplementedAuthServiceServer) ExtAuthzMcpTools(context.Context, *ExtAuthzMcpToolsRequest) (*ExtAuthzMcpToolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtAuthzMcpTools not implemented")
+}
+func (UnimplementedAuthServiceServer) ExtAuthzA2A(context.Context, *ExtAuthzA2ARequest) (*ExtAuthzResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtAuthzA2A not implemented")
+}
+func (UnimplementedAuthServiceServer) ExtAuthzA2ASkills(context.Context, *ExtAuthzA2ASkillsRequest) (*ExtAuthzA2ASkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtAuthzA2ASkills not implemented")
+}
 func (UnimplementedAuthServiceServer) ApproveToken(context.Context, *ApproveTokenRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApproveToken not implemented")
 }
+func (UnimplementedAuthServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*Receipt, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedAuthServiceServer) Introspect(context.Context, *IntrospectRequest) (*IntrospectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Introspect not implemented")
+}
+func (UnimplementedAuthServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) GetSession(context.Context, *GetSessionRequest) (*Session, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAllSessionsForApp(context.Context, *RevokeAllSessionsForAppRequest) (*RevokeAllSessionsForAppResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllSessionsForApp not implemented")
+}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +448,78 @@ func _AuthService_ExtAuthz_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ExtAuthzMcp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtAuthzMcpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExtAuthzMcp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExtAuthzMcp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExtAuthzMcp(ctx, req.(*ExtAuthzMcpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExtAuthzMcpTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtAuthzMcpToolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExtAuthzMcpTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExtAuthzMcpTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExtAuthzMcpTools(ctx, req.(*ExtAuthzMcpToolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExtAuthzA2A_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtAuthzA2ARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExtAuthzA2A(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExtAuthzA2A_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExtAuthzA2A(ctx, req.(*ExtAuthzA2ARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExtAuthzA2ASkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtAuthzA2ASkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExtAuthzA2ASkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExtAuthzA2ASkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExtAuthzA2ASkills(ctx, req.(*ExtAuthzA2ASkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_ApproveToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveTokenRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +538,132 @@ func _AuthService_ApproveToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Introspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Introspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Introspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Introspect(ctx, req.(*IntrospectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Revoke(ctx, req.(*RevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAllSessionsForApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsForAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAllSessionsForApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAllSessionsForApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAllSessionsForApp(ctx, req.(*RevokeAllSessionsForAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,10 +687,54 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExtAuthz",
 			Handler:    _AuthService_ExtAuthz_Handler,
 		},
+		{
+			MethodName: "ExtAuthzMcp",
+			Handler:    _AuthService_ExtAuthzMcp_Handler,
+		},
+		{
+			MethodName: "ExtAuthzMcpTools",
+			Handler:    _AuthService_ExtAuthzMcpTools_Handler,
+		},
+		{
+			MethodName: "ExtAuthzA2A",
+			Handler:    _AuthService_ExtAuthzA2A_Handler,
+		},
+		{
+			MethodName: "ExtAuthzA2ASkills",
+			Handler:    _AuthService_ExtAuthzA2ASkills_Handler,
+		},
 		{
 			MethodName: "ApproveToken",
 			Handler:    _AuthService_ApproveToken_Handler,
 		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _AuthService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "Introspect",
+			Handler:    _AuthService_Introspect_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _AuthService_Revoke_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AuthService_ListSessions_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _AuthService_GetSession_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessionsForApp",
+			Handler:    _AuthService_RevokeAllSessionsForApp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/service/v1alpha1/auth_service.proto",
@@ -83,6 +83,63 @@ func (BadgeType) EnumDescriptor() ([]byte, []int) {
 	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{0}
 }
 
+// The type of a change of a claim
+type ClaimChangeType int32
+
+const (
+	// Unspecified change
+	ClaimChangeType_CLAIM_CHANGE_TYPE_UNSPECIFIED ClaimChangeType = 0
+	// The claim was added
+	ClaimChangeType_CLAIM_CHANGE_TYPE_ADDED ClaimChangeType = 1
+	// The claim was removed
+	ClaimChangeType_CLAIM_CHANGE_TYPE_REMOVED ClaimChangeType = 2
+	// The value of the claim changed
+	ClaimChangeType_CLAIM_CHANGE_TYPE_MODIFIED ClaimChangeType = 3
+)
+
+// Enum value maps for ClaimChangeType.
+var (
+	ClaimChangeType_name = map[int32]string{
+		0: "CLAIM_CHANGE_TYPE_UNSPECIFIED",
+		1: "CLAIM_CHANGE_TYPE_ADDED",
+		2: "CLAIM_CHANGE_TYPE_REMOVED",
+		3: "CLAIM_CHANGE_TYPE_MODIFIED",
+	}
+	ClaimChangeType_value = map[string]int32{
+		"CLAIM_CHANGE_TYPE_UNSPECIFIED": 0,
+		"CLAIM_CHANGE_TYPE_ADDED":       1,
+		"CLAIM_CHANGE_TYPE_REMOVED":     2,
+		"CLAIM_CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x ClaimChangeType) Enum() *ClaimChangeType {
+	p := new(ClaimChangeType)
+	*p = x
+	return p
+}
+
+func (x ClaimChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClaimChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[1].Descriptor()
+}
+
+func (ClaimChangeType) Type() protoreflect.EnumType {
+	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[1]
+}
+
+func (x ClaimChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClaimChangeType.Descriptor instead.
+func (ClaimChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{1}
+}
+
 // The purpose of the status entry
 type CredentialStatusPurpose int32
 
@@ -92,6 +149,9 @@ const (
 	// Used to cancel the validity of a verifiable credential.
 	// This status is not reversible.
 	CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_REVOCATION CredentialStatusPurpose = 1
+	// Used to temporarily pause the validity of a verifiable credential.
+	// This status is reversible.
+	CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_SUSPENSION CredentialStatusPurpose = 2
 )
 
 // Enum value maps for CredentialStatusPurpose.
@@ -99,10 +159,12 @@ var (
 	CredentialStatusPurpose_name = map[int32]string{
 		0: "CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED",
 		1: "CREDENTIAL_STATUS_PURPOSE_REVOCATION",
+		2: "CREDENTIAL_STATUS_PURPOSE_SUSPENSION",
 	}
 	CredentialStatusPurpose_value = map[string]int32{
 		"CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED": 0,
 		"CREDENTIAL_STATUS_PURPOSE_REVOCATION":  1,
+		"CREDENTIAL_STATUS_PURPOSE_SUSPENSION":  2,
 	}
 )
 
@@ -117,11 +179,11 @@ func (x CredentialStatusPurpose) String() string {
 }
 
 func (CredentialStatusPurpose) Descriptor() protoreflect.EnumDescriptor {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[1].Descriptor()
+	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[2].Descriptor()
 }
 
 func (CredentialStatusPurpose) Type() protoreflect.EnumType {
-	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[1]
+	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[2]
 }
 
 func (x CredentialStatusPurpose) Number() protoreflect.EnumNumber {
@@ -130,7 +192,182 @@ func (x CredentialStatusPurpose) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CredentialStatusPurpose.Descriptor instead.
 func (CredentialStatusPurpose) EnumDescriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{1}
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{2}
+}
+
+// The format of the proof securing a badge
+type ProofFormat int32
+
+const (
+	// Unspecified format, the default format of the tenant applies
+	ProofFormat_PROOF_FORMAT_UNSPECIFIED ProofFormat = 0
+	// A JOSE enveloped credential signed with the issuer key
+	ProofFormat_PROOF_FORMAT_JOSE ProofFormat = 1
+	// A Data Integrity proof using the eddsa-jcs-2022 cryptosuite
+	ProofFormat_PROOF_FORMAT_EDDSA_JCS_2022 ProofFormat = 2
+	// A Data Integrity proof using the ecdsa-jcs-2019 cryptosuite
+	ProofFormat_PROOF_FORMAT_ECDSA_JCS_2019 ProofFormat = 3
+	// An SD-JWT VC with selectively disclosable claims
+	ProofFormat_PROOF_FORMAT_SD_JWT_VC ProofFormat = 4
+)
+
+// Enum value maps for ProofFormat.
+var (
+	ProofFormat_name = map[int32]string{
+		0: "PROOF_FORMAT_UNSPECIFIED",
+		1: "PROOF_FORMAT_JOSE",
+		2: "PROOF_FORMAT_EDDSA_JCS_2022",
+		3: "PROOF_FORMAT_ECDSA_JCS_2019",
+		4: "PROOF_FORMAT_SD_JWT_VC",
+	}
+	ProofFormat_value = map[string]int32{
+		"PROOF_FORMAT_UNSPECIFIED":    0,
+		"PROOF_FORMAT_JOSE":           1,
+		"PROOF_FORMAT_EDDSA_JCS_2022": 2,
+		"PROOF_FORMAT_ECDSA_JCS_2019": 3,
+		"PROOF_FORMAT_SD_JWT_VC":      4,
+	}
+)
+
+func (x ProofFormat) Enum() *ProofFormat {
+	p := new(ProofFormat)
+	*p = x
+	return p
+}
+
+func (x ProofFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProofFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[3].Descriptor()
+}
+
+func (ProofFormat) Type() protoreflect.EnumType {
+	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[3]
+}
+
+func (x ProofFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProofFormat.Descriptor instead.
+func (ProofFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{3}
+}
+
+// The reason of the revocation of a badge
+type RevocationReason int32
+
+const (
+	// Unspecified reason
+	RevocationReason_REVOCATION_REASON_UNSPECIFIED RevocationReason = 0
+	// The private key of the subject was compromised
+	RevocationReason_REVOCATION_REASON_KEY_COMPROMISE RevocationReason = 1
+	// The badge was replaced by a new badge
+	RevocationReason_REVOCATION_REASON_SUPERSEDED RevocationReason = 2
+	// The subject was decommissioned
+	RevocationReason_REVOCATION_REASON_DECOMMISSIONED RevocationReason = 3
+	// The subject violated a policy
+	RevocationReason_REVOCATION_REASON_POLICY_VIOLATION RevocationReason = 4
+)
+
+// Enum value maps for RevocationReason.
+var (
+	RevocationReason_name = map[int32]string{
+		0: "REVOCATION_REASON_UNSPECIFIED",
+		1: "REVOCATION_REASON_KEY_COMPROMISE",
+		2: "REVOCATION_REASON_SUPERSEDED",
+		3: "REVOCATION_REASON_DECOMMISSIONED",
+		4: "REVOCATION_REASON_POLICY_VIOLATION",
+	}
+	RevocationReason_value = map[string]int32{
+		"REVOCATION_REASON_UNSPECIFIED":      0,
+		"REVOCATION_REASON_KEY_COMPROMISE":   1,
+		"REVOCATION_REASON_SUPERSEDED":       2,
+		"REVOCATION_REASON_DECOMMISSIONED":   3,
+		"REVOCATION_REASON_POLICY_VIOLATION": 4,
+	}
+)
+
+func (x RevocationReason) Enum() *RevocationReason {
+	p := new(RevocationReason)
+	*p = x
+	return p
+}
+
+func (x RevocationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevocationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[4].Descriptor()
+}
+
+func (RevocationReason) Type() protoreflect.EnumType {
+	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[4]
+}
+
+func (x RevocationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevocationReason.Descriptor instead.
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{4}
+}
+
+// Where a badge was verified
+type VerificationSource int32
+
+const (
+	// Unspecified source
+	VerificationSource_VERIFICATION_SOURCE_UNSPECIFIED VerificationSource = 0
+	// The badge was verified by the service, with the cached keys and statuses of its issuer
+	VerificationSource_VERIFICATION_SOURCE_LOCAL VerificationSource = 1
+	// The badge was verified by the identity node
+	VerificationSource_VERIFICATION_SOURCE_REMOTE VerificationSource = 2
+)
+
+// Enum value maps for VerificationSource.
+var (
+	VerificationSource_name = map[int32]string{
+		0: "VERIFICATION_SOURCE_UNSPECIFIED",
+		1: "VERIFICATION_SOURCE_LOCAL",
+		2: "VERIFICATION_SOURCE_REMOTE",
+	}
+	VerificationSource_value = map[string]int32{
+		"VERIFICATION_SOURCE_UNSPECIFIED": 0,
+		"VERIFICATION_SOURCE_LOCAL":       1,
+		"VERIFICATION_SOURCE_REMOTE":      2,
+	}
+)
+
+func (x VerificationSource) Enum() *VerificationSource {
+	p := new(VerificationSource)
+	*p = x
+	return p
+}
+
+func (x VerificationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[5].Descriptor()
+}
+
+func (VerificationSource) Type() protoreflect.EnumType {
+	return &file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes[5]
+}
+
+func (x VerificationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationSource.Descriptor instead.
+func (VerificationSource) EnumDescriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{5}
 }
 
 type Badge struct {
@@ -163,58 +400,669 @@ func (x *Badge) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Badge.ProtoReflect.Descriptor instead.
+func (*Badge) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Badge) GetVerifiableCredential() *VerifiableCredential {
+	if x != nil {
+		return x.VerifiableCredential
+	}
+	return nil
+}
+
+func (x *Badge) GetAppId() string {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return ""
+}
+
+// BadgeClaims represents the content of a Badge VC defined [here]
+//
+// [here]: https://spec.identity.agntcy.org/docs/vc/intro/
+type BadgeClaims struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID as defined [here]
+	//
+	// [here]: https://www.w3.org/TR/vc-data-model/#credential-subject
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// The content of the badge
+	Badge         *string `protobuf:"bytes,2,opt,name=badge,proto3,oneof" json:"badge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BadgeClaims) Reset() {
+	*x = BadgeClaims{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BadgeClaims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeClaims) ProtoMessage() {}
+
+func (x *BadgeClaims) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeClaims.ProtoReflect.Descriptor instead.
+func (*BadgeClaims) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BadgeClaims) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *BadgeClaims) GetBadge() string {
+	if x != nil && x.Badge != nil {
+		return *x.Badge
+	}
+	return ""
+}
+
+// BadgeDiff represents how the claims changed between two badges of an App
+type BadgeDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the previous badge
+	FromBadgeId *string `protobuf:"bytes,1,opt,name=from_badge_id,json=fromBadgeId,proto3,oneof" json:"from_badge_id,omitempty"`
+	// The ID of the next badge
+	ToBadgeId *string `protobuf:"bytes,2,opt,name=to_badge_id,json=toBadgeId,proto3,oneof" json:"to_badge_id,omitempty"`
+	// The changes of the claims, sorted by path
+	Changes       []*ClaimChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BadgeDiff) Reset() {
+	*x = BadgeDiff{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BadgeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeDiff) ProtoMessage() {}
+
+func (x *BadgeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeDiff.ProtoReflect.Descriptor instead.
+func (*BadgeDiff) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BadgeDiff) GetFromBadgeId() string {
+	if x != nil && x.FromBadgeId != nil {
+		return *x.FromBadgeId
+	}
+	return ""
+}
+
+func (x *BadgeDiff) GetToBadgeId() string {
+	if x != nil && x.ToBadgeId != nil {
+		return *x.ToBadgeId
+	}
+	return ""
+}
+
+func (x *BadgeDiff) GetChanges() []*ClaimChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// BitstringStatusList represents the credential subject of a status list credential defined [here]
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/#bitstringstatuslist
+type BitstringStatusList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the status list
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// The type of the credential subject, always BitstringStatusList
+	Type *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// The purpose of the statuses of the list (ex: revocation)
+	StatusPurpose *string `protobuf:"bytes,3,opt,name=status_purpose,json=statusPurpose,proto3,oneof" json:"status_purpose,omitempty"`
+	// The GZIP compressed bitstring, encoded as a multibase base64url string
+	EncodedList   *string `protobuf:"bytes,4,opt,name=encoded_list,json=encodedList,proto3,oneof" json:"encoded_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BitstringStatusList) Reset() {
+	*x = BitstringStatusList{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitstringStatusList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitstringStatusList) ProtoMessage() {}
+
+func (x *BitstringStatusList) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitstringStatusList.ProtoReflect.Descriptor instead.
+func (*BitstringStatusList) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BitstringStatusList) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *BitstringStatusList) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *BitstringStatusList) GetStatusPurpose() string {
+	if x != nil && x.StatusPurpose != nil {
+		return *x.StatusPurpose
+	}
+	return ""
+}
+
+func (x *BitstringStatusList) GetEncodedList() string {
+	if x != nil && x.EncodedList != nil {
+		return *x.EncodedList
+	}
+	return ""
+}
+
+// ClaimChange represents a change of a claim of a badge
+type ClaimChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the claim (ex: skills[id=search].description)
+	Path *string `protobuf:"bytes,1,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// The type of the change
+	Type *ClaimChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=agntcy.identity.service.v1alpha1.ClaimChangeType,oneof" json:"type,omitempty"`
+	// The JSON encoded value of the claim in the previous badge
+	OldValue *string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	// The JSON encoded value of the claim in the next badge
+	NewValue      *string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimChange) Reset() {
+	*x = ClaimChange{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimChange) ProtoMessage() {}
+
+func (x *ClaimChange) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimChange.ProtoReflect.Descriptor instead.
+func (*ClaimChange) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClaimChange) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *ClaimChange) GetType() ClaimChangeType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ClaimChangeType_CLAIM_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ClaimChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *ClaimChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+// CredentialSchema represents the credentialSchema property of a Verifiable Credential.
+// more information can be found [here]
+//
+// [here]: https://www.w3.org/TR/vc-data-model-2.0/#data-schemas
+type CredentialSchema struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type specifies the type of the file
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// The URL identifying the schema file
+	Id            *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialSchema) Reset() {
+	*x = CredentialSchema{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialSchema) ProtoMessage() {}
+
+func (x *CredentialSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialSchema.ProtoReflect.Descriptor instead.
+func (*CredentialSchema) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CredentialSchema) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *CredentialSchema) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+// CredentialStatus represents the credentialStatus property of a Verifiable Credential.
+// more information can be found [here]
+//
+// [here]: https://www.w3.org/TR/vc-data-model-2.0/#status
+type CredentialStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL identifying the schema file
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// Type specifies the type of the file
+	Type *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// The creation date and time of the status
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	// The value of the purpose for the status entry
+	Purpose *CredentialStatusPurpose `protobuf:"varint,4,opt,name=purpose,proto3,enum=agntcy.identity.service.v1alpha1.CredentialStatusPurpose,oneof" json:"purpose,omitempty"`
+	// The purpose of the Bitstring Status List entry (ex: revocation)
+	StatusPurpose *string `protobuf:"bytes,5,opt,name=status_purpose,json=statusPurpose,proto3,oneof" json:"status_purpose,omitempty"`
+	// The position of the status of the credential in the status list
+	StatusListIndex *string `protobuf:"bytes,6,opt,name=status_list_index,json=statusListIndex,proto3,oneof" json:"status_list_index,omitempty"`
+	// The URL of the status list credential
+	StatusListCredential *string `protobuf:"bytes,7,opt,name=status_list_credential,json=statusListCredential,proto3,oneof" json:"status_list_credential,omitempty"`
+	// The user who created the status (ex: who suspended the badge)
+	CreatedBy *string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	// Why the status was created
+	Comment *string `protobuf:"bytes,9,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	// Why the credential was revoked, set for the revocation statuses
+	RevocationReason *RevocationReason `protobuf:"varint,10,opt,name=revocation_reason,json=revocationReason,proto3,enum=agntcy.identity.service.v1alpha1.RevocationReason,oneof" json:"revocation_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CredentialStatus) Reset() {
+	*x = CredentialStatus{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialStatus) ProtoMessage() {}
+
+func (x *CredentialStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialStatus.ProtoReflect.Descriptor instead.
+func (*CredentialStatus) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CredentialStatus) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CredentialStatus) GetPurpose() CredentialStatusPurpose {
+	if x != nil && x.Purpose != nil {
+		return *x.Purpose
+	}
+	return CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED
+}
+
+func (x *CredentialStatus) GetStatusPurpose() string {
+	if x != nil && x.StatusPurpose != nil {
+		return *x.StatusPurpose
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetStatusListIndex() string {
+	if x != nil && x.StatusListIndex != nil {
+		return *x.StatusListIndex
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetStatusListCredential() string {
+	if x != nil && x.StatusListCredential != nil {
+		return *x.StatusListCredential
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *CredentialStatus) GetRevocationReason() RevocationReason {
+	if x != nil && x.RevocationReason != nil {
+		return *x.RevocationReason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+type ErrorInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        *string                `protobuf:"bytes,1,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorInfo) Reset() {
+	*x = ErrorInfo{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorInfo) ProtoMessage() {}
+
+func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorInfo.ProtoReflect.Descriptor instead.
+func (*ErrorInfo) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ErrorInfo) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ErrorInfo) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+// A data integrity proof provides information about the proof mechanism,
+// parameters required to verify that proof, and the proof value itself.
+type Proof struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the proof
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// The proof purpose
+	ProofPurpose *string `protobuf:"bytes,2,opt,name=proof_purpose,json=proofPurpose,proto3,oneof" json:"proof_purpose,omitempty"`
+	// The proof value
+	ProofValue *string `protobuf:"bytes,3,opt,name=proof_value,json=proofValue,proto3,oneof" json:"proof_value,omitempty"`
+	// The cryptographic suite of a Data Integrity proof (ex: eddsa-jcs-2022)
+	Cryptosuite *string `protobuf:"bytes,4,opt,name=cryptosuite,proto3,oneof" json:"cryptosuite,omitempty"`
+	// The identifier of the public key verifying a Data Integrity or an SD-JWT VC proof
+	VerificationMethod *string `protobuf:"bytes,5,opt,name=verification_method,json=verificationMethod,proto3,oneof" json:"verification_method,omitempty"`
+	// When a Data Integrity proof was created
+	Created       *string `protobuf:"bytes,6,opt,name=created,proto3,oneof" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proof) Reset() {
+	*x = Proof{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proof) ProtoMessage() {}
+
+func (x *Proof) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proof.ProtoReflect.Descriptor instead.
+func (*Proof) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Proof) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *Proof) GetProofPurpose() string {
+	if x != nil && x.ProofPurpose != nil {
+		return *x.ProofPurpose
+	}
+	return ""
+}
+
+func (x *Proof) GetProofValue() string {
+	if x != nil && x.ProofValue != nil {
+		return *x.ProofValue
+	}
+	return ""
 }
 
-// Deprecated: Use Badge.ProtoReflect.Descriptor instead.
-func (*Badge) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{0}
+func (x *Proof) GetCryptosuite() string {
+	if x != nil && x.Cryptosuite != nil {
+		return *x.Cryptosuite
+	}
+	return ""
 }
 
-func (x *Badge) GetVerifiableCredential() *VerifiableCredential {
-	if x != nil {
-		return x.VerifiableCredential
+func (x *Proof) GetVerificationMethod() string {
+	if x != nil && x.VerificationMethod != nil {
+		return *x.VerificationMethod
 	}
-	return nil
+	return ""
 }
 
-func (x *Badge) GetAppId() string {
-	if x != nil && x.AppId != nil {
-		return *x.AppId
+func (x *Proof) GetCreated() string {
+	if x != nil && x.Created != nil {
+		return *x.Created
 	}
 	return ""
 }
 
-// BadgeClaims represents the content of a Badge VC defined [here]
+// StatusListCredential represents a Bitstring Status List credential defined [here]
 //
-// [here]: https://spec.identity.agntcy.org/docs/vc/intro/
-type BadgeClaims struct {
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/#bitstringstatuslistcredential
+type StatusListCredential struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID as defined [here]
-	//
-	// [here]: https://www.w3.org/TR/vc-data-model/#credential-subject
-	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// The content of the badge
-	Badge         *string `protobuf:"bytes,2,opt,name=badge,proto3,oneof" json:"badge,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#contexts
+	Context []string `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
+	// The URL of the status list credential
+	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#types
+	Type []string `protobuf:"bytes,3,rep,name=type,proto3" json:"type,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#issuer
+	Issuer *string `protobuf:"bytes,4,opt,name=issuer,proto3,oneof" json:"issuer,omitempty"`
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidFrom *string `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`
+	// The status list
+	CredentialSubject *BitstringStatusList `protobuf:"bytes,6,opt,name=credential_subject,json=credentialSubject,proto3,oneof" json:"credential_subject,omitempty"`
+	// https://w3id.org/security#proof
+	Proof         *Proof `protobuf:"bytes,7,opt,name=proof,proto3,oneof" json:"proof,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BadgeClaims) Reset() {
-	*x = BadgeClaims{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[1]
+func (x *StatusListCredential) Reset() {
+	*x = StatusListCredential{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BadgeClaims) String() string {
+func (x *StatusListCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BadgeClaims) ProtoMessage() {}
+func (*StatusListCredential) ProtoMessage() {}
 
-func (x *BadgeClaims) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[1]
+func (x *StatusListCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,54 +1073,93 @@ func (x *BadgeClaims) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BadgeClaims.ProtoReflect.Descriptor instead.
-func (*BadgeClaims) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use StatusListCredential.ProtoReflect.Descriptor instead.
+func (*StatusListCredential) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BadgeClaims) GetId() string {
+func (x *StatusListCredential) GetContext() []string {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *StatusListCredential) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-func (x *BadgeClaims) GetBadge() string {
-	if x != nil && x.Badge != nil {
-		return *x.Badge
+func (x *StatusListCredential) GetType() []string {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *StatusListCredential) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
 	}
 	return ""
 }
 
-// CredentialSchema represents the credentialSchema property of a Verifiable Credential.
-// more information can be found [here]
-//
-// [here]: https://www.w3.org/TR/vc-data-model-2.0/#data-schemas
-type CredentialSchema struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Type specifies the type of the file
-	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	// The URL identifying the schema file
-	Id            *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StatusListCredential) GetValidFrom() string {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return ""
 }
 
-func (x *CredentialSchema) Reset() {
-	*x = CredentialSchema{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[2]
+func (x *StatusListCredential) GetCredentialSubject() *BitstringStatusList {
+	if x != nil {
+		return x.CredentialSubject
+	}
+	return nil
+}
+
+func (x *StatusListCredential) GetProof() *Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// IssuerDocument is the controlled identifier document of an issuer defined [here],
+// it publishes the keys verifying the Data Integrity proofs of the badges of the issuer
+//
+// [here]: https://www.w3.org/TR/cid-1.0/#controlled-identifier-documents
+type IssuerDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// https://www.w3.org/TR/cid-1.0/#json-ld-context
+	Context []string `protobuf:"bytes,1,rep,name=context,proto3" json:"context,omitempty"`
+	// The URL of the document, the controller of its verification methods
+	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// The keys of the issuer
+	VerificationMethod []*VerificationMethod `protobuf:"bytes,3,rep,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	// The IDs of the verification methods signing the badges
+	AssertionMethod []string `protobuf:"bytes,4,rep,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IssuerDocument) Reset() {
+	*x = IssuerDocument{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CredentialSchema) String() string {
+func (x *IssuerDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CredentialSchema) ProtoMessage() {}
+func (*IssuerDocument) ProtoMessage() {}
 
-func (x *CredentialSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[2]
+func (x *IssuerDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,58 +1170,71 @@ func (x *CredentialSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CredentialSchema.ProtoReflect.Descriptor instead.
-func (*CredentialSchema) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use IssuerDocument.ProtoReflect.Descriptor instead.
+func (*IssuerDocument) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CredentialSchema) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *IssuerDocument) GetContext() []string {
+	if x != nil {
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *CredentialSchema) GetId() string {
+func (x *IssuerDocument) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-// CredentialStatus represents the credentialStatus property of a Verifiable Credential.
-// more information can be found [here]
+func (x *IssuerDocument) GetVerificationMethod() []*VerificationMethod {
+	if x != nil {
+		return x.VerificationMethod
+	}
+	return nil
+}
+
+func (x *IssuerDocument) GetAssertionMethod() []string {
+	if x != nil {
+		return x.AssertionMethod
+	}
+	return nil
+}
+
+// VerificationMethod is a Multikey verification method defined [here]
 //
-// [here]: https://www.w3.org/TR/vc-data-model-2.0/#status
-type CredentialStatus struct {
+// [here]: https://www.w3.org/TR/cid-1.0/#Multikey
+type VerificationMethod struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The URL identifying the schema file
+	// The URL of the document with the public key as fragment
 	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// Type specifies the type of the file
+	// The type of the verification method, always Multikey
 	Type *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	// The creation date and time of the status
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
-	// The value of the purpose for the status entry
-	Purpose       *CredentialStatusPurpose `protobuf:"varint,4,opt,name=purpose,proto3,enum=agntcy.identity.service.v1alpha1.CredentialStatusPurpose,oneof" json:"purpose,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The URL of the document of the issuer
+	Controller *string `protobuf:"bytes,3,opt,name=controller,proto3,oneof" json:"controller,omitempty"`
+	// The multibase encoded public key with its multicodec prefix
+	PublicKeyMultibase *string `protobuf:"bytes,4,opt,name=public_key_multibase,json=publicKeyMultibase,proto3,oneof" json:"public_key_multibase,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CredentialStatus) Reset() {
-	*x = CredentialStatus{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[3]
+func (x *VerificationMethod) Reset() {
+	*x = VerificationMethod{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CredentialStatus) String() string {
+func (x *VerificationMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CredentialStatus) ProtoMessage() {}
+func (*VerificationMethod) ProtoMessage() {}
 
-func (x *CredentialStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[3]
+func (x *VerificationMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,62 +1245,76 @@ func (x *CredentialStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CredentialStatus.ProtoReflect.Descriptor instead.
-func (*CredentialStatus) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use VerificationMethod.ProtoReflect.Descriptor instead.
+func (*VerificationMethod) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CredentialStatus) GetId() string {
+func (x *VerificationMethod) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-func (x *CredentialStatus) GetType() string {
+func (x *VerificationMethod) GetType() string {
 	if x != nil && x.Type != nil {
 		return *x.Type
 	}
 	return ""
 }
 
-func (x *CredentialStatus) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *VerificationMethod) GetController() string {
+	if x != nil && x.Controller != nil {
+		return *x.Controller
 	}
-	return nil
+	return ""
 }
 
-func (x *CredentialStatus) GetPurpose() CredentialStatusPurpose {
-	if x != nil && x.Purpose != nil {
-		return *x.Purpose
+func (x *VerificationMethod) GetPublicKeyMultibase() string {
+	if x != nil && x.PublicKeyMultibase != nil {
+		return *x.PublicKeyMultibase
 	}
-	return CredentialStatusPurpose_CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED
+	return ""
 }
 
-type ErrorInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        *string                `protobuf:"bytes,1,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+// TrustPolicy is a named set of checks a relying party applies
+// on top of the verification of a badge
+type TrustPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the trust policy
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// The name of the trust policy, unique in the tenant
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// The issuers of the trusted badges, any issuer is trusted when empty
+	Issuers []string `protobuf:"bytes,3,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	// The types of the trusted badges, any type is trusted when empty
+	BadgeTypes []BadgeType `protobuf:"varint,4,rep,packed,name=badge_types,json=badgeTypes,proto3,enum=agntcy.identity.service.v1alpha1.BadgeType" json:"badge_types,omitempty"`
+	// The maximum age in days of the trusted badges, no limit when zero
+	IssuedWithinDays *int32 `protobuf:"varint,5,opt,name=issued_within_days,json=issuedWithinDays,proto3,oneof" json:"issued_within_days,omitempty"`
+	// Only trust the badges of the Apps with the APP_STATUS_ACTIVE status
+	RequireActiveApp *bool `protobuf:"varint,6,opt,name=require_active_app,json=requireActiveApp,proto3,oneof" json:"require_active_app,omitempty"`
+	// The creation date and time of the trust policy
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ErrorInfo) Reset() {
-	*x = ErrorInfo{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[4]
+func (x *TrustPolicy) Reset() {
+	*x = TrustPolicy{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ErrorInfo) String() string {
+func (x *TrustPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorInfo) ProtoMessage() {}
+func (*TrustPolicy) ProtoMessage() {}
 
-func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[4]
+func (x *TrustPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,88 +1325,58 @@ func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorInfo.ProtoReflect.Descriptor instead.
-func (*ErrorInfo) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TrustPolicy.ProtoReflect.Descriptor instead.
+func (*TrustPolicy) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ErrorInfo) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *TrustPolicy) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *ErrorInfo) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *TrustPolicy) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-// A data integrity proof provides information about the proof mechanism,
-// parameters required to verify that proof, and the proof value itself.
-type Proof struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The type of the proof
-	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	// The proof purpose
-	ProofPurpose *string `protobuf:"bytes,2,opt,name=proof_purpose,json=proofPurpose,proto3,oneof" json:"proof_purpose,omitempty"`
-	// The proof value
-	ProofValue    *string `protobuf:"bytes,3,opt,name=proof_value,json=proofValue,proto3,oneof" json:"proof_value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Proof) Reset() {
-	*x = Proof{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Proof) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Proof) ProtoMessage() {}
-
-func (x *Proof) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[5]
+func (x *TrustPolicy) GetIssuers() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Issuers
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Proof.ProtoReflect.Descriptor instead.
-func (*Proof) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{5}
+func (x *TrustPolicy) GetBadgeTypes() []BadgeType {
+	if x != nil {
+		return x.BadgeTypes
+	}
+	return nil
 }
 
-func (x *Proof) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *TrustPolicy) GetIssuedWithinDays() int32 {
+	if x != nil && x.IssuedWithinDays != nil {
+		return *x.IssuedWithinDays
 	}
-	return ""
+	return 0
 }
 
-func (x *Proof) GetProofPurpose() string {
-	if x != nil && x.ProofPurpose != nil {
-		return *x.ProofPurpose
+func (x *TrustPolicy) GetRequireActiveApp() bool {
+	if x != nil && x.RequireActiveApp != nil {
+		return *x.RequireActiveApp
 	}
-	return ""
+	return false
 }
 
-func (x *Proof) GetProofValue() string {
-	if x != nil && x.ProofValue != nil {
-		return *x.ProofValue
+func (x *TrustPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
 // DataModel represents the W3C Verifiable Credential Data Model defined [here]
@@ -526,7 +1410,7 @@ type VerifiableCredential struct {
 
 func (x *VerifiableCredential) Reset() {
 	*x = VerifiableCredential{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[6]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +1422,7 @@ func (x *VerifiableCredential) String() string {
 func (*VerifiableCredential) ProtoMessage() {}
 
 func (x *VerifiableCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[6]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +1435,7 @@ func (x *VerifiableCredential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifiableCredential.ProtoReflect.Descriptor instead.
 func (*VerifiableCredential) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{6}
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *VerifiableCredential) GetContext() []string {
@@ -644,14 +1528,18 @@ type VerificationResult struct {
 	// A list represents zero or more warnings generated by the verification process
 	Warnings []*ErrorInfo `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	// A list represents zero or more errors generated by the verification process
-	Errors        []*ErrorInfo `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	Errors []*ErrorInfo `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Why the badge was revoked, set when the badge is revoked
+	RevocationReason *RevocationReason `protobuf:"varint,8,opt,name=revocation_reason,json=revocationReason,proto3,enum=agntcy.identity.service.v1alpha1.RevocationReason,oneof" json:"revocation_reason,omitempty"`
+	// Where the badge was verified
+	Source        *VerificationSource `protobuf:"varint,9,opt,name=source,proto3,enum=agntcy.identity.service.v1alpha1.VerificationSource,oneof" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VerificationResult) Reset() {
 	*x = VerificationResult{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[7]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +1551,7 @@ func (x *VerificationResult) String() string {
 func (*VerificationResult) ProtoMessage() {}
 
 func (x *VerificationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[7]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +1564,7 @@ func (x *VerificationResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationResult.ProtoReflect.Descriptor instead.
 func (*VerificationResult) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{7}
+	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *VerificationResult) GetStatus() bool {
@@ -728,6 +1616,20 @@ func (x *VerificationResult) GetErrors() []*ErrorInfo {
 	return nil
 }
 
+func (x *VerificationResult) GetRevocationReason() RevocationReason {
+	if x != nil && x.RevocationReason != nil {
+		return *x.RevocationReason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (x *VerificationResult) GetSource() VerificationSource {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return VerificationSource_VERIFICATION_SOURCE_UNSPECIFIED
+}
+
 var File_agntcy_identity_service_v1alpha1_badge_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc = "" +
@@ -742,37 +1644,131 @@ const file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x19\n" +
 	"\x05badge\x18\x02 \x01(\tH\x01R\x05badge\x88\x01\x01B\x05\n" +
 	"\x03_idB\b\n" +
-	"\x06_badge\"P\n" +
+	"\x06_badge\"\xc4\x01\n" +
+	"\tBadgeDiff\x12'\n" +
+	"\rfrom_badge_id\x18\x01 \x01(\tH\x00R\vfromBadgeId\x88\x01\x01\x12#\n" +
+	"\vto_badge_id\x18\x02 \x01(\tH\x01R\ttoBadgeId\x88\x01\x01\x12G\n" +
+	"\achanges\x18\x03 \x03(\v2-.agntcy.identity.service.v1alpha1.ClaimChangeR\achangesB\x10\n" +
+	"\x0e_from_badge_idB\x0e\n" +
+	"\f_to_badge_id\"\xcb\x01\n" +
+	"\x13BitstringStatusList\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12*\n" +
+	"\x0estatus_purpose\x18\x03 \x01(\tH\x02R\rstatusPurpose\x88\x01\x01\x12&\n" +
+	"\fencoded_list\x18\x04 \x01(\tH\x03R\vencodedList\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_typeB\x11\n" +
+	"\x0f_status_purposeB\x0f\n" +
+	"\r_encoded_list\"\xe4\x01\n" +
+	"\vClaimChange\x12\x17\n" +
+	"\x04path\x18\x01 \x01(\tH\x00R\x04path\x88\x01\x01\x12J\n" +
+	"\x04type\x18\x02 \x01(\x0e21.agntcy.identity.service.v1alpha1.ClaimChangeTypeH\x01R\x04type\x88\x01\x01\x12 \n" +
+	"\told_value\x18\x03 \x01(\tH\x02R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x04 \x01(\tH\x03R\bnewValue\x88\x01\x01B\a\n" +
+	"\x05_pathB\a\n" +
+	"\x05_typeB\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"P\n" +
 	"\x10CredentialSchema\x12\x17\n" +
 	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x13\n" +
 	"\x02id\x18\x02 \x01(\tH\x01R\x02id\x88\x01\x01B\a\n" +
 	"\x05_typeB\x05\n" +
-	"\x03_id\"\x85\x02\n" +
+	"\x03_id\"\xbb\x05\n" +
 	"\x10CredentialStatus\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12>\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tcreatedAt\x88\x01\x01\x12X\n" +
-	"\apurpose\x18\x04 \x01(\x0e29.agntcy.identity.service.v1alpha1.CredentialStatusPurposeH\x03R\apurpose\x88\x01\x01B\x05\n" +
+	"\apurpose\x18\x04 \x01(\x0e29.agntcy.identity.service.v1alpha1.CredentialStatusPurposeH\x03R\apurpose\x88\x01\x01\x12*\n" +
+	"\x0estatus_purpose\x18\x05 \x01(\tH\x04R\rstatusPurpose\x88\x01\x01\x12/\n" +
+	"\x11status_list_index\x18\x06 \x01(\tH\x05R\x0fstatusListIndex\x88\x01\x01\x129\n" +
+	"\x16status_list_credential\x18\a \x01(\tH\x06R\x14statusListCredential\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tH\aR\tcreatedBy\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\t \x01(\tH\bR\acomment\x88\x01\x01\x12d\n" +
+	"\x11revocation_reason\x18\n" +
+	" \x01(\x0e22.agntcy.identity.service.v1alpha1.RevocationReasonH\tR\x10revocationReason\x88\x01\x01B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_typeB\r\n" +
 	"\v_created_atB\n" +
 	"\n" +
-	"\b_purpose\"^\n" +
+	"\b_purposeB\x11\n" +
+	"\x0f_status_purposeB\x14\n" +
+	"\x12_status_list_indexB\x19\n" +
+	"\x17_status_list_credentialB\r\n" +
+	"\v_created_byB\n" +
+	"\n" +
+	"\b_commentB\x14\n" +
+	"\x12_revocation_reason\"^\n" +
 	"\tErrorInfo\x12\x1b\n" +
 	"\x06reason\x18\x01 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x1d\n" +
 	"\amessage\x18\x02 \x01(\tH\x01R\amessage\x88\x01\x01B\t\n" +
 	"\a_reasonB\n" +
 	"\n" +
-	"\b_message\"\x9b\x01\n" +
+	"\b_message\"\xcb\x02\n" +
 	"\x05Proof\x12\x17\n" +
 	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12(\n" +
 	"\rproof_purpose\x18\x02 \x01(\tH\x01R\fproofPurpose\x88\x01\x01\x12$\n" +
 	"\vproof_value\x18\x03 \x01(\tH\x02R\n" +
-	"proofValue\x88\x01\x01B\a\n" +
+	"proofValue\x88\x01\x01\x12%\n" +
+	"\vcryptosuite\x18\x04 \x01(\tH\x03R\vcryptosuite\x88\x01\x01\x124\n" +
+	"\x13verification_method\x18\x05 \x01(\tH\x04R\x12verificationMethod\x88\x01\x01\x12\x1d\n" +
+	"\acreated\x18\x06 \x01(\tH\x05R\acreated\x88\x01\x01B\a\n" +
 	"\x05_typeB\x10\n" +
 	"\x0e_proof_purposeB\x0e\n" +
-	"\f_proof_value\"\x90\x05\n" +
+	"\f_proof_valueB\x0e\n" +
+	"\f_cryptosuiteB\x16\n" +
+	"\x14_verification_methodB\n" +
+	"\n" +
+	"\b_created\"\x8b\x03\n" +
+	"\x14StatusListCredential\x12\x18\n" +
+	"\acontext\x18\x01 \x03(\tR\acontext\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x12\n" +
+	"\x04type\x18\x03 \x03(\tR\x04type\x12\x1b\n" +
+	"\x06issuer\x18\x04 \x01(\tH\x01R\x06issuer\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\tH\x02R\tvalidFrom\x88\x01\x01\x12i\n" +
+	"\x12credential_subject\x18\x06 \x01(\v25.agntcy.identity.service.v1alpha1.BitstringStatusListH\x03R\x11credentialSubject\x88\x01\x01\x12B\n" +
+	"\x05proof\x18\a \x01(\v2'.agntcy.identity.service.v1alpha1.ProofH\x04R\x05proof\x88\x01\x01B\x05\n" +
+	"\x03_idB\t\n" +
+	"\a_issuerB\r\n" +
+	"\v_valid_fromB\x15\n" +
+	"\x13_credential_subjectB\b\n" +
+	"\x06_proof\"\xd8\x01\n" +
+	"\x0eIssuerDocument\x12\x18\n" +
+	"\acontext\x18\x01 \x03(\tR\acontext\x12\x13\n" +
+	"\x02id\x18\x02 \x01(\tH\x00R\x02id\x88\x01\x01\x12e\n" +
+	"\x13verification_method\x18\x03 \x03(\v24.agntcy.identity.service.v1alpha1.VerificationMethodR\x12verificationMethod\x12)\n" +
+	"\x10assertion_method\x18\x04 \x03(\tR\x0fassertionMethodB\x05\n" +
+	"\x03_id\"\xd6\x01\n" +
+	"\x12VerificationMethod\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12#\n" +
+	"\n" +
+	"controller\x18\x03 \x01(\tH\x02R\n" +
+	"controller\x88\x01\x01\x125\n" +
+	"\x14public_key_multibase\x18\x04 \x01(\tH\x03R\x12publicKeyMultibase\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_typeB\r\n" +
+	"\v_controllerB\x17\n" +
+	"\x15_public_key_multibase\"\x96\x03\n" +
+	"\vTrustPolicy\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x18\n" +
+	"\aissuers\x18\x03 \x03(\tR\aissuers\x12L\n" +
+	"\vbadge_types\x18\x04 \x03(\x0e2+.agntcy.identity.service.v1alpha1.BadgeTypeR\n" +
+	"badgeTypes\x121\n" +
+	"\x12issued_within_days\x18\x05 \x01(\x05H\x02R\x10issuedWithinDays\x88\x01\x01\x121\n" +
+	"\x12require_active_app\x18\x06 \x01(\bH\x03R\x10requireActiveApp\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tcreatedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\x15\n" +
+	"\x13_issued_within_daysB\x15\n" +
+	"\x13_require_active_appB\r\n" +
+	"\v_created_at\"\x90\x05\n" +
 	"\x14VerifiableCredential\x12\x18\n" +
 	"\acontext\x18\x01 \x03(\tR\acontext\x12\x12\n" +
 	"\x04type\x18\x02 \x03(\tR\x04type\x12\x1b\n" +
@@ -790,7 +1786,7 @@ const file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc = "" +
 	"\x03_idB\x10\n" +
 	"\x0e_issuance_dateB\x12\n" +
 	"\x10_expiration_dateB\b\n" +
-	"\x06_proof\"\x85\x04\n" +
+	"\x06_proof\"\xdf\x05\n" +
 	"\x12VerificationResult\x12\x1b\n" +
 	"\x06status\x18\x01 \x01(\bH\x00R\x06status\x88\x01\x01\x12W\n" +
 	"\bdocument\x18\x02 \x01(\v26.agntcy.identity.service.v1alpha1.VerifiableCredentialH\x01R\bdocument\x88\x01\x01\x12\"\n" +
@@ -801,19 +1797,45 @@ const file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc = "" +
 	"controller\x88\x01\x01\x12I\n" +
 	"\x1econtrolled_identifier_document\x18\x05 \x01(\tH\x04R\x1ccontrolledIdentifierDocument\x88\x01\x01\x12G\n" +
 	"\bwarnings\x18\x06 \x03(\v2+.agntcy.identity.service.v1alpha1.ErrorInfoR\bwarnings\x12C\n" +
-	"\x06errors\x18\a \x03(\v2+.agntcy.identity.service.v1alpha1.ErrorInfoR\x06errorsB\t\n" +
+	"\x06errors\x18\a \x03(\v2+.agntcy.identity.service.v1alpha1.ErrorInfoR\x06errors\x12d\n" +
+	"\x11revocation_reason\x18\b \x01(\x0e22.agntcy.identity.service.v1alpha1.RevocationReasonH\x05R\x10revocationReason\x88\x01\x01\x12Q\n" +
+	"\x06source\x18\t \x01(\x0e24.agntcy.identity.service.v1alpha1.VerificationSourceH\x06R\x06source\x88\x01\x01B\t\n" +
 	"\a_statusB\v\n" +
 	"\t_documentB\r\n" +
 	"\v_media_typeB\r\n" +
 	"\v_controllerB!\n" +
-	"\x1f_controlled_identifier_document*]\n" +
+	"\x1f_controlled_identifier_documentB\x14\n" +
+	"\x12_revocation_reasonB\t\n" +
+	"\a_source*]\n" +
 	"\tBadgeType\x12\x1a\n" +
 	"\x16BADGE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16BADGE_TYPE_AGENT_BADGE\x10\x01\x12\x18\n" +
-	"\x14BADGE_TYPE_MCP_BADGE\x10\x02*n\n" +
+	"\x14BADGE_TYPE_MCP_BADGE\x10\x02*\x90\x01\n" +
+	"\x0fClaimChangeType\x12!\n" +
+	"\x1dCLAIM_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CLAIM_CHANGE_TYPE_ADDED\x10\x01\x12\x1d\n" +
+	"\x19CLAIM_CHANGE_TYPE_REMOVED\x10\x02\x12\x1e\n" +
+	"\x1aCLAIM_CHANGE_TYPE_MODIFIED\x10\x03*\x98\x01\n" +
 	"\x17CredentialStatusPurpose\x12)\n" +
 	"%CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED\x10\x00\x12(\n" +
-	"$CREDENTIAL_STATUS_PURPOSE_REVOCATION\x10\x01BhZfgithub.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1;identity_service_sdk_gob\x06proto3"
+	"$CREDENTIAL_STATUS_PURPOSE_REVOCATION\x10\x01\x12(\n" +
+	"$CREDENTIAL_STATUS_PURPOSE_SUSPENSION\x10\x02*\xa0\x01\n" +
+	"\vProofFormat\x12\x1c\n" +
+	"\x18PROOF_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PROOF_FORMAT_JOSE\x10\x01\x12\x1f\n" +
+	"\x1bPROOF_FORMAT_EDDSA_JCS_2022\x10\x02\x12\x1f\n" +
+	"\x1bPROOF_FORMAT_ECDSA_JCS_2019\x10\x03\x12\x1a\n" +
+	"\x16PROOF_FORMAT_SD_JWT_VC\x10\x04*\xcb\x01\n" +
+	"\x10RevocationReason\x12!\n" +
+	"\x1dREVOCATION_REASON_UNSPECIFIED\x10\x00\x12$\n" +
+	" REVOCATION_REASON_KEY_COMPROMISE\x10\x01\x12 \n" +
+	"\x1cREVOCATION_REASON_SUPERSEDED\x10\x02\x12$\n" +
+	" REVOCATION_REASON_DECOMMISSIONED\x10\x03\x12&\n" +
+	"\"REVOCATION_REASON_POLICY_VIOLATION\x10\x04*x\n" +
+	"\x12VerificationSource\x12#\n" +
+	"\x1fVERIFICATION_SOURCE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19VERIFICATION_SOURCE_LOCAL\x10\x01\x12\x1e\n" +
+	"\x1aVERIFICATION_SOURCE_REMOTE\x10\x02BhZfgithub.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1;identity_service_sdk_gob\x06proto3"
 
 var (
 	file_agntcy_identity_service_v1alpha1_badge_proto_rawDescOnce sync.Once
@@ -827,37 +1849,58 @@ func file_agntcy_identity_service_v1alpha1_badge_proto_rawDescGZIP() []byte {
 	return file_agntcy_identity_service_v1alpha1_badge_proto_rawDescData
 }
 
-var file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_agntcy_identity_service_v1alpha1_badge_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_agntcy_identity_service_v1alpha1_badge_proto_goTypes = []any{
 	(BadgeType)(0),                // 0: agntcy.identity.service.v1alpha1.BadgeType
-	(CredentialStatusPurpose)(0),  // 1: agntcy.identity.service.v1alpha1.CredentialStatusPurpose
-	(*Badge)(nil),                 // 2: agntcy.identity.service.v1alpha1.Badge
-	(*BadgeClaims)(nil),           // 3: agntcy.identity.service.v1alpha1.BadgeClaims
-	(*CredentialSchema)(nil),      // 4: agntcy.identity.service.v1alpha1.CredentialSchema
-	(*CredentialStatus)(nil),      // 5: agntcy.identity.service.v1alpha1.CredentialStatus
-	(*ErrorInfo)(nil),             // 6: agntcy.identity.service.v1alpha1.ErrorInfo
-	(*Proof)(nil),                 // 7: agntcy.identity.service.v1alpha1.Proof
-	(*VerifiableCredential)(nil),  // 8: agntcy.identity.service.v1alpha1.VerifiableCredential
-	(*VerificationResult)(nil),    // 9: agntcy.identity.service.v1alpha1.VerificationResult
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(ClaimChangeType)(0),          // 1: agntcy.identity.service.v1alpha1.ClaimChangeType
+	(CredentialStatusPurpose)(0),  // 2: agntcy.identity.service.v1alpha1.CredentialStatusPurpose
+	(ProofFormat)(0),              // 3: agntcy.identity.service.v1alpha1.ProofFormat
+	(RevocationReason)(0),         // 4: agntcy.identity.service.v1alpha1.RevocationReason
+	(VerificationSource)(0),       // 5: agntcy.identity.service.v1alpha1.VerificationSource
+	(*Badge)(nil),                 // 6: agntcy.identity.service.v1alpha1.Badge
+	(*BadgeClaims)(nil),           // 7: agntcy.identity.service.v1alpha1.BadgeClaims
+	(*BadgeDiff)(nil),             // 8: agntcy.identity.service.v1alpha1.BadgeDiff
+	(*BitstringStatusList)(nil),   // 9: agntcy.identity.service.v1alpha1.BitstringStatusList
+	(*ClaimChange)(nil),           // 10: agntcy.identity.service.v1alpha1.ClaimChange
+	(*CredentialSchema)(nil),      // 11: agntcy.identity.service.v1alpha1.CredentialSchema
+	(*CredentialStatus)(nil),      // 12: agntcy.identity.service.v1alpha1.CredentialStatus
+	(*ErrorInfo)(nil),             // 13: agntcy.identity.service.v1alpha1.ErrorInfo
+	(*Proof)(nil),                 // 14: agntcy.identity.service.v1alpha1.Proof
+	(*StatusListCredential)(nil),  // 15: agntcy.identity.service.v1alpha1.StatusListCredential
+	(*IssuerDocument)(nil),        // 16: agntcy.identity.service.v1alpha1.IssuerDocument
+	(*VerificationMethod)(nil),    // 17: agntcy.identity.service.v1alpha1.VerificationMethod
+	(*TrustPolicy)(nil),           // 18: agntcy.identity.service.v1alpha1.TrustPolicy
+	(*VerifiableCredential)(nil),  // 19: agntcy.identity.service.v1alpha1.VerifiableCredential
+	(*VerificationResult)(nil),    // 20: agntcy.identity.service.v1alpha1.VerificationResult
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
 }
 var file_agntcy_identity_service_v1alpha1_badge_proto_depIdxs = []int32{
-	8,  // 0: agntcy.identity.service.v1alpha1.Badge.verifiable_credential:type_name -> agntcy.identity.service.v1alpha1.VerifiableCredential
-	10, // 1: agntcy.identity.service.v1alpha1.CredentialStatus.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: agntcy.identity.service.v1alpha1.CredentialStatus.purpose:type_name -> agntcy.identity.service.v1alpha1.CredentialStatusPurpose
-	3,  // 3: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_subject:type_name -> agntcy.identity.service.v1alpha1.BadgeClaims
-	4,  // 4: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_schema:type_name -> agntcy.identity.service.v1alpha1.CredentialSchema
-	5,  // 5: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_status:type_name -> agntcy.identity.service.v1alpha1.CredentialStatus
-	7,  // 6: agntcy.identity.service.v1alpha1.VerifiableCredential.proof:type_name -> agntcy.identity.service.v1alpha1.Proof
-	8,  // 7: agntcy.identity.service.v1alpha1.VerificationResult.document:type_name -> agntcy.identity.service.v1alpha1.VerifiableCredential
-	6,  // 8: agntcy.identity.service.v1alpha1.VerificationResult.warnings:type_name -> agntcy.identity.service.v1alpha1.ErrorInfo
-	6,  // 9: agntcy.identity.service.v1alpha1.VerificationResult.errors:type_name -> agntcy.identity.service.v1alpha1.ErrorInfo
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	19, // 0: agntcy.identity.service.v1alpha1.Badge.verifiable_credential:type_name -> agntcy.identity.service.v1alpha1.VerifiableCredential
+	10, // 1: agntcy.identity.service.v1alpha1.BadgeDiff.changes:type_name -> agntcy.identity.service.v1alpha1.ClaimChange
+	1,  // 2: agntcy.identity.service.v1alpha1.ClaimChange.type:type_name -> agntcy.identity.service.v1alpha1.ClaimChangeType
+	21, // 3: agntcy.identity.service.v1alpha1.CredentialStatus.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: agntcy.identity.service.v1alpha1.CredentialStatus.purpose:type_name -> agntcy.identity.service.v1alpha1.CredentialStatusPurpose
+	4,  // 5: agntcy.identity.service.v1alpha1.CredentialStatus.revocation_reason:type_name -> agntcy.identity.service.v1alpha1.RevocationReason
+	9,  // 6: agntcy.identity.service.v1alpha1.StatusListCredential.credential_subject:type_name -> agntcy.identity.service.v1alpha1.BitstringStatusList
+	14, // 7: agntcy.identity.service.v1alpha1.StatusListCredential.proof:type_name -> agntcy.identity.service.v1alpha1.Proof
+	17, // 8: agntcy.identity.service.v1alpha1.IssuerDocument.verification_method:type_name -> agntcy.identity.service.v1alpha1.VerificationMethod
+	0,  // 9: agntcy.identity.service.v1alpha1.TrustPolicy.badge_types:type_name -> agntcy.identity.service.v1alpha1.BadgeType
+	21, // 10: agntcy.identity.service.v1alpha1.TrustPolicy.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 11: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_subject:type_name -> agntcy.identity.service.v1alpha1.BadgeClaims
+	11, // 12: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_schema:type_name -> agntcy.identity.service.v1alpha1.CredentialSchema
+	12, // 13: agntcy.identity.service.v1alpha1.VerifiableCredential.credential_status:type_name -> agntcy.identity.service.v1alpha1.CredentialStatus
+	14, // 14: agntcy.identity.service.v1alpha1.VerifiableCredential.proof:type_name -> agntcy.identity.service.v1alpha1.Proof
+	19, // 15: agntcy.identity.service.v1alpha1.VerificationResult.document:type_name -> agntcy.identity.service.v1alpha1.VerifiableCredential
+	13, // 16: agntcy.identity.service.v1alpha1.VerificationResult.warnings:type_name -> agntcy.identity.service.v1alpha1.ErrorInfo
+	13, // 17: agntcy.identity.service.v1alpha1.VerificationResult.errors:type_name -> agntcy.identity.service.v1alpha1.ErrorInfo
+	4,  // 18: agntcy.identity.service.v1alpha1.VerificationResult.revocation_reason:type_name -> agntcy.identity.service.v1alpha1.RevocationReason
+	5,  // 19: agntcy.identity.service.v1alpha1.VerificationResult.source:type_name -> agntcy.identity.service.v1alpha1.VerificationSource
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_service_v1alpha1_badge_proto_init() }
@@ -873,13 +1916,20 @@ func file_agntcy_identity_service_v1alpha1_badge_proto_init() {
 	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[5].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[6].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[7].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[8].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[9].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[10].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[11].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[12].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[13].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc), len(file_agntcy_identity_service_v1alpha1_badge_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      6,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
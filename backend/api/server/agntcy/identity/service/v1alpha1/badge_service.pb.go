@@ -12,8 +12,10 @@ package identity_service_sdk_go
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -35,7 +37,11 @@ type IssueBadgeRequest struct {
 	// The MCP badge.
 	Mcp *IssueMcpBadgeRequest `protobuf:"bytes,3,opt,name=mcp,proto3" json:"mcp,omitempty"`
 	// The OASF badge.
-	Oasf          *IssueOASFBadgeRequest `protobuf:"bytes,4,opt,name=oasf,proto3" json:"oasf,omitempty"`
+	Oasf *IssueOASFBadgeRequest `protobuf:"bytes,4,opt,name=oasf,proto3" json:"oasf,omitempty"`
+	// The format of the proof, the default format of the tenant applies when unspecified.
+	ProofFormat ProofFormat `protobuf:"varint,5,opt,name=proof_format,json=proofFormat,proto3,enum=agntcy.identity.service.v1alpha1.ProofFormat" json:"proof_format,omitempty"`
+	// The public JWK of the holder, binding an SD-JWT VC badge to the key.
+	HolderKey     *string `protobuf:"bytes,6,opt,name=holder_key,json=holderKey,proto3,oneof" json:"holder_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +104,20 @@ func (x *IssueBadgeRequest) GetOasf() *IssueOASFBadgeRequest {
 	return nil
 }
 
+func (x *IssueBadgeRequest) GetProofFormat() ProofFormat {
+	if x != nil {
+		return x.ProofFormat
+	}
+	return ProofFormat_PROOF_FORMAT_UNSPECIFIED
+}
+
+func (x *IssueBadgeRequest) GetHolderKey() string {
+	if x != nil && x.HolderKey != nil {
+		return *x.HolderKey
+	}
+	return ""
+}
+
 type IssueMcpBadgeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The MCP badge name.
@@ -260,17 +280,242 @@ func (x *IssueOASFBadgeRequest) GetSchemaBase64() string {
 	return ""
 }
 
+type ListBadgesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App Id.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The current page of the pagination
+	Page *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// The page size of the pagination
+	Size          *int32 `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBadgesRequest) Reset() {
+	*x = ListBadgesRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBadgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBadgesRequest) ProtoMessage() {}
+
+func (x *ListBadgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBadgesRequest.ProtoReflect.Descriptor instead.
+func (*ListBadgesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBadgesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ListBadgesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListBadgesRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+type ListBadgesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A list of Badges.
+	Badges []*Badge `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges,omitempty"`
+	// Pagination response.
+	Pagination    *PagedResponse `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBadgesResponse) Reset() {
+	*x = ListBadgesResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBadgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBadgesResponse) ProtoMessage() {}
+
+func (x *ListBadgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBadgesResponse.ProtoReflect.Descriptor instead.
+func (*ListBadgesResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBadgesResponse) GetBadges() []*Badge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *ListBadgesResponse) GetPagination() *PagedResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetBadgeByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the badge.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBadgeByIDRequest) Reset() {
+	*x = GetBadgeByIDRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBadgeByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBadgeByIDRequest) ProtoMessage() {}
+
+func (x *GetBadgeByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBadgeByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetBadgeByIDRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBadgeByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DiffBadgesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the previous badge.
+	FromBadgeId string `protobuf:"bytes,1,opt,name=from_badge_id,json=fromBadgeId,proto3" json:"from_badge_id,omitempty"`
+	// The ID of the next badge.
+	ToBadgeId     string `protobuf:"bytes,2,opt,name=to_badge_id,json=toBadgeId,proto3" json:"to_badge_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBadgesRequest) Reset() {
+	*x = DiffBadgesRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBadgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBadgesRequest) ProtoMessage() {}
+
+func (x *DiffBadgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBadgesRequest.ProtoReflect.Descriptor instead.
+func (*DiffBadgesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffBadgesRequest) GetFromBadgeId() string {
+	if x != nil {
+		return x.FromBadgeId
+	}
+	return ""
+}
+
+func (x *DiffBadgesRequest) GetToBadgeId() string {
+	if x != nil {
+		return x.ToBadgeId
+	}
+	return ""
+}
+
 type VerifyBadgeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The JOSE enveloped badge to verify.
-	Badge         string `protobuf:"bytes,1,opt,name=badge,proto3" json:"badge,omitempty"`
+	// The JOSE enveloped badge, the credential with a Data Integrity proof or the SD-JWT VC presentation to verify.
+	Badge string `protobuf:"bytes,1,opt,name=badge,proto3" json:"badge,omitempty"`
+	// The expected audience of the key binding JWT of an SD-JWT VC presentation.
+	// Mandatory when the presentation is bound to a holder key.
+	Audience *string `protobuf:"bytes,2,opt,name=audience,proto3,oneof" json:"audience,omitempty"`
+	// The expected nonce of the key binding JWT of an SD-JWT VC presentation.
+	// Mandatory when the presentation is bound to a holder key.
+	Nonce *string `protobuf:"bytes,3,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
+	// The name of a trust policy of the tenant whose checks apply to the badge,
+	// the request must then be authenticated.
+	TrustPolicy   *string `protobuf:"bytes,4,opt,name=trust_policy,json=trustPolicy,proto3,oneof" json:"trust_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VerifyBadgeRequest) Reset() {
 	*x = VerifyBadgeRequest{}
-	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[4]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +527,7 @@ func (x *VerifyBadgeRequest) String() string {
 func (*VerifyBadgeRequest) ProtoMessage() {}
 
 func (x *VerifyBadgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[4]
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +540,7 @@ func (x *VerifyBadgeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyBadgeRequest.ProtoReflect.Descriptor instead.
 func (*VerifyBadgeRequest) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{4}
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VerifyBadgeRequest) GetBadge() string {
@@ -305,37 +550,849 @@ func (x *VerifyBadgeRequest) GetBadge() string {
 	return ""
 }
 
-var File_agntcy_identity_service_v1alpha1_badge_service_proto protoreflect.FileDescriptor
+func (x *VerifyBadgeRequest) GetAudience() string {
+	if x != nil && x.Audience != nil {
+		return *x.Audience
+	}
+	return ""
+}
 
-const file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDesc = "" +
-	"\n" +
-	"4agntcy/identity/service/v1alpha1/badge_service.proto\x12 agntcy.identity.service.v1alpha1\x1a,agntcy/identity/service/v1alpha1/badge.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x8b\x02\n" +
-	"\x11IssueBadgeRequest\x12\x15\n" +
-	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12H\n" +
-	"\x03a2a\x18\x02 \x01(\v26.agntcy.identity.service.v1alpha1.IssueA2ABadgeRequestR\x03a2a\x12H\n" +
-	"\x03mcp\x18\x03 \x01(\v26.agntcy.identity.service.v1alpha1.IssueMcpBadgeRequestR\x03mcp\x12K\n" +
-	"\x04oasf\x18\x04 \x01(\v27.agntcy.identity.service.v1alpha1.IssueOASFBadgeRequestR\x04oasf\"\x93\x01\n" +
-	"\x14IssueMcpBadgeRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x15\n" +
-	"\x03url\x18\x02 \x01(\tH\x01R\x03url\x88\x01\x01\x12(\n" +
-	"\rschema_base64\x18\x03 \x01(\tH\x02R\fschemaBase64\x88\x01\x01B\a\n" +
-	"\x05_nameB\x06\n" +
-	"\x04_urlB\x10\n" +
-	"\x0e_schema_base64\"\x90\x01\n" +
-	"\x14IssueA2ABadgeRequest\x12)\n" +
-	"\x0ewell_known_url\x18\x01 \x01(\tH\x00R\fwellKnownUrl\x88\x01\x01\x12(\n" +
-	"\rschema_base64\x18\x02 \x01(\tH\x01R\fschemaBase64\x88\x01\x01B\x11\n" +
-	"\x0f_well_known_urlB\x10\n" +
-	"\x0e_schema_base64\"<\n" +
-	"\x15IssueOASFBadgeRequest\x12#\n" +
-	"\rschema_base64\x18\x01 \x01(\tR\fschemaBase64\"*\n" +
-	"\x12VerifyBadgeRequest\x12\x14\n" +
-	"\x05badge\x18\x01 \x01(\tR\x05badge2\x90\x03\n" +
-	"\fBadgeService\x12\xb3\x01\n" +
-	"\n" +
-	"IssueBadge\x123.agntcy.identity.service.v1alpha1.IssueBadgeRequest\x1a'.agntcy.identity.service.v1alpha1.Badge\"G\x92A\x1b\x12\rIssue a badge*\n" +
-	"IssueBadge\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1alpha1/apps/{app_id}/badges\x12\xbd\x01\n" +
-	"\vVerifyBadge\x124.agntcy.identity.service.v1alpha1.VerifyBadgeRequest\x1a4.agntcy.identity.service.v1alpha1.VerificationResult\"B\x92A\x1d\x12\x0eVerify a badge*\vVerifyBadge\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1alpha1/badges/verify\x1a\n" +
+func (x *VerifyBadgeRequest) GetNonce() string {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return ""
+}
+
+func (x *VerifyBadgeRequest) GetTrustPolicy() string {
+	if x != nil && x.TrustPolicy != nil {
+		return *x.TrustPolicy
+	}
+	return ""
+}
+
+type VerifyBadgesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The badges to verify, at most 100.
+	Badges        []*VerifyBadgeRequest `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBadgesRequest) Reset() {
+	*x = VerifyBadgesRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBadgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBadgesRequest) ProtoMessage() {}
+
+func (x *VerifyBadgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBadgesRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBadgesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyBadgesRequest) GetBadges() []*VerifyBadgeRequest {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+type VerifyBadgesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results of the verifications, in the order of the badges of the request.
+	Results       []*VerifyBadgesResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBadgesResponse) Reset() {
+	*x = VerifyBadgesResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBadgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBadgesResponse) ProtoMessage() {}
+
+func (x *VerifyBadgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBadgesResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBadgesResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VerifyBadgesResponse) GetResults() []*VerifyBadgesResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type VerifyBadgesResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The result of the verification, set when the badge was verified.
+	Result *VerificationResult `protobuf:"bytes,1,opt,name=result,proto3,oneof" json:"result,omitempty"`
+	// The error that prevented the verification of the badge.
+	Error         *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBadgesResult) Reset() {
+	*x = VerifyBadgesResult{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBadgesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBadgesResult) ProtoMessage() {}
+
+func (x *VerifyBadgesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBadgesResult.ProtoReflect.Descriptor instead.
+func (*VerifyBadgesResult) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VerifyBadgesResult) GetResult() *VerificationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *VerifyBadgesResult) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetStatusListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the status list.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusListRequest) Reset() {
+	*x = GetStatusListRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusListRequest) ProtoMessage() {}
+
+func (x *GetStatusListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusListRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusListRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetStatusListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIssuerDocumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the issuer.
+	Issuer        string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssuerDocumentRequest) Reset() {
+	*x = GetIssuerDocumentRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssuerDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssuerDocumentRequest) ProtoMessage() {}
+
+func (x *GetIssuerDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssuerDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetIssuerDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetIssuerDocumentRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+type RevokeBadgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App Id.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// The ID of the badge to revoke, all the active badges
+	// of the App are revoked when not set.
+	BadgeId *string `protobuf:"bytes,2,opt,name=badge_id,json=badgeId,proto3,oneof" json:"badge_id,omitempty"`
+	// Why the badge is revoked.
+	Reason RevocationReason `protobuf:"varint,3,opt,name=reason,proto3,enum=agntcy.identity.service.v1alpha1.RevocationReason" json:"reason,omitempty"`
+	// A free-text comment on the revocation.
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeBadgeRequest) Reset() {
+	*x = RevokeBadgeRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeBadgeRequest) ProtoMessage() {}
+
+func (x *RevokeBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeBadgeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeBadgeRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *RevokeBadgeRequest) GetBadgeId() string {
+	if x != nil && x.BadgeId != nil {
+		return *x.BadgeId
+	}
+	return ""
+}
+
+func (x *RevokeBadgeRequest) GetReason() RevocationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RevocationReason_REVOCATION_REASON_UNSPECIFIED
+}
+
+func (x *RevokeBadgeRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SuspendBadgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App Id.
+	AppId string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// Why the App is suspended.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendBadgeRequest) Reset() {
+	*x = SuspendBadgeRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendBadgeRequest) ProtoMessage() {}
+
+func (x *SuspendBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendBadgeRequest.ProtoReflect.Descriptor instead.
+func (*SuspendBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SuspendBadgeRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SuspendBadgeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ResumeBadgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// App Id.
+	AppId         string `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeBadgeRequest) Reset() {
+	*x = ResumeBadgeRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeBadgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeBadgeRequest) ProtoMessage() {}
+
+func (x *ResumeBadgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeBadgeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeBadgeRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResumeBadgeRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ListTrustPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrustPoliciesRequest) Reset() {
+	*x = ListTrustPoliciesRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrustPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrustPoliciesRequest) ProtoMessage() {}
+
+func (x *ListTrustPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrustPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrustPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{17}
+}
+
+type ListTrustPoliciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The trust policies of the tenant.
+	TrustPolicies []*TrustPolicy `protobuf:"bytes,1,rep,name=trust_policies,json=trustPolicies,proto3" json:"trust_policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrustPoliciesResponse) Reset() {
+	*x = ListTrustPoliciesResponse{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrustPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrustPoliciesResponse) ProtoMessage() {}
+
+func (x *ListTrustPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrustPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrustPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTrustPoliciesResponse) GetTrustPolicies() []*TrustPolicy {
+	if x != nil {
+		return x.TrustPolicies
+	}
+	return nil
+}
+
+type CreateTrustPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the trust policy, unique in the tenant.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The issuers of the trusted badges, any issuer is trusted when empty.
+	Issuers []string `protobuf:"bytes,2,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	// The types of the trusted badges, any type is trusted when empty.
+	BadgeTypes []BadgeType `protobuf:"varint,3,rep,packed,name=badge_types,json=badgeTypes,proto3,enum=agntcy.identity.service.v1alpha1.BadgeType" json:"badge_types,omitempty"`
+	// The maximum age in days of the trusted badges, no limit when zero.
+	IssuedWithinDays int32 `protobuf:"varint,4,opt,name=issued_within_days,json=issuedWithinDays,proto3" json:"issued_within_days,omitempty"`
+	// Only trust the badges of the Apps with the APP_STATUS_ACTIVE status.
+	RequireActiveApp bool `protobuf:"varint,5,opt,name=require_active_app,json=requireActiveApp,proto3" json:"require_active_app,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateTrustPolicyRequest) Reset() {
+	*x = CreateTrustPolicyRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTrustPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTrustPolicyRequest) ProtoMessage() {}
+
+func (x *CreateTrustPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTrustPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateTrustPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateTrustPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTrustPolicyRequest) GetIssuers() []string {
+	if x != nil {
+		return x.Issuers
+	}
+	return nil
+}
+
+func (x *CreateTrustPolicyRequest) GetBadgeTypes() []BadgeType {
+	if x != nil {
+		return x.BadgeTypes
+	}
+	return nil
+}
+
+func (x *CreateTrustPolicyRequest) GetIssuedWithinDays() int32 {
+	if x != nil {
+		return x.IssuedWithinDays
+	}
+	return 0
+}
+
+func (x *CreateTrustPolicyRequest) GetRequireActiveApp() bool {
+	if x != nil {
+		return x.RequireActiveApp
+	}
+	return false
+}
+
+type UpdateTrustPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the trust policy to update.
+	TrustPolicyId string `protobuf:"bytes,1,opt,name=trust_policy_id,json=trustPolicyId,proto3" json:"trust_policy_id,omitempty"`
+	// The name of the trust policy, unique in the tenant.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The issuers of the trusted badges, any issuer is trusted when empty.
+	Issuers []string `protobuf:"bytes,3,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	// The types of the trusted badges, any type is trusted when empty.
+	BadgeTypes []BadgeType `protobuf:"varint,4,rep,packed,name=badge_types,json=badgeTypes,proto3,enum=agntcy.identity.service.v1alpha1.BadgeType" json:"badge_types,omitempty"`
+	// The maximum age in days of the trusted badges, no limit when zero.
+	IssuedWithinDays int32 `protobuf:"varint,5,opt,name=issued_within_days,json=issuedWithinDays,proto3" json:"issued_within_days,omitempty"`
+	// Only trust the badges of the Apps with the APP_STATUS_ACTIVE status.
+	RequireActiveApp bool `protobuf:"varint,6,opt,name=require_active_app,json=requireActiveApp,proto3" json:"require_active_app,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateTrustPolicyRequest) Reset() {
+	*x = UpdateTrustPolicyRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrustPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrustPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateTrustPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTrustPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTrustPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTrustPolicyRequest) GetTrustPolicyId() string {
+	if x != nil {
+		return x.TrustPolicyId
+	}
+	return ""
+}
+
+func (x *UpdateTrustPolicyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTrustPolicyRequest) GetIssuers() []string {
+	if x != nil {
+		return x.Issuers
+	}
+	return nil
+}
+
+func (x *UpdateTrustPolicyRequest) GetBadgeTypes() []BadgeType {
+	if x != nil {
+		return x.BadgeTypes
+	}
+	return nil
+}
+
+func (x *UpdateTrustPolicyRequest) GetIssuedWithinDays() int32 {
+	if x != nil {
+		return x.IssuedWithinDays
+	}
+	return 0
+}
+
+func (x *UpdateTrustPolicyRequest) GetRequireActiveApp() bool {
+	if x != nil {
+		return x.RequireActiveApp
+	}
+	return false
+}
+
+type DeleteTrustPolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the trust policy to delete.
+	TrustPolicyId string `protobuf:"bytes,1,opt,name=trust_policy_id,json=trustPolicyId,proto3" json:"trust_policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTrustPolicyRequest) Reset() {
+	*x = DeleteTrustPolicyRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTrustPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTrustPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteTrustPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTrustPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTrustPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTrustPolicyRequest) GetTrustPolicyId() string {
+	if x != nil {
+		return x.TrustPolicyId
+	}
+	return ""
+}
+
+var File_agntcy_identity_service_v1alpha1_badge_service_proto protoreflect.FileDescriptor
+
+const file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDesc = "" +
+	"\n" +
+	"4agntcy/identity/service/v1alpha1/badge_service.proto\x12 agntcy.identity.service.v1alpha1\x1a,agntcy/identity/service/v1alpha1/badge.proto\x1a1agntcy/identity/service/v1alpha1/pagination.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17google/rpc/status.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x90\x03\n" +
+	"\x11IssueBadgeRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12H\n" +
+	"\x03a2a\x18\x02 \x01(\v26.agntcy.identity.service.v1alpha1.IssueA2ABadgeRequestR\x03a2a\x12H\n" +
+	"\x03mcp\x18\x03 \x01(\v26.agntcy.identity.service.v1alpha1.IssueMcpBadgeRequestR\x03mcp\x12K\n" +
+	"\x04oasf\x18\x04 \x01(\v27.agntcy.identity.service.v1alpha1.IssueOASFBadgeRequestR\x04oasf\x12P\n" +
+	"\fproof_format\x18\x05 \x01(\x0e2-.agntcy.identity.service.v1alpha1.ProofFormatR\vproofFormat\x12\"\n" +
+	"\n" +
+	"holder_key\x18\x06 \x01(\tH\x00R\tholderKey\x88\x01\x01B\r\n" +
+	"\v_holder_key\"\x93\x01\n" +
+	"\x14IssueMcpBadgeRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tH\x01R\x03url\x88\x01\x01\x12(\n" +
+	"\rschema_base64\x18\x03 \x01(\tH\x02R\fschemaBase64\x88\x01\x01B\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_urlB\x10\n" +
+	"\x0e_schema_base64\"\x90\x01\n" +
+	"\x14IssueA2ABadgeRequest\x12)\n" +
+	"\x0ewell_known_url\x18\x01 \x01(\tH\x00R\fwellKnownUrl\x88\x01\x01\x12(\n" +
+	"\rschema_base64\x18\x02 \x01(\tH\x01R\fschemaBase64\x88\x01\x01B\x11\n" +
+	"\x0f_well_known_urlB\x10\n" +
+	"\x0e_schema_base64\"<\n" +
+	"\x15IssueOASFBadgeRequest\x12#\n" +
+	"\rschema_base64\x18\x01 \x01(\tR\fschemaBase64\"n\n" +
+	"\x11ListBadgesRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x00R\x04page\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x03 \x01(\x05H\x01R\x04size\x88\x01\x01B\a\n" +
+	"\x05_pageB\a\n" +
+	"\x05_size\"\xba\x01\n" +
+	"\x12ListBadgesResponse\x12?\n" +
+	"\x06badges\x18\x01 \x03(\v2'.agntcy.identity.service.v1alpha1.BadgeR\x06badges\x12T\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2/.agntcy.identity.service.v1alpha1.PagedResponseH\x00R\n" +
+	"pagination\x88\x01\x01B\r\n" +
+	"\v_pagination\"%\n" +
+	"\x13GetBadgeByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x11DiffBadgesRequest\x12\"\n" +
+	"\rfrom_badge_id\x18\x01 \x01(\tR\vfromBadgeId\x12\x1e\n" +
+	"\vto_badge_id\x18\x02 \x01(\tR\ttoBadgeId\"\xb6\x01\n" +
+	"\x12VerifyBadgeRequest\x12\x14\n" +
+	"\x05badge\x18\x01 \x01(\tR\x05badge\x12\x1f\n" +
+	"\baudience\x18\x02 \x01(\tH\x00R\baudience\x88\x01\x01\x12\x19\n" +
+	"\x05nonce\x18\x03 \x01(\tH\x01R\x05nonce\x88\x01\x01\x12&\n" +
+	"\ftrust_policy\x18\x04 \x01(\tH\x02R\vtrustPolicy\x88\x01\x01B\v\n" +
+	"\t_audienceB\b\n" +
+	"\x06_nonceB\x0f\n" +
+	"\r_trust_policy\"c\n" +
+	"\x13VerifyBadgesRequest\x12L\n" +
+	"\x06badges\x18\x01 \x03(\v24.agntcy.identity.service.v1alpha1.VerifyBadgeRequestR\x06badges\"f\n" +
+	"\x14VerifyBadgesResponse\x12N\n" +
+	"\aresults\x18\x01 \x03(\v24.agntcy.identity.service.v1alpha1.VerifyBadgesResultR\aresults\"\xab\x01\n" +
+	"\x12VerifyBadgesResult\x12Q\n" +
+	"\x06result\x18\x01 \x01(\v24.agntcy.identity.service.v1alpha1.VerificationResultH\x00R\x06result\x88\x01\x01\x12-\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x01R\x05error\x88\x01\x01B\t\n" +
+	"\a_resultB\b\n" +
+	"\x06_error\"&\n" +
+	"\x14GetStatusListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x18GetIssuerDocumentRequest\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\"\xbe\x01\n" +
+	"\x12RevokeBadgeRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1e\n" +
+	"\bbadge_id\x18\x02 \x01(\tH\x00R\abadgeId\x88\x01\x01\x12J\n" +
+	"\x06reason\x18\x03 \x01(\x0e22.agntcy.identity.service.v1alpha1.RevocationReasonR\x06reason\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acommentB\v\n" +
+	"\t_badge_id\"D\n" +
+	"\x13SuspendBadgeRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"+\n" +
+	"\x12ResumeBadgeRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"\x1a\n" +
+	"\x18ListTrustPoliciesRequest\"q\n" +
+	"\x19ListTrustPoliciesResponse\x12T\n" +
+	"\x0etrust_policies\x18\x01 \x03(\v2-.agntcy.identity.service.v1alpha1.TrustPolicyR\rtrustPolicies\"\xf2\x01\n" +
+	"\x18CreateTrustPolicyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aissuers\x18\x02 \x03(\tR\aissuers\x12L\n" +
+	"\vbadge_types\x18\x03 \x03(\x0e2+.agntcy.identity.service.v1alpha1.BadgeTypeR\n" +
+	"badgeTypes\x12,\n" +
+	"\x12issued_within_days\x18\x04 \x01(\x05R\x10issuedWithinDays\x12,\n" +
+	"\x12require_active_app\x18\x05 \x01(\bR\x10requireActiveApp\"\x9a\x02\n" +
+	"\x18UpdateTrustPolicyRequest\x12&\n" +
+	"\x0ftrust_policy_id\x18\x01 \x01(\tR\rtrustPolicyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aissuers\x18\x03 \x03(\tR\aissuers\x12L\n" +
+	"\vbadge_types\x18\x04 \x03(\x0e2+.agntcy.identity.service.v1alpha1.BadgeTypeR\n" +
+	"badgeTypes\x12,\n" +
+	"\x12issued_within_days\x18\x05 \x01(\x05R\x10issuedWithinDays\x12,\n" +
+	"\x12require_active_app\x18\x06 \x01(\bR\x10requireActiveApp\"B\n" +
+	"\x18DeleteTrustPolicyRequest\x12&\n" +
+	"\x0ftrust_policy_id\x18\x01 \x01(\tR\rtrustPolicyId2\x9c\x18\n" +
+	"\fBadgeService\x12\xb3\x01\n" +
+	"\n" +
+	"IssueBadge\x123.agntcy.identity.service.v1alpha1.IssueBadgeRequest\x1a'.agntcy.identity.service.v1alpha1.Badge\"G\x92A\x1b\x12\rIssue a badge*\n" +
+	"IssueBadge\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1alpha1/apps/{app_id}/badges\x12\xc9\x01\n" +
+	"\n" +
+	"ListBadges\x123.agntcy.identity.service.v1alpha1.ListBadgesRequest\x1a4.agntcy.identity.service.v1alpha1.ListBadgesResponse\"P\x92A'\x12\x19List the badges of an App*\n" +
+	"ListBadges\x82\xd3\xe4\x93\x02 \x12\x1e/v1alpha1/apps/{app_id}/badges\x12\xab\x01\n" +
+	"\fGetBadgeByID\x125.agntcy.identity.service.v1alpha1.GetBadgeByIDRequest\x1a'.agntcy.identity.service.v1alpha1.Badge\";\x92A\x1b\x12\vGet a badge*\fGetBadgeByID\x82\xd3\xe4\x93\x02\x17\x12\x15/v1alpha1/badges/{id}\x12\xdc\x01\n" +
+	"\n" +
+	"DiffBadges\x123.agntcy.identity.service.v1alpha1.DiffBadgesRequest\x1a+.agntcy.identity.service.v1alpha1.BadgeDiff\"l\x92A.\x12 Compare the claims of two badges*\n" +
+	"DiffBadges\x82\xd3\xe4\x93\x025\x123/v1alpha1/badges/{from_badge_id}/diff/{to_badge_id}\x12\xbd\x01\n" +
+	"\vVerifyBadge\x124.agntcy.identity.service.v1alpha1.VerifyBadgeRequest\x1a4.agntcy.identity.service.v1alpha1.VerificationResult\"B\x92A\x1d\x12\x0eVerify a badge*\vVerifyBadge\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1alpha1/badges/verify\x12\xd2\x01\n" +
+	"\fVerifyBadges\x125.agntcy.identity.service.v1alpha1.VerifyBadgesRequest\x1a6.agntcy.identity.service.v1alpha1.VerifyBadgesResponse\"S\x92A(\x12\x18Verify a batch of badges*\fVerifyBadges\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1alpha1/badges/verify/batch\x12\xdb\x01\n" +
+	"\rGetStatusList\x126.agntcy.identity.service.v1alpha1.GetStatusListRequest\x1a6.agntcy.identity.service.v1alpha1.StatusListCredential\"Z\x92A-\x12\x1cGet a status list credential*\rGetStatusList\x82\xd3\xe4\x93\x02$\x12\"/v1alpha1/badges/status-lists/{id}\x12\xe1\x01\n" +
+	"\x11GetIssuerDocument\x12:.agntcy.identity.service.v1alpha1.GetIssuerDocumentRequest\x1a0.agntcy.identity.service.v1alpha1.IssuerDocument\"^\x92A2\x12\x1dGet the document of an issuer*\x11GetIssuerDocument\x82\xd3\xe4\x93\x02#\x12!/v1alpha1/badges/issuers/{issuer}\x12\xba\x01\n" +
+	"\vRevokeBadge\x124.agntcy.identity.service.v1alpha1.RevokeBadgeRequest\x1a\x16.google.protobuf.Empty\"]\x92A*\x12\x1bRevoke the badges of an App*\vRevokeBadge\x82\xd3\xe4\x93\x02*:\x01*\"%/v1alpha1/apps/{app_id}/badges/revoke\x12\xbf\x01\n" +
+	"\fSuspendBadge\x125.agntcy.identity.service.v1alpha1.SuspendBadgeRequest\x1a\x16.google.protobuf.Empty\"`\x92A,\x12\x1cSuspend the badges of an App*\fSuspendBadge\x82\xd3\xe4\x93\x02+:\x01*\"&/v1alpha1/apps/{app_id}/badges/suspend\x12\xb7\x01\n" +
+	"\vResumeBadge\x124.agntcy.identity.service.v1alpha1.ResumeBadgeRequest\x1a\x16.google.protobuf.Empty\"Z\x92A*\x12\x1bResume the badges of an App*\vResumeBadge\x82\xd3\xe4\x93\x02'\"%/v1alpha1/apps/{app_id}/badges/resume\x12\xdd\x01\n" +
+	"\x11ListTrustPolicies\x12:.agntcy.identity.service.v1alpha1.ListTrustPoliciesRequest\x1a;.agntcy.identity.service.v1alpha1.ListTrustPoliciesResponse\"O\x92A,\x12\x17List the trust policies*\x11ListTrustPolicies\x82\xd3\xe4\x93\x02\x1a\x12\x18/v1alpha1/trust-policies\x12\xd0\x01\n" +
+	"\x11CreateTrustPolicy\x12:.agntcy.identity.service.v1alpha1.CreateTrustPolicyRequest\x1a-.agntcy.identity.service.v1alpha1.TrustPolicy\"P\x92A*\x12\x15Create a trust policy*\x11CreateTrustPolicy\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1alpha1/trust-policies\x12\xe2\x01\n" +
+	"\x11UpdateTrustPolicy\x12:.agntcy.identity.service.v1alpha1.UpdateTrustPolicyRequest\x1a-.agntcy.identity.service.v1alpha1.TrustPolicy\"b\x92A*\x12\x15Update a trust policy*\x11UpdateTrustPolicy\x82\xd3\xe4\x93\x02/:\x01*2*/v1alpha1/trust-policies/{trust_policy_id}\x12\xc8\x01\n" +
+	"\x11DeleteTrustPolicy\x12:.agntcy.identity.service.v1alpha1.DeleteTrustPolicyRequest\x1a\x16.google.protobuf.Empty\"_\x92A*\x12\x15Delete a trust policy*\x11DeleteTrustPolicy\x82\xd3\xe4\x93\x02,**/v1alpha1/trust-policies/{trust_policy_id}\x1a\n" +
 	"\x92A\a\n" +
 	"\x05BadgeBhZfgithub.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1;identity_service_sdk_gob\x06proto3"
 
@@ -351,29 +1408,93 @@ func file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescGZIP() []b
 	return file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDescData
 }
 
-var file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_agntcy_identity_service_v1alpha1_badge_service_proto_goTypes = []any{
-	(*IssueBadgeRequest)(nil),     // 0: agntcy.identity.service.v1alpha1.IssueBadgeRequest
-	(*IssueMcpBadgeRequest)(nil),  // 1: agntcy.identity.service.v1alpha1.IssueMcpBadgeRequest
-	(*IssueA2ABadgeRequest)(nil),  // 2: agntcy.identity.service.v1alpha1.IssueA2ABadgeRequest
-	(*IssueOASFBadgeRequest)(nil), // 3: agntcy.identity.service.v1alpha1.IssueOASFBadgeRequest
-	(*VerifyBadgeRequest)(nil),    // 4: agntcy.identity.service.v1alpha1.VerifyBadgeRequest
-	(*Badge)(nil),                 // 5: agntcy.identity.service.v1alpha1.Badge
-	(*VerificationResult)(nil),    // 6: agntcy.identity.service.v1alpha1.VerificationResult
+	(*IssueBadgeRequest)(nil),         // 0: agntcy.identity.service.v1alpha1.IssueBadgeRequest
+	(*IssueMcpBadgeRequest)(nil),      // 1: agntcy.identity.service.v1alpha1.IssueMcpBadgeRequest
+	(*IssueA2ABadgeRequest)(nil),      // 2: agntcy.identity.service.v1alpha1.IssueA2ABadgeRequest
+	(*IssueOASFBadgeRequest)(nil),     // 3: agntcy.identity.service.v1alpha1.IssueOASFBadgeRequest
+	(*ListBadgesRequest)(nil),         // 4: agntcy.identity.service.v1alpha1.ListBadgesRequest
+	(*ListBadgesResponse)(nil),        // 5: agntcy.identity.service.v1alpha1.ListBadgesResponse
+	(*GetBadgeByIDRequest)(nil),       // 6: agntcy.identity.service.v1alpha1.GetBadgeByIDRequest
+	(*DiffBadgesRequest)(nil),         // 7: agntcy.identity.service.v1alpha1.DiffBadgesRequest
+	(*VerifyBadgeRequest)(nil),        // 8: agntcy.identity.service.v1alpha1.VerifyBadgeRequest
+	(*VerifyBadgesRequest)(nil),       // 9: agntcy.identity.service.v1alpha1.VerifyBadgesRequest
+	(*VerifyBadgesResponse)(nil),      // 10: agntcy.identity.service.v1alpha1.VerifyBadgesResponse
+	(*VerifyBadgesResult)(nil),        // 11: agntcy.identity.service.v1alpha1.VerifyBadgesResult
+	(*GetStatusListRequest)(nil),      // 12: agntcy.identity.service.v1alpha1.GetStatusListRequest
+	(*GetIssuerDocumentRequest)(nil),  // 13: agntcy.identity.service.v1alpha1.GetIssuerDocumentRequest
+	(*RevokeBadgeRequest)(nil),        // 14: agntcy.identity.service.v1alpha1.RevokeBadgeRequest
+	(*SuspendBadgeRequest)(nil),       // 15: agntcy.identity.service.v1alpha1.SuspendBadgeRequest
+	(*ResumeBadgeRequest)(nil),        // 16: agntcy.identity.service.v1alpha1.ResumeBadgeRequest
+	(*ListTrustPoliciesRequest)(nil),  // 17: agntcy.identity.service.v1alpha1.ListTrustPoliciesRequest
+	(*ListTrustPoliciesResponse)(nil), // 18: agntcy.identity.service.v1alpha1.ListTrustPoliciesResponse
+	(*CreateTrustPolicyRequest)(nil),  // 19: agntcy.identity.service.v1alpha1.CreateTrustPolicyRequest
+	(*UpdateTrustPolicyRequest)(nil),  // 20: agntcy.identity.service.v1alpha1.UpdateTrustPolicyRequest
+	(*DeleteTrustPolicyRequest)(nil),  // 21: agntcy.identity.service.v1alpha1.DeleteTrustPolicyRequest
+	(ProofFormat)(0),                  // 22: agntcy.identity.service.v1alpha1.ProofFormat
+	(*Badge)(nil),                     // 23: agntcy.identity.service.v1alpha1.Badge
+	(*PagedResponse)(nil),             // 24: agntcy.identity.service.v1alpha1.PagedResponse
+	(*VerificationResult)(nil),        // 25: agntcy.identity.service.v1alpha1.VerificationResult
+	(*status.Status)(nil),             // 26: google.rpc.Status
+	(RevocationReason)(0),             // 27: agntcy.identity.service.v1alpha1.RevocationReason
+	(*TrustPolicy)(nil),               // 28: agntcy.identity.service.v1alpha1.TrustPolicy
+	(BadgeType)(0),                    // 29: agntcy.identity.service.v1alpha1.BadgeType
+	(*BadgeDiff)(nil),                 // 30: agntcy.identity.service.v1alpha1.BadgeDiff
+	(*StatusListCredential)(nil),      // 31: agntcy.identity.service.v1alpha1.StatusListCredential
+	(*IssuerDocument)(nil),            // 32: agntcy.identity.service.v1alpha1.IssuerDocument
+	(*emptypb.Empty)(nil),             // 33: google.protobuf.Empty
 }
 var file_agntcy_identity_service_v1alpha1_badge_service_proto_depIdxs = []int32{
-	2, // 0: agntcy.identity.service.v1alpha1.IssueBadgeRequest.a2a:type_name -> agntcy.identity.service.v1alpha1.IssueA2ABadgeRequest
-	1, // 1: agntcy.identity.service.v1alpha1.IssueBadgeRequest.mcp:type_name -> agntcy.identity.service.v1alpha1.IssueMcpBadgeRequest
-	3, // 2: agntcy.identity.service.v1alpha1.IssueBadgeRequest.oasf:type_name -> agntcy.identity.service.v1alpha1.IssueOASFBadgeRequest
-	0, // 3: agntcy.identity.service.v1alpha1.BadgeService.IssueBadge:input_type -> agntcy.identity.service.v1alpha1.IssueBadgeRequest
-	4, // 4: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadge:input_type -> agntcy.identity.service.v1alpha1.VerifyBadgeRequest
-	5, // 5: agntcy.identity.service.v1alpha1.BadgeService.IssueBadge:output_type -> agntcy.identity.service.v1alpha1.Badge
-	6, // 6: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadge:output_type -> agntcy.identity.service.v1alpha1.VerificationResult
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: agntcy.identity.service.v1alpha1.IssueBadgeRequest.a2a:type_name -> agntcy.identity.service.v1alpha1.IssueA2ABadgeRequest
+	1,  // 1: agntcy.identity.service.v1alpha1.IssueBadgeRequest.mcp:type_name -> agntcy.identity.service.v1alpha1.IssueMcpBadgeRequest
+	3,  // 2: agntcy.identity.service.v1alpha1.IssueBadgeRequest.oasf:type_name -> agntcy.identity.service.v1alpha1.IssueOASFBadgeRequest
+	22, // 3: agntcy.identity.service.v1alpha1.IssueBadgeRequest.proof_format:type_name -> agntcy.identity.service.v1alpha1.ProofFormat
+	23, // 4: agntcy.identity.service.v1alpha1.ListBadgesResponse.badges:type_name -> agntcy.identity.service.v1alpha1.Badge
+	24, // 5: agntcy.identity.service.v1alpha1.ListBadgesResponse.pagination:type_name -> agntcy.identity.service.v1alpha1.PagedResponse
+	8,  // 6: agntcy.identity.service.v1alpha1.VerifyBadgesRequest.badges:type_name -> agntcy.identity.service.v1alpha1.VerifyBadgeRequest
+	11, // 7: agntcy.identity.service.v1alpha1.VerifyBadgesResponse.results:type_name -> agntcy.identity.service.v1alpha1.VerifyBadgesResult
+	25, // 8: agntcy.identity.service.v1alpha1.VerifyBadgesResult.result:type_name -> agntcy.identity.service.v1alpha1.VerificationResult
+	26, // 9: agntcy.identity.service.v1alpha1.VerifyBadgesResult.error:type_name -> google.rpc.Status
+	27, // 10: agntcy.identity.service.v1alpha1.RevokeBadgeRequest.reason:type_name -> agntcy.identity.service.v1alpha1.RevocationReason
+	28, // 11: agntcy.identity.service.v1alpha1.ListTrustPoliciesResponse.trust_policies:type_name -> agntcy.identity.service.v1alpha1.TrustPolicy
+	29, // 12: agntcy.identity.service.v1alpha1.CreateTrustPolicyRequest.badge_types:type_name -> agntcy.identity.service.v1alpha1.BadgeType
+	29, // 13: agntcy.identity.service.v1alpha1.UpdateTrustPolicyRequest.badge_types:type_name -> agntcy.identity.service.v1alpha1.BadgeType
+	0,  // 14: agntcy.identity.service.v1alpha1.BadgeService.IssueBadge:input_type -> agntcy.identity.service.v1alpha1.IssueBadgeRequest
+	4,  // 15: agntcy.identity.service.v1alpha1.BadgeService.ListBadges:input_type -> agntcy.identity.service.v1alpha1.ListBadgesRequest
+	6,  // 16: agntcy.identity.service.v1alpha1.BadgeService.GetBadgeByID:input_type -> agntcy.identity.service.v1alpha1.GetBadgeByIDRequest
+	7,  // 17: agntcy.identity.service.v1alpha1.BadgeService.DiffBadges:input_type -> agntcy.identity.service.v1alpha1.DiffBadgesRequest
+	8,  // 18: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadge:input_type -> agntcy.identity.service.v1alpha1.VerifyBadgeRequest
+	9,  // 19: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadges:input_type -> agntcy.identity.service.v1alpha1.VerifyBadgesRequest
+	12, // 20: agntcy.identity.service.v1alpha1.BadgeService.GetStatusList:input_type -> agntcy.identity.service.v1alpha1.GetStatusListRequest
+	13, // 21: agntcy.identity.service.v1alpha1.BadgeService.GetIssuerDocument:input_type -> agntcy.identity.service.v1alpha1.GetIssuerDocumentRequest
+	14, // 22: agntcy.identity.service.v1alpha1.BadgeService.RevokeBadge:input_type -> agntcy.identity.service.v1alpha1.RevokeBadgeRequest
+	15, // 23: agntcy.identity.service.v1alpha1.BadgeService.SuspendBadge:input_type -> agntcy.identity.service.v1alpha1.SuspendBadgeRequest
+	16, // 24: agntcy.identity.service.v1alpha1.BadgeService.ResumeBadge:input_type -> agntcy.identity.service.v1alpha1.ResumeBadgeRequest
+	17, // 25: agntcy.identity.service.v1alpha1.BadgeService.ListTrustPolicies:input_type -> agntcy.identity.service.v1alpha1.ListTrustPoliciesRequest
+	19, // 26: agntcy.identity.service.v1alpha1.BadgeService.CreateTrustPolicy:input_type -> agntcy.identity.service.v1alpha1.CreateTrustPolicyRequest
+	20, // 27: agntcy.identity.service.v1alpha1.BadgeService.UpdateTrustPolicy:input_type -> agntcy.identity.service.v1alpha1.UpdateTrustPolicyRequest
+	21, // 28: agntcy.identity.service.v1alpha1.BadgeService.DeleteTrustPolicy:input_type -> agntcy.identity.service.v1alpha1.DeleteTrustPolicyRequest
+	23, // 29: agntcy.identity.service.v1alpha1.BadgeService.IssueBadge:output_type -> agntcy.identity.service.v1alpha1.Badge
+	5,  // 30: agntcy.identity.service.v1alpha1.BadgeService.ListBadges:output_type -> agntcy.identity.service.v1alpha1.ListBadgesResponse
+	23, // 31: agntcy.identity.service.v1alpha1.BadgeService.GetBadgeByID:output_type -> agntcy.identity.service.v1alpha1.Badge
+	30, // 32: agntcy.identity.service.v1alpha1.BadgeService.DiffBadges:output_type -> agntcy.identity.service.v1alpha1.BadgeDiff
+	25, // 33: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadge:output_type -> agntcy.identity.service.v1alpha1.VerificationResult
+	10, // 34: agntcy.identity.service.v1alpha1.BadgeService.VerifyBadges:output_type -> agntcy.identity.service.v1alpha1.VerifyBadgesResponse
+	31, // 35: agntcy.identity.service.v1alpha1.BadgeService.GetStatusList:output_type -> agntcy.identity.service.v1alpha1.StatusListCredential
+	32, // 36: agntcy.identity.service.v1alpha1.BadgeService.GetIssuerDocument:output_type -> agntcy.identity.service.v1alpha1.IssuerDocument
+	33, // 37: agntcy.identity.service.v1alpha1.BadgeService.RevokeBadge:output_type -> google.protobuf.Empty
+	33, // 38: agntcy.identity.service.v1alpha1.BadgeService.SuspendBadge:output_type -> google.protobuf.Empty
+	33, // 39: agntcy.identity.service.v1alpha1.BadgeService.ResumeBadge:output_type -> google.protobuf.Empty
+	18, // 40: agntcy.identity.service.v1alpha1.BadgeService.ListTrustPolicies:output_type -> agntcy.identity.service.v1alpha1.ListTrustPoliciesResponse
+	28, // 41: agntcy.identity.service.v1alpha1.BadgeService.CreateTrustPolicy:output_type -> agntcy.identity.service.v1alpha1.TrustPolicy
+	28, // 42: agntcy.identity.service.v1alpha1.BadgeService.UpdateTrustPolicy:output_type -> agntcy.identity.service.v1alpha1.TrustPolicy
+	33, // 43: agntcy.identity.service.v1alpha1.BadgeService.DeleteTrustPolicy:output_type -> google.protobuf.Empty
+	29, // [29:44] is the sub-list for method output_type
+	14, // [14:29] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_service_v1alpha1_badge_service_proto_init() }
@@ -382,15 +1503,22 @@ func file_agntcy_identity_service_v1alpha1_badge_service_proto_init() {
 		return
 	}
 	file_agntcy_identity_service_v1alpha1_badge_proto_init()
+	file_agntcy_identity_service_v1alpha1_pagination_proto_init()
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[1].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_badge_service_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDesc), len(file_agntcy_identity_service_v1alpha1_badge_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
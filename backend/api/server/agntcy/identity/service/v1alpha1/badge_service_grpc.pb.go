@@ -14,6 +14,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,8 +23,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BadgeService_IssueBadge_FullMethodName  = "/agntcy.identity.service.v1alpha1.BadgeService/IssueBadge"
-	BadgeService_VerifyBadge_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/VerifyBadge"
+	BadgeService_IssueBadge_FullMethodName        = "/agntcy.identity.service.v1alpha1.BadgeService/IssueBadge"
+	BadgeService_ListBadges_FullMethodName        = "/agntcy.identity.service.v1alpha1.BadgeService/ListBadges"
+	BadgeService_GetBadgeByID_FullMethodName      = "/agntcy.identity.service.v1alpha1.BadgeService/GetBadgeByID"
+	BadgeService_DiffBadges_FullMethodName        = "/agntcy.identity.service.v1alpha1.BadgeService/DiffBadges"
+	BadgeService_VerifyBadge_FullMethodName       = "/agntcy.identity.service.v1alpha1.BadgeService/VerifyBadge"
+	BadgeService_VerifyBadges_FullMethodName      = "/agntcy.identity.service.v1alpha1.BadgeService/VerifyBadges"
+	BadgeService_GetStatusList_FullMethodName     = "/agntcy.identity.service.v1alpha1.BadgeService/GetStatusList"
+	BadgeService_GetIssuerDocument_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/GetIssuerDocument"
+	BadgeService_RevokeBadge_FullMethodName       = "/agntcy.identity.service.v1alpha1.BadgeService/RevokeBadge"
+	BadgeService_SuspendBadge_FullMethodName      = "/agntcy.identity.service.v1alpha1.BadgeService/SuspendBadge"
+	BadgeService_ResumeBadge_FullMethodName       = "/agntcy.identity.service.v1alpha1.BadgeService/ResumeBadge"
+	BadgeService_ListTrustPolicies_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/ListTrustPolicies"
+	BadgeService_CreateTrustPolicy_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/CreateTrustPolicy"
+	BadgeService_UpdateTrustPolicy_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/UpdateTrustPolicy"
+	BadgeService_DeleteTrustPolicy_FullMethodName = "/agntcy.identity.service.v1alpha1.BadgeService/DeleteTrustPolicy"
 )
 
 // BadgeServiceClient is the client API for BadgeService service.
@@ -34,8 +48,40 @@ const (
 type BadgeServiceClient interface {
 	// Create a new Badge.
 	IssueBadge(ctx context.Context, in *IssueBadgeRequest, opts ...grpc.CallOption) (*Badge, error)
+	// List the badges of an App, from the latest to the oldest,
+	// including the revoked badges.
+	ListBadges(ctx context.Context, in *ListBadgesRequest, opts ...grpc.CallOption) (*ListBadgesResponse, error)
+	// Get a badge by its ID.
+	GetBadgeByID(ctx context.Context, in *GetBadgeByIDRequest, opts ...grpc.CallOption) (*Badge, error)
+	// Show how the claims changed between two badges of an App.
+	DiffBadges(ctx context.Context, in *DiffBadgesRequest, opts ...grpc.CallOption) (*BadgeDiff, error)
 	// Verify a badge.
 	VerifyBadge(ctx context.Context, in *VerifyBadgeRequest, opts ...grpc.CallOption) (*VerificationResult, error)
+	// Verify a batch of badges, the results are returned in the order of the badges.
+	VerifyBadges(ctx context.Context, in *VerifyBadgesRequest, opts ...grpc.CallOption) (*VerifyBadgesResponse, error)
+	// Get a Bitstring Status List credential, used by the verifiers
+	// to check the status of the badges.
+	GetStatusList(ctx context.Context, in *GetStatusListRequest, opts ...grpc.CallOption) (*StatusListCredential, error)
+	// Get the controlled identifier document of an issuer, used by the verifiers
+	// to resolve the keys of the Data Integrity proofs of the badges.
+	GetIssuerDocument(ctx context.Context, in *GetIssuerDocumentRequest, opts ...grpc.CallOption) (*IssuerDocument, error)
+	// Revoke a badge of an App, or all the active badges of the App
+	// when no badge is specified. The revocation cannot be undone.
+	RevokeBadge(ctx context.Context, in *RevokeBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Suspend the active badges of an App, the suspension can be undone
+	// with ResumeBadge unlike the revocation.
+	SuspendBadge(ctx context.Context, in *SuspendBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resume the suspended badges of an App.
+	ResumeBadge(ctx context.Context, in *ResumeBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the trust policies of the tenant.
+	ListTrustPolicies(ctx context.Context, in *ListTrustPoliciesRequest, opts ...grpc.CallOption) (*ListTrustPoliciesResponse, error)
+	// Create a trust policy, the relying parties reference it by its name
+	// when verifying a badge.
+	CreateTrustPolicy(ctx context.Context, in *CreateTrustPolicyRequest, opts ...grpc.CallOption) (*TrustPolicy, error)
+	// Update a trust policy.
+	UpdateTrustPolicy(ctx context.Context, in *UpdateTrustPolicyRequest, opts ...grpc.CallOption) (*TrustPolicy, error)
+	// Delete a trust policy.
+	DeleteTrustPolicy(ctx context.Context, in *DeleteTrustPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type badgeServiceClient struct {
@@ -56,6 +102,36 @@ func (c *badgeServiceClient) IssueBadge(ctx context.Context, in *IssueBadgeReque
 	return out, nil
 }
 
+func (c *badgeServiceClient) ListBadges(ctx context.Context, in *ListBadgesRequest, opts ...grpc.CallOption) (*ListBadgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBadgesResponse)
+	err := c.cc.Invoke(ctx, BadgeService_ListBadges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) GetBadgeByID(ctx context.Context, in *GetBadgeByIDRequest, opts ...grpc.CallOption) (*Badge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Badge)
+	err := c.cc.Invoke(ctx, BadgeService_GetBadgeByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) DiffBadges(ctx context.Context, in *DiffBadgesRequest, opts ...grpc.CallOption) (*BadgeDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BadgeDiff)
+	err := c.cc.Invoke(ctx, BadgeService_DiffBadges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *badgeServiceClient) VerifyBadge(ctx context.Context, in *VerifyBadgeRequest, opts ...grpc.CallOption) (*VerificationResult, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VerificationResult)
@@ -66,6 +142,106 @@ func (c *badgeServiceClient) VerifyBadge(ctx context.Context, in *VerifyBadgeReq
 	return out, nil
 }
 
+func (c *badgeServiceClient) VerifyBadges(ctx context.Context, in *VerifyBadgesRequest, opts ...grpc.CallOption) (*VerifyBadgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyBadgesResponse)
+	err := c.cc.Invoke(ctx, BadgeService_VerifyBadges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) GetStatusList(ctx context.Context, in *GetStatusListRequest, opts ...grpc.CallOption) (*StatusListCredential, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusListCredential)
+	err := c.cc.Invoke(ctx, BadgeService_GetStatusList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) GetIssuerDocument(ctx context.Context, in *GetIssuerDocumentRequest, opts ...grpc.CallOption) (*IssuerDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssuerDocument)
+	err := c.cc.Invoke(ctx, BadgeService_GetIssuerDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) RevokeBadge(ctx context.Context, in *RevokeBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BadgeService_RevokeBadge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) SuspendBadge(ctx context.Context, in *SuspendBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BadgeService_SuspendBadge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) ResumeBadge(ctx context.Context, in *ResumeBadgeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BadgeService_ResumeBadge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) ListTrustPolicies(ctx context.Context, in *ListTrustPoliciesRequest, opts ...grpc.CallOption) (*ListTrustPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrustPoliciesResponse)
+	err := c.cc.Invoke(ctx, BadgeService_ListTrustPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) CreateTrustPolicy(ctx context.Context, in *CreateTrustPolicyRequest, opts ...grpc.CallOption) (*TrustPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrustPolicy)
+	err := c.cc.Invoke(ctx, BadgeService_CreateTrustPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) UpdateTrustPolicy(ctx context.Context, in *UpdateTrustPolicyRequest, opts ...grpc.CallOption) (*TrustPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrustPolicy)
+	err := c.cc.Invoke(ctx, BadgeService_UpdateTrustPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *badgeServiceClient) DeleteTrustPolicy(ctx context.Context, in *DeleteTrustPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BadgeService_DeleteTrustPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BadgeServiceServer is the server API for BadgeService service.
 // All implementations should embed UnimplementedBadgeServiceServer
 // for forward compatibility.
@@ -74,8 +250,40 @@ func (c *badgeServiceClient) VerifyBadge(ctx context.Context, in *VerifyBadgeReq
 type BadgeServiceServer interface {
 	// Create a new Badge.
 	IssueBadge(context.Context, *IssueBadgeRequest) (*Badge, error)
+	// List the badges of an App, from the latest to the oldest,
+	// including the revoked badges.
+	ListBadges(context.Context, *ListBadgesRequest) (*ListBadgesResponse, error)
+	// Get a badge by its ID.
+	GetBadgeByID(context.Context, *GetBadgeByIDRequest) (*Badge, error)
+	// Show how the claims changed between two badges of an App.
+	DiffBadges(context.Context, *DiffBadgesRequest) (*BadgeDiff, error)
 	// Verify a badge.
 	VerifyBadge(context.Context, *VerifyBadgeRequest) (*VerificationResult, error)
+	// Verify a batch of badges, the results are returned in the order of the badges.
+	VerifyBadges(context.Context, *VerifyBadgesRequest) (*VerifyBadgesResponse, error)
+	// Get a Bitstring Status List credential, used by the verifiers
+	// to check the status of the badges.
+	GetStatusList(context.Context, *GetStatusListRequest) (*StatusListCredential, error)
+	// Get the controlled identifier document of an issuer, used by the verifiers
+	// to resolve the keys of the Data Integrity proofs of the badges.
+	GetIssuerDocument(context.Context, *GetIssuerDocumentRequest) (*IssuerDocument, error)
+	// Revoke a badge of an App, or all the active badges of the App
+	// when no badge is specified. The revocation cannot be undone.
+	RevokeBadge(context.Context, *RevokeBadgeRequest) (*emptypb.Empty, error)
+	// Suspend the active badges of an App, the suspension can be undone
+	// with ResumeBadge unlike the revocation.
+	SuspendBadge(context.Context, *SuspendBadgeRequest) (*emptypb.Empty, error)
+	// Resume the suspended badges of an App.
+	ResumeBadge(context.Context, *ResumeBadgeRequest) (*emptypb.Empty, error)
+	// List the trust policies of the tenant.
+	ListTrustPolicies(context.Context, *ListTrustPoliciesRequest) (*ListTrustPoliciesResponse, error)
+	// Create a trust policy, the relying parties reference it by its name
+	// when verifying a badge.
+	CreateTrustPolicy(context.Context, *CreateTrustPolicyRequest) (*TrustPolicy, error)
+	// Update a trust policy.
+	UpdateTrustPolicy(context.Context, *UpdateTrustPolicyRequest) (*TrustPolicy, error)
+	// Delete a trust policy.
+	DeleteTrustPolicy(context.Context, *DeleteTrustPolicyRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedBadgeServiceServer should be embedded to have
@@ -88,9 +296,48 @@ type UnimplementedBadgeServiceServer struct{}
 func (UnimplementedBadgeServiceServer) IssueBadge(context.Context, *IssueBadgeRequest) (*Badge, error) {
 	return nil, status.Error(codes.Unimplemented, "method IssueBadge not implemented")
 }
+func (UnimplementedBadgeServiceServer) ListBadges(context.Context, *ListBadgesRequest) (*ListBadgesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBadges not implemented")
+}
+func (UnimplementedBadgeServiceServer) GetBadgeByID(context.Context, *GetBadgeByIDRequest) (*Badge, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBadgeByID not implemented")
+}
+func (UnimplementedBadgeServiceServer) DiffBadges(context.Context, *DiffBadgesRequest) (*BadgeDiff, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffBadges not implemented")
+}
 func (UnimplementedBadgeServiceServer) VerifyBadge(context.Context, *VerifyBadgeRequest) (*VerificationResult, error) {
 	return nil, status.Error(codes.Unimplemented, "method VerifyBadge not implemented")
 }
+func (UnimplementedBadgeServiceServer) VerifyBadges(context.Context, *VerifyBadgesRequest) (*VerifyBadgesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyBadges not implemented")
+}
+func (UnimplementedBadgeServiceServer) GetStatusList(context.Context, *GetStatusListRequest) (*StatusListCredential, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatusList not implemented")
+}
+func (UnimplementedBadgeServiceServer) GetIssuerDocument(context.Context, *GetIssuerDocumentRequest) (*IssuerDocument, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIssuerDocument not implemented")
+}
+func (UnimplementedBadgeServiceServer) RevokeBadge(context.Context, *RevokeBadgeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeBadge not implemented")
+}
+func (UnimplementedBadgeServiceServer) SuspendBadge(context.Context, *SuspendBadgeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuspendBadge not implemented")
+}
+func (UnimplementedBadgeServiceServer) ResumeBadge(context.Context, *ResumeBadgeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeBadge not implemented")
+}
+func (UnimplementedBadgeServiceServer) ListTrustPolicies(context.Context, *ListTrustPoliciesRequest) (*ListTrustPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrustPolicies not implemented")
+}
+func (UnimplementedBadgeServiceServer) CreateTrustPolicy(context.Context, *CreateTrustPolicyRequest) (*TrustPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTrustPolicy not implemented")
+}
+func (UnimplementedBadgeServiceServer) UpdateTrustPolicy(context.Context, *UpdateTrustPolicyRequest) (*TrustPolicy, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrustPolicy not implemented")
+}
+func (UnimplementedBadgeServiceServer) DeleteTrustPolicy(context.Context, *DeleteTrustPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTrustPolicy not implemented")
+}
 func (UnimplementedBadgeServiceServer) testEmbeddedByValue() {}
 
 // UnsafeBadgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +376,60 @@ func _BadgeService_IssueBadge_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BadgeService_ListBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).ListBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_ListBadges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).ListBadges(ctx, req.(*ListBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_GetBadgeByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadgeByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).GetBadgeByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_GetBadgeByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).GetBadgeByID(ctx, req.(*GetBadgeByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_DiffBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).DiffBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_DiffBadges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).DiffBadges(ctx, req.(*DiffBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BadgeService_VerifyBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyBadgeRequest)
 	if err := dec(in); err != nil {
@@ -147,6 +448,186 @@ func _BadgeService_VerifyBadge_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BadgeService_VerifyBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).VerifyBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_VerifyBadges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).VerifyBadges(ctx, req.(*VerifyBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_GetStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).GetStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_GetStatusList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).GetStatusList(ctx, req.(*GetStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_GetIssuerDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssuerDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).GetIssuerDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_GetIssuerDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).GetIssuerDocument(ctx, req.(*GetIssuerDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_RevokeBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).RevokeBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_RevokeBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).RevokeBadge(ctx, req.(*RevokeBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_SuspendBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).SuspendBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_SuspendBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).SuspendBadge(ctx, req.(*SuspendBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_ResumeBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeBadgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).ResumeBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_ResumeBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).ResumeBadge(ctx, req.(*ResumeBadgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_ListTrustPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrustPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).ListTrustPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_ListTrustPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).ListTrustPolicies(ctx, req.(*ListTrustPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_CreateTrustPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTrustPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).CreateTrustPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_CreateTrustPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).CreateTrustPolicy(ctx, req.(*CreateTrustPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_UpdateTrustPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrustPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).UpdateTrustPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_UpdateTrustPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).UpdateTrustPolicy(ctx, req.(*UpdateTrustPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BadgeService_DeleteTrustPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrustPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BadgeServiceServer).DeleteTrustPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BadgeService_DeleteTrustPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BadgeServiceServer).DeleteTrustPolicy(ctx, req.(*DeleteTrustPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BadgeService_ServiceDesc is the grpc.ServiceDesc for BadgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,10 +639,62 @@ var BadgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueBadge",
 			Handler:    _BadgeService_IssueBadge_Handler,
 		},
+		{
+			MethodName: "ListBadges",
+			Handler:    _BadgeService_ListBadges_Handler,
+		},
+		{
+			MethodName: "GetBadgeByID",
+			Handler:    _BadgeService_GetBadgeByID_Handler,
+		},
+		{
+			MethodName: "DiffBadges",
+			Handler:    _BadgeService_DiffBadges_Handler,
+		},
 		{
 			MethodName: "VerifyBadge",
 			Handler:    _BadgeService_VerifyBadge_Handler,
 		},
+		{
+			MethodName: "VerifyBadges",
+			Handler:    _BadgeService_VerifyBadges_Handler,
+		},
+		{
+			MethodName: "GetStatusList",
+			Handler:    _BadgeService_GetStatusList_Handler,
+		},
+		{
+			MethodName: "GetIssuerDocument",
+			Handler:    _BadgeService_GetIssuerDocument_Handler,
+		},
+		{
+			MethodName: "RevokeBadge",
+			Handler:    _BadgeService_RevokeBadge_Handler,
+		},
+		{
+			MethodName: "SuspendBadge",
+			Handler:    _BadgeService_SuspendBadge_Handler,
+		},
+		{
+			MethodName: "ResumeBadge",
+			Handler:    _BadgeService_ResumeBadge_Handler,
+		},
+		{
+			MethodName: "ListTrustPolicies",
+			Handler:    _BadgeService_ListTrustPolicies_Handler,
+		},
+		{
+			MethodName: "CreateTrustPolicy",
+			Handler:    _BadgeService_CreateTrustPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateTrustPolicy",
+			Handler:    _BadgeService_UpdateTrustPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteTrustPolicy",
+			Handler:    _BadgeService_DeleteTrustPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/service/v1alpha1/badge_service.proto",
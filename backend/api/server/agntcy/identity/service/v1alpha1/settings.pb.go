@@ -143,6 +143,89 @@ func (x *ApiKey) GetApiKey() string {
 	return ""
 }
 
+// Badge Settings
+type BadgeSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The lifetime of the badges in seconds, used for the application types
+	// without a specific lifetime. Zero falls back to the deployment default.
+	DefaultLifetimeSeconds *int64 `protobuf:"varint,1,opt,name=default_lifetime_seconds,json=defaultLifetimeSeconds,proto3,oneof" json:"default_lifetime_seconds,omitempty"`
+	// The lifetime of the badges of the A2A agents in seconds.
+	AgentA2ALifetimeSeconds *int64 `protobuf:"varint,2,opt,name=agent_a2a_lifetime_seconds,json=agentA2aLifetimeSeconds,proto3,oneof" json:"agent_a2a_lifetime_seconds,omitempty"`
+	// The lifetime of the badges of the OASF agents in seconds.
+	AgentOasfLifetimeSeconds *int64 `protobuf:"varint,3,opt,name=agent_oasf_lifetime_seconds,json=agentOasfLifetimeSeconds,proto3,oneof" json:"agent_oasf_lifetime_seconds,omitempty"`
+	// The lifetime of the badges of the MCP servers in seconds.
+	McpServerLifetimeSeconds *int64 `protobuf:"varint,4,opt,name=mcp_server_lifetime_seconds,json=mcpServerLifetimeSeconds,proto3,oneof" json:"mcp_server_lifetime_seconds,omitempty"`
+	// The format of the proof of the badges, JOSE when unspecified.
+	ProofFormat   *ProofFormat `protobuf:"varint,5,opt,name=proof_format,json=proofFormat,proto3,enum=agntcy.identity.service.v1alpha1.ProofFormat,oneof" json:"proof_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BadgeSettings) Reset() {
+	*x = BadgeSettings{}
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BadgeSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeSettings) ProtoMessage() {}
+
+func (x *BadgeSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeSettings.ProtoReflect.Descriptor instead.
+func (*BadgeSettings) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BadgeSettings) GetDefaultLifetimeSeconds() int64 {
+	if x != nil && x.DefaultLifetimeSeconds != nil {
+		return *x.DefaultLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *BadgeSettings) GetAgentA2ALifetimeSeconds() int64 {
+	if x != nil && x.AgentA2ALifetimeSeconds != nil {
+		return *x.AgentA2ALifetimeSeconds
+	}
+	return 0
+}
+
+func (x *BadgeSettings) GetAgentOasfLifetimeSeconds() int64 {
+	if x != nil && x.AgentOasfLifetimeSeconds != nil {
+		return *x.AgentOasfLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *BadgeSettings) GetMcpServerLifetimeSeconds() int64 {
+	if x != nil && x.McpServerLifetimeSeconds != nil {
+		return *x.McpServerLifetimeSeconds
+	}
+	return 0
+}
+
+func (x *BadgeSettings) GetProofFormat() ProofFormat {
+	if x != nil && x.ProofFormat != nil {
+		return *x.ProofFormat
+	}
+	return ProofFormat_PROOF_FORMAT_UNSPECIFIED
+}
+
 // Duo IdP Settings
 type DuoIdpSettings struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -155,7 +238,7 @@ type DuoIdpSettings struct {
 
 func (x *DuoIdpSettings) Reset() {
 	*x = DuoIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[1]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -167,7 +250,7 @@ func (x *DuoIdpSettings) String() string {
 func (*DuoIdpSettings) ProtoMessage() {}
 
 func (x *DuoIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[1]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +263,7 @@ func (x *DuoIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DuoIdpSettings.ProtoReflect.Descriptor instead.
 func (*DuoIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{1}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DuoIdpSettings) GetHostname() string {
@@ -216,7 +299,7 @@ type EntraIdpSettings struct {
 
 func (x *EntraIdpSettings) Reset() {
 	*x = EntraIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[2]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +311,7 @@ func (x *EntraIdpSettings) String() string {
 func (*EntraIdpSettings) ProtoMessage() {}
 
 func (x *EntraIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[2]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +324,7 @@ func (x *EntraIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntraIdpSettings.ProtoReflect.Descriptor instead.
 func (*EntraIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{2}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EntraIdpSettings) GetTenantId() string {
@@ -295,7 +378,7 @@ type IssuerSettings struct {
 
 func (x *IssuerSettings) Reset() {
 	*x = IssuerSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[3]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +390,7 @@ func (x *IssuerSettings) String() string {
 func (*IssuerSettings) ProtoMessage() {}
 
 func (x *IssuerSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[3]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +403,7 @@ func (x *IssuerSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssuerSettings.ProtoReflect.Descriptor instead.
 func (*IssuerSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{3}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IssuerSettings) GetIssuerId() string {
@@ -406,7 +489,7 @@ type KeycloakIdpSettings struct {
 
 func (x *KeycloakIdpSettings) Reset() {
 	*x = KeycloakIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[4]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +501,7 @@ func (x *KeycloakIdpSettings) String() string {
 func (*KeycloakIdpSettings) ProtoMessage() {}
 
 func (x *KeycloakIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[4]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +514,7 @@ func (x *KeycloakIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeycloakIdpSettings.ProtoReflect.Descriptor instead.
 func (*KeycloakIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{4}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *KeycloakIdpSettings) GetBaseUrl() string {
@@ -474,7 +557,7 @@ type OktaIdpSettings struct {
 
 func (x *OktaIdpSettings) Reset() {
 	*x = OktaIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[5]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +569,7 @@ func (x *OktaIdpSettings) String() string {
 func (*OktaIdpSettings) ProtoMessage() {}
 
 func (x *OktaIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[5]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +582,7 @@ func (x *OktaIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OktaIdpSettings.ProtoReflect.Descriptor instead.
 func (*OktaIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{5}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OktaIdpSettings) GetOrgUrl() string {
@@ -534,7 +617,7 @@ type OryIdpSettings struct {
 
 func (x *OryIdpSettings) Reset() {
 	*x = OryIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[6]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +629,7 @@ func (x *OryIdpSettings) String() string {
 func (*OryIdpSettings) ProtoMessage() {}
 
 func (x *OryIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[6]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +642,7 @@ func (x *OryIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OryIdpSettings.ProtoReflect.Descriptor instead.
 func (*OryIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{6}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OryIdpSettings) GetProjectSlug() string {
@@ -589,7 +672,7 @@ type PingIdpSettings struct {
 
 func (x *PingIdpSettings) Reset() {
 	*x = PingIdpSettings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[7]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +684,7 @@ func (x *PingIdpSettings) String() string {
 func (*PingIdpSettings) ProtoMessage() {}
 
 func (x *PingIdpSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[7]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +697,7 @@ func (x *PingIdpSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingIdpSettings.ProtoReflect.Descriptor instead.
 func (*PingIdpSettings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{7}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PingIdpSettings) GetEnvironmentId() string {
@@ -645,6 +728,129 @@ func (x *PingIdpSettings) GetRegion() string {
 	return ""
 }
 
+// A token bucket rate limit
+type RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of requests per second refilling the bucket.
+	// A zero value falls back to the limit of the deployment.
+	// The limits above the ones of the deployment are lowered to them.
+	RequestsPerSecond *float64 `protobuf:"fixed64,1,opt,name=requests_per_second,json=requestsPerSecond,proto3,oneof" json:"requests_per_second,omitempty"`
+	// The maximum number of requests allowed in a burst.
+	// Defaults to the number of requests per second rounded up.
+	Burst         *int32 `protobuf:"varint,2,opt,name=burst,proto3,oneof" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RateLimit) GetRequestsPerSecond() float64 {
+	if x != nil && x.RequestsPerSecond != nil {
+		return *x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int32 {
+	if x != nil && x.Burst != nil {
+		return *x.Burst
+	}
+	return 0
+}
+
+// Rate limits applied to the authorization endpoints
+type RateLimitSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The limit shared by all the requests of the tenant.
+	Tenant *RateLimit `protobuf:"bytes,1,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// The limit applied to each application calling Authorize and Token.
+	CallerApp *RateLimit `protobuf:"bytes,2,opt,name=caller_app,json=callerApp,proto3,oneof" json:"caller_app,omitempty"`
+	// The limit applied to each application authorizing the requests
+	// it receives through the ExtAuthz endpoints.
+	CalleeApp     *RateLimit `protobuf:"bytes,3,opt,name=callee_app,json=calleeApp,proto3,oneof" json:"callee_app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitSettings) Reset() {
+	*x = RateLimitSettings{}
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitSettings) ProtoMessage() {}
+
+func (x *RateLimitSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitSettings.ProtoReflect.Descriptor instead.
+func (*RateLimitSettings) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RateLimitSettings) GetTenant() *RateLimit {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *RateLimitSettings) GetCallerApp() *RateLimit {
+	if x != nil {
+		return x.CallerApp
+	}
+	return nil
+}
+
+func (x *RateLimitSettings) GetCalleeApp() *RateLimit {
+	if x != nil {
+		return x.CalleeApp
+	}
+	return nil
+}
+
 // Identity Settings
 type Settings struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -652,13 +858,17 @@ type Settings struct {
 	ApiKey *ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3,oneof" json:"api_key,omitempty"`
 	// Settings for the Issuer.
 	IssuerSettings *IssuerSettings `protobuf:"bytes,2,opt,name=issuer_settings,json=issuerSettings,proto3,oneof" json:"issuer_settings,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The rate limits applied to the authorization endpoints.
+	RateLimitSettings *RateLimitSettings `protobuf:"bytes,3,opt,name=rate_limit_settings,json=rateLimitSettings,proto3,oneof" json:"rate_limit_settings,omitempty"`
+	// The lifetime of the badges issued for the applications.
+	BadgeSettings *BadgeSettings `protobuf:"bytes,4,opt,name=badge_settings,json=badgeSettings,proto3,oneof" json:"badge_settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Settings) Reset() {
 	*x = Settings{}
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[8]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -670,7 +880,7 @@ func (x *Settings) String() string {
 func (*Settings) ProtoMessage() {}
 
 func (x *Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[8]
+	mi := &file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +893,7 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Settings.ProtoReflect.Descriptor instead.
 func (*Settings) Descriptor() ([]byte, []int) {
-	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{8}
+	return file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Settings) GetApiKey() *ApiKey {
@@ -700,15 +910,40 @@ func (x *Settings) GetIssuerSettings() *IssuerSettings {
 	return nil
 }
 
+func (x *Settings) GetRateLimitSettings() *RateLimitSettings {
+	if x != nil {
+		return x.RateLimitSettings
+	}
+	return nil
+}
+
+func (x *Settings) GetBadgeSettings() *BadgeSettings {
+	if x != nil {
+		return x.BadgeSettings
+	}
+	return nil
+}
+
 var File_agntcy_identity_service_v1alpha1_settings_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_service_v1alpha1_settings_proto_rawDesc = "" +
 	"\n" +
-	"/agntcy/identity/service/v1alpha1/settings.proto\x12 agntcy.identity.service.v1alpha1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"/agntcy/identity/service/v1alpha1/settings.proto\x12 agntcy.identity.service.v1alpha1\x1a,agntcy/identity/service/v1alpha1/badge.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
 	"\x06ApiKey\x12\x1c\n" +
 	"\aapi_key\x18\x01 \x01(\tH\x00R\x06apiKey\x88\x01\x01B\n" +
 	"\n" +
-	"\b_api_key\"\xb3\x01\n" +
+	"\b_api_key\"\x95\x04\n" +
+	"\rBadgeSettings\x12B\n" +
+	"\x18default_lifetime_seconds\x18\x01 \x01(\x03B\x03\xe0A\x01H\x00R\x16defaultLifetimeSeconds\x88\x01\x01\x12E\n" +
+	"\x1aagent_a2a_lifetime_seconds\x18\x02 \x01(\x03B\x03\xe0A\x01H\x01R\x17agentA2aLifetimeSeconds\x88\x01\x01\x12G\n" +
+	"\x1bagent_oasf_lifetime_seconds\x18\x03 \x01(\x03B\x03\xe0A\x01H\x02R\x18agentOasfLifetimeSeconds\x88\x01\x01\x12G\n" +
+	"\x1bmcp_server_lifetime_seconds\x18\x04 \x01(\x03B\x03\xe0A\x01H\x03R\x18mcpServerLifetimeSeconds\x88\x01\x01\x12Z\n" +
+	"\fproof_format\x18\x05 \x01(\x0e2-.agntcy.identity.service.v1alpha1.ProofFormatB\x03\xe0A\x01H\x04R\vproofFormat\x88\x01\x01B\x1b\n" +
+	"\x19_default_lifetime_secondsB\x1d\n" +
+	"\x1b_agent_a2a_lifetime_secondsB\x1e\n" +
+	"\x1c_agent_oasf_lifetime_secondsB\x1e\n" +
+	"\x1c_mcp_server_lifetime_secondsB\x0f\n" +
+	"\r_proof_format\"\xb3\x01\n" +
 	"\x0eDuoIdpSettings\x12\x1f\n" +
 	"\bhostname\x18\x01 \x01(\tH\x00R\bhostname\x88\x01\x01\x12,\n" +
 	"\x0fintegration_key\x18\x02 \x01(\tH\x01R\x0eintegrationKey\x88\x01\x01\x12\"\n" +
@@ -786,13 +1021,31 @@ const file_agntcy_identity_service_v1alpha1_settings_proto_rawDesc = "" +
 	"\n" +
 	"_client_idB\x10\n" +
 	"\x0e_client_secretB\t\n" +
-	"\a_region\"\xdc\x01\n" +
+	"\a_region\"\x87\x01\n" +
+	"\tRateLimit\x128\n" +
+	"\x13requests_per_second\x18\x01 \x01(\x01B\x03\xe0A\x02H\x00R\x11requestsPerSecond\x88\x01\x01\x12\x1e\n" +
+	"\x05burst\x18\x02 \x01(\x05B\x03\xe0A\x01H\x01R\x05burst\x88\x01\x01B\x16\n" +
+	"\x14_requests_per_secondB\b\n" +
+	"\x06_burst\"\xb7\x02\n" +
+	"\x11RateLimitSettings\x12M\n" +
+	"\x06tenant\x18\x01 \x01(\v2+.agntcy.identity.service.v1alpha1.RateLimitB\x03\xe0A\x01H\x00R\x06tenant\x88\x01\x01\x12T\n" +
+	"\n" +
+	"caller_app\x18\x02 \x01(\v2+.agntcy.identity.service.v1alpha1.RateLimitB\x03\xe0A\x01H\x01R\tcallerApp\x88\x01\x01\x12T\n" +
+	"\n" +
+	"callee_app\x18\x03 \x01(\v2+.agntcy.identity.service.v1alpha1.RateLimitB\x03\xe0A\x01H\x02R\tcalleeApp\x88\x01\x01B\t\n" +
+	"\a_tenantB\r\n" +
+	"\v_caller_appB\r\n" +
+	"\v_callee_app\"\xd8\x03\n" +
 	"\bSettings\x12K\n" +
 	"\aapi_key\x18\x01 \x01(\v2(.agntcy.identity.service.v1alpha1.ApiKeyB\x03\xe0A\x03H\x00R\x06apiKey\x88\x01\x01\x12c\n" +
-	"\x0fissuer_settings\x18\x02 \x01(\v20.agntcy.identity.service.v1alpha1.IssuerSettingsB\x03\xe0A\x01H\x01R\x0eissuerSettings\x88\x01\x01B\n" +
+	"\x0fissuer_settings\x18\x02 \x01(\v20.agntcy.identity.service.v1alpha1.IssuerSettingsB\x03\xe0A\x01H\x01R\x0eissuerSettings\x88\x01\x01\x12m\n" +
+	"\x13rate_limit_settings\x18\x03 \x01(\v23.agntcy.identity.service.v1alpha1.RateLimitSettingsB\x03\xe0A\x03H\x02R\x11rateLimitSettings\x88\x01\x01\x12`\n" +
+	"\x0ebadge_settings\x18\x04 \x01(\v2/.agntcy.identity.service.v1alpha1.BadgeSettingsB\x03\xe0A\x03H\x03R\rbadgeSettings\x88\x01\x01B\n" +
 	"\n" +
 	"\b_api_keyB\x12\n" +
-	"\x10_issuer_settings*\xae\x01\n" +
+	"\x10_issuer_settingsB\x16\n" +
+	"\x14_rate_limit_settingsB\x11\n" +
+	"\x0f_badge_settings*\xae\x01\n" +
 	"\aIdpType\x12\x18\n" +
 	"\x14IDP_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fIDP_TYPE_DUO\x10\x01\x12\x11\n" +
@@ -816,37 +1069,47 @@ func file_agntcy_identity_service_v1alpha1_settings_proto_rawDescGZIP() []byte {
 }
 
 var file_agntcy_identity_service_v1alpha1_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_agntcy_identity_service_v1alpha1_settings_proto_goTypes = []any{
 	(IdpType)(0),                  // 0: agntcy.identity.service.v1alpha1.IdpType
 	(*ApiKey)(nil),                // 1: agntcy.identity.service.v1alpha1.ApiKey
-	(*DuoIdpSettings)(nil),        // 2: agntcy.identity.service.v1alpha1.DuoIdpSettings
-	(*EntraIdpSettings)(nil),      // 3: agntcy.identity.service.v1alpha1.EntraIdpSettings
-	(*IssuerSettings)(nil),        // 4: agntcy.identity.service.v1alpha1.IssuerSettings
-	(*KeycloakIdpSettings)(nil),   // 5: agntcy.identity.service.v1alpha1.KeycloakIdpSettings
-	(*OktaIdpSettings)(nil),       // 6: agntcy.identity.service.v1alpha1.OktaIdpSettings
-	(*OryIdpSettings)(nil),        // 7: agntcy.identity.service.v1alpha1.OryIdpSettings
-	(*PingIdpSettings)(nil),       // 8: agntcy.identity.service.v1alpha1.PingIdpSettings
-	(*Settings)(nil),              // 9: agntcy.identity.service.v1alpha1.Settings
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*BadgeSettings)(nil),         // 2: agntcy.identity.service.v1alpha1.BadgeSettings
+	(*DuoIdpSettings)(nil),        // 3: agntcy.identity.service.v1alpha1.DuoIdpSettings
+	(*EntraIdpSettings)(nil),      // 4: agntcy.identity.service.v1alpha1.EntraIdpSettings
+	(*IssuerSettings)(nil),        // 5: agntcy.identity.service.v1alpha1.IssuerSettings
+	(*KeycloakIdpSettings)(nil),   // 6: agntcy.identity.service.v1alpha1.KeycloakIdpSettings
+	(*OktaIdpSettings)(nil),       // 7: agntcy.identity.service.v1alpha1.OktaIdpSettings
+	(*OryIdpSettings)(nil),        // 8: agntcy.identity.service.v1alpha1.OryIdpSettings
+	(*PingIdpSettings)(nil),       // 9: agntcy.identity.service.v1alpha1.PingIdpSettings
+	(*RateLimit)(nil),             // 10: agntcy.identity.service.v1alpha1.RateLimit
+	(*RateLimitSettings)(nil),     // 11: agntcy.identity.service.v1alpha1.RateLimitSettings
+	(*Settings)(nil),              // 12: agntcy.identity.service.v1alpha1.Settings
+	(ProofFormat)(0),              // 13: agntcy.identity.service.v1alpha1.ProofFormat
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_agntcy_identity_service_v1alpha1_settings_proto_depIdxs = []int32{
-	0,  // 0: agntcy.identity.service.v1alpha1.IssuerSettings.idp_type:type_name -> agntcy.identity.service.v1alpha1.IdpType
-	2,  // 1: agntcy.identity.service.v1alpha1.IssuerSettings.duo_idp_settings:type_name -> agntcy.identity.service.v1alpha1.DuoIdpSettings
-	6,  // 2: agntcy.identity.service.v1alpha1.IssuerSettings.okta_idp_settings:type_name -> agntcy.identity.service.v1alpha1.OktaIdpSettings
-	7,  // 3: agntcy.identity.service.v1alpha1.IssuerSettings.ory_idp_settings:type_name -> agntcy.identity.service.v1alpha1.OryIdpSettings
-	5,  // 4: agntcy.identity.service.v1alpha1.IssuerSettings.keycloak_idp_settings:type_name -> agntcy.identity.service.v1alpha1.KeycloakIdpSettings
-	8,  // 5: agntcy.identity.service.v1alpha1.IssuerSettings.ping_idp_settings:type_name -> agntcy.identity.service.v1alpha1.PingIdpSettings
-	3,  // 6: agntcy.identity.service.v1alpha1.IssuerSettings.entra_idp_settings:type_name -> agntcy.identity.service.v1alpha1.EntraIdpSettings
-	10, // 7: agntcy.identity.service.v1alpha1.IssuerSettings.created_at:type_name -> google.protobuf.Timestamp
-	10, // 8: agntcy.identity.service.v1alpha1.IssuerSettings.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: agntcy.identity.service.v1alpha1.Settings.api_key:type_name -> agntcy.identity.service.v1alpha1.ApiKey
-	4,  // 10: agntcy.identity.service.v1alpha1.Settings.issuer_settings:type_name -> agntcy.identity.service.v1alpha1.IssuerSettings
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 0: agntcy.identity.service.v1alpha1.BadgeSettings.proof_format:type_name -> agntcy.identity.service.v1alpha1.ProofFormat
+	0,  // 1: agntcy.identity.service.v1alpha1.IssuerSettings.idp_type:type_name -> agntcy.identity.service.v1alpha1.IdpType
+	3,  // 2: agntcy.identity.service.v1alpha1.IssuerSettings.duo_idp_settings:type_name -> agntcy.identity.service.v1alpha1.DuoIdpSettings
+	7,  // 3: agntcy.identity.service.v1alpha1.IssuerSettings.okta_idp_settings:type_name -> agntcy.identity.service.v1alpha1.OktaIdpSettings
+	8,  // 4: agntcy.identity.service.v1alpha1.IssuerSettings.ory_idp_settings:type_name -> agntcy.identity.service.v1alpha1.OryIdpSettings
+	6,  // 5: agntcy.identity.service.v1alpha1.IssuerSettings.keycloak_idp_settings:type_name -> agntcy.identity.service.v1alpha1.KeycloakIdpSettings
+	9,  // 6: agntcy.identity.service.v1alpha1.IssuerSettings.ping_idp_settings:type_name -> agntcy.identity.service.v1alpha1.PingIdpSettings
+	4,  // 7: agntcy.identity.service.v1alpha1.IssuerSettings.entra_idp_settings:type_name -> agntcy.identity.service.v1alpha1.EntraIdpSettings
+	14, // 8: agntcy.identity.service.v1alpha1.IssuerSettings.created_at:type_name -> google.protobuf.Timestamp
+	14, // 9: agntcy.identity.service.v1alpha1.IssuerSettings.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 10: agntcy.identity.service.v1alpha1.RateLimitSettings.tenant:type_name -> agntcy.identity.service.v1alpha1.RateLimit
+	10, // 11: agntcy.identity.service.v1alpha1.RateLimitSettings.caller_app:type_name -> agntcy.identity.service.v1alpha1.RateLimit
+	10, // 12: agntcy.identity.service.v1alpha1.RateLimitSettings.callee_app:type_name -> agntcy.identity.service.v1alpha1.RateLimit
+	1,  // 13: agntcy.identity.service.v1alpha1.Settings.api_key:type_name -> agntcy.identity.service.v1alpha1.ApiKey
+	5,  // 14: agntcy.identity.service.v1alpha1.Settings.issuer_settings:type_name -> agntcy.identity.service.v1alpha1.IssuerSettings
+	11, // 15: agntcy.identity.service.v1alpha1.Settings.rate_limit_settings:type_name -> agntcy.identity.service.v1alpha1.RateLimitSettings
+	2,  // 16: agntcy.identity.service.v1alpha1.Settings.badge_settings:type_name -> agntcy.identity.service.v1alpha1.BadgeSettings
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_service_v1alpha1_settings_proto_init() }
@@ -854,6 +1117,7 @@ func file_agntcy_identity_service_v1alpha1_settings_proto_init() {
 	if File_agntcy_identity_service_v1alpha1_settings_proto != nil {
 		return
 	}
+	file_agntcy_identity_service_v1alpha1_badge_proto_init()
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[0].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[1].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[2].OneofWrappers = []any{}
@@ -863,13 +1127,16 @@ func file_agntcy_identity_service_v1alpha1_settings_proto_init() {
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[6].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[7].OneofWrappers = []any{}
 	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[8].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[9].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[10].OneofWrappers = []any{}
+	file_agntcy_identity_service_v1alpha1_settings_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_service_v1alpha1_settings_proto_rawDesc), len(file_agntcy_identity_service_v1alpha1_settings_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
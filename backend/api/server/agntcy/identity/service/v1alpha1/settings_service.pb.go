@@ -143,6 +143,96 @@ func (x *SetIssuerRequest) GetIssuerSettings() *IssuerSettings {
 	return nil
 }
 
+type SetRateLimitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Rate Limit Settings to set up.
+	RateLimitSettings *RateLimitSettings `protobuf:"bytes,1,opt,name=rate_limit_settings,json=rateLimitSettings,proto3" json:"rate_limit_settings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetRateLimitsRequest) Reset() {
+	*x = SetRateLimitsRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRateLimitsRequest) ProtoMessage() {}
+
+func (x *SetRateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetRateLimitsRequest) GetRateLimitSettings() *RateLimitSettings {
+	if x != nil {
+		return x.RateLimitSettings
+	}
+	return nil
+}
+
+type SetBadgeSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Badge Settings to set up.
+	BadgeSettings *BadgeSettings `protobuf:"bytes,1,opt,name=badge_settings,json=badgeSettings,proto3" json:"badge_settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBadgeSettingsRequest) Reset() {
+	*x = SetBadgeSettingsRequest{}
+	mi := &file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBadgeSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBadgeSettingsRequest) ProtoMessage() {}
+
+func (x *SetBadgeSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBadgeSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetBadgeSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetBadgeSettingsRequest) GetBadgeSettings() *BadgeSettings {
+	if x != nil {
+		return x.BadgeSettings
+	}
+	return nil
+}
+
 var File_agntcy_identity_service_v1alpha1_settings_service_proto protoreflect.FileDescriptor
 
 const file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDesc = "" +
@@ -151,11 +241,18 @@ const file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDesc = ""
 	"\x12GetSettingsRequest\"\x12\n" +
 	"\x10SetApiKeyRequest\"r\n" +
 	"\x10SetIssuerRequest\x12^\n" +
-	"\x0fissuer_settings\x18\x01 \x01(\v20.agntcy.identity.service.v1alpha1.IssuerSettingsB\x03\xe0A\x02R\x0eissuerSettings2\xb5\x05\n" +
+	"\x0fissuer_settings\x18\x01 \x01(\v20.agntcy.identity.service.v1alpha1.IssuerSettingsB\x03\xe0A\x02R\x0eissuerSettings\"\x80\x01\n" +
+	"\x14SetRateLimitsRequest\x12h\n" +
+	"\x13rate_limit_settings\x18\x01 \x01(\v23.agntcy.identity.service.v1alpha1.RateLimitSettingsB\x03\xe0A\x02R\x11rateLimitSettings\"v\n" +
+	"\x17SetBadgeSettingsRequest\x12[\n" +
+	"\x0ebadge_settings\x18\x01 \x01(\v2/.agntcy.identity.service.v1alpha1.BadgeSettingsB\x03\xe0A\x02R\rbadgeSettings2\xdc\n" +
+	"\n" +
 	"\x0fSettingsService\x12\xb9\x01\n" +
 	"\vGetSettings\x124.agntcy.identity.service.v1alpha1.GetSettingsRequest\x1a*.agntcy.identity.service.v1alpha1.Settings\"H\x92A+\x12\x1bGet Settings for the Tenant*\fGet Settings\x82\xd3\xe4\x93\x02\x14\x12\x12/v1alpha1/settings\x12\xe2\x01\n" +
 	"\tSetApiKey\x122.agntcy.identity.service.v1alpha1.SetApiKeyRequest\x1a(.agntcy.identity.service.v1alpha1.ApiKey\"w\x92AR\x12\x0eSet up API Key\x1a@Create a new API Key for the Tenant. Revoke any previous API Key\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1alpha1/settings/api-key\x12\xf1\x01\n" +
-	"\tSetIssuer\x122.agntcy.identity.service.v1alpha1.SetIssuerRequest\x1a0.agntcy.identity.service.v1alpha1.IssuerSettings\"~\x92AW\x12FCreate and register Issuer for the Tenant. Revoke any previous Issuer.*\rSet up Issuer\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1alpha1/settings/issuer\x1a\r\x92A\n" +
+	"\tSetIssuer\x122.agntcy.identity.service.v1alpha1.SetIssuerRequest\x1a0.agntcy.identity.service.v1alpha1.IssuerSettings\"~\x92AW\x12FCreate and register Issuer for the Tenant. Revoke any previous Issuer.*\rSet up Issuer\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1alpha1/settings/issuer\x12\xd5\x02\n" +
+	"\rSetRateLimits\x126.agntcy.identity.service.v1alpha1.SetRateLimitsRequest\x1a3.agntcy.identity.service.v1alpha1.RateLimitSettings\"\xd6\x01\x92A\xa9\x01\x12ISet the rate limits applied to the authorization endpoints of the Tenant.\x1aHThe limits that are not set fall back to the defaults of the deployment.*\x12Set up Rate Limits\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1alpha1/settings/rate-limits\x12\xcc\x02\n" +
+	"\x10SetBadgeSettings\x129.agntcy.identity.service.v1alpha1.SetBadgeSettingsRequest\x1a/.agntcy.identity.service.v1alpha1.BadgeSettings\"\xcb\x01\x92A\xa3\x01\x12ISet the lifetime of the badges issued for the applications of the Tenant.\x1a?The badges issued before the change keep their expiration date.*\x15Set up Badge Settings\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1alpha1/settings/badges\x1a\r\x92A\n" +
 	"\n" +
 	"\bSettingsBhZfgithub.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1;identity_service_sdk_gob\x06proto3"
 
@@ -171,28 +268,38 @@ func file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDescGZIP()
 	return file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDescData
 }
 
-var file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_agntcy_identity_service_v1alpha1_settings_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_agntcy_identity_service_v1alpha1_settings_service_proto_goTypes = []any{
-	(*GetSettingsRequest)(nil), // 0: agntcy.identity.service.v1alpha1.GetSettingsRequest
-	(*SetApiKeyRequest)(nil),   // 1: agntcy.identity.service.v1alpha1.SetApiKeyRequest
-	(*SetIssuerRequest)(nil),   // 2: agntcy.identity.service.v1alpha1.SetIssuerRequest
-	(*IssuerSettings)(nil),     // 3: agntcy.identity.service.v1alpha1.IssuerSettings
-	(*Settings)(nil),           // 4: agntcy.identity.service.v1alpha1.Settings
-	(*ApiKey)(nil),             // 5: agntcy.identity.service.v1alpha1.ApiKey
+	(*GetSettingsRequest)(nil),      // 0: agntcy.identity.service.v1alpha1.GetSettingsRequest
+	(*SetApiKeyRequest)(nil),        // 1: agntcy.identity.service.v1alpha1.SetApiKeyRequest
+	(*SetIssuerRequest)(nil),        // 2: agntcy.identity.service.v1alpha1.SetIssuerRequest
+	(*SetRateLimitsRequest)(nil),    // 3: agntcy.identity.service.v1alpha1.SetRateLimitsRequest
+	(*SetBadgeSettingsRequest)(nil), // 4: agntcy.identity.service.v1alpha1.SetBadgeSettingsRequest
+	(*IssuerSettings)(nil),          // 5: agntcy.identity.service.v1alpha1.IssuerSettings
+	(*RateLimitSettings)(nil),       // 6: agntcy.identity.service.v1alpha1.RateLimitSettings
+	(*BadgeSettings)(nil),           // 7: agntcy.identity.service.v1alpha1.BadgeSettings
+	(*Settings)(nil),                // 8: agntcy.identity.service.v1alpha1.Settings
+	(*ApiKey)(nil),                  // 9: agntcy.identity.service.v1alpha1.ApiKey
 }
 var file_agntcy_identity_service_v1alpha1_settings_service_proto_depIdxs = []int32{
-	3, // 0: agntcy.identity.service.v1alpha1.SetIssuerRequest.issuer_settings:type_name -> agntcy.identity.service.v1alpha1.IssuerSettings
-	0, // 1: agntcy.identity.service.v1alpha1.SettingsService.GetSettings:input_type -> agntcy.identity.service.v1alpha1.GetSettingsRequest
-	1, // 2: agntcy.identity.service.v1alpha1.SettingsService.SetApiKey:input_type -> agntcy.identity.service.v1alpha1.SetApiKeyRequest
-	2, // 3: agntcy.identity.service.v1alpha1.SettingsService.SetIssuer:input_type -> agntcy.identity.service.v1alpha1.SetIssuerRequest
-	4, // 4: agntcy.identity.service.v1alpha1.SettingsService.GetSettings:output_type -> agntcy.identity.service.v1alpha1.Settings
-	5, // 5: agntcy.identity.service.v1alpha1.SettingsService.SetApiKey:output_type -> agntcy.identity.service.v1alpha1.ApiKey
-	3, // 6: agntcy.identity.service.v1alpha1.SettingsService.SetIssuer:output_type -> agntcy.identity.service.v1alpha1.IssuerSettings
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: agntcy.identity.service.v1alpha1.SetIssuerRequest.issuer_settings:type_name -> agntcy.identity.service.v1alpha1.IssuerSettings
+	6, // 1: agntcy.identity.service.v1alpha1.SetRateLimitsRequest.rate_limit_settings:type_name -> agntcy.identity.service.v1alpha1.RateLimitSettings
+	7, // 2: agntcy.identity.service.v1alpha1.SetBadgeSettingsRequest.badge_settings:type_name -> agntcy.identity.service.v1alpha1.BadgeSettings
+	0, // 3: agntcy.identity.service.v1alpha1.SettingsService.GetSettings:input_type -> agntcy.identity.service.v1alpha1.GetSettingsRequest
+	1, // 4: agntcy.identity.service.v1alpha1.SettingsService.SetApiKey:input_type -> agntcy.identity.service.v1alpha1.SetApiKeyRequest
+	2, // 5: agntcy.identity.service.v1alpha1.SettingsService.SetIssuer:input_type -> agntcy.identity.service.v1alpha1.SetIssuerRequest
+	3, // 6: agntcy.identity.service.v1alpha1.SettingsService.SetRateLimits:input_type -> agntcy.identity.service.v1alpha1.SetRateLimitsRequest
+	4, // 7: agntcy.identity.service.v1alpha1.SettingsService.SetBadgeSettings:input_type -> agntcy.identity.service.v1alpha1.SetBadgeSettingsRequest
+	8, // 8: agntcy.identity.service.v1alpha1.SettingsService.GetSettings:output_type -> agntcy.identity.service.v1alpha1.Settings
+	9, // 9: agntcy.identity.service.v1alpha1.SettingsService.SetApiKey:output_type -> agntcy.identity.service.v1alpha1.ApiKey
+	5, // 10: agntcy.identity.service.v1alpha1.SettingsService.SetIssuer:output_type -> agntcy.identity.service.v1alpha1.IssuerSettings
+	6, // 11: agntcy.identity.service.v1alpha1.SettingsService.SetRateLimits:output_type -> agntcy.identity.service.v1alpha1.RateLimitSettings
+	7, // 12: agntcy.identity.service.v1alpha1.SettingsService.SetBadgeSettings:output_type -> agntcy.identity.service.v1alpha1.BadgeSettings
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_agntcy_identity_service_v1alpha1_settings_service_proto_init() }
@@ -207,7 +314,7 @@ func file_agntcy_identity_service_v1alpha1_settings_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDesc), len(file_agntcy_identity_service_v1alpha1_settings_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
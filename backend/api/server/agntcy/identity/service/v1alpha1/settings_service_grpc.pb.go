@@ -22,9 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SettingsService_GetSettings_FullMethodName = "/agntcy.identity.service.v1alpha1.SettingsService/GetSettings"
-	SettingsService_SetApiKey_FullMethodName   = "/agntcy.identity.service.v1alpha1.SettingsService/SetApiKey"
-	SettingsService_SetIssuer_FullMethodName   = "/agntcy.identity.service.v1alpha1.SettingsService/SetIssuer"
+	SettingsService_GetSettings_FullMethodName      = "/agntcy.identity.service.v1alpha1.SettingsService/GetSettings"
+	SettingsService_SetApiKey_FullMethodName        = "/agntcy.identity.service.v1alpha1.SettingsService/SetApiKey"
+	SettingsService_SetIssuer_FullMethodName        = "/agntcy.identity.service.v1alpha1.SettingsService/SetIssuer"
+	SettingsService_SetRateLimits_FullMethodName    = "/agntcy.identity.service.v1alpha1.SettingsService/SetRateLimits"
+	SettingsService_SetBadgeSettings_FullMethodName = "/agntcy.identity.service.v1alpha1.SettingsService/SetBadgeSettings"
 )
 
 // SettingsServiceClient is the client API for SettingsService service.
@@ -39,6 +41,10 @@ type SettingsServiceClient interface {
 	SetApiKey(ctx context.Context, in *SetApiKeyRequest, opts ...grpc.CallOption) (*ApiKey, error)
 	// Set up Issuer
 	SetIssuer(ctx context.Context, in *SetIssuerRequest, opts ...grpc.CallOption) (*IssuerSettings, error)
+	// Set up Rate Limits
+	SetRateLimits(ctx context.Context, in *SetRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitSettings, error)
+	// Set up Badge Settings
+	SetBadgeSettings(ctx context.Context, in *SetBadgeSettingsRequest, opts ...grpc.CallOption) (*BadgeSettings, error)
 }
 
 type settingsServiceClient struct {
@@ -79,6 +85,26 @@ func (c *settingsServiceClient) SetIssuer(ctx context.Context, in *SetIssuerRequ
 	return out, nil
 }
 
+func (c *settingsServiceClient) SetRateLimits(ctx context.Context, in *SetRateLimitsRequest, opts ...grpc.CallOption) (*RateLimitSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitSettings)
+	err := c.cc.Invoke(ctx, SettingsService_SetRateLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsServiceClient) SetBadgeSettings(ctx context.Context, in *SetBadgeSettingsRequest, opts ...grpc.CallOption) (*BadgeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BadgeSettings)
+	err := c.cc.Invoke(ctx, SettingsService_SetBadgeSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingsServiceServer is the server API for SettingsService service.
 // All implementations should embed UnimplementedSettingsServiceServer
 // for forward compatibility.
@@ -91,6 +117,10 @@ type SettingsServiceServer interface {
 	SetApiKey(context.Context, *SetApiKeyRequest) (*ApiKey, error)
 	// Set up Issuer
 	SetIssuer(context.Context, *SetIssuerRequest) (*IssuerSettings, error)
+	// Set up Rate Limits
+	SetRateLimits(context.Context, *SetRateLimitsRequest) (*RateLimitSettings, error)
+	// Set up Badge Settings
+	SetBadgeSettings(context.Context, *SetBadgeSettingsRequest) (*BadgeSettings, error)
 }
 
 // UnimplementedSettingsServiceServer should be embedded to have
@@ -109,6 +139,12 @@ func (UnimplementedSettingsServiceServer) SetApiKey(context.Context, *SetApiKeyR
 func (UnimplementedSettingsServiceServer) SetIssuer(context.Context, *SetIssuerRequest) (*IssuerSettings, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetIssuer not implemented")
 }
+func (UnimplementedSettingsServiceServer) SetRateLimits(context.Context, *SetRateLimitsRequest) (*RateLimitSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRateLimits not implemented")
+}
+func (UnimplementedSettingsServiceServer) SetBadgeSettings(context.Context, *SetBadgeSettingsRequest) (*BadgeSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBadgeSettings not implemented")
+}
 func (UnimplementedSettingsServiceServer) testEmbeddedByValue() {}
 
 // UnsafeSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +219,42 @@ func _SettingsService_SetIssuer_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_SetRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).SetRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_SetRateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).SetRateLimits(ctx, req.(*SetRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsService_SetBadgeSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBadgeSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).SetBadgeSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsService_SetBadgeSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).SetBadgeSettings(ctx, req.(*SetBadgeSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettingsService_ServiceDesc is the grpc.ServiceDesc for SettingsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +274,14 @@ var SettingsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetIssuer",
 			Handler:    _SettingsService_SetIssuer_Handler,
 		},
+		{
+			MethodName: "SetRateLimits",
+			Handler:    _SettingsService_SetRateLimits_Handler,
+		},
+		{
+			MethodName: "SetBadgeSettings",
+			Handler:    _SettingsService_SetBadgeSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agntcy/identity/service/v1alpha1/settings_service.proto",
@@ -0,0 +1,25 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"net/http"
+	"net/url"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/gateway"
+	gatewaya2a "github.com/agntcy/identity-service/internal/gateway/a2a"
+)
+
+func main() {
+	gateway.Run("A2A gateway", apptypes.APP_TYPE_AGENT_A2A, func(
+		upstream *url.URL,
+		publicURL string,
+		client gateway.IdentityClient,
+		app *apptypes.App,
+		maxBodySize int64,
+	) http.Handler {
+		return gatewaya2a.NewProxy(upstream, publicURL, client, app, maxBodySize)
+	})
+}
@@ -14,6 +14,13 @@ const (
 	KeyStoreTypeAwsSm KeyStoreType = "awssm"
 )
 
+type RateLimitBackend string
+
+const (
+	RateLimitBackendMemory   RateLimitBackend = "memory"
+	RateLimitBackendPostgres RateLimitBackend = "postgres"
+)
+
 //nolint:lll // Ignore linting for long lines
 type Configuration struct {
 	ServerHttpHost                                          string        `split_words:"true" default:":4000"`
@@ -59,6 +66,77 @@ type Configuration struct {
 	HttpServerReadTimeout                                   int           `split_words:"true" default:"100"`
 	HttpServerReadHeaderTimeout                             int           `split_words:"true" default:"100"`
 	DefaultCallTimeout                                      time.Duration `split_words:"true" default:"10000ms"`
+
+	// Actions (allow, authenticate, deny) applied to the non tool MCP methods
+	// when authorizing MCP requests forwarded by HTTP proxies
+	McpMethodRules         map[string]string `split_words:"true" default:"initialize:allow,notifications/initialized:allow,ping:allow"`
+	McpDefaultMethodAction string            `split_words:"true" default:"authenticate"`
+
+	// Issue signed self-contained session tokens verified without a database round-trip.
+	// Revoked sessions are checked against a deny-list reloaded at the refresh interval,
+	// the checks fail when it could not be reloaded for longer than the max staleness
+	SelfContainedSessionTokens     bool          `split_words:"true" default:"false"`
+	SessionDenyListRefreshInterval time.Duration `split_words:"true" default:"10s"`
+	SessionDenyListMaxStaleness    time.Duration `split_words:"true" default:"1m"`
+
+	// Secret of the HMAC of the DPoP nonces, which must be shared by all the replicas.
+	// A random secret is generated when empty, the nonces are then only accepted
+	// by the replica that issued them
+	DpopNonceSecret string `split_words:"true"`
+
+	// Verification of the access tokens issued by the IdPs against their JWKS.
+	// The tokens must be intended for one of the audiences, which are not checked
+	// when empty. The algorithms default to the asymmetric signature algorithms.
+	// The cached JWKS are used while the IdPs are unreachable up to the max staleness
+	AccessTokenAudiences  []string      `split_words:"true"`
+	AccessTokenAlgorithms []string      `split_words:"true"`
+	JwksCacheTtl          time.Duration `split_words:"true" default:"1h"`
+	JwksMaxStaleness      time.Duration `split_words:"true" default:"6h"`
+
+	// Background purge of the expired sessions, authorization codes, device OTPs,
+	// denied sessions and DPoP proofs. Expired rows are kept for their retention
+	// period, advisory locks make sure a single replica runs each purge
+	MaintenanceEnabled                bool          `split_words:"true" default:"true"`
+	MaintenanceInterval               time.Duration `split_words:"true" default:"1h"`
+	MaintenanceBatchSize              int           `split_words:"true" default:"1000"`
+	ExpiredSessionRetention           time.Duration `split_words:"true" default:"24h"`
+	ExpiredAuthorizationCodeRetention time.Duration `split_words:"true" default:"1h"`
+	ExpiredDeviceOtpRetention         time.Duration `split_words:"true" default:"24h"`
+
+	// Token bucket rate limits of the Authorize, Token and ExtAuthz endpoints per caller app,
+	// per callee app and per tenant. A zero rate disables a limit, the burst defaults to the
+	// rate rounded up. The tenants override these defaults through the settings API, their
+	// settings are cached for the cache TTL and can only lower these limits. The memory backend
+	// limits each replica on its own, the postgres backend shares the buckets between the replicas
+	// and splits the bucket of each tenant in shards to spread the updates over several rows
+	RateLimitBackend                    RateLimitBackend `split_words:"true" default:"memory"`
+	RateLimitTenantRequestsPerSecond    float64          `split_words:"true" default:"0"`
+	RateLimitTenantBurst                int32            `split_words:"true" default:"0"`
+	RateLimitCallerAppRequestsPerSecond float64          `split_words:"true" default:"0"`
+	RateLimitCallerAppBurst             int32            `split_words:"true" default:"0"`
+	RateLimitCalleeAppRequestsPerSecond float64          `split_words:"true" default:"0"`
+	RateLimitCalleeAppBurst             int32            `split_words:"true" default:"0"`
+	RateLimitTenantShards               int              `split_words:"true" default:"8"`
+	RateLimitSettingsCacheTtl           time.Duration    `split_words:"true" default:"30s"`
+	RateLimitBucketIdleTimeout          time.Duration    `split_words:"true" default:"1h"`
+
+	// Default lifetime of the issued badges, the tenants override it per app type through
+	// the settings API. A zero lifetime issues badges that never expire. The badges are
+	// reissued within the renewal window before they expire, a failed renewal notifies
+	// the admins and is retried after the retry interval, up to the max attempts
+	BadgeLifetime             time.Duration `split_words:"true" default:"2160h"`
+	BadgeRenewalWindow        time.Duration `split_words:"true" default:"168h"`
+	BadgeRenewalRetryInterval time.Duration `split_words:"true" default:"24h"`
+	BadgeRenewalMaxAttempts   int           `split_words:"true" default:"5"`
+
+	// The JOSE badges are verified without the identity node when the keys of their issuers
+	// are resolved. The keys and the status lists of the other issuers are cached for the TTL,
+	// the cached copies are used while the issuers are unreachable up to the max staleness
+	BadgeIssuerCacheTtl     time.Duration `split_words:"true" default:"1h"`
+	BadgeIssuerMaxStaleness time.Duration `split_words:"true" default:"24h"`
+
+	// Address of the Prometheus metrics endpoint, disabled when empty
+	MetricsHttpHost string `split_words:"true"`
 }
 
 func (c *Configuration) IsProd() bool {
@@ -5,18 +5,25 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"math"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"time"
 
 	identity_service_api "github.com/agntcy/identity-service/api/server"
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
 	"github.com/agntcy/identity-service/internal/bff"
 	bffgrpc "github.com/agntcy/identity-service/internal/bff/grpc"
 	"github.com/agntcy/identity-service/internal/bff/grpc/interceptors"
 	apppg "github.com/agntcy/identity-service/internal/core/app/postgres"
+	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authmcp "github.com/agntcy/identity-service/internal/core/auth/mcp"
 	authpg "github.com/agntcy/identity-service/internal/core/auth/postgres"
 	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	badgea2a "github.com/agntcy/identity-service/internal/core/badge/a2a"
@@ -26,12 +33,18 @@ import (
 	iampg "github.com/agntcy/identity-service/internal/core/iam/postgres"
 	identitycore "github.com/agntcy/identity-service/internal/core/identity"
 	idpcore "github.com/agntcy/identity-service/internal/core/idp"
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	maintenancepg "github.com/agntcy/identity-service/internal/core/maintenance/postgres"
 	policycore "github.com/agntcy/identity-service/internal/core/policy"
 	policypg "github.com/agntcy/identity-service/internal/core/policy/postgres"
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	ratelimitpg "github.com/agntcy/identity-service/internal/core/ratelimit/postgres"
 	settingscore "github.com/agntcy/identity-service/internal/core/settings"
 	settingspg "github.com/agntcy/identity-service/internal/core/settings/postgres"
+	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
 	"github.com/agntcy/identity-service/internal/pkg/grpcutil"
 	"github.com/agntcy/identity-service/internal/pkg/iam"
+	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
 	"github.com/agntcy/identity-service/internal/pkg/secrets"
 	"github.com/agntcy/identity-service/internal/pkg/vault"
 	"github.com/agntcy/identity-service/internal/pkg/webpush"
@@ -42,6 +55,8 @@ import (
 	"github.com/agntcy/identity/pkg/oidc"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -54,6 +69,9 @@ import (
 
 var maxMsgSize = math.MaxInt64
 
+// The size of the DPoP nonce secret generated when none is configured
+const dpopNonceSecretSize = 32
+
 // ------------------------ GLOBAL -------------------- //
 
 func main() {
@@ -70,6 +88,16 @@ func main() {
 
 	log.Info("Starting in env:", config.GoEnv)
 
+	// The deployments predating the audiences keep verifying the access tokens without them
+	if len(config.AccessTokenAudiences) == 0 {
+		log.Warn("AccessTokenAudiences is not set, the audience of the access tokens will not be checked")
+	}
+
+	// The maintenance tasks delete and renew the rows in batches until a partial one
+	if config.MaintenanceBatchSize <= 0 {
+		log.Fatal("invalid MaintenanceBatchSize value ", config.MaintenanceBatchSize)
+	}
+
 	// Initialize the database connection
 	dbContext, err := initializeDbContext(config)
 	if err != nil {
@@ -88,15 +116,24 @@ func main() {
 		&settingspg.OryIdpSettings{},
 		&settingspg.KeycloakIdpSettings{},
 		&settingspg.PingIdpSettings{},
+		&settingspg.RateLimitSettings{},
+		&settingspg.BadgeSettings{},
 		&badgepg.Badge{},
 		&badgepg.CredentialSchema{},
 		&badgepg.CredentialStatus{},
+		&badgepg.StatusList{},
+		&badgepg.TrustPolicy{},
+		&badgepg.VerificationMethod{},
 		&authpg.Session{},
 		&authpg.SessionDeviceOTP{},
+		&authpg.Receipt{},
+		&authpg.DeniedSession{},
+		&authpg.DPoPProof{},
 		&policypg.Policy{},
 		&policypg.Task{},
 		&policypg.Rule{},
 		&iampg.APIKey{},
+		&ratelimitpg.RateLimitBucket{},
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -110,6 +147,15 @@ func main() {
 	// Tenant interceptor
 	authInterceptor := interceptors.NewAuthInterceptor(iamClient)
 
+	// Initialize the application services
+	register, authSrv, badgeRenewalTask := initializeServices(ctx, config, dbContext, crypter, iamClient)
+
+	// Rate limits of the authorization endpoints
+	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(
+		initializeRateLimitEnforcer(config, dbContext, crypter),
+		authSrv.ResolveCaller,
+	)
+
 	// Unhandled errors interceptor
 	errorInterceptor := interceptors.NewErrorInterceptor(config.IsProd())
 
@@ -119,14 +165,13 @@ func main() {
 		interceptors.RequestIdUnary,
 		authInterceptor.Unary,
 		interceptors.ContextualLoggerUnary,
+		rateLimitInterceptor.Unary,
 		errorInterceptor.Unary,
 	)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// Initialize the application services
-	register := initializeServices(ctx, config, dbContext, crypter, iamClient)
 	register.RegisterGrpcHandlers(grpcsrv.Server)
 
 	// Serve gRPC server
@@ -167,6 +212,28 @@ func main() {
 		}
 	}()
 
+	// Serve the Prometheus metrics
+	if config.MetricsHttpHost != "" {
+		metricsServer := initializeMetricsServer(config)
+
+		defer func() {
+			_ = metricsServer.Shutdown(ctx)
+		}()
+
+		go func() {
+			log.Info("Serving metrics on:", config.MetricsHttpHost)
+
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	// Purge the expired data and renew the expiring badges in the background
+	if config.MaintenanceEnabled {
+		go initializeMaintenanceScheduler(config, dbContext, crypter, badgeRenewalTask).Start(ctx)
+	}
+
 	interrupChannel := make(chan os.Signal, 1)
 	signal.Notify(interrupChannel, os.Interrupt)
 	<-interrupChannel
@@ -267,11 +334,13 @@ func initializeServices(
 	dbContext db.Context,
 	crypter secrets.Crypter,
 	iamClient iam.Client,
-) *identity_service_api.GrpcServiceRegister {
+) (*identity_service_api.GrpcServiceRegister, bff.AuthService, maintenance.Task) {
 	// Create repositories
 	appRepository := apppg.NewRepository(dbContext.Client())
 	settingsRepository := settingspg.NewRepository(dbContext.Client(), crypter)
 	badgeRepository := badgepg.NewRepository(dbContext.Client())
+	statusListRepository := badgepg.NewStatusListRepository(dbContext.Client())
+	trustPolicyRepository := badgepg.NewTrustPolicyRepository(dbContext.Client())
 	deviceRepository := devicepg.NewRepository(dbContext.Client())
 	authRepository := authpg.NewRepository(dbContext.Client(), crypter)
 	policyRepository := policypg.NewPolicyRepository(dbContext.Client())
@@ -353,10 +422,27 @@ func initializeServices(
 	)
 	taskService := policycore.NewTaskService(taskRepository)
 
-	badgeRevoker := badgecore.NewRevoker(badgeRepository, identityService)
+	statusListService := badgecore.NewStatusListService(statusListRepository, config.ApiUrl)
+	badgeRevoker := badgecore.NewRevoker(badgeRepository, identityService, statusListService)
+	badgeSuspender := badgecore.NewSuspender(badgeRepository, statusListService)
 
 	policyEvaluator := policycore.NewEvaluator(policyRepository)
 
+	mcpMethodRules, err := authmcp.NewMethodRules(config.McpMethodRules, config.McpDefaultMethodAction)
+	if err != nil {
+		log.Fatal("invalid MCP method rules ", err)
+	}
+
+	// A nil deny-list keeps the sessions stored in the database only
+	var sessionDenyList authcore.DenyList
+	if config.SelfContainedSessionTokens {
+		sessionDenyList = authcore.NewDenyList(
+			authRepository,
+			config.SessionDenyListRefreshInterval,
+			config.SessionDenyListMaxStaleness,
+		)
+	}
+
 	// Create internal services
 	appSrv := bff.NewAppService(
 		appRepository,
@@ -393,6 +479,17 @@ func initializeServices(
 		credentialStore,
 		taskService,
 		badgeRevoker,
+		statusListRepository,
+		statusListService,
+		badgeSuspender,
+		trustPolicyRepository,
+		badgecore.NewIssuerResolver(
+			"http://"+net.JoinHostPort(config.IdentityHost, config.IdentityPort),
+			config.BadgeIssuerCacheTtl,
+			config.BadgeIssuerMaxStaleness,
+		),
+		config.BadgeLifetime,
+		config.ApiUrl,
 	)
 	notificationSrv := bff.NewNotificationService(
 		webpush.NewWebPushSender(),
@@ -410,6 +507,16 @@ func initializeServices(
 		notificationSrv,
 		settingsRepository,
 		keyStore,
+		badgeRepository,
+		mcpMethodRules,
+		sessionDenyList,
+		jwtutil.NewVerifier(
+			config.AccessTokenAudiences,
+			config.AccessTokenAlgorithms,
+			config.JwksCacheTtl,
+			config.JwksMaxStaleness,
+		),
+		dpop.NewVerifier(config.ApiUrl, initializeDPoPNonceSecret(config), authRepository),
 	)
 	policySrv := bff.NewPolicyService(
 		appRepository,
@@ -431,7 +538,120 @@ func initializeServices(
 		DeviceServiceServer:   bffgrpc.NewDeviceService(deviceSrv),
 	}
 
-	return &register
+	badgeRenewalTask := bff.NewBadgeRenewalTask(
+		badgeRepository,
+		appRepository,
+		deviceRepository,
+		badgeSrv,
+		notificationSrv,
+		config.BadgeRenewalWindow,
+		config.BadgeRenewalRetryInterval,
+		config.BadgeRenewalMaxAttempts,
+		config.MaintenanceBatchSize,
+	)
+
+	return &register, authSrv, badgeRenewalTask
+}
+
+func initializeMaintenanceScheduler(
+	config *Configuration,
+	dbContext db.Context,
+	crypter secrets.Crypter,
+	badgeRenewalTask maintenance.Task,
+) maintenance.Scheduler {
+	authRepository := authpg.NewRepository(dbContext.Client(), crypter)
+
+	tasks := authcore.NewPurgeTasks(
+		authRepository,
+		authcore.PurgeRetention{
+			Sessions:           config.ExpiredSessionRetention,
+			AuthorizationCodes: config.ExpiredAuthorizationCodeRetention,
+			DeviceOTPs:         config.ExpiredDeviceOtpRetention,
+		},
+		config.MaintenanceBatchSize,
+	)
+
+	// The memory buckets are evicted by the limiter itself
+	if config.RateLimitBackend == RateLimitBackendPostgres {
+		tasks = append(tasks, ratelimitpg.NewPurgeTask(
+			dbContext.Client(),
+			config.RateLimitBucketIdleTimeout,
+			config.MaintenanceBatchSize,
+		))
+	}
+
+	tasks = append(tasks, badgeRenewalTask)
+
+	return maintenance.NewScheduler(
+		maintenancepg.NewLocker(dbContext.Client()),
+		config.MaintenanceInterval,
+		prometheus.DefaultRegisterer,
+		tasks...,
+	)
+}
+
+func initializeRateLimitEnforcer(
+	config *Configuration,
+	dbContext db.Context,
+	crypter secrets.Crypter,
+) ratelimit.Enforcer {
+	var limiter ratelimit.Limiter
+
+	switch config.RateLimitBackend {
+	case RateLimitBackendMemory:
+		limiter = ratelimit.NewMemoryLimiter(config.RateLimitBucketIdleTimeout)
+	case RateLimitBackendPostgres:
+		limiter = ratelimitpg.NewLimiter(dbContext.Client())
+	default:
+		log.Fatal("invalid RateLimitBackend value ", config.RateLimitBackend)
+	}
+
+	return ratelimit.NewEnforcer(
+		limiter,
+		settingspg.NewRepository(dbContext.Client(), crypter),
+		&settingstypes.RateLimitSettings{
+			Tenant: &settingstypes.RateLimit{
+				RequestsPerSecond: config.RateLimitTenantRequestsPerSecond,
+				Burst:             config.RateLimitTenantBurst,
+			},
+			CallerApp: &settingstypes.RateLimit{
+				RequestsPerSecond: config.RateLimitCallerAppRequestsPerSecond,
+				Burst:             config.RateLimitCallerAppBurst,
+			},
+			CalleeApp: &settingstypes.RateLimit{
+				RequestsPerSecond: config.RateLimitCalleeAppRequestsPerSecond,
+				Burst:             config.RateLimitCalleeAppBurst,
+			},
+		},
+		config.RateLimitTenantShards,
+		config.RateLimitSettingsCacheTtl,
+	)
+}
+
+func initializeDPoPNonceSecret(config *Configuration) []byte {
+	if config.DpopNonceSecret != "" {
+		return []byte(config.DpopNonceSecret)
+	}
+
+	log.Warn("DpopNonceSecret is not set, the DPoP nonces will only be accepted by this replica")
+
+	secret := make([]byte, dpopNonceSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatal("unable to generate the DPoP nonce secret ", err)
+	}
+
+	return secret
+}
+
+func initializeMetricsServer(config *Configuration) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:              config.MetricsHttpHost,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Duration(config.HttpServerReadHeaderTimeout) * time.Second,
+	}
 }
 
 func initializeHttpServer(
@@ -470,7 +690,15 @@ func initializeHttpServer(
 	gwOpts := []runtime.ServeMuxOption{
 		runtime.WithHealthzEndpoint(grpc_health_v1.NewHealthClient(conn)),
 		runtime.WithIncomingHeaderMatcher(grpcutil.CustomMatcher),
+		runtime.WithOutgoingHeaderMatcher(grpcutil.CustomOutgoingMatcher),
 		runtime.WithForwardResponseOption(interceptors.RequestIdHttpForwardResponseOption),
+		runtime.WithMarshalerOption(
+			grpcutil.MIMEFormURLEncoded,
+			grpcutil.NewFormMarshaler(
+				&identity_service_sdk_go.IntrospectRequest{},
+				&identity_service_sdk_go.RevokeRequest{},
+			),
+		),
 	}
 	gwmux := runtime.NewServeMux(gwOpts...)
 
@@ -0,0 +1,25 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"net/http"
+	"net/url"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/gateway"
+	gatewaymcp "github.com/agntcy/identity-service/internal/gateway/mcp"
+)
+
+func main() {
+	gateway.Run("MCP gateway", apptypes.APP_TYPE_MCP_SERVER, func(
+		upstream *url.URL,
+		publicURL string,
+		client gateway.IdentityClient,
+		app *apptypes.App,
+		maxBodySize int64,
+	) http.Handler {
+		return gatewaymcp.NewProxy(upstream, publicURL, client, app, maxBodySize)
+	})
+}
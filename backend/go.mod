@@ -5,7 +5,7 @@ go 1.24.5
 require (
 	github.com/eko/gocache/lib/v4 v4.2.0
 	github.com/joho/godotenv v1.5.1
-	github.com/prometheus/client_golang v1.23.0 // indirect
+	github.com/prometheus/client_golang v1.23.0
 	github.com/rs/cors v1.11.1
 	github.com/sirupsen/logrus v1.9.3
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.62.0
@@ -43,6 +43,7 @@ require (
 	github.com/okta/okta-sdk-golang/v5 v5.0.6
 	github.com/ory/client-go v1.21.6
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/sync v0.16.0
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250825161204-c5933d9347a5
 	gorm.io/driver/postgres v1.6.0
 	gorm.io/gorm v1.30.1
@@ -163,7 +164,6 @@ require (
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.27.2
 	github.com/kelseyhightower/envconfig v1.4.0
 	golang.org/x/net v0.43.0 // indirect
-	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.35.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
 )
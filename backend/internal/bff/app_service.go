@@ -15,6 +15,7 @@ import (
 	appcore "github.com/agntcy/identity-service/internal/core/app"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	badgecore "github.com/agntcy/identity-service/internal/core/badge"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	identitycore "github.com/agntcy/identity-service/internal/core/identity"
 	idpcore "github.com/agntcy/identity-service/internal/core/idp"
 	policycore "github.com/agntcy/identity-service/internal/core/policy"
@@ -234,6 +235,11 @@ func (s *appService) UpdateApp(
 
 	storedApp.Name = app.Name
 	storedApp.Description = app.Description
+	// The DPoP requirement is only changed when provided
+	if app.RequireDPoP != nil {
+		storedApp.RequireDPoP = app.RequireDPoP
+	}
+
 	storedApp.UpdatedAt = ptrutil.Ptr(time.Now().UTC())
 
 	err = s.appRepository.UpdateApp(ctx, storedApp)
@@ -402,7 +408,14 @@ func (s *appService) revokeAppBadges(
 		KeyID:      issSettings.KeyID,
 	}
 
-	err = s.badgeRevoker.RevokeAll(ctx, appID, clientCredentials, &issuer, privKey)
+	userID, _ := identitycontext.GetUserID(ctx)
+	revocation := &badgecore.Revocation{
+		Reason:    badgetypes.REVOCATION_REASON_DECOMMISSIONED,
+		Comment:   "The application was deleted.",
+		RevokedBy: userID,
+	}
+
+	err = s.badgeRevoker.RevokeAll(ctx, appID, revocation, clientCredentials, &issuer, privKey)
 	if err != nil {
 		return fmt.Errorf("unable to revoke badges for app %s: %w", appID, err)
 	}
@@ -15,7 +15,9 @@ import (
 	appcore "github.com/agntcy/identity-service/internal/core/app"
 	appmocks "github.com/agntcy/identity-service/internal/core/app/mocks"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	iamtypes "github.com/agntcy/identity-service/internal/core/iam/types"
 	identitycore "github.com/agntcy/identity-service/internal/core/identity"
 	identitymocks "github.com/agntcy/identity-service/internal/core/identity/mocks"
@@ -509,6 +511,47 @@ func TestAppService_UpdateApp_should_succeed(t *testing.T) {
 	assert.Equal(t, app.Description, storedApp.Description)
 }
 
+func TestAppService_UpdateApp_should_only_update_the_dpop_requirement_when_provided(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		requireDPoP *bool
+		expected    *bool
+	}{
+		"not provided": {requireDPoP: nil, expected: ptrutil.Ptr(true)},
+		"provided":     {requireDPoP: ptrutil.Ptr(false), expected: ptrutil.Ptr(false)},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			app := &apptypes.App{
+				ID:          uuid.NewString(),
+				Name:        ptrutil.Ptr("new_name"),
+				RequireDPoP: tc.requireDPoP,
+			}
+			storedApp := &apptypes.App{
+				ID:          app.ID,
+				Type:        apptypes.APP_TYPE_MCP_SERVER,
+				RequireDPoP: ptrutil.Ptr(true),
+			}
+			appRepo := appmocks.NewRepository(t)
+			appRepo.EXPECT().GetApp(ctx, app.ID).Return(storedApp, nil)
+			appRepo.EXPECT().UpdateApp(ctx, storedApp).Return(nil)
+			mockValidGetAppStatus(t, appRepo)
+			iamClient := createValidIamClientWithGettersOnly(t)
+			sut := bff.NewAppService(appRepo, nil, nil, nil, nil, iamClient, nil, nil, nil, nil, nil)
+
+			_, err := sut.UpdateApp(ctx, app)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, storedApp.RequireDPoP)
+		})
+	}
+}
+
 // GetApp
 
 func TestAppService_GetApp_should_return_app(t *testing.T) {
@@ -641,6 +684,9 @@ func TestAppService_DeleteApp_should_succeed(t *testing.T) {
 	badgeRevoker.EXPECT().RevokeAll(
 		ctx,
 		app.ID,
+		mock.MatchedBy(func(revocation *badgecore.Revocation) bool {
+			return revocation.Reason == badgetypes.REVOCATION_REASON_DECOMMISSIONED
+		}),
 		mock.Anything,
 		&identitycore.Issuer{CommonName: issuer.IssuerID, KeyID: issuer.KeyID},
 		mock.Anything,
@@ -4,6 +4,7 @@
 package bff
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -12,20 +13,33 @@ import (
 	appcore "github.com/agntcy/identity-service/internal/core/app"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	autha2a "github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authmcp "github.com/agntcy/identity-service/internal/core/auth/mcp"
+	"github.com/agntcy/identity-service/internal/core/auth/pkce"
+	"github.com/agntcy/identity-service/internal/core/auth/receipt"
+	"github.com/agntcy/identity-service/internal/core/auth/sessiontoken"
+	"github.com/agntcy/identity-service/internal/core/auth/txntoken"
 	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	devicecore "github.com/agntcy/identity-service/internal/core/device"
 	"github.com/agntcy/identity-service/internal/core/identity"
 	idpcore "github.com/agntcy/identity-service/internal/core/idp"
 	policycore "github.com/agntcy/identity-service/internal/core/policy"
+	policytypes "github.com/agntcy/identity-service/internal/core/policy/types"
 	settingscore "github.com/agntcy/identity-service/internal/core/settings"
 	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
 	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity-service/internal/pkg/strutil"
 	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -36,22 +50,109 @@ const (
 	sessionDuration = 5 * time.Minute
 
 	waitForDeviceApprovalTime = 500 // milliseconds
+
+	// Endpoints for which the DPoP proofs of the Authorize and Token calls are created
+	authorizeEndpoint = "/v1alpha1/auth/authorize"
+	tokenEndpoint     = "/v1alpha1/auth/token"
 )
 
+type extAuthZInput struct {
+	issueReceipt bool
+	body         []byte
+	transaction  *transactionInput
+	dpop         *dpopInput
+}
+
+// transactionInput is the transaction of a forwarded request. The claims are set once
+// the transaction is resolved, allowing the messages of a batch to share the transaction.
+type transactionInput struct {
+	token   string
+	purpose string
+	claims  *txntoken.Claims
+}
+
+// dpopInput is the DPoP proof of a forwarded request. The thumbprint is set once
+// the proof is verified, allowing the messages of a batch to share the proof.
+type dpopInput struct {
+	proof      string
+	method     string
+	url        string
+	thumbprint string
+}
+
+type ExtAuthZOption func(in *extAuthZInput)
+
+// WithReceipt requests a signed receipt of the authorization.
+// The body, when provided, is hashed into the receipt.
+func WithReceipt(body []byte) ExtAuthZOption {
+	return func(in *extAuthZInput) {
+		in.issueReceipt = true
+		in.body = body
+	}
+}
+
+// WithTransaction propagates the transaction of the token or, when
+// the token is empty, starts a new transaction for the purpose.
+func WithTransaction(token, purpose string) ExtAuthZOption {
+	input := &transactionInput{token: token, purpose: purpose}
+
+	return func(in *extAuthZInput) {
+		in.transaction = input
+	}
+}
+
+// WithDPoP provides the DPoP proof received by the resource server
+// with the HTTP request.
+func WithDPoP(proof, method, url string) ExtAuthZOption {
+	input := &dpopInput{proof: proof, method: method, url: url}
+
+	return func(in *extAuthZInput) {
+		in.dpop = input
+	}
+}
+
 type AuthService interface {
 	Authorize(
 		ctx context.Context,
-		resolverMetadataID, toolName, userToken *string,
+		resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod *string,
 	) (*authtypes.Session, error)
 	Token(
 		ctx context.Context,
 		authorizationCode string,
+		dpopProof, codeVerifier *string,
 	) (*authtypes.Session, error)
 	ExtAuthZ(
 		ctx context.Context,
 		accessToken string,
 		toolName string,
-	) error
+		opts ...ExtAuthZOption,
+	) (*authtypes.CallerIdentity, error)
+	ExtAuthZMcp(
+		ctx context.Context,
+		accessToken string,
+		body []byte,
+		contentType string,
+		opts ...ExtAuthZOption,
+	) (*authtypes.CallerIdentity, error)
+	FilterMcpTools(
+		ctx context.Context,
+		accessToken string,
+		toolNames []string,
+	) ([]string, error)
+	ExtAuthZA2A(
+		ctx context.Context,
+		accessToken string,
+		body []byte,
+		contentType string,
+		opts ...ExtAuthZOption,
+	) (*authtypes.CallerIdentity, error)
+	FilterA2ASkills(
+		ctx context.Context,
+		accessToken string,
+		skillIDs []string,
+	) ([]string, error)
+	ResolveCaller(ctx context.Context, accessToken string) (context.Context, string, error)
+	DPoPNonce() string
 	ApproveToken(
 		ctx context.Context,
 		deviceID string,
@@ -59,6 +160,17 @@ type AuthService interface {
 		otpValue string,
 		approve bool,
 	) error
+	GetReceipt(ctx context.Context, id string) (*authtypes.Receipt, error)
+	Introspect(ctx context.Context, token string) (*authtypes.TokenIntrospection, error)
+	Revoke(ctx context.Context, token string) error
+	ListSessions(
+		ctx context.Context,
+		paginationFilter pagination.PaginationFilter,
+		filter *authtypes.SessionFilter,
+	) (*pagination.Pageable[authtypes.Session], error)
+	GetSession(ctx context.Context, id string) (*authtypes.Session, error)
+	RevokeSession(ctx context.Context, id string) error
+	RevokeAllSessionsForApp(ctx context.Context, appID string) (int, error)
 }
 
 type authService struct {
@@ -71,8 +183,16 @@ type authService struct {
 	notifService       NotificationService
 	settingsRepository settingscore.Repository
 	keyStore           identity.KeyStore
+	badgeRepository    badgecore.Repository
+	mcpMethodRules     *authmcp.MethodRules
+	sessionDenyList    authcore.DenyList
+	tokenVerifier      jwtutil.Verifier
+	dpopVerifier       dpop.Verifier
 }
 
+// NewAuthService returns an AuthService. The mcpMethodRules default to authmcp.DefaultMethodRules,
+// a nil sessionDenyList keeps the sessions in the database only and a nil dpopVerifier
+// rejects the DPoP proofs.
 func NewAuthService(
 	authRepository authcore.Repository,
 	credentialStore idpcore.CredentialStore,
@@ -83,7 +203,16 @@ func NewAuthService(
 	notifService NotificationService,
 	settingsRepository settingscore.Repository,
 	keyStore identity.KeyStore,
+	badgeRepository badgecore.Repository,
+	mcpMethodRules *authmcp.MethodRules,
+	sessionDenyList authcore.DenyList,
+	tokenVerifier jwtutil.Verifier,
+	dpopVerifier dpop.Verifier,
 ) AuthService {
+	if mcpMethodRules == nil {
+		mcpMethodRules = authmcp.DefaultMethodRules()
+	}
+
 	return &authService{
 		authRepository:     authRepository,
 		credentialStore:    credentialStore,
@@ -94,12 +223,17 @@ func NewAuthService(
 		notifService:       notifService,
 		settingsRepository: settingsRepository,
 		keyStore:           keyStore,
+		badgeRepository:    badgeRepository,
+		mcpMethodRules:     mcpMethodRules,
+		sessionDenyList:    sessionDenyList,
+		tokenVerifier:      tokenVerifier,
+		dpopVerifier:       dpopVerifier,
 	}
 }
 
 func (s *authService) Authorize(
 	ctx context.Context,
-	resolverMetadataID, toolName, _ *string,
+	resolverMetadataID, toolName, _, dpopProof, codeChallenge, codeChallengeMethod *string,
 ) (*authtypes.Session, error) {
 	// Get calling identity from context
 	callerAppID, ok := identitycontext.GetAppID(ctx)
@@ -107,7 +241,7 @@ func (s *authService) Authorize(
 		return nil, errutil.Unauthorized("auth.invalidCallerAppId", "Caller application ID should be present in the request.")
 	}
 
-	_, err := s.appRepository.GetApp(ctx, callerAppID)
+	callerApp, err := s.appRepository.GetApp(ctx, callerAppID)
 	if err != nil {
 		if errors.Is(err, appcore.ErrAppNotFound) {
 			return nil, errutil.NotFound("auth.callerAppNotFound", "Caller application not found.")
@@ -116,6 +250,33 @@ func (s *authService) Authorize(
 		return nil, fmt.Errorf("repository failed to fetch the app %s: %w", callerAppID, err)
 	}
 
+	err = s.ensureAppNotSuspended(ctx, callerApp)
+	if err != nil {
+		return nil, err
+	}
+
+	if ptrutil.Derefrence(callerApp.RequireDPoP, false) && ptrutil.DerefStr(dpopProof) == "" {
+		return nil, errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the application.")
+	}
+
+	dpopThumbprint, err := s.bindDPoPKey(ctx, dpopProof, authorizeEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolvedChallengeMethod *string
+
+	if codeChallenge != nil && *codeChallenge != "" {
+		method, err := pkce.ValidateChallenge(*codeChallenge, codeChallengeMethod)
+		if err != nil {
+			return nil, errutil.ValidationFailed("auth.invalidCodeChallenge", "The code challenge is invalid.")
+		}
+
+		resolvedChallengeMethod = &method
+	} else {
+		codeChallenge = nil
+	}
+
 	// If resolverMetadataID is provided, get calleeAppID
 	var calleeAppID *string
 
@@ -134,6 +295,11 @@ func (s *authService) Authorize(
 			)
 		}
 
+		err = s.ensureAppNotSuspended(ctx, calleeApp)
+		if err != nil {
+			return nil, err
+		}
+
 		calleeAppID = &calleeApp.ID
 
 		// Evaluate the session based on existing policies
@@ -145,11 +311,14 @@ func (s *authService) Authorize(
 
 	// Create new session
 	session, err := s.authRepository.CreateSession(ctx, &authtypes.Session{
-		OwnerAppID:        callerAppID,
-		AppID:             calleeAppID,
-		ToolName:          toolName,
-		AuthorizationCode: ptrutil.Ptr(strutil.Random(codeLength)),
-		ExpiresAt:         ptrutil.Ptr(time.Now().Add(sessionDuration).Unix()),
+		OwnerAppID:          callerAppID,
+		AppID:               calleeAppID,
+		ToolName:            toolName,
+		AuthorizationCode:   ptrutil.Ptr(strutil.Random(codeLength)),
+		ExpiresAt:           ptrutil.Ptr(time.Now().Add(sessionDuration).Unix()),
+		DPoPThumbprint:      dpopThumbprint,
+		CodeChallenge:       codeChallenge,
+		CodeChallengeMethod: resolvedChallengeMethod,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("repository failed to save session: %w", err)
@@ -188,11 +357,21 @@ func (s *authService) getCalleeAppByResolverMetadataID(
 func (s *authService) Token(
 	ctx context.Context,
 	authorizationCode string,
+	dpopProof, codeVerifier *string,
 ) (*authtypes.Session, error) {
 	if authorizationCode == "" {
 		return nil, errutil.ValidationFailed("auth.emptyAuthCode", "Authorization code cannot be empty.")
 	}
 
+	// Get calling identity from context
+	callerAppID, ok := identitycontext.GetAppID(ctx)
+	if !ok || callerAppID == "" {
+		return nil, errutil.Unauthorized(
+			"auth.invalidCallerAppId",
+			"Caller application ID should be present in the request.",
+		)
+	}
+
 	// Get session by authorization code
 	session, err := s.authRepository.GetSessionByAuthCode(ctx, authorizationCode)
 	if err != nil {
@@ -205,11 +384,38 @@ func (s *authService) Token(
 
 	log.FromContext(ctx).Debug("Got session by authorization code: ", session.ID)
 
+	// Only the app that requested the code can redeem it
+	if session.OwnerAppID != callerAppID {
+		return nil, errutil.Unauthorized(
+			"auth.codeNotIssuedToApp",
+			"The authorization code was not issued to the application.",
+		)
+	}
+
 	// Check if session already has an access token
 	if session.AccessToken != nil {
 		return nil, errutil.InvalidRequest("auth.tokenAlreadyIssued", "A token has already been issued.")
 	}
 
+	if session.HasExpired() {
+		return nil, errutil.Unauthorized("auth.authorizationCodeExpired", "The authorization code has expired.")
+	}
+
+	err = verifyCodeVerifier(session, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// A session bound at the authorization step requires a proof of the same key
+	if session.DPoPThumbprint != nil && ptrutil.DerefStr(dpopProof) == "" {
+		return nil, errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the session.")
+	}
+
+	session.DPoPThumbprint, err = s.bindDPoPKey(ctx, dpopProof, tokenEndpoint, session.DPoPThumbprint)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get client credentials from the session
 	clientCredentials, err := s.credentialStore.Get(ctx, session.OwnerAppID)
 	if err != nil || clientCredentials == nil {
@@ -226,6 +432,12 @@ func (s *authService) Token(
 		return nil, fmt.Errorf("failed to issue access token: %w", err)
 	}
 
+	// Self-contained session tokens are unique per session, there is no need
+	// to deal with IdPs returning the same access token
+	if s.selfContainedSessions() {
+		return s.issueSessionToken(ctx, issuer, session, accessToken)
+	}
+
 	// Look if a session with the same access token already exists
 	existingSession, err := s.authRepository.GetSessionByAccessToken(ctx, accessToken)
 	if err == nil {
@@ -259,6 +471,194 @@ func (s *authService) Token(
 	return session, nil
 }
 
+// verifyCodeVerifier checks the PKCE code verifier against the challenge
+// the authorization code is bound to. A verifier sent for a code without
+// a challenge is rejected as well.
+func verifyCodeVerifier(session *authtypes.Session, codeVerifier *string) error {
+	verifier := ptrutil.DerefStr(codeVerifier)
+
+	if session.CodeChallenge == nil {
+		if verifier != "" {
+			return errutil.InvalidRequest(
+				"auth.unexpectedCodeVerifier",
+				"A code verifier was provided but the authorization code has no code challenge.",
+			)
+		}
+
+		return nil
+	}
+
+	if verifier == "" {
+		return errutil.Unauthorized(
+			"auth.codeVerifierRequired",
+			"A code verifier is required for the authorization code.",
+		)
+	}
+
+	if !pkce.Verify(verifier, *session.CodeChallenge, ptrutil.DerefStr(session.CodeChallengeMethod)) {
+		return errutil.Unauthorized(
+			"auth.invalidCodeVerifier",
+			"The code verifier does not match the code challenge.",
+		)
+	}
+
+	return nil
+}
+
+// issueSessionToken wraps the access token in a self-contained session token
+// signed with the issuer key, which ExtAuthZ verifies without a database round-trip.
+func (s *authService) issueSessionToken(
+	ctx context.Context,
+	issuer *settingstypes.IssuerSettings,
+	session *authtypes.Session,
+	accessToken string,
+) (*authtypes.Session, error) {
+	privKey, err := s.keyStore.RetrievePrivKey(ctx, issuer.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving private key from vault for session token: %w", err)
+	}
+
+	sessionToken, claims, err := sessiontoken.Issue(issuer.IssuerID, session, accessToken, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue a session token: %w", err)
+	}
+
+	session.AccessToken = &sessionToken
+	session.ExpiresAt = &claims.ExpiresAt
+
+	err = s.authRepository.UpdateSession(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to update the session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (s *authService) selfContainedSessions() bool {
+	return s.sessionDenyList != nil
+}
+
+// bindDPoPKey verifies the DPoP proof sent to the endpoint and returns the thumbprint
+// of its key. When a thumbprint is provided, the proof must be signed with the same key.
+// Without a proof, the provided thumbprint is returned unchanged.
+func (s *authService) bindDPoPKey(
+	ctx context.Context,
+	proof *string,
+	endpoint string,
+	thumbprint *string,
+) (*string, error) {
+	if ptrutil.DerefStr(proof) == "" {
+		return thumbprint, nil
+	}
+
+	if s.dpopVerifier == nil {
+		return nil, errutil.ValidationFailed("auth.dpopNotSupported", "DPoP is not supported.")
+	}
+
+	claims, err := s.dpopVerifier.Verify(
+		ctx,
+		*proof,
+		dpop.WithEndpoint(endpoint),
+		dpop.WithThumbprint(ptrutil.DerefStr(thumbprint)),
+	)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to verify the DPoP proof")
+		return nil, dpopError(err)
+	}
+
+	return &claims.Thumbprint, nil
+}
+
+// dpopError tells the client to retry with the nonce sent in the response
+// when the proof is only missing a valid nonce.
+func dpopError(err error) error {
+	if errors.Is(err, dpop.ErrUseNonce) {
+		return errutil.Unauthorized("auth.useDPoPNonce", "The DPoP proof must carry the nonce issued by the server.")
+	}
+
+	return errutil.Unauthorized("auth.invalidDPoPProof", "The DPoP proof is invalid.")
+}
+
+// ResolveCaller returns the ID of the app owning the session of the access token,
+// without authorizing the request. The session is kept in the returned context,
+// where the authorization of the same request reuses it instead of resolving it again.
+func (s *authService) ResolveCaller(
+	ctx context.Context,
+	accessToken string,
+) (context.Context, string, error) {
+	session, proof, err := s.resolveSession(ctx, accessToken)
+	if err != nil {
+		return ctx, "", err
+	}
+
+	ctx = context.WithValue(ctx, resolvedSessionKey{}, &resolvedSession{
+		accessToken: accessToken,
+		session:     session,
+		proof:       proof,
+	})
+
+	return ctx, session.OwnerAppID, nil
+}
+
+func (s *authService) DPoPNonce() string {
+	if s.dpopVerifier == nil {
+		return ""
+	}
+
+	return s.dpopVerifier.Nonce()
+}
+
+// verifyDPoPProof requires a valid DPoP proof for the access token when the session
+// is bound to a DPoP key or when the caller app requires DPoP. A proof already
+// verified for the same request is not verified again.
+func (s *authService) verifyDPoPProof(
+	ctx context.Context,
+	session *authtypes.Session,
+	callerApp *apptypes.App,
+	accessToken string,
+	input *dpopInput,
+) error {
+	if session.DPoPThumbprint == nil {
+		if ptrutil.Derefrence(callerApp.RequireDPoP, false) {
+			return errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the application.")
+		}
+
+		return nil
+	}
+
+	if input == nil || input.proof == "" {
+		return errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the session.")
+	}
+
+	if input.thumbprint != "" {
+		if input.thumbprint != *session.DPoPThumbprint {
+			return errutil.Unauthorized("auth.invalidDPoPProof", "The DPoP proof is invalid.")
+		}
+
+		return nil
+	}
+
+	if s.dpopVerifier == nil {
+		return errutil.ValidationFailed("auth.dpopNotSupported", "DPoP is not supported.")
+	}
+
+	claims, err := s.dpopVerifier.Verify(
+		ctx,
+		input.proof,
+		dpop.WithRequest(input.method, input.url),
+		dpop.WithAccessToken(accessToken),
+		dpop.WithThumbprint(*session.DPoPThumbprint),
+	)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to verify the DPoP proof in ExtAuthZ")
+		return dpopError(err)
+	}
+
+	input.thumbprint = claims.Thumbprint
+
+	return nil
+}
+
 func (s *authService) issueAccessToken(
 	ctx context.Context,
 	issuer *settingstypes.IssuerSettings,
@@ -307,165 +707,1068 @@ func (s *authService) ExtAuthZ(
 	ctx context.Context,
 	accessToken string,
 	toolName string,
-) error {
-	if accessToken == "" {
-		return errutil.ValidationFailed("auth.emptyAccessToken", "Access token cannot be empty.")
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	in := extAuthZInput{}
+	for _, opt := range opts {
+		opt(&in)
 	}
 
-	session, err := s.getSessionByAccessToken(ctx, accessToken)
+	session, callerApp, calleeApp, err := s.authenticateExtAuthZ(ctx, accessToken, &toolName)
 	if err != nil {
-		return err
-	}
-
-	if session.HasExpired() {
-		return errutil.Unauthorized("auth.sessionExpired", "The session has expired.")
+		return nil, err
 	}
 
-	log.FromContext(ctx).Debug("Got session by access token: ", session.ID)
-
-	calleeAppID, _ := identitycontext.GetAppID(ctx)
-
-	log.FromContext(ctx).Debug("Session appID: ", calleeAppID)
-
-	calleeApp, err := s.getExtAuthZCalleeApp(ctx, calleeAppID)
+	err = s.verifyDPoPProof(ctx, session, callerApp, accessToken, in.dpop)
 	if err != nil {
-		return err
-	}
-
-	log.FromContext(ctx).Debug("Got app info: ", calleeApp.ID)
-
-	// If the session appID is provided (in the authorize call)
-	// it needs to match the current context appID
-	if !session.ValidateApp(calleeAppID) {
-		return errutil.Unauthorized(
-			"auth.invalidAccessTokenForApp",
-			"The access token is not valid for the specified app.",
-		)
-	}
-
-	// If the session toolName is provided (in the authorize call)
-	// we cannot specify another toolName in the ext-authz request
-	if !session.ValidateTool(toolName) {
-		return errutil.Unauthorized(
-			"auth.invalidAccessTokenForTool",
-			"The access token is not valid for the specified tool.",
-		)
+		return nil, err
 	}
 
-	// validate the caller app
-	callerApp, err := s.getExtAuthZCallerApp(ctx, session.OwnerAppID)
+	txn, txnToken, err := s.resolveTransaction(ctx, &in, session, callerApp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	log.FromContext(ctx).Debug("Verifying access token: ", accessToken)
-
-	// Validate expiration of the access token
-	err = jwtutil.Verify(accessToken)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("failed to verify JWT in ExtAuthZ")
-		return errutil.Unauthorized("auth.invalidAccessToken", "The access token is invalid.")
+	if txn != nil {
+		ctx = log.EnrichContext(ctx, logrus.Fields{"transaction_id": txn.TransactionID})
 	}
 
+	decisionLog := log.FromContext(ctx).WithFields(logrus.Fields{
+		"caller_app_id": callerApp.ID,
+		"callee_app_id": calleeApp.ID,
+		"tool_name":     toolName,
+		"session_id":    session.ID,
+	})
+
 	// Evaluate the session based on existing policies
 	// Evaluate based on provided appID and toolName and the session appID, toolName
 	rule, err := s.policyEvaluator.Evaluate(ctx, calleeApp, session.OwnerAppID, toolName)
 	if err != nil {
-		return err
+		decisionLog.WithError(err).Info("external authorization denied")
+		return nil, err
 	}
 
+	decisionLog = decisionLog.WithField("rule_id", rule.ID)
+
+	var approvalID *string
+
 	if rule.NeedsApproval {
-		err := s.sendDeviceOTPAndWaitForApproval(ctx, session, callerApp, calleeApp, &toolName)
+		otpID, err := s.sendDeviceOTPAndWaitForApproval(ctx, session, callerApp, calleeApp, &toolName)
 		if err != nil {
-			return err
+			decisionLog.WithError(err).Info("external authorization denied")
+			return nil, err
 		}
-	}
 
-	err = s.expireSessionIfNecessary(ctx, session)
-	if err != nil {
-		return err
+		approvalID = &otpID
+		decisionLog = decisionLog.WithField("approval_id", otpID)
 	}
 
-	return nil
-}
-
-func (s *authService) getExtAuthZCalleeApp(ctx context.Context, appID string) (*apptypes.App, error) {
-	calleeApp, err := s.appRepository.GetApp(ctx, appID)
-	if err != nil {
-		if errors.Is(err, appcore.ErrAppNotFound) {
-			return nil, errutil.Unauthorized("auth.calleeAppNotFound", "Callee application not found.")
-		}
+	decisionLog.Info("external authorization granted")
 
-		return nil, fmt.Errorf("repository in ExtAuthZ failed to fetch callee app %s: %w", appID, err)
+	// Both changes are saved at once, the session is only saved when it changes
+	changed := attachTransaction(session, txn)
+	if expireSessionIfNecessary(session) {
+		changed = true
 	}
 
-	return calleeApp, nil
-}
-
-func (s *authService) getExtAuthZCallerApp(ctx context.Context, appID string) (*apptypes.App, error) {
-	callerApp, err := s.appRepository.GetApp(ctx, appID)
-	if err != nil {
-		if errors.Is(err, appcore.ErrAppNotFound) {
-			return nil, errutil.Unauthorized("auth.callerAppNotFound", "Caller application not found.")
+	if changed {
+		err = s.authRepository.UpdateSession(ctx, session)
+		if err != nil {
+			return nil, fmt.Errorf("repository in ExtAuthZ failed to update session: %w", err)
 		}
+	}
 
-		return nil, fmt.Errorf("repository in ExtAuthZ failed to fetch caller app %s: %w", appID, err)
+	identity, err := s.newCallerIdentity(ctx, session, callerApp, rule)
+	if err != nil {
+		return nil, err
 	}
 
-	return callerApp, nil
-}
+	if txn != nil {
+		identity.TransactionID = &txn.TransactionID
+		identity.TransactionToken = &txnToken
+	}
 
-func (s *authService) getSessionByAccessToken(
-	ctx context.Context,
-	accessToken string,
-) (*authtypes.Session, error) {
-	session, err := s.authRepository.GetSessionByAccessToken(ctx, accessToken)
-	if err != nil {
-		if errors.Is(err, authcore.ErrSessionNotFound) {
-			return nil, errutil.Unauthorized("auth.sessionNotFound", "Session not found.")
+	if in.issueReceipt {
+		identity.Receipt, err = s.issueReceipt(
+			ctx,
+			receipt.New(
+				callerApp.ID,
+				callerApp.ResolverMetadataID,
+				calleeApp.ID,
+				calleeApp.ResolverMetadataID,
+				toolName,
+				rule.ID,
+				approvalID,
+				in.body,
+			),
+		)
+		if err != nil {
+			return nil, err
 		}
-
-		return nil, fmt.Errorf("repository failed to fetch session with access token: %w", err)
 	}
 
-	return session, nil
+	return identity, nil
 }
 
-func (s *authService) sendDeviceOTPAndWaitForApproval(
+// resolveTransaction validates the transaction token received from the upstream
+// call or, at the first hop of a workflow, starts a new transaction.
+// No transaction is returned when none was requested. The transaction is resolved
+// once per request, the messages of a batch share it.
+func (s *authService) resolveTransaction(
 	ctx context.Context,
+	in *extAuthZInput,
 	session *authtypes.Session,
 	callerApp *apptypes.App,
-	calleeApp *apptypes.App,
-	toolName *string,
-) error {
-	otp, err := s.sendDeviceOTP(ctx, session, callerApp, calleeApp, toolName)
+) (*txntoken.Claims, string, error) {
+	txn := in.transaction
+	if txn == nil || (txn.token == "" && txn.purpose == "") {
+		return nil, "", nil
+	}
+
+	if txn.claims != nil {
+		return txn.claims, txn.token, nil
+	}
+
+	issuer, err := s.settingsRepository.GetIssuerSettings(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("repository failed to fetch issuer settings: %w", err)
+	}
+
+	if txn.token != "" {
+		pubKey, err := s.keyStore.RetrievePubKey(ctx, issuer.KeyID)
+		if err != nil {
+			return nil, "", fmt.Errorf("error retrieving public key from vault for transaction token: %w", err)
+		}
+
+		claims, err := txntoken.Verify(txn.token, issuer.IssuerID, pubKey)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("failed to verify the transaction token")
+
+			return nil, "", errutil.Unauthorized(
+				"auth.invalidTransactionToken",
+				"The transaction token is invalid.",
+			)
+		}
+
+		txn.claims = claims
+
+		return claims, txn.token, nil
+	}
+
+	privKey, err := s.keyStore.RetrievePrivKey(ctx, issuer.KeyID)
+	if err != nil {
+		return nil, "", fmt.Errorf("error retrieving private key from vault for transaction token: %w", err)
+	}
+
+	token, claims, err := txntoken.Issue(
+		issuer.IssuerID,
+		callerApp.ID,
+		callerApp.ResolverMetadataID,
+		session.UserID,
+		txn.purpose,
+		privKey,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to issue a transaction token: %w", err)
+	}
+
+	log.FromContext(ctx).Debug("Started transaction: ", claims.TransactionID)
+
+	txn.claims = claims
+	txn.token = token
+
+	return claims, token, nil
+}
+
+// attachTransaction records the transaction on the session so the calls
+// of a workflow can be traced end to end, it returns true when the session changes.
+func attachTransaction(session *authtypes.Session, txn *txntoken.Claims) bool {
+	if txn == nil || ptrutil.DerefStr(session.TransactionID) == txn.TransactionID {
+		return false
+	}
+
+	session.TransactionID = &txn.TransactionID
+
+	return true
+}
+
+// issueReceipt signs the receipt with the issuer key of the tenant and stores it.
+func (s *authService) issueReceipt(
+	ctx context.Context,
+	rcpt *authtypes.Receipt,
+) (*authtypes.Receipt, error) {
+	issuer, err := s.settingsRepository.GetIssuerSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to fetch issuer settings: %w", err)
+	}
+
+	privKey, err := s.keyStore.RetrievePrivKey(ctx, issuer.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving private key from vault for receipt signing: %w", err)
+	}
+
+	err = receipt.Sign(rcpt, issuer.IssuerID, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the receipt: %w", err)
+	}
+
+	err = s.authRepository.CreateReceipt(ctx, rcpt)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to store the receipt: %w", err)
+	}
+
+	return rcpt, nil
+}
+
+// GetReceipt returns a receipt of the tenant. When called by an application
+// the receipt is only returned if the application is the caller or the callee.
+func (s *authService) GetReceipt(ctx context.Context, id string) (*authtypes.Receipt, error) {
+	if id == "" {
+		return nil, errutil.ValidationFailed("auth.invalidReceiptId", "Receipt ID cannot be empty.")
+	}
+
+	rcpt, err := s.authRepository.GetReceiptByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, authcore.ErrReceiptNotFound) {
+			return nil, errutil.NotFound("auth.receiptNotFound", "Receipt not found.")
+		}
+
+		return nil, fmt.Errorf("repository failed to fetch receipt %s: %w", id, err)
+	}
+
+	appID, ok := identitycontext.GetAppID(ctx)
+	if ok && appID != "" && appID != rcpt.CallerAppID && appID != rcpt.CalleeAppID {
+		return nil, errutil.NotFound("auth.receiptNotFound", "Receipt not found.")
+	}
+
+	return rcpt, nil
+}
+
+// Introspect returns the state of an access token (RFC 7662) to the application
+// it was issued to or to an application it can be used against. The token is
+// reported as inactive when it is unknown, expired, revoked or has an invalid
+// signature, without telling the reason.
+func (s *authService) Introspect(
+	ctx context.Context,
+	token string,
+) (*authtypes.TokenIntrospection, error) {
+	appID, ok := identitycontext.GetAppID(ctx)
+	if !ok || appID == "" {
+		return nil, errutil.Unauthorized(
+			"auth.invalidCallerAppId",
+			"Caller application ID should be present in the request.",
+		)
+	}
+
+	if token == "" {
+		return nil, errutil.ValidationFailed("auth.emptyToken", "Token cannot be empty.")
+	}
+
+	inactive := &authtypes.TokenIntrospection{Active: false}
+
+	session, proof, err := s.resolveSession(ctx, token)
+	if err != nil {
+		if errutil.IsDomainError(err) {
+			return inactive, nil
+		}
+
+		return nil, err
+	}
+
+	if session.HasExpired() || (session.OwnerAppID != appID && !session.ValidateApp(appID)) {
+		return inactive, nil
+	}
+
+	err = s.verifyAccessToken(ctx, session.OwnerAppID, proof)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("introspected an invalid access token")
+		return inactive, nil
+	}
+
+	expiresAt := session.ExpiresAt
+	if exp, ok := jwtutil.Expiration(proof); ok && (expiresAt == nil || exp < *expiresAt) {
+		expiresAt = &exp
+	}
+
+	tokenType := session.TokenType()
+
+	return &authtypes.TokenIntrospection{
+		Active:         true,
+		ClientID:       &session.OwnerAppID,
+		Scope:          session.ToolName,
+		TokenType:      &tokenType,
+		ExpiresAt:      expiresAt,
+		IssuedAt:       &session.CreatedAt,
+		Subject:        session.UserID,
+		Audience:       session.AppID,
+		SessionID:      &session.ID,
+		DPoPThumbprint: session.DPoPThumbprint,
+	}, nil
+}
+
+// Revoke revokes an access token (RFC 7009) on behalf of the application it was
+// issued to. The session is expired and, for self-contained session tokens, added
+// to the deny-list so that ExtAuthZ rejects it right away. Unknown and expired
+// tokens are ignored.
+func (s *authService) Revoke(ctx context.Context, token string) error {
+	appID, ok := identitycontext.GetAppID(ctx)
+	if !ok || appID == "" {
+		return errutil.Unauthorized(
+			"auth.invalidCallerAppId",
+			"Caller application ID should be present in the request.",
+		)
+	}
+
+	if token == "" {
+		return errutil.ValidationFailed("auth.emptyToken", "Token cannot be empty.")
+	}
+
+	session, err := s.authRepository.GetSessionByAccessToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, authcore.ErrSessionNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("repository failed to fetch session with access token: %w", err)
+	}
+
+	if session.OwnerAppID != appID {
+		return errutil.Unauthorized("auth.tokenNotIssuedToApp", "The token was not issued to the application.")
+	}
+
+	if session.HasExpired() {
+		return nil
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+// revokeSession expires the session and, for self-contained session tokens,
+// adds it to the deny-list so that ExtAuthZ rejects its token right away.
+func (s *authService) revokeSession(ctx context.Context, session *authtypes.Session) error {
+	err := s.denySession(ctx, session)
 	if err != nil {
 		return err
 	}
 
-	return s.waitForDeviceApproval(ctx, otp.ID)
+	session.Expire()
+
+	err = s.authRepository.UpdateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("repository failed to revoke the session %s: %w", session.ID, err)
+	}
+
+	log.FromContext(ctx).Info("revoked the session ", session.ID)
+
+	return nil
 }
 
-func (s *authService) expireSessionIfNecessary(ctx context.Context, session *authtypes.Session) error {
-	if session.ExpiresAt == nil {
-		// We set the token to expire after 1 min.
-		// The reason we do this is because DUO and ORY
-		// generate the same access token in a 1 sec time window
-		// and agents can call other services multiple times
-		// during the same prompt which can lead to a failure.
-		//
-		//nolint:mnd // obviously it's not a magic number
-		session.ExpireAfter(60 * time.Second)
+func (s *authService) denySession(ctx context.Context, session *authtypes.Session) error {
+	if !s.selfContainedSessions() {
+		return nil
+	}
 
-		err := s.authRepository.UpdateSession(ctx, session)
+	expiresAt := time.Now().Add(sessiontoken.Duration).Unix()
+	if session.ExpiresAt != nil {
+		expiresAt = *session.ExpiresAt
+	}
+
+	return s.sessionDenyList.Deny(ctx, session.ID, expiresAt)
+}
+
+// ListSessions returns the sessions of the tenant with their tokens redacted.
+func (s *authService) ListSessions(
+	ctx context.Context,
+	paginationFilter pagination.PaginationFilter,
+	filter *authtypes.SessionFilter,
+) (*pagination.Pageable[authtypes.Session], error) {
+	sessions, err := s.authRepository.ListSessions(ctx, paginationFilter, filter)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to list the sessions: %w", err)
+	}
+
+	for _, session := range sessions.Items {
+		session.Redact()
+	}
+
+	return sessions, nil
+}
+
+// GetSession returns a session of the tenant with its tokens redacted.
+func (s *authService) GetSession(ctx context.Context, id string) (*authtypes.Session, error) {
+	session, err := s.getSessionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Redact()
+
+	return session, nil
+}
+
+// RevokeSession revokes a session of the tenant. Revoking an expired session has no effect.
+func (s *authService) RevokeSession(ctx context.Context, id string) error {
+	session, err := s.getSessionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if session.HasExpired() {
+		return nil
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+// RevokeAllSessionsForApp revokes the active sessions owned by an app of the tenant,
+// cutting off a compromised app at once. It returns the number of revoked sessions.
+func (s *authService) RevokeAllSessionsForApp(ctx context.Context, appID string) (int, error) {
+	app, err := s.appRepository.GetApp(ctx, appID)
+	if err != nil {
+		if errors.Is(err, appcore.ErrAppNotFound) {
+			return 0, errutil.NotFound("auth.appNotFound", "Application not found.")
+		}
+
+		return 0, fmt.Errorf("repository failed to fetch the app %s: %w", appID, err)
+	}
+
+	sessions, err := s.authRepository.GetActiveSessionsForApp(ctx, app.ID)
+	if err != nil {
+		return 0, fmt.Errorf("repository failed to fetch the sessions of the app %s: %w", app.ID, err)
+	}
+
+	// The sessions are denied before being expired, so that the sessions whose
+	// denial failed are still active and revoked again when the call is retried
+	sessionIDs := make([]string, 0, len(sessions))
+
+	for _, session := range sessions {
+		err = s.denySession(ctx, session)
+		if err != nil {
+			return 0, err
+		}
+
+		sessionIDs = append(sessionIDs, session.ID)
+	}
+
+	err = s.authRepository.ExpireSessions(ctx, sessionIDs)
+	if err != nil {
+		return 0, fmt.Errorf("repository failed to expire the sessions of the app %s: %w", app.ID, err)
+	}
+
+	log.FromContext(ctx).Infof("revoked %d sessions of the app %s", len(sessions), app.ID)
+
+	return len(sessions), nil
+}
+
+func (s *authService) getSessionByID(ctx context.Context, id string) (*authtypes.Session, error) {
+	if id == "" {
+		return nil, errutil.ValidationFailed("auth.invalidSessionId", "Session ID cannot be empty.")
+	}
+
+	session, err := s.authRepository.GetSessionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, authcore.ErrSessionNotFound) {
+			return nil, errutil.NotFound("auth.sessionNotFound", "Session not found.")
+		}
+
+		return nil, fmt.Errorf("repository failed to fetch the session %s: %w", id, err)
+	}
+
+	return session, nil
+}
+
+// ExtAuthZMcp authorizes a request forwarded by an HTTP proxy in front of an MCP server.
+// The body can contain a single JSON-RPC message, a batch or an SSE stream of messages.
+// Every tools/call is evaluated separately against the policies while the other
+// methods are authorized based on the configured MCP method rules.
+// A request without a body, such as the GET opening an SSE stream or the DELETE
+// terminating a session, only needs a valid access token.
+func (s *authService) ExtAuthZMcp(
+	ctx context.Context,
+	accessToken string,
+	body []byte,
+	contentType string,
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return s.authenticateCaller(ctx, accessToken, opts...)
+	}
+
+	messages, err := jsonrpc.Parse(body, contentType)
+	if err != nil {
+		return nil, errutil.ValidationFailed(
+			"auth.invalidMcpMessage",
+			"Unable to parse the MCP message: %s.",
+			err,
+		)
+	}
+
+	return authorizeMessages(messages, func(msg *jsonrpc.Message) (*authtypes.CallerIdentity, error) {
+		return s.authorizeMcpMessage(ctx, accessToken, msg, opts...)
+	})
+}
+
+func (s *authService) authorizeMcpMessage(
+	ctx context.Context,
+	accessToken string,
+	msg *jsonrpc.Message,
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	if authmcp.IsToolCall(msg) {
+		params, err := authmcp.ParseToolCall(msg)
+		if err != nil {
+			return nil, errutil.ValidationFailed(
+				"auth.invalidMcpToolCall",
+				"Invalid MCP tool call: %s.",
+				err,
+			)
+		}
+
+		log.FromContext(ctx).Debug("Authorizing MCP tool call: ", params.Name)
+
+		return s.ExtAuthZ(ctx, accessToken, params.Name, opts...)
+	}
+
+	// Responses sent by the client (e.g. to sampling requests) have no method
+	// and are authorized with the default action
+	action := s.mcpMethodRules.ActionFor(msg.Method)
+
+	log.FromContext(ctx).Debugf("Applying action %s to MCP method %s", action, msg.Method)
+
+	switch action {
+	case authmcp.MethodActionAllow:
+		return nil, nil
+	case authmcp.MethodActionAuthenticate:
+		return s.authenticateCaller(ctx, accessToken, opts...)
+	default:
+		return nil, errutil.Unauthorized(
+			"auth.mcpMethodNotAllowed",
+			"The MCP method %s is not allowed.",
+			msg.Method,
+		)
+	}
+}
+
+// FilterMcpTools returns the tools, among the ones provided, that the caller
+// is allowed to invoke on the callee MCP Server present in the context.
+func (s *authService) FilterMcpTools(
+	ctx context.Context,
+	accessToken string,
+	toolNames []string,
+) ([]string, error) {
+	return s.filterInvocable(ctx, accessToken, toolNames)
+}
+
+// ExtAuthZA2A authorizes a request forwarded by a gateway in front of an A2A agent.
+// The messages sent to the agent (message/send and message/stream) must set
+// a skill in their metadata and are evaluated against the policies for that skill.
+// The skill is declared by the caller, so the decision only holds when the agent
+// runs the skill set in the same field. The other A2A methods, as well as the
+// requests without body, only need a valid access token.
+func (s *authService) ExtAuthZA2A(
+	ctx context.Context,
+	accessToken string,
+	body []byte,
+	contentType string,
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return s.authenticateCaller(ctx, accessToken, opts...)
+	}
+
+	messages, err := jsonrpc.Parse(body, contentType)
+	if err != nil {
+		return nil, errutil.ValidationFailed(
+			"auth.invalidA2AMessage",
+			"Unable to parse the A2A message: %s.",
+			err,
+		)
+	}
+
+	return authorizeMessages(messages, func(msg *jsonrpc.Message) (*authtypes.CallerIdentity, error) {
+		return s.authorizeA2AMessage(ctx, accessToken, msg, opts...)
+	})
+}
+
+func (s *authService) authorizeA2AMessage(
+	ctx context.Context,
+	accessToken string,
+	msg *jsonrpc.Message,
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	if autha2a.IsInvocation(msg) {
+		skillID, err := autha2a.ParseSkillID(msg)
+		if err != nil {
+			return nil, errutil.ValidationFailed(
+				"auth.invalidA2AMessage",
+				"Invalid A2A message: %s.",
+				err,
+			)
+		}
+
+		log.FromContext(ctx).Debug("Authorizing A2A skill: ", skillID)
+
+		return s.ExtAuthZ(ctx, accessToken, skillID, opts...)
+	}
+
+	if !autha2a.IsKnownMethod(msg.Method) {
+		return nil, errutil.Unauthorized(
+			"auth.a2aMethodNotAllowed",
+			"The A2A method %s is not allowed.",
+			msg.Method,
+		)
+	}
+
+	return s.authenticateCaller(ctx, accessToken, opts...)
+}
+
+// FilterA2ASkills returns the skills, among the ones provided, that the caller
+// is allowed to use on the callee A2A agent present in the context.
+func (s *authService) FilterA2ASkills(
+	ctx context.Context,
+	accessToken string,
+	skillIDs []string,
+) ([]string, error) {
+	return s.filterInvocable(ctx, accessToken, skillIDs)
+}
+
+// filterInvocable evaluates the policies for each tool (or skill)
+// and returns the ones the caller is allowed to invoke.
+func (s *authService) filterInvocable(
+	ctx context.Context,
+	accessToken string,
+	names []string,
+) ([]string, error) {
+	session, _, calleeApp, err := s.authenticateExtAuthZ(ctx, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if name == "" || !session.ValidateTool(name) {
+			continue
+		}
+
+		_, err := s.policyEvaluator.Evaluate(ctx, calleeApp, session.OwnerAppID, name)
+		if err != nil {
+			var domainErr *errutil.DomainError
+			if errors.As(err, &domainErr) && domainErr.Reason == errutil.ErrorReasonUnauthorized {
+				continue
+			}
+
+			return nil, err
+		}
+
+		allowed = append(allowed, name)
+	}
+
+	return allowed, nil
+}
+
+// authorizeMessages authorizes each message of a request and returns the identity
+// of the caller. The rule is only part of the identity when all the messages
+// evaluated against the policies matched the same rule. The identity carries
+// the receipt of the last message a receipt was issued for.
+func authorizeMessages(
+	messages []*jsonrpc.Message,
+	authorize func(msg *jsonrpc.Message) (*authtypes.CallerIdentity, error),
+) (*authtypes.CallerIdentity, error) {
+	var (
+		identity    *authtypes.CallerIdentity
+		transaction *authtypes.CallerIdentity
+		rcpt        *authtypes.Receipt
+	)
+
+	ruleIDs := make(map[string]struct{})
+
+	for _, msg := range messages {
+		msgIdentity, err := authorize(msg)
 		if err != nil {
-			return fmt.Errorf("repository in ExtAuthZ failed to update session: %w", err)
+			return nil, err
+		}
+
+		if msgIdentity == nil {
+			continue
+		}
+
+		if msgIdentity.RuleID != nil {
+			ruleIDs[*msgIdentity.RuleID] = struct{}{}
+		}
+
+		if msgIdentity.TransactionID != nil {
+			transaction = msgIdentity
+		}
+
+		if msgIdentity.Receipt != nil {
+			rcpt = msgIdentity.Receipt
+		}
+
+		identity = msgIdentity
+	}
+
+	if identity != nil {
+		identity.RuleID = nil
+		identity.Receipt = rcpt
+
+		if transaction != nil {
+			identity.TransactionID = transaction.TransactionID
+			identity.TransactionToken = transaction.TransactionToken
+		}
+
+		if len(ruleIDs) == 1 {
+			for ruleID := range ruleIDs {
+				identity.RuleID = &ruleID
+			}
+		}
+	}
+
+	return identity, nil
+}
+
+// authenticateCaller validates the access token without evaluating
+// the policies and returns the identity of the caller.
+func (s *authService) authenticateCaller(
+	ctx context.Context,
+	accessToken string,
+	opts ...ExtAuthZOption,
+) (*authtypes.CallerIdentity, error) {
+	in := extAuthZInput{}
+	for _, opt := range opts {
+		opt(&in)
+	}
+
+	session, callerApp, _, err := s.authenticateExtAuthZ(ctx, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.verifyDPoPProof(ctx, session, callerApp, accessToken, in.dpop)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.newCallerIdentity(ctx, session, callerApp, nil)
+}
+
+func (s *authService) newCallerIdentity(
+	ctx context.Context,
+	session *authtypes.Session,
+	callerApp *apptypes.App,
+	rule *policytypes.Rule,
+) (*authtypes.CallerIdentity, error) {
+	identity := &authtypes.CallerIdentity{
+		AppID:              callerApp.ID,
+		AppName:            callerApp.Name,
+		AppType:            callerApp.Type,
+		ResolverMetadataID: callerApp.ResolverMetadataID,
+		UserID:             session.UserID,
+		SessionID:          session.ID,
+	}
+
+	if rule != nil {
+		identity.RuleID = &rule.ID
+	}
+
+	badge, err := s.badgeRepository.GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID)
+
+	switch {
+	case err == nil:
+		identity.BadgeID = &badge.ID
+	case !errors.Is(err, badgecore.ErrBadgeNotFound):
+		return nil, fmt.Errorf("repository failed to fetch the badge of caller app %s: %w", callerApp.ID, err)
+	}
+
+	return identity, nil
+}
+
+// authenticateExtAuthZ validates the access token against the callee app
+// present in the context and returns the session with the caller and callee apps.
+// The tool name is validated against the session only when provided.
+func (s *authService) authenticateExtAuthZ(
+	ctx context.Context,
+	accessToken string,
+	toolName *string,
+) (*authtypes.Session, *apptypes.App, *apptypes.App, error) {
+	if accessToken == "" {
+		return nil, nil, nil, errutil.ValidationFailed("auth.emptyAccessToken", "Access token cannot be empty.")
+	}
+
+	session, proof, err := s.resolveSession(ctx, accessToken)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if session.HasExpired() {
+		return nil, nil, nil, errutil.Unauthorized("auth.sessionExpired", "The session has expired.")
+	}
+
+	log.FromContext(ctx).Debug("Got session by access token: ", session.ID)
+
+	calleeAppID, _ := identitycontext.GetAppID(ctx)
+
+	log.FromContext(ctx).Debug("Session appID: ", calleeAppID)
+
+	calleeApp, err := s.getExtAuthZCalleeApp(ctx, calleeAppID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	log.FromContext(ctx).Debug("Got app info: ", calleeApp.ID)
+
+	// If the session appID is provided (in the authorize call)
+	// it needs to match the current context appID
+	if !session.ValidateApp(calleeAppID) {
+		return nil, nil, nil, errutil.Unauthorized(
+			"auth.invalidAccessTokenForApp",
+			"The access token is not valid for the specified app.",
+		)
+	}
+
+	// If the session toolName is provided (in the authorize call)
+	// we cannot specify another toolName in the ext-authz request
+	if toolName != nil && !session.ValidateTool(*toolName) {
+		return nil, nil, nil, errutil.Unauthorized(
+			"auth.invalidAccessTokenForTool",
+			"The access token is not valid for the specified tool.",
+		)
+	}
+
+	// validate the caller app
+	callerApp, err := s.getExtAuthZCallerApp(ctx, session.OwnerAppID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for _, app := range []*apptypes.App{callerApp, calleeApp} {
+		err = s.ensureAppNotSuspended(ctx, app)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	log.FromContext(ctx).Debug("Verifying access token: ", accessToken)
+
+	err = s.verifyAccessToken(ctx, session.OwnerAppID, proof)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to verify JWT in ExtAuthZ")
+		return nil, nil, nil, errutil.Unauthorized("auth.invalidAccessToken", "The access token is invalid.")
+	}
+
+	return session, callerApp, calleeApp, nil
+}
+
+func (s *authService) getExtAuthZCalleeApp(ctx context.Context, appID string) (*apptypes.App, error) {
+	calleeApp, err := s.appRepository.GetApp(ctx, appID)
+	if err != nil {
+		if errors.Is(err, appcore.ErrAppNotFound) {
+			return nil, errutil.Unauthorized("auth.calleeAppNotFound", "Callee application not found.")
+		}
+
+		return nil, fmt.Errorf("repository in ExtAuthZ failed to fetch callee app %s: %w", appID, err)
+	}
+
+	return calleeApp, nil
+}
+
+func (s *authService) getExtAuthZCallerApp(ctx context.Context, appID string) (*apptypes.App, error) {
+	callerApp, err := s.appRepository.GetApp(ctx, appID)
+	if err != nil {
+		if errors.Is(err, appcore.ErrAppNotFound) {
+			return nil, errutil.Unauthorized("auth.callerAppNotFound", "Caller application not found.")
 		}
+
+		return nil, fmt.Errorf("repository in ExtAuthZ failed to fetch caller app %s: %w", appID, err)
+	}
+
+	return callerApp, nil
+}
+
+// ensureAppNotSuspended refuses the apps with a suspended badge
+func (s *authService) ensureAppNotSuspended(ctx context.Context, app *apptypes.App) error {
+	statuses, err := s.appRepository.GetAppStatuses(ctx, app.ID)
+	if err != nil {
+		return fmt.Errorf("repository failed to fetch the status of the app %s: %w", app.ID, err)
+	}
+
+	if statuses[app.ID] == apptypes.APP_STATUS_SUSPENDED {
+		return errutil.Unauthorized("auth.appSuspended", "The application %s is suspended.", app.ID)
 	}
 
 	return nil
 }
 
+// resolvedSessionKey is the context key of the session resolved by ResolveCaller
+type resolvedSessionKey struct{}
+
+type resolvedSession struct {
+	accessToken string
+	session     *authtypes.Session
+	proof       string
+}
+
+// resolveSession returns the session of the access token along with the token
+// issued by the IdP. Self-contained session tokens are verified against the issuer
+// key and the deny-list, the other tokens (e.g. issued before the mode was enabled)
+// are looked up in the database. The session already resolved by ResolveCaller
+// for the request is reused.
+func (s *authService) resolveSession(
+	ctx context.Context,
+	accessToken string,
+) (*authtypes.Session, string, error) {
+	resolved, ok := ctx.Value(resolvedSessionKey{}).(*resolvedSession)
+	if ok && resolved.accessToken == accessToken {
+		return resolved.session, resolved.proof, nil
+	}
+
+	if !s.selfContainedSessions() {
+		session, err := s.getSessionByAccessToken(ctx, accessToken)
+		return session, accessToken, err
+	}
+
+	claims, err := s.verifySessionToken(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, sessiontoken.ErrExpiredToken) {
+			return nil, "", errutil.Unauthorized("auth.sessionExpired", "The session has expired.")
+		}
+
+		log.FromContext(ctx).WithError(err).Debug("not a self-contained session token")
+
+		session, err := s.getSessionByAccessToken(ctx, accessToken)
+
+		return session, accessToken, err
+	}
+
+	denied, err := s.sessionDenyList.IsDenied(ctx, claims.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if denied {
+		return nil, "", errutil.Unauthorized("auth.sessionRevoked", "The session has been revoked.")
+	}
+
+	return claims.Session(accessToken), claims.Proof, nil
+}
+
+// verifyAccessToken verifies the signature of the token issued by the IdP
+// to the owner app, along with its issuer, audience and client. Self-issued
+// tokens are verified with the issuer key of the tenant. Without a verifier
+// every token is rejected.
+func (s *authService) verifyAccessToken(ctx context.Context, ownerAppID, token string) error {
+	if s.tokenVerifier == nil {
+		return errors.New("no verifier of the access tokens is configured")
+	}
+
+	clientCredentials, err := s.credentialStore.Get(ctx, ownerAppID)
+	if err != nil || clientCredentials == nil {
+		return fmt.Errorf("credential store failed to get client credentials: %w", err)
+	}
+
+	opts := []jwtutil.VerifyOption{
+		jwtutil.WithIssuer(clientCredentials.Issuer),
+		jwtutil.WithClientID(clientCredentials.ClientID),
+	}
+
+	issuer, err := s.settingsRepository.GetIssuerSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("repository failed to fetch issuer settings: %w", err)
+	}
+
+	if issuer.IdpType == settingstypes.IDP_TYPE_SELF {
+		// The key ID of the token is not trusted, the self-issued
+		// tokens are only signed with the issuer key of the tenant
+		opts = append(opts, jwtutil.WithKeyFunc(func(ctx context.Context, keyID string) (*jwk.Jwk, error) {
+			if keyID != issuer.KeyID {
+				return nil, fmt.Errorf("the key %s is not the issuer key", keyID)
+			}
+
+			return s.keyStore.RetrievePubKey(ctx, keyID)
+		}))
+	}
+
+	return s.tokenVerifier.Verify(ctx, token, opts...)
+}
+
+func (s *authService) verifySessionToken(
+	ctx context.Context,
+	accessToken string,
+) (*sessiontoken.Claims, error) {
+	keyID, err := sessiontoken.KeyID(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := s.settingsRepository.GetIssuerSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to fetch issuer settings: %w", err)
+	}
+
+	// The key ID of the token is not trusted, the session tokens
+	// are only signed with the issuer key of the tenant
+	if keyID != issuer.KeyID {
+		return nil, sessiontoken.ErrInvalidToken
+	}
+
+	pubKey, err := s.keyStore.RetrievePubKey(ctx, issuer.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", sessiontoken.ErrInvalidToken, err)
+	}
+
+	return sessiontoken.Verify(accessToken, issuer.IssuerID, pubKey)
+}
+
+func (s *authService) getSessionByAccessToken(
+	ctx context.Context,
+	accessToken string,
+) (*authtypes.Session, error) {
+	session, err := s.authRepository.GetSessionByAccessToken(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, authcore.ErrSessionNotFound) {
+			return nil, errutil.Unauthorized("auth.sessionNotFound", "Session not found.")
+		}
+
+		return nil, fmt.Errorf("repository failed to fetch session with access token: %w", err)
+	}
+
+	return session, nil
+}
+
+func (s *authService) sendDeviceOTPAndWaitForApproval(
+	ctx context.Context,
+	session *authtypes.Session,
+	callerApp *apptypes.App,
+	calleeApp *apptypes.App,
+	toolName *string,
+) (string, error) {
+	otp, err := s.sendDeviceOTP(ctx, session, callerApp, calleeApp, toolName)
+	if err != nil {
+		return "", err
+	}
+
+	return otp.ID, s.waitForDeviceApproval(ctx, otp.ID)
+}
+
+// expireSessionIfNecessary sets the expiration of the session on its first use,
+// it returns true when the session changes.
+func expireSessionIfNecessary(session *authtypes.Session) bool {
+	if session.ExpiresAt != nil {
+		return false
+	}
+
+	// We set the token to expire after 1 min.
+	// The reason we do this is because DUO and ORY
+	// generate the same access token in a 1 sec time window
+	// and agents can call other services multiple times
+	// during the same prompt which can lead to a failure.
+	//
+	//nolint:mnd // obviously it's not a magic number
+	session.ExpireAfter(60 * time.Second)
+
+	return true
+}
+
 func (s *authService) ApproveToken(
 	ctx context.Context,
 	deviceID string,
@@ -5,6 +5,12 @@ package bff_test
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -16,8 +22,17 @@ import (
 	appmocks "github.com/agntcy/identity-service/internal/core/app/mocks"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authmcp "github.com/agntcy/identity-service/internal/core/auth/mcp"
 	authmocks "github.com/agntcy/identity-service/internal/core/auth/mocks"
+	"github.com/agntcy/identity-service/internal/core/auth/pkce"
+	"github.com/agntcy/identity-service/internal/core/auth/receipt"
+	"github.com/agntcy/identity-service/internal/core/auth/sessiontoken"
+	"github.com/agntcy/identity-service/internal/core/auth/txntoken"
 	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
+	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	devicemocks "github.com/agntcy/identity-service/internal/core/device/mocks"
 	devicetypes "github.com/agntcy/identity-service/internal/core/device/types"
 	identitymocks "github.com/agntcy/identity-service/internal/core/identity/mocks"
@@ -29,20 +44,29 @@ import (
 	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
+	jwtutilmocks "github.com/agntcy/identity-service/internal/pkg/jwtutil/mocks"
 	oidctesting "github.com/agntcy/identity-service/internal/pkg/oidc/testing"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/agntcy/identity/pkg/oidc"
 	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 const (
 	validOwnerAppID = "owner-app-id"
+	testAPIURL      = "https://api.example.com"
 )
 
+var testDPoPNonceSecret = []byte("dpop-nonce-secret")
+
 // Authorize
 
 func TestAuthService_Authorize_should_generate_auth_code(t *testing.T) {
@@ -56,19 +80,41 @@ func TestAuthService_Authorize_should_generate_auth_code(t *testing.T) {
 			return s, nil
 		})
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		Return(&apptypes.App{ID: validOwnerAppID}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	session, err := sut.Authorize(ctx, nil, nil, nil)
+	session, err := sut.Authorize(ctx, nil, nil, nil, nil, nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, session.AuthorizationCode)
 	assert.Greater(t, *session.ExpiresAt, time.Now().Unix())
 }
 
+func TestAuthService_Authorize_should_return_err_when_caller_app_is_suspended(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().
+		GetApp(ctx, validOwnerAppID).
+		Return(&apptypes.App{ID: validOwnerAppID}, nil)
+	appRepo.EXPECT().
+		GetAppStatuses(ctx, validOwnerAppID).
+		Return(map[string]apptypes.AppStatus{validOwnerAppID: apptypes.APP_STATUS_SUSPENDED}, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Authorize(ctx, nil, nil, nil, nil, nil, nil)
+
+	assert.ErrorIs(t, err, errutil.Unauthorized(
+		"auth.appSuspended",
+		"The application %s is suspended.",
+		validOwnerAppID,
+	))
+}
+
 func TestAuthService_Authorize_should_generate_auth_code_for_specific_app(t *testing.T) {
 	t.Parallel()
 
@@ -82,7 +128,7 @@ func TestAuthService_Authorize_should_generate_auth_code_for_specific_app(t *tes
 			return s, nil
 		})
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string) (*apptypes.App, error) {
@@ -96,9 +142,9 @@ func TestAuthService_Authorize_should_generate_auth_code_for_specific_app(t *tes
 	policyEvaluator.EXPECT().
 		Evaluate(mock.Anything, calledApp, validOwnerAppID, "").
 		Return(&policytypes.Rule{}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	session, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil)
+	session, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil, nil, nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, session.AuthorizationCode)
@@ -119,7 +165,7 @@ func TestAuthService_Authorize_should_generate_session_for_tool(t *testing.T) {
 			return s, nil
 		})
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string) (*apptypes.App, error) {
@@ -133,9 +179,9 @@ func TestAuthService_Authorize_should_generate_session_for_tool(t *testing.T) {
 	policyEvaluator.EXPECT().
 		Evaluate(mock.Anything, calledApp, validOwnerAppID, toolName).
 		Return(&policytypes.Rule{}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	session, err := sut.Authorize(ctx, &resolverMetadataID, &toolName, nil)
+	session, err := sut.Authorize(ctx, &resolverMetadataID, &toolName, nil, nil, nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, session.AuthorizationCode)
@@ -157,9 +203,9 @@ func TestAuthService_Authorize_should_return_err_when_owner_app_not_in_context(t
 				invalidCtx = identitycontext.InsertAppID(invalidCtx, *c)
 			}
 
-			sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-			_, err := sut.Authorize(invalidCtx, nil, nil, nil)
+			_, err := sut.Authorize(invalidCtx, nil, nil, nil, nil, nil, nil)
 
 			assert.Error(t, err)
 			assert.ErrorIs(
@@ -176,7 +222,7 @@ func TestAuthService_Authorize_should_return_err_when_resolver_metadata_id_inval
 
 	invalidResolverMD := "invalid"
 	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string) (*apptypes.App, error) {
@@ -185,9 +231,9 @@ func TestAuthService_Authorize_should_return_err_when_resolver_metadata_id_inval
 	appRepo.EXPECT().
 		GetAppByResolverMetadataID(mock.Anything, invalidResolverMD).
 		Return(nil, appcore.ErrAppNotFound)
-	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	_, err := sut.Authorize(ctx, &invalidResolverMD, nil, nil)
+	_, err := sut.Authorize(ctx, &invalidResolverMD, nil, nil, nil, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.InvalidRequest(
@@ -205,7 +251,7 @@ func TestAuthService_Authorize_should_return_err_when_called_app_is_same_as_owne
 	invalidCalledApp := &apptypes.App{ID: validOwnerAppID}
 	resolverMetadataID := uuid.NewString()
 	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string) (*apptypes.App, error) {
@@ -214,9 +260,9 @@ func TestAuthService_Authorize_should_return_err_when_called_app_is_same_as_owne
 	appRepo.EXPECT().
 		GetAppByResolverMetadataID(mock.Anything, resolverMetadataID).
 		Return(invalidCalledApp, nil)
-	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	_, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil)
+	_, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil, nil, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.InvalidRequest(
@@ -230,13 +276,13 @@ func TestAuthService_Authorize_should_return_err_when_owner_app_not_found(t *tes
 
 	invalidOwnerAppID := "invalid-owner-app-id"
 	ctx := identitycontext.InsertAppID(context.Background(), invalidOwnerAppID)
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		Return(nil, appcore.ErrAppNotFound)
-	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	_, err := sut.Authorize(ctx, nil, nil, nil)
+	_, err := sut.Authorize(ctx, nil, nil, nil, nil, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.NotFound("auth.callerAppNotFound", "Caller application not found."))
@@ -248,7 +294,7 @@ func TestAuthService_Authorize_should_return_err_when_policy_evaluation_fails(t
 	calledApp := &apptypes.App{ID: "specific-app-id"}
 	resolverMetadataID := uuid.NewString()
 	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().
 		GetApp(mock.Anything, mock.Anything).
 		RunAndReturn(func(ctx context.Context, id string) (*apptypes.App, error) {
@@ -262,19 +308,244 @@ func TestAuthService_Authorize_should_return_err_when_policy_evaluation_fails(t
 	policyEvaluator.EXPECT().
 		Evaluate(mock.Anything, calledApp, validOwnerAppID, "").
 		Return(nil, errors.New("invalid evaluation"))
-	sut := bff.NewAuthService(nil, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, policyEvaluator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	_, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil)
+	_, err := sut.Authorize(ctx, &resolverMetadataID, nil, nil, nil, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "invalid evaluation")
 }
 
+func TestAuthService_Authorize_should_return_err_when_dpop_is_required(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().
+		GetApp(ctx, validOwnerAppID).
+		Return(&apptypes.App{ID: validOwnerAppID, RequireDPoP: ptrutil.Ptr(true)}, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Authorize(ctx, nil, nil, nil, nil, nil, nil)
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the application."),
+	)
+}
+
+func TestAuthService_Authorize_should_bind_the_session_to_the_dpop_key(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	key := generateDPoPKey(t)
+	proof := createDPoPProof(t, key, "POST", testAPIURL+"/v1alpha1/auth/authorize", "")
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().StoreDPoPProof(ctx, dpopThumbprint(t, key), mock.Anything, mock.Anything).Return(nil)
+	authRepo.EXPECT().
+		CreateSession(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, s *authtypes.Session) (*authtypes.Session, error) {
+			return s, nil
+		})
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().
+		GetApp(ctx, validOwnerAppID).
+		Return(&apptypes.App{ID: validOwnerAppID, RequireDPoP: ptrutil.Ptr(true)}, nil)
+	sut := bff.NewAuthService(
+		authRepo,
+		nil,
+		nil,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		dpop.NewVerifier(testAPIURL, testDPoPNonceSecret, authRepo),
+	)
+
+	session, err := sut.Authorize(ctx, nil, nil, nil, &proof, nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ptrutil.Ptr(dpopThumbprint(t, key)), session.DPoPThumbprint)
+}
+
+func TestAuthService_Authorize_should_bind_the_code_challenge(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	challenge := pkce.S256Challenge(pkce.NewVerifier())
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		CreateSession(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, s *authtypes.Session) (*authtypes.Session, error) {
+			return s, nil
+		})
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, validOwnerAppID).Return(&apptypes.App{ID: validOwnerAppID}, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	session, err := sut.Authorize(ctx, nil, nil, nil, nil, &challenge, ptrutil.Ptr(pkce.MethodS256))
+
+	assert.NoError(t, err)
+	assert.Equal(t, &challenge, session.CodeChallenge)
+	assert.Equal(t, ptrutil.Ptr(pkce.MethodS256), session.CodeChallengeMethod)
+}
+
+func TestAuthService_Authorize_should_return_err_when_code_challenge_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, validOwnerAppID).Return(&apptypes.App{ID: validOwnerAppID}, nil)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Authorize(ctx, nil, nil, nil, nil, ptrutil.Ptr("short"), nil)
+
+	assert.ErrorIs(t, err, errutil.ValidationFailed("auth.invalidCodeChallenge", "The code challenge is invalid."))
+}
+
 // Token
 
+func TestAuthService_Token_should_return_an_access_token_with_a_valid_code_verifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	authCode := uuid.NewString()
+	verifier := pkce.NewVerifier()
+	session := &authtypes.Session{
+		OwnerAppID:          validOwnerAppID,
+		CodeChallenge:       ptrutil.Ptr(pkce.S256Challenge(verifier)),
+		CodeChallengeMethod: ptrutil.Ptr(pkce.MethodS256),
+	}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAuthCode(ctx, authCode).Return(session, nil)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(ctx, mock.Anything).
+		Return(nil, authcore.ErrSessionNotFound)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	credStore.EXPECT().
+		Get(ctx, session.OwnerAppID).
+		Return(&idpcore.ClientCredentials{ClientSecret: "secret"}, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IdpType: settingstypes.IDP_TYPE_DUO,
+	}, nil)
+
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		oidctesting.NewValidAuthenticator(),
+		nil,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	returnedSess, err := sut.Token(ctx, authCode, nil, &verifier)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, returnedSess.AccessToken)
+}
+
+func TestAuthService_Token_should_reject_codes_that_cannot_be_redeemed(t *testing.T) {
+	t.Parallel()
+
+	authCode := uuid.NewString()
+	verifier := pkce.NewVerifier()
+	challenge := pkce.S256Challenge(verifier)
+
+	testCases := map[string]*struct {
+		session      *authtypes.Session
+		codeVerifier *string
+		expectedErr  error
+	}{
+		"code requested by another app": {
+			session: &authtypes.Session{OwnerAppID: uuid.NewString()},
+			expectedErr: errutil.Unauthorized(
+				"auth.codeNotIssuedToApp",
+				"The authorization code was not issued to the application.",
+			),
+		},
+		"expired code": {
+			session: &authtypes.Session{
+				OwnerAppID: validOwnerAppID,
+				ExpiresAt:  ptrutil.Ptr(time.Now().Add(-time.Minute).Unix()),
+			},
+			expectedErr: errutil.Unauthorized("auth.authorizationCodeExpired", "The authorization code has expired."),
+		},
+		"missing code verifier": {
+			session: &authtypes.Session{
+				OwnerAppID:          validOwnerAppID,
+				CodeChallenge:       &challenge,
+				CodeChallengeMethod: ptrutil.Ptr(pkce.MethodS256),
+			},
+			expectedErr: errutil.Unauthorized(
+				"auth.codeVerifierRequired",
+				"A code verifier is required for the authorization code.",
+			),
+		},
+		"other code verifier": {
+			session: &authtypes.Session{
+				OwnerAppID:          validOwnerAppID,
+				CodeChallenge:       &challenge,
+				CodeChallengeMethod: ptrutil.Ptr(pkce.MethodS256),
+			},
+			codeVerifier: ptrutil.Ptr(pkce.NewVerifier()),
+			expectedErr: errutil.Unauthorized(
+				"auth.invalidCodeVerifier",
+				"The code verifier does not match the code challenge.",
+			),
+		},
+		"code verifier without code challenge": {
+			session:      &authtypes.Session{OwnerAppID: validOwnerAppID},
+			codeVerifier: &verifier,
+			expectedErr: errutil.InvalidRequest(
+				"auth.unexpectedCodeVerifier",
+				"A code verifier was provided but the authorization code has no code challenge.",
+			),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAuthCode(ctx, authCode).Return(tc.session, nil)
+			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := sut.Token(ctx, authCode, nil, tc.codeVerifier)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
 func TestAuthService_Token_should_return_an_access_token_with_idp(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	authCode := uuid.NewString()
 	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
 	authRepo := authmocks.NewRepository(t)
@@ -305,9 +576,14 @@ func TestAuthService_Token_should_return_an_access_token_with_idp(t *testing.T)
 		nil,
 		settingsRepo,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	returnedSess, err := sut.Token(context.Background(), authCode)
+	returnedSess, err := sut.Token(ctx, authCode, nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, returnedSess.AccessToken)
@@ -316,6 +592,7 @@ func TestAuthService_Token_should_return_an_access_token_with_idp(t *testing.T)
 func TestAuthService_Token_should_return_an_access_token_as_self_issuer(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	authCode := uuid.NewString()
 	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
 	authRepo := authmocks.NewRepository(t)
@@ -338,9 +615,24 @@ func TestAuthService_Token_should_return_an_access_token_as_self_issuer(t *testi
 	keyStore := identitymocks.NewKeyStore(t)
 	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
 	keyStore.EXPECT().RetrievePrivKey(mock.Anything, mock.Anything).Return(priv, nil)
-	sut := bff.NewAuthService(authRepo, credStore, nil, nil, nil, nil, nil, settingsRepo, keyStore)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
 
-	returnedSess, err := sut.Token(context.Background(), authCode)
+	returnedSess, err := sut.Token(ctx, authCode, nil, nil)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, returnedSess.AccessToken)
@@ -349,6 +641,7 @@ func TestAuthService_Token_should_return_an_access_token_as_self_issuer(t *testi
 func TestAuthService_Token_should_expire_session_with_same_access_token(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	authCode := uuid.NewString()
 	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
 	existingSession := &authtypes.Session{AccessToken: ptrutil.Ptr("existingtoken")}
@@ -378,92 +671,211 @@ func TestAuthService_Token_should_expire_session_with_same_access_token(t *testi
 		nil,
 		settingsRepo,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	returnedSess, err := sut.Token(context.Background(), authCode)
+	returnedSess, err := sut.Token(ctx, authCode, nil, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, existingSession.AccessToken, returnedSess.AccessToken)
 	assert.Less(t, *session.ExpiresAt, time.Now().Unix())
 }
 
-func TestAuthService_Token_should_return_err_if_auth_code_is_empty(t *testing.T) {
-	t.Parallel()
-
-	emptyAuthCode := ""
-	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
-
-	_, err := sut.Token(context.Background(), emptyAuthCode)
-
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, errutil.ValidationFailed("auth.emptyAuthCode", "Authorization code cannot be empty."))
-}
-
-func TestAuthService_Token_should_return_err_if_auth_code_not_stored(t *testing.T) {
-	t.Parallel()
-
-	invalidAuthCode := "invalid"
-	authRepo := authmocks.NewRepository(t)
-	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, invalidAuthCode).Return(nil, authcore.ErrSessionNotFound)
-	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
-
-	_, err := sut.Token(context.Background(), invalidAuthCode)
-
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionNotFound", "Session not found."))
-}
-
-func TestAuthService_Token_should_return_err_if_session_already_has_access_token(t *testing.T) {
+func TestAuthService_Token_should_return_a_session_token_when_self_contained(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	authCode := uuid.NewString()
-	session := &authtypes.Session{AccessToken: ptrutil.Ptr("exists")}
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: validOwnerAppID}
 	authRepo := authmocks.NewRepository(t)
 	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
-
-	_, err := sut.Token(context.Background(), authCode)
+	authRepo.EXPECT().UpdateSession(mock.Anything, session).Return(nil)
 
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, errutil.InvalidRequest("auth.tokenAlreadyIssued", "A token has already been issued."))
-}
+	credStore := idpmocks.NewCredentialStore(t)
+	credStore.EXPECT().
+		Get(mock.Anything, session.OwnerAppID).
+		Return(&idpcore.ClientCredentials{ClientID: "ID", Issuer: "Issuer"}, nil)
 
-func TestAuthService_Token_should_return_err_if_client_cred_not_found(t *testing.T) {
-	t.Parallel()
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(mock.Anything).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+		IdpType:  settingstypes.IDP_TYPE_SELF,
+	}, nil)
 
-	authCode := uuid.NewString()
-	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
-	authRepo := authmocks.NewRepository(t)
-	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
+	keyStore := identitymocks.NewKeyStore(t)
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	keyStore.EXPECT().RetrievePrivKey(mock.Anything, mock.Anything).Return(priv, nil)
+	denyList := authmocks.NewDenyList(t)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		nil,
+		nil,
+		denyList,
+		nil,
+		nil,
+	)
 
-	credStore := idpmocks.NewCredentialStore(t)
-	credStore.EXPECT().Get(mock.Anything, session.OwnerAppID).Return(nil, errors.New("not found"))
-	sut := bff.NewAuthService(authRepo, credStore, nil, nil, nil, nil, nil, nil, nil)
+	returnedSess, err := sut.Token(ctx, authCode, nil, nil)
 
-	_, err := sut.Token(context.Background(), authCode)
+	assert.NoError(t, err)
 
-	assert.Error(t, err)
-	assert.ErrorContains(t, err, "failed to get client credentials")
+	claims, err := sessiontoken.Verify(*returnedSess.AccessToken, "issuer", priv.PublicKey())
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, claims.ID)
+	assert.Equal(t, validOwnerAppID, claims.Subject)
+	assert.NotEmpty(t, claims.Proof)
+	assert.Equal(t, &claims.ExpiresAt, returnedSess.ExpiresAt)
 }
 
-func TestAuthService_Token_should_return_err_if_issuer_not_found(t *testing.T) {
+func TestAuthService_Token_should_require_a_dpop_proof_for_bound_sessions(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	key := generateDPoPKey(t)
+	otherKey := generateDPoPKey(t)
 	authCode := uuid.NewString()
-	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
-	authRepo := authmocks.NewRepository(t)
-	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
-
-	credStore := idpmocks.NewCredentialStore(t)
-	credStore.EXPECT().
-		Get(mock.Anything, session.OwnerAppID).
-		Return(&idpcore.ClientCredentials{ClientSecret: "secret"}, nil)
 
-	settingsRepo := settingsmocks.NewRepository(t)
+	testCases := map[string]*struct {
+		proof       *string
+		expectedErr error
+	}{
+		"missing proof": {
+			expectedErr: errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the session."),
+		},
+		"proof signed with another key": {
+			proof:       ptrutil.Ptr(createDPoPProof(t, otherKey, "POST", testAPIURL+"/v1alpha1/auth/token", "")),
+			expectedErr: errutil.Unauthorized("auth.invalidDPoPProof", "The DPoP proof is invalid."),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			session := &authtypes.Session{
+				OwnerAppID:     validOwnerAppID,
+				DPoPThumbprint: ptrutil.Ptr(dpopThumbprint(t, key)),
+			}
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAuthCode(ctx, authCode).Return(session, nil)
+			sut := bff.NewAuthService(
+				authRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				dpop.NewVerifier(testAPIURL, testDPoPNonceSecret, authRepo),
+			)
+
+			_, err := sut.Token(ctx, authCode, tc.proof, nil)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestAuthService_Token_should_return_err_if_auth_code_is_empty(t *testing.T) {
+	t.Parallel()
+
+	emptyAuthCode := ""
+	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Token(context.Background(), emptyAuthCode, nil, nil)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errutil.ValidationFailed("auth.emptyAuthCode", "Authorization code cannot be empty."))
+}
+
+func TestAuthService_Token_should_return_err_if_auth_code_not_stored(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	invalidAuthCode := "invalid"
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, invalidAuthCode).Return(nil, authcore.ErrSessionNotFound)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Token(ctx, invalidAuthCode, nil, nil)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionNotFound", "Session not found."))
+}
+
+func TestAuthService_Token_should_return_err_if_session_already_has_access_token(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	authCode := uuid.NewString()
+	session := &authtypes.Session{OwnerAppID: validOwnerAppID, AccessToken: ptrutil.Ptr("exists")}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Token(ctx, authCode, nil, nil)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errutil.InvalidRequest("auth.tokenAlreadyIssued", "A token has already been issued."))
+}
+
+func TestAuthService_Token_should_return_err_if_client_cred_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	authCode := uuid.NewString()
+	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	credStore.EXPECT().Get(mock.Anything, session.OwnerAppID).Return(nil, errors.New("not found"))
+	sut := bff.NewAuthService(authRepo, credStore, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.Token(ctx, authCode, nil, nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "failed to get client credentials")
+}
+
+func TestAuthService_Token_should_return_err_if_issuer_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	authCode := uuid.NewString()
+	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAuthCode(mock.Anything, authCode).Return(session, nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	credStore.EXPECT().
+		Get(mock.Anything, session.OwnerAppID).
+		Return(&idpcore.ClientCredentials{ClientSecret: "secret"}, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
 	settingsRepo.EXPECT().GetIssuerSettings(mock.Anything).Return(nil, errors.New("not found"))
-	sut := bff.NewAuthService(authRepo, credStore, nil, nil, nil, nil, nil, settingsRepo, nil)
+	sut := bff.NewAuthService(authRepo, credStore, nil, nil, nil, nil, nil, settingsRepo, nil, nil, nil, nil, nil, nil)
 
-	_, err := sut.Token(context.Background(), authCode)
+	_, err := sut.Token(ctx, authCode, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "failed to fetch issuer settings")
@@ -472,6 +884,7 @@ func TestAuthService_Token_should_return_err_if_issuer_not_found(t *testing.T) {
 func TestAuthService_Token_should_return_err_if_access_token_generation_fails(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	testCases := []struct {
 		idpType      settingstypes.IdpType
 		clientSecret string
@@ -524,6 +937,11 @@ func TestAuthService_Token_should_return_err_if_access_token_generation_fails(t
 					nil,
 					settingsRepo,
 					keyStore,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
 				)
 			case settingstypes.IDP_TYPE_UNSPECIFIED:
 				sut = bff.NewAuthService(
@@ -536,6 +954,11 @@ func TestAuthService_Token_should_return_err_if_access_token_generation_fails(t
 					nil,
 					settingsRepo,
 					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
 				)
 			default:
 				authenticator := oidctesting.NewErroneousAuthenticator()
@@ -549,10 +972,15 @@ func TestAuthService_Token_should_return_err_if_access_token_generation_fails(t
 					nil,
 					settingsRepo,
 					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
 				)
 			}
 
-			_, err := sut.Token(context.Background(), authCode)
+			_, err := sut.Token(ctx, authCode, nil, nil)
 
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "failed to issue access token")
@@ -563,6 +991,7 @@ func TestAuthService_Token_should_return_err_if_access_token_generation_fails(t
 func TestAuthService_Token_should_return_err_if_update_fails(t *testing.T) {
 	t.Parallel()
 
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
 	authCode := uuid.NewString()
 	session := &authtypes.Session{OwnerAppID: validOwnerAppID}
 	authRepo := authmocks.NewRepository(t)
@@ -593,9 +1022,14 @@ func TestAuthService_Token_should_return_err_if_update_fails(t *testing.T) {
 		nil,
 		settingsRepo,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	_, err := sut.Token(context.Background(), authCode)
+	_, err := sut.Token(ctx, authCode, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "failed to update the session")
@@ -645,21 +1079,63 @@ func TestAuthService_ExtAuthZ_should_succeed(t *testing.T) {
 				Return(&tc.session, nil)
 			authRepo.EXPECT().UpdateSession(ctx, &tc.session).Return(nil)
 
-			appRepo := appmocks.NewRepository(t)
+			callerApp := &apptypes.App{
+				ID:                 tc.session.OwnerAppID,
+				Name:               ptrutil.Ptr("caller"),
+				Type:               apptypes.APP_TYPE_AGENT_A2A,
+				ResolverMetadataID: uuid.NewString(),
+			}
+			appRepo := newAppRepositoryMock(t)
 			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
 			appRepo.EXPECT().
 				GetApp(ctx, tc.session.OwnerAppID).
-				Return(&apptypes.App{ID: tc.session.OwnerAppID}, nil)
+				Return(callerApp, nil)
 
+			rule := &policytypes.Rule{ID: uuid.NewString(), NeedsApproval: false}
 			policyEva := policymocks.NewEvaluator(t)
 			policyEva.EXPECT().
 				Evaluate(ctx, calledApp, tc.session.OwnerAppID, ptrutil.DerefStr(tc.session.ToolName)).
-				Return(&policytypes.Rule{NeedsApproval: false}, nil)
-			sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEva, nil, nil, nil, nil)
+				Return(rule, nil)
+
+			badge := &badgetypes.Badge{
+				VerifiableCredential: badgetypes.VerifiableCredential{ID: uuid.NewString()},
+			}
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().
+				GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+				Return(badge, nil)
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
 
-			err := sut.ExtAuthZ(ctx, accessToken, ptrutil.DerefStr(tc.inputToolName))
+			identity, err := sut.ExtAuthZ(ctx, accessToken, ptrutil.DerefStr(tc.inputToolName))
 
 			assert.NoError(t, err)
+			assert.Equal(t, &authtypes.CallerIdentity{
+				AppID:              callerApp.ID,
+				AppName:            callerApp.Name,
+				AppType:            callerApp.Type,
+				ResolverMetadataID: callerApp.ResolverMetadataID,
+				BadgeID:            &badge.ID,
+				SessionID:          tc.session.ID,
+				RuleID:             &rule.ID,
+			}, identity)
 		})
 	}
 }
@@ -668,9 +1144,9 @@ func TestAuthService_ExtAuthZ_should_return_err_for_empty_access_token(t *testin
 	t.Parallel()
 
 	emptyAccessToken := ""
-	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(context.Background(), emptyAccessToken, "")
+	_, err := sut.ExtAuthZ(context.Background(), emptyAccessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.ValidationFailed("auth.emptyAccessToken", "Access token cannot be empty."))
@@ -684,14 +1160,38 @@ func TestAuthService_ExtAuthZ_should_return_err_when_session_not_found(t *testin
 	authRepo.EXPECT().
 		GetSessionByAccessToken(mock.Anything, invalidAccessToken).
 		Return(nil, authcore.ErrSessionNotFound)
-	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(context.Background(), invalidAccessToken, "")
+	_, err := sut.ExtAuthZ(context.Background(), invalidAccessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionNotFound", "Session not found."))
 }
 
+func TestAuthService_ResolveCaller_should_return_the_owner_of_the_session(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	callerAppID := uuid.NewString()
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(mock.Anything, accessToken).
+		Return(&authtypes.Session{OwnerAppID: callerAppID}, nil).
+		Once()
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	ctx, ret, err := sut.ResolveCaller(context.Background(), accessToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callerAppID, ret)
+
+	// The session kept in the context is reused without looking it up again
+	_, ret, err = sut.ResolveCaller(ctx, accessToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, callerAppID, ret)
+}
+
 func TestAuthService_ExtAuthZ_should_return_err_when_session_is_expired(t *testing.T) {
 	t.Parallel()
 
@@ -702,9 +1202,9 @@ func TestAuthService_ExtAuthZ_should_return_err_when_session_is_expired(t *testi
 		Return(&authtypes.Session{
 			ExpiresAt: ptrutil.Ptr(time.Now().Add(-1 * time.Second).Unix()),
 		}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(context.Background(), accessToken, "")
+	_, err := sut.ExtAuthZ(context.Background(), accessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionExpired", "The session has expired."))
@@ -721,11 +1221,11 @@ func TestAuthService_ExtAuthZ_should_return_err_when_called_app_not_found(t *tes
 		GetSessionByAccessToken(ctx, accessToken).
 		Return(&authtypes.Session{}, nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, invalidCalledApp.ID).Return(nil, appcore.ErrAppNotFound)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized("auth.calleeAppNotFound", "Callee application not found."))
@@ -744,11 +1244,11 @@ func TestAuthService_ExtAuthZ_should_return_err_when_called_app_is_not_same_as_i
 		GetSessionByAccessToken(ctx, accessToken).
 		Return(&authtypes.Session{AppID: ptrutil.Ptr("VALID_APP")}, nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, invalidCalledApp.ID).Return(invalidCalledApp, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized(
@@ -771,11 +1271,11 @@ func TestAuthService_ExtAuthZ_should_return_err_when_tool_name_is_not_same_as_in
 		GetSessionByAccessToken(ctx, accessToken).
 		Return(&authtypes.Session{ToolName: ptrutil.Ptr("VALID_TOOL")}, nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(ctx, accessToken, invalidToolName)
+	_, err := sut.ExtAuthZ(ctx, accessToken, invalidToolName)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized(
@@ -796,17 +1296,46 @@ func TestAuthService_ExtAuthZ_should_return_err_when_caller_app_not_found(t *tes
 		GetSessionByAccessToken(ctx, accessToken).
 		Return(session, nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
 	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(nil, appcore.ErrAppNotFound)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized("auth.callerAppNotFound", "Caller application not found."))
 }
 
+func TestAuthService_ExtAuthZ_should_return_err_when_caller_app_is_suspended(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	callerApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(ctx, accessToken).
+		Return(&authtypes.Session{OwnerAppID: callerApp.ID}, nil)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
+	appRepo.EXPECT().
+		GetAppStatuses(ctx, callerApp.ID).
+		Return(map[string]apptypes.AppStatus{callerApp.ID: apptypes.APP_STATUS_SUSPENDED}, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.ErrorIs(t, err, errutil.Unauthorized(
+		"auth.appSuspended",
+		"The application %s is suspended.",
+		callerApp.ID,
+	))
+}
+
 func TestAuthService_ExtAuthZ_should_return_err_when_access_token_invalid(t *testing.T) {
 	t.Parallel()
 
@@ -819,19 +1348,151 @@ func TestAuthService_ExtAuthZ_should_return_err_when_access_token_invalid(t *tes
 		GetSessionByAccessToken(ctx, accessToken).
 		Return(session, nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
 	appRepo.EXPECT().
 		GetApp(ctx, session.OwnerAppID).
 		Return(&apptypes.App{ID: session.OwnerAppID}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, errutil.Unauthorized("auth.invalidAccessToken", "The access token is invalid."))
 }
 
+func TestAuthService_ExtAuthZ_should_verify_the_access_token_signature(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	storedKey, _ := joseutil.GenerateJWK("RS256", "sig", "storedKeyId")
+
+	testCases := map[string]*struct {
+		signingKey *jwk.Jwk
+		clientID   string
+		succeeds   bool
+	}{
+		"signed by the issuer": {
+			signingKey: issuerKey,
+			clientID:   "client_id",
+			succeeds:   true,
+		},
+		"signed by another key": {
+			signingKey: otherKey,
+			clientID:   "client_id",
+		},
+		"signed by another key of the key store": {
+			signingKey: storedKey,
+			clientID:   "client_id",
+		},
+		"issued to another client": {
+			signingKey: issuerKey,
+			clientID:   "other_client_id",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			accessToken, _ := oidc.SelfIssueJWT("issuer", tc.clientID, tc.signingKey)
+			callerApp := &apptypes.App{ID: uuid.NewString()}
+			calledApp := &apptypes.App{ID: uuid.NewString()}
+			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+			session := &authtypes.Session{
+				OwnerAppID: callerApp.ID,
+				ExpiresAt:  ptrutil.Ptr(time.Now().Add(time.Minute).Unix()),
+			}
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
+
+			credStore := idpmocks.NewCredentialStore(t)
+			credStore.EXPECT().
+				Get(ctx, callerApp.ID).
+				Return(&idpcore.ClientCredentials{ClientID: "client_id", Issuer: "issuer"}, nil)
+
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+				IdpType: settingstypes.IDP_TYPE_SELF,
+				KeyID:   "keyId",
+			}, nil)
+
+			keyStore := identitymocks.NewKeyStore(t)
+			keyStore.EXPECT().RetrievePubKey(ctx, "keyId").Return(issuerKey.PublicKey(), nil).Maybe()
+			keyStore.EXPECT().RetrievePubKey(ctx, "storedKeyId").Return(storedKey.PublicKey(), nil).Maybe()
+
+			policyEva := policymocks.NewEvaluator(t)
+			badgeRepo := badgemocks.NewRepository(t)
+
+			if tc.succeeds {
+				policyEva.EXPECT().
+					Evaluate(ctx, calledApp, callerApp.ID, "").
+					Return(&policytypes.Rule{ID: uuid.NewString()}, nil)
+				badgeRepo.EXPECT().
+					GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+					Return(nil, badgecore.ErrBadgeNotFound)
+			}
+
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				nil,
+				nil,
+				settingsRepo,
+				keyStore,
+				badgeRepo,
+				nil,
+				nil,
+				jwtutil.NewVerifier(nil, nil, time.Hour, time.Hour),
+				nil,
+			)
+
+			_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+			if tc.succeeds {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(
+					t,
+					err,
+					errutil.Unauthorized("auth.invalidAccessToken", "The access token is invalid."),
+				)
+			}
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZ_should_reject_the_access_tokens_without_a_verifier(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.ErrorIs(t, err, errutil.Unauthorized("auth.invalidAccessToken", "The access token is invalid."))
+}
+
 func TestAuthService_ExtAuthZ_should_return_err_if_update_fails(t *testing.T) {
 	t.Parallel()
 
@@ -845,7 +1506,7 @@ func TestAuthService_ExtAuthZ_should_return_err_if_update_fails(t *testing.T) {
 		Return(session, nil)
 	authRepo.EXPECT().UpdateSession(ctx, session).Return(errors.New("failed update"))
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
 	appRepo.EXPECT().
 		GetApp(ctx, session.OwnerAppID).
@@ -855,14 +1516,32 @@ func TestAuthService_ExtAuthZ_should_return_err_if_update_fails(t *testing.T) {
 	policyEva.EXPECT().
 		Evaluate(ctx, calledApp, session.OwnerAppID, "").
 		Return(&policytypes.Rule{NeedsApproval: false}, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEva, nil, nil, nil, nil)
-
-	err := sut.ExtAuthZ(ctx, accessToken, "")
-
-	assert.Error(t, err)
-	assert.ErrorContains(t, err, "failed update")
-}
-
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "failed update")
+}
+
 func TestAuthService_ExtAuthZ_should_send_device_otp_and_continue_after_approving(t *testing.T) {
 	t.Parallel()
 
@@ -891,7 +1570,7 @@ func TestAuthService_ExtAuthZ_should_send_device_otp_and_continue_after_approvin
 	authRepo.EXPECT().GetDeviceOTP(ctx, mock.Anything).Return(deviceOTP, nil)
 	authRepo.EXPECT().UpdateDeviceOTP(ctx, deviceOTP).Return(nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
 	appRepo.EXPECT().
 		GetApp(ctx, session.OwnerAppID).
@@ -913,267 +1592,2150 @@ func TestAuthService_ExtAuthZ_should_send_device_otp_and_continue_after_approvin
 	notifServ.EXPECT().
 		SendOTPNotification(device2, session, mock.Anything, callerApp, calledApp, mock.Anything).
 		Return(nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+		Return(nil, badgecore.ErrBadgeNotFound)
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
 	sut := bff.NewAuthService(
 		authRepo,
-		nil,
+		credStore,
 		nil,
 		appRepo,
 		policyEva,
 		deviceRepo,
 		notifServ,
+		settingsRepo,
+		nil,
+		badgeRepo,
+		nil,
 		nil,
+		tokenVerifier,
 		nil,
 	)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	identity, err := sut.ExtAuthZ(ctx, accessToken, "")
 
 	assert.NoError(t, err)
 	assert.True(t, deviceOTP.Used)
+	assert.Equal(t, session.UserID, identity.UserID)
+	assert.Nil(t, identity.BadgeID)
 }
 
-func TestAuthService_ExtAuthZ_should_return_err_when_no_device_registered_during_human_approval(
-	t *testing.T,
-) {
+func TestAuthService_ExtAuthZ_should_issue_receipt(t *testing.T) {
 	t.Parallel()
 
 	accessToken := generateValidJWT(t)
-	callerApp := &apptypes.App{ID: uuid.NewString()}
-	calledApp := &apptypes.App{ID: uuid.NewString()}
+	callerApp := &apptypes.App{ID: uuid.NewString(), ResolverMetadataID: "did:caller"}
+	calledApp := &apptypes.App{ID: uuid.NewString(), ResolverMetadataID: "did:callee"}
 	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
-	session := &authtypes.Session{
-		OwnerAppID: callerApp.ID,
-		UserID:     ptrutil.Ptr(uuid.NewString()),
-	}
-	authRepo := authmocks.NewRepository(t)
-	authRepo.EXPECT().
-		GetSessionByAccessToken(ctx, accessToken).
-		Return(session, nil)
-
-	appRepo := appmocks.NewRepository(t)
-	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
-	appRepo.EXPECT().
-		GetApp(ctx, session.OwnerAppID).
-		Return(callerApp, nil)
-
-	policyEva := policymocks.NewEvaluator(t)
-	policyEva.EXPECT().
-		Evaluate(ctx, calledApp, session.OwnerAppID, "").
-		Return(&policytypes.Rule{NeedsApproval: true}, nil)
-
-	deviceRepo := devicemocks.NewRepository(t)
-	deviceRepo.EXPECT().GetDevices(ctx, session.UserID).Return(nil, nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, policyEva, deviceRepo, nil, nil, nil)
-
-	err := sut.ExtAuthZ(ctx, accessToken, "")
-
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, errutil.InvalidRequest(
-		"auth.noDevicesRegistered",
-		"No user devices registered. Unable to send a notification for user approval.",
-	))
-}
+	session := &authtypes.Session{OwnerAppID: callerApp.ID}
+	body := []byte(`{"tool":"cool_tool"}`)
 
-func TestAuthService_ExtAuthZ_should_return_err_when_send_notification_fails(t *testing.T) {
-	t.Parallel()
+	var stored *authtypes.Receipt
 
-	accessToken := generateValidJWT(t)
-	callerApp := &apptypes.App{ID: uuid.NewString()}
-	calledApp := &apptypes.App{ID: uuid.NewString()}
-	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
-	session := &authtypes.Session{
-		OwnerAppID: callerApp.ID,
-		UserID:     ptrutil.Ptr(uuid.NewString()),
-	}
 	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
 	authRepo.EXPECT().
-		GetSessionByAccessToken(ctx, accessToken).
-		Return(session, nil)
-	authRepo.EXPECT().CreateDeviceOTP(ctx, mock.Anything).Return(nil)
+		CreateReceipt(ctx, mock.Anything).
+		Run(func(_ context.Context, rcpt *authtypes.Receipt) {
+			stored = rcpt
+		}).
+		Return(nil)
 
-	appRepo := appmocks.NewRepository(t)
+	appRepo := newAppRepositoryMock(t)
 	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
-	appRepo.EXPECT().
-		GetApp(ctx, session.OwnerAppID).
-		Return(callerApp, nil)
+	appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
 
+	rule := &policytypes.Rule{ID: uuid.NewString()}
 	policyEva := policymocks.NewEvaluator(t)
-	policyEva.EXPECT().
-		Evaluate(ctx, calledApp, session.OwnerAppID, "").
-		Return(&policytypes.Rule{NeedsApproval: true}, nil)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, callerApp.ID, "cool_tool").Return(rule, nil)
 
-	deviceRepo := devicemocks.NewRepository(t)
-	deviceRepo.EXPECT().
-		GetDevices(ctx, session.UserID).
-		Return([]*devicetypes.Device{{}}, nil)
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+		Return(nil, badgecore.ErrBadgeNotFound)
 
-	notifServ := bffmocks.NewNotificationService(t)
-	notifServ.EXPECT().
-		SendOTPNotification(mock.Anything, session, mock.Anything, callerApp, calledApp, mock.Anything).
-		Return(errors.New("failed"))
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	keyStore.EXPECT().RetrievePrivKey(ctx, "keyId").Return(priv, nil)
+	credStore := idpmocks.NewCredentialStore(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
 	sut := bff.NewAuthService(
 		authRepo,
-		nil,
+		credStore,
 		nil,
 		appRepo,
 		policyEva,
-		deviceRepo,
-		notifServ,
 		nil,
 		nil,
+		settingsRepo,
+		keyStore,
+		badgeRepo,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
 	)
 
-	err := sut.ExtAuthZ(ctx, accessToken, "")
+	identity, err := sut.ExtAuthZ(ctx, accessToken, "cool_tool", bff.WithReceipt(body))
 
-	assert.Error(t, err)
-	assert.ErrorContains(t, err, "unable to send notification")
+	assert.NoError(t, err)
+	assert.NotNil(t, identity.Receipt)
+	assert.Equal(t, stored, identity.Receipt)
+
+	claims, err := receipt.Verify(identity.Receipt.Token, priv.PublicKey())
+	assert.NoError(t, err)
+	assert.Equal(t, "issuer", claims.Issuer)
+	assert.Equal(t, "did:caller", claims.Subject)
+	assert.Equal(t, "did:callee", claims.Audience)
+	assert.Equal(t, "cool_tool", claims.ToolName)
+	assert.Equal(t, rule.ID, claims.RuleID)
+	assert.Nil(t, claims.ApprovalID)
+	assert.True(t, claims.MatchesBody(body))
 }
 
-func TestAuthService_ExtAuthZ_should_return_err_when_device_otp_is_invalid(t *testing.T) {
+func TestAuthService_ExtAuthZ_should_start_and_propagate_transactions(t *testing.T) {
 	t.Parallel()
 
-	testCases := map[string]*authtypes.SessionDeviceOTP{
-		"Device OTP is already used": {
-			Used: true,
-		},
-		"Device OTP is already expired": {
-			ExpiresAt: time.Now().Add(-time.Second).Unix(),
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	upstreamToken, upstream, _ := txntoken.Issue("issuer", "initiating_app", "did:app", nil, "purpose", priv)
+
+	testCases := map[string]*struct {
+		option      bff.ExtAuthZOption
+		expectedTxn func(claims *txntoken.Claims) bool
+	}{
+		"start transaction": {
+			option: bff.WithTransaction("", "book a trip"),
+			expectedTxn: func(claims *txntoken.Claims) bool {
+				return claims.Purpose == "book a trip" && claims.TransactionID != upstream.TransactionID
+			},
 		},
-		"Device OTP is not approved": {
-			Approved:  ptrutil.Ptr(false),
-			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		"propagate transaction": {
+			option: bff.WithTransaction(upstreamToken, ""),
+			expectedTxn: func(claims *txntoken.Claims) bool {
+				return claims.TransactionID == upstream.TransactionID
+			},
 		},
 	}
 
-	for tn, otp := range testCases {
+	for tn, tc := range testCases {
 		t.Run(tn, func(t *testing.T) {
 			t.Parallel()
 
 			accessToken := generateValidJWT(t)
-			callerApp := &apptypes.App{ID: uuid.NewString()}
+			callerApp := &apptypes.App{ID: uuid.NewString(), ResolverMetadataID: "did:caller"}
 			calledApp := &apptypes.App{ID: uuid.NewString()}
 			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
-			session := &authtypes.Session{
-				OwnerAppID: callerApp.ID,
-				UserID:     ptrutil.Ptr(uuid.NewString()),
-			}
+			session := &authtypes.Session{OwnerAppID: callerApp.ID, UserID: ptrutil.Ptr("user")}
+
 			authRepo := authmocks.NewRepository(t)
-			authRepo.EXPECT().
-				GetSessionByAccessToken(ctx, accessToken).
-				Return(session, nil)
-			authRepo.EXPECT().CreateDeviceOTP(ctx, mock.Anything).Return(nil)
-			authRepo.EXPECT().
-				GetDeviceOTP(ctx, mock.Anything).
-				Return(otp, nil)
-			authRepo.EXPECT().GetDeviceOTP(ctx, mock.Anything).Return(otp, nil)
-			authRepo.EXPECT().UpdateDeviceOTP(ctx, otp).Return(nil)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+			authRepo.EXPECT().UpdateSession(mock.Anything, session).Return(nil)
 
-			appRepo := appmocks.NewRepository(t)
+			appRepo := newAppRepositoryMock(t)
 			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
-			appRepo.EXPECT().
-				GetApp(ctx, session.OwnerAppID).
-				Return(callerApp, nil)
+			appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
 
 			policyEva := policymocks.NewEvaluator(t)
 			policyEva.EXPECT().
-				Evaluate(ctx, calledApp, session.OwnerAppID, "").
-				Return(&policytypes.Rule{NeedsApproval: true}, nil)
+				Evaluate(mock.Anything, calledApp, callerApp.ID, "").
+				Return(&policytypes.Rule{ID: uuid.NewString()}, nil)
 
-			deviceRepo := devicemocks.NewRepository(t)
-			deviceRepo.EXPECT().
-				GetDevices(ctx, session.UserID).
-				Return([]*devicetypes.Device{{}}, nil)
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().
+				GetLatestByAppIdOrResolverMetadataID(mock.Anything, callerApp.ID).
+				Return(nil, badgecore.ErrBadgeNotFound)
 
-			notifServ := bffmocks.NewNotificationService(t)
-			notifServ.EXPECT().
-				SendOTPNotification(mock.Anything, session, mock.Anything, callerApp, calledApp, mock.Anything).
-				Return(nil)
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+				IssuerID: "issuer",
+				KeyID:    "keyId",
+			}, nil)
+
+			keyStore := identitymocks.NewKeyStore(t)
+			keyStore.EXPECT().RetrievePrivKey(ctx, "keyId").Return(priv, nil).Maybe()
+			keyStore.EXPECT().RetrievePubKey(ctx, "keyId").Return(priv.PublicKey(), nil).Maybe()
+			credStore := idpmocks.NewCredentialStore(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
 			sut := bff.NewAuthService(
 				authRepo,
-				nil,
+				credStore,
 				nil,
 				appRepo,
 				policyEva,
-				deviceRepo,
-				notifServ,
 				nil,
 				nil,
+				settingsRepo,
+				keyStore,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
 			)
 
-			err := sut.ExtAuthZ(ctx, accessToken, "")
+			identity, err := sut.ExtAuthZ(ctx, accessToken, "", tc.option)
 
-			assert.Error(t, err)
-			assert.ErrorIs(
-				t,
-				err,
-				errutil.Unauthorized("auth.invocationNotApproved", "The user did not approve the invocation."),
-			)
+			assert.NoError(t, err)
+
+			claims, err := txntoken.Verify(*identity.TransactionToken, "issuer", priv.PublicKey())
+			assert.NoError(t, err)
+			assert.True(t, tc.expectedTxn(claims))
+			assert.Equal(t, claims.TransactionID, *identity.TransactionID)
+			assert.Equal(t, claims.TransactionID, *session.TransactionID)
 		})
 	}
 }
 
-func generateValidJWT(t *testing.T) string {
-	t.Helper()
+func TestAuthService_ExtAuthZMcp_should_start_one_transaction_per_batch(t *testing.T) {
+	t.Parallel()
 
 	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
-	accessToken, _ := oidc.SelfIssueJWT(uuid.NewString(), uuid.NewString(), priv)
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool_a"}},
+		{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"tool_b"}}
+	]`
 
-	return accessToken
-}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	// The transaction and the expiration are saved at once, by the first tool call
+	authRepo.EXPECT().UpdateSession(mock.Anything, session).Return(nil).Once()
 
-// ApproveToken
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
 
-func TestAuthService_ApproveToken_should_succeed(t *testing.T) {
-	t.Parallel()
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().
+		Evaluate(mock.Anything, calledApp, session.OwnerAppID, mock.Anything).
+		Return(&policytypes.Rule{ID: uuid.NewString()}, nil).
+		Twice()
 
-	ctx := context.Background()
-	otp := &authtypes.SessionDeviceOTP{
-		DeviceID:  uuid.NewString(),
-		SessionID: uuid.NewString(),
-		Value:     uuid.NewString(),
-		ExpiresAt: time.Now().Add(time.Minute).Unix(),
-		Used:      false,
-	}
-	authRepo := authmocks.NewRepository(t)
-	authRepo.EXPECT().
-		GetDeviceOTPByValue(ctx, otp.DeviceID, otp.SessionID, otp.Value).
-		Return(otp, nil)
-	authRepo.EXPECT().UpdateDeviceOTP(ctx, otp).Return(nil)
-	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(mock.Anything, session.OwnerAppID).
+		Return(nil, badgecore.ErrBadgeNotFound)
 
-	err := sut.ApproveToken(ctx, otp.DeviceID, otp.SessionID, otp.Value, true)
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePrivKey(ctx, "keyId").Return(priv, nil).Once()
+	credStore := idpmocks.NewCredentialStore(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		badgeRepo,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	identity, err := sut.ExtAuthZMcp(
+		ctx,
+		accessToken,
+		[]byte(body),
+		"application/json",
+		bff.WithTransaction("", "book a trip"),
+	)
 
 	assert.NoError(t, err)
+	assert.NotNil(t, identity.TransactionID)
+	assert.Equal(t, identity.TransactionID, session.TransactionID)
+	assert.NotNil(t, session.ExpiresAt)
 }
 
-func TestAuthService_ApproveToken_should_fail(t *testing.T) {
+func TestAuthService_ExtAuthZMcp_should_return_the_receipt_of_the_tool_calls(t *testing.T) {
 	t.Parallel()
 
-	testCases := map[string]*struct {
-		otp *authtypes.SessionDeviceOTP
-		err error
-	}{
-		"OTP already expired": {
-			otp: &authtypes.SessionDeviceOTP{
-				DeviceID:  uuid.NewString(),
-				SessionID: uuid.NewString(),
-				Value:     uuid.NewString(),
-				ExpiresAt: time.Now().Add(-time.Minute).Unix(),
-			},
-			err: errutil.InvalidRequest("auth.otpExpired", "The device OTP is expired."),
-		},
-		"OTP already used": {
-			otp: &authtypes.SessionDeviceOTP{
-				DeviceID:  uuid.NewString(),
-				SessionID: uuid.NewString(),
-				Value:     uuid.NewString(),
-				ExpiresAt: time.Now().Add(time.Minute).Unix(),
-				Used:      true,
-			},
-			err: errutil.InvalidRequest("auth.otpAlreadyUsed", "The device OTP is already used."),
-		},
-		"OTP already approved": {
-			otp: &authtypes.SessionDeviceOTP{
-				DeviceID:  uuid.NewString(),
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool_a"}},
+		{"jsonrpc":"2.0","id":2,"method":"resources/list"}
+	]`
+
+	var stored *authtypes.Receipt
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(mock.Anything, session).Return(nil)
+	authRepo.EXPECT().
+		CreateReceipt(ctx, mock.Anything).
+		Run(func(_ context.Context, rcpt *authtypes.Receipt) {
+			stored = rcpt
+		}).
+		Return(nil).
+		Once()
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().
+		Evaluate(mock.Anything, calledApp, session.OwnerAppID, "tool_a").
+		Return(&policytypes.Rule{ID: uuid.NewString()}, nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(mock.Anything, session.OwnerAppID).
+		Return(nil, badgecore.ErrBadgeNotFound)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePrivKey(ctx, "keyId").Return(priv, nil)
+	credStore := idpmocks.NewCredentialStore(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		badgeRepo,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	identity, err := sut.ExtAuthZMcp(
+		ctx,
+		accessToken,
+		[]byte(body),
+		"application/json",
+		bff.WithReceipt([]byte(body)),
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, identity.Receipt)
+	assert.Equal(t, stored, identity.Receipt)
+	assert.Equal(t, "tool_a", identity.Receipt.ToolName)
+}
+
+func TestAuthService_ExtAuthZ_should_return_err_when_transaction_token_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	callerApp := &apptypes.App{ID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	session := &authtypes.Session{OwnerAppID: callerApp.ID}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(ctx, "keyId").Return(priv.PublicKey(), nil)
+	credStore := idpmocks.NewCredentialStore(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "", bff.WithTransaction("invalid", ""))
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.Unauthorized("auth.invalidTransactionToken", "The transaction token is invalid."),
+	)
+}
+
+func TestAuthService_ExtAuthZ_should_verify_self_contained_session_tokens(t *testing.T) {
+	t.Parallel()
+
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	callerApp := &apptypes.App{ID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: callerApp.ID, AppID: &calledApp.ID}
+	accessToken, _, _ := sessiontoken.Issue("issuer", session, generateValidJWT(t), priv)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(ctx, "keyId").Return(priv.PublicKey(), nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().IsDenied(ctx, session.ID).Return(false, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
+
+	rule := &policytypes.Rule{ID: uuid.NewString()}
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, callerApp.ID, "").Return(rule, nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+		Return(nil, badgecore.ErrBadgeNotFound)
+
+	// The auth repository is not expected to be called
+	authRepo := authmocks.NewRepository(t)
+	credStore := idpmocks.NewCredentialStore(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		badgeRepo,
+		nil,
+		denyList,
+		tokenVerifier,
+		nil,
+	)
+
+	identity, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, identity.SessionID)
+	assert.Equal(t, callerApp.ID, identity.AppID)
+	assert.Equal(t, &rule.ID, identity.RuleID)
+}
+
+func TestAuthService_ExtAuthZ_should_return_err_when_session_is_revoked(t *testing.T) {
+	t.Parallel()
+
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+	accessToken, _, _ := sessiontoken.Issue("issuer", session, generateValidJWT(t), priv)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(mock.Anything).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(mock.Anything, "keyId").Return(priv.PublicKey(), nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().IsDenied(mock.Anything, session.ID).Return(true, nil)
+	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, settingsRepo, keyStore, nil, nil, denyList, nil, nil)
+
+	_, err := sut.ExtAuthZ(context.Background(), accessToken, "")
+
+	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionRevoked", "The session has been revoked."))
+}
+
+func TestAuthService_ExtAuthZ_should_not_trust_session_tokens_signed_with_other_keys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		kid    string
+		issuer string
+	}{
+		"key ID other than the issuer key": {kid: "otherKeyId", issuer: "issuer"},
+		"issuer other than the tenant":     {kid: "keyId", issuer: "otherIssuer"},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			priv, _ := joseutil.GenerateJWK("RS256", "sig", tc.kid)
+			session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+			accessToken, _, _ := sessiontoken.Issue(tc.issuer, session, generateValidJWT(t), priv)
+
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetIssuerSettings(mock.Anything).Return(&settingstypes.IssuerSettings{
+				IssuerID: "issuer",
+				KeyID:    "keyId",
+			}, nil)
+
+			keyStore := identitymocks.NewKeyStore(t)
+			keyStore.EXPECT().RetrievePubKey(mock.Anything, "keyId").Return(priv.PublicKey(), nil).Maybe()
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().
+				GetSessionByAccessToken(mock.Anything, accessToken).
+				Return(nil, authcore.ErrSessionNotFound)
+			sut := bff.NewAuthService(
+				authRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				settingsRepo,
+				keyStore,
+				nil,
+				nil,
+				authmocks.NewDenyList(t),
+				nil,
+				nil,
+			)
+
+			_, err := sut.ExtAuthZ(context.Background(), accessToken, "")
+
+			assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionNotFound", "Session not found."))
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZ_should_fall_back_to_stored_sessions_when_self_contained(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(mock.Anything, accessToken).
+		Return(nil, authcore.ErrSessionNotFound)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(mock.Anything).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil).Maybe()
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(mock.Anything, "keyId").Return(nil, errors.New("not found")).Maybe()
+	sut := bff.NewAuthService(
+		authRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		keyStore,
+		nil,
+		nil,
+		authmocks.NewDenyList(t),
+		nil,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZ(context.Background(), accessToken, "")
+
+	assert.ErrorIs(t, err, errutil.Unauthorized("auth.sessionNotFound", "Session not found."))
+}
+
+func TestAuthService_ExtAuthZ_should_verify_the_dpop_proof_of_bound_sessions(t *testing.T) {
+	t.Parallel()
+
+	key := generateDPoPKey(t)
+	otherKey := generateDPoPKey(t)
+	accessToken := generateValidJWT(t)
+	requestURL := "https://mcp.example.com/mcp"
+
+	testCases := map[string]*struct {
+		opts        []bff.ExtAuthZOption
+		expectedErr error
+	}{
+		"valid proof": {
+			opts: []bff.ExtAuthZOption{
+				bff.WithDPoP(createDPoPProof(t, key, "POST", requestURL, accessToken), "POST", requestURL),
+			},
+		},
+		"missing proof": {
+			expectedErr: errutil.Unauthorized("auth.dpopRequired", "A DPoP proof is required for the session."),
+		},
+		"proof signed with another key": {
+			opts: []bff.ExtAuthZOption{
+				bff.WithDPoP(createDPoPProof(t, otherKey, "POST", requestURL, accessToken), "POST", requestURL),
+			},
+			expectedErr: errutil.Unauthorized("auth.invalidDPoPProof", "The DPoP proof is invalid."),
+		},
+		"proof created for another request": {
+			opts: []bff.ExtAuthZOption{
+				bff.WithDPoP(createDPoPProof(t, key, "GET", requestURL, accessToken), "POST", requestURL),
+			},
+			expectedErr: errutil.Unauthorized("auth.invalidDPoPProof", "The DPoP proof is invalid."),
+		},
+		"proof without nonce": {
+			opts: []bff.ExtAuthZOption{
+				bff.WithDPoP(
+					createDPoPProofWithNonce(t, key, "POST", requestURL, accessToken, ""),
+					"POST",
+					requestURL,
+				),
+			},
+			expectedErr: errutil.Unauthorized(
+				"auth.useDPoPNonce",
+				"The DPoP proof must carry the nonce issued by the server.",
+			),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			session := &authtypes.Session{
+				OwnerAppID:     uuid.NewString(),
+				DPoPThumbprint: ptrutil.Ptr(dpopThumbprint(t, key)),
+			}
+			callerApp := &apptypes.App{ID: session.OwnerAppID}
+			calledApp := &apptypes.App{}
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().GetApp(ctx, callerApp.ID).Return(callerApp, nil)
+
+			policyEva := policymocks.NewEvaluator(t)
+			badgeRepo := badgemocks.NewRepository(t)
+
+			if tc.expectedErr == nil {
+				authRepo.EXPECT().StoreDPoPProof(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+				policyEva.EXPECT().
+					Evaluate(ctx, calledApp, callerApp.ID, "").
+					Return(&policytypes.Rule{ID: uuid.NewString()}, nil)
+				badgeRepo.EXPECT().
+					GetLatestByAppIdOrResolverMetadataID(ctx, callerApp.ID).
+					Return(nil, badgecore.ErrBadgeNotFound)
+			}
+
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				dpop.NewVerifier(testAPIURL, testDPoPNonceSecret, authRepo),
+			)
+
+			_, err := sut.ExtAuthZ(ctx, accessToken, "", tc.opts...)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZ_should_return_err_when_no_device_registered_during_human_approval(
+	t *testing.T,
+) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	callerApp := &apptypes.App{ID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	session := &authtypes.Session{
+		OwnerAppID: callerApp.ID,
+		UserID:     ptrutil.Ptr(uuid.NewString()),
+	}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(ctx, accessToken).
+		Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().
+		GetApp(ctx, session.OwnerAppID).
+		Return(callerApp, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().
+		Evaluate(ctx, calledApp, session.OwnerAppID, "").
+		Return(&policytypes.Rule{NeedsApproval: true}, nil)
+
+	deviceRepo := devicemocks.NewRepository(t)
+	deviceRepo.EXPECT().GetDevices(ctx, session.UserID).Return(nil, nil)
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		deviceRepo,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, errutil.InvalidRequest(
+		"auth.noDevicesRegistered",
+		"No user devices registered. Unable to send a notification for user approval.",
+	))
+}
+
+func TestAuthService_ExtAuthZ_should_return_err_when_send_notification_fails(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	callerApp := &apptypes.App{ID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	session := &authtypes.Session{
+		OwnerAppID: callerApp.ID,
+		UserID:     ptrutil.Ptr(uuid.NewString()),
+	}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetSessionByAccessToken(ctx, accessToken).
+		Return(session, nil)
+	authRepo.EXPECT().CreateDeviceOTP(ctx, mock.Anything).Return(nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().
+		GetApp(ctx, session.OwnerAppID).
+		Return(callerApp, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().
+		Evaluate(ctx, calledApp, session.OwnerAppID, "").
+		Return(&policytypes.Rule{NeedsApproval: true}, nil)
+
+	deviceRepo := devicemocks.NewRepository(t)
+	deviceRepo.EXPECT().
+		GetDevices(ctx, session.UserID).
+		Return([]*devicetypes.Device{{}}, nil)
+
+	notifServ := bffmocks.NewNotificationService(t)
+	notifServ.EXPECT().
+		SendOTPNotification(mock.Anything, session, mock.Anything, callerApp, calledApp, mock.Anything).
+		Return(errors.New("failed"))
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		deviceRepo,
+		notifServ,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "unable to send notification")
+}
+
+func TestAuthService_ExtAuthZ_should_return_err_when_device_otp_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*authtypes.SessionDeviceOTP{
+		"Device OTP is already used": {
+			Used: true,
+		},
+		"Device OTP is already expired": {
+			ExpiresAt: time.Now().Add(-time.Second).Unix(),
+		},
+		"Device OTP is not approved": {
+			Approved:  ptrutil.Ptr(false),
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		},
+	}
+
+	for tn, otp := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			accessToken := generateValidJWT(t)
+			callerApp := &apptypes.App{ID: uuid.NewString()}
+			calledApp := &apptypes.App{ID: uuid.NewString()}
+			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+			session := &authtypes.Session{
+				OwnerAppID: callerApp.ID,
+				UserID:     ptrutil.Ptr(uuid.NewString()),
+			}
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().
+				GetSessionByAccessToken(ctx, accessToken).
+				Return(session, nil)
+			authRepo.EXPECT().CreateDeviceOTP(ctx, mock.Anything).Return(nil)
+			authRepo.EXPECT().
+				GetDeviceOTP(ctx, mock.Anything).
+				Return(otp, nil)
+			authRepo.EXPECT().GetDeviceOTP(ctx, mock.Anything).Return(otp, nil)
+			authRepo.EXPECT().UpdateDeviceOTP(ctx, otp).Return(nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().
+				GetApp(ctx, session.OwnerAppID).
+				Return(callerApp, nil)
+
+			policyEva := policymocks.NewEvaluator(t)
+			policyEva.EXPECT().
+				Evaluate(ctx, calledApp, session.OwnerAppID, "").
+				Return(&policytypes.Rule{NeedsApproval: true}, nil)
+
+			deviceRepo := devicemocks.NewRepository(t)
+			deviceRepo.EXPECT().
+				GetDevices(ctx, session.UserID).
+				Return([]*devicetypes.Device{{}}, nil)
+
+			notifServ := bffmocks.NewNotificationService(t)
+			notifServ.EXPECT().
+				SendOTPNotification(mock.Anything, session, mock.Anything, callerApp, calledApp, mock.Anything).
+				Return(nil)
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				deviceRepo,
+				notifServ,
+				settingsRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
+
+			_, err := sut.ExtAuthZ(ctx, accessToken, "")
+
+			assert.Error(t, err)
+			assert.ErrorIs(
+				t,
+				err,
+				errutil.Unauthorized("auth.invocationNotApproved", "The user did not approve the invocation."),
+			)
+		})
+	}
+}
+
+// ExtAuthZMcp
+
+func TestAuthService_ExtAuthZMcp_should_evaluate_each_tool_call(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body        string
+		contentType string
+	}{
+		"batch": {
+			body: `[
+				{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool_a"}},
+				{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"tool_b","arguments":{}}}
+			]`,
+			contentType: "application/json",
+		},
+		"sse": {
+			body: "event: message\n" +
+				"data: {\"jsonrpc\":\"2.0\",\"id\":1,\"method\":\"tools/call\",\"params\":{\"name\":\"tool_a\"}}\n\n" +
+				"event: message\n" +
+				"data: {\"jsonrpc\":\"2.0\",\"id\":2,\"method\":\"tools/call\",\"params\":{\"name\":\"tool_b\"}}\n\n",
+			contentType: "text/event-stream",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			accessToken := generateValidJWT(t)
+			session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+			calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+			authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().
+				GetApp(ctx, session.OwnerAppID).
+				Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+			policyEva := policymocks.NewEvaluator(t)
+			policyEva.EXPECT().
+				Evaluate(ctx, calledApp, session.OwnerAppID, "tool_a").
+				Return(&policytypes.Rule{ID: uuid.NewString()}, nil).
+				Once()
+			policyEva.EXPECT().
+				Evaluate(ctx, calledApp, session.OwnerAppID, "tool_b").
+				Return(&policytypes.Rule{ID: uuid.NewString()}, nil).
+				Once()
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().
+				GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+				Return(nil, badgecore.ErrBadgeNotFound)
+
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
+
+			identity, err := sut.ExtAuthZMcp(ctx, accessToken, []byte(tc.body), tc.contentType)
+
+			assert.NoError(t, err)
+			assert.Equal(t, session.OwnerAppID, identity.AppID)
+			// The tool calls matched different rules
+			assert.Nil(t, identity.RuleID)
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZMcp_should_return_err_when_a_tool_call_is_denied(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool_a"}},
+		{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"tool_b"}}
+	]`
+	policyErr := errutil.Unauthorized("policy.unauthorized", "denied")
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, "tool_a").Return(&policytypes.Rule{}, nil)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, "tool_b").Return(nil, policyErr)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+		Return(nil, badgecore.ErrBadgeNotFound)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.ExtAuthZMcp(ctx, accessToken, []byte(body), "application/json")
+
+	assert.ErrorIs(t, err, policyErr)
+}
+
+func TestAuthService_ExtAuthZMcp_should_apply_method_rules(t *testing.T) {
+	t.Parallel()
+
+	rules, _ := authmcp.NewMethodRules(
+		map[string]string{
+			"initialize": "allow",
+			"tools/list": "authenticate",
+		},
+		"deny",
+	)
+
+	t.Run("allowed without access token", func(t *testing.T) {
+		t.Parallel()
+
+		credStore := idpmocks.NewCredentialStore(t)
+		settingsRepo := settingsmocks.NewRepository(t)
+		tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+		sut := bff.NewAuthService(
+			nil,
+			credStore,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			settingsRepo,
+			nil,
+			nil,
+			rules,
+			nil,
+			tokenVerifier,
+			nil,
+		)
+
+		identity, err := sut.ExtAuthZMcp(
+			context.Background(),
+			"",
+			[]byte(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`),
+			"",
+		)
+
+		assert.NoError(t, err)
+		assert.Nil(t, identity)
+	})
+
+	t.Run("authenticated without policy evaluation", func(t *testing.T) {
+		t.Parallel()
+
+		accessToken := generateValidJWT(t)
+		session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+		calledApp := &apptypes.App{ID: uuid.NewString()}
+		ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+		authRepo := authmocks.NewRepository(t)
+		authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+		appRepo := newAppRepositoryMock(t)
+		appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+		appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+		badgeRepo := badgemocks.NewRepository(t)
+		badgeRepo.EXPECT().
+			GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+			Return(nil, badgecore.ErrBadgeNotFound)
+
+		credStore := idpmocks.NewCredentialStore(t)
+		settingsRepo := settingsmocks.NewRepository(t)
+		tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+		sut := bff.NewAuthService(
+			authRepo,
+			credStore,
+			nil,
+			appRepo,
+			nil,
+			nil,
+			nil,
+			settingsRepo,
+			nil,
+			badgeRepo,
+			rules,
+			nil,
+			tokenVerifier,
+			nil,
+		)
+
+		identity, err := sut.ExtAuthZMcp(
+			ctx,
+			accessToken,
+			[]byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`),
+			"application/json",
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, session.OwnerAppID, identity.AppID)
+		assert.Nil(t, identity.RuleID)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		t.Parallel()
+
+		credStore := idpmocks.NewCredentialStore(t)
+		settingsRepo := settingsmocks.NewRepository(t)
+		tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+		sut := bff.NewAuthService(
+			nil,
+			credStore,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			settingsRepo,
+			nil,
+			nil,
+			rules,
+			nil,
+			tokenVerifier,
+			nil,
+		)
+
+		_, err := sut.ExtAuthZMcp(
+			context.Background(),
+			uuid.NewString(),
+			[]byte(`{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"file://x"}}`),
+			"application/json",
+		)
+
+		assert.ErrorIs(
+			t,
+			err,
+			errutil.Unauthorized("auth.mcpMethodNotAllowed", "The MCP method %s is not allowed.", "resources/read"),
+		)
+	})
+}
+
+func TestAuthService_ExtAuthZMcp_should_authenticate_requests_without_body(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString()}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+		Return(nil, badgecore.ErrBadgeNotFound)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	identity, err := sut.ExtAuthZMcp(ctx, accessToken, nil, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, session.OwnerAppID, identity.AppID)
+	assert.Nil(t, identity.RuleID)
+}
+
+func TestAuthService_ExtAuthZMcp_should_return_err_when_body_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body    string
+		errorID string
+	}{
+		"not a JSON-RPC message": {
+			body:    `{"foo":"bar"}`,
+			errorID: "auth.invalidMcpMessage",
+		},
+		"tool call without name": {
+			body:    `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{}}`,
+			errorID: "auth.invalidMcpToolCall",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := sut.ExtAuthZMcp(context.Background(), uuid.NewString(), []byte(tc.body), "")
+
+			var domainErr *errutil.DomainError
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tc.errorID, domainErr.ID)
+		})
+	}
+}
+
+func TestAuthService_FilterMcpTools_should_return_allowed_tools(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, "tool_a").Return(&policytypes.Rule{}, nil)
+	policyEva.EXPECT().
+		Evaluate(ctx, calledApp, session.OwnerAppID, "tool_b").
+		Return(nil, errutil.Unauthorized("policy.unauthorized", "denied"))
+	policyEva.EXPECT().
+		Evaluate(ctx, calledApp, session.OwnerAppID, "tool_c").
+		Return(&policytypes.Rule{NeedsApproval: true}, nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	tools, err := sut.FilterMcpTools(ctx, accessToken, []string{"tool_a", "tool_b", "", "tool_c"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tool_a", "tool_c"}, tools)
+}
+
+func TestAuthService_FilterMcpTools_should_only_return_session_tool(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	toolName := "tool_b"
+	session := &authtypes.Session{OwnerAppID: uuid.NewString(), ToolName: &toolName}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, toolName).Return(&policytypes.Rule{}, nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	tools, err := sut.FilterMcpTools(ctx, accessToken, []string{"tool_a", "tool_b"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{toolName}, tools)
+}
+
+func TestAuthService_FilterMcpTools_should_return_err_when_policy_evaluation_fails(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+	policyErr := errors.New("failed")
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, "tool_a").Return(nil, policyErr)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	_, err := sut.FilterMcpTools(ctx, accessToken, []string{"tool_a"})
+
+	assert.ErrorIs(t, err, policyErr)
+}
+
+func TestAuthService_ExtAuthZA2A_should_evaluate_skills(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body    string
+		skillID string
+	}{
+		"skill in metadata": {
+			body: `{"jsonrpc":"2.0","id":1,"method":"message/send",` +
+				`"params":{"message":{"role":"user","parts":[]},"metadata":{"skillId":"skill_a"}}}`,
+			skillID: "skill_a",
+		},
+		"skill in message metadata": {
+			body: `{"jsonrpc":"2.0","id":1,"method":"message/stream",` +
+				`"params":{"message":{"role":"user","parts":[],"metadata":{"skillId":"skill_b"}}}}`,
+			skillID: "skill_b",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			accessToken := generateValidJWT(t)
+			session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+			calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+			authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+			rule := &policytypes.Rule{ID: uuid.NewString()}
+			policyEva := policymocks.NewEvaluator(t)
+			policyEva.EXPECT().
+				Evaluate(ctx, calledApp, session.OwnerAppID, tc.skillID).
+				Return(rule, nil)
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().
+				GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+				Return(nil, badgecore.ErrBadgeNotFound)
+
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				policyEva,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
+
+			identity, err := sut.ExtAuthZA2A(ctx, accessToken, []byte(tc.body), "application/json")
+
+			assert.NoError(t, err)
+			assert.Equal(t, &rule.ID, identity.RuleID)
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZA2A_should_authenticate_task_methods(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body string
+	}{
+		"task method": {body: `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"task"}}`},
+		"no body":     {body: ""},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			accessToken := generateValidJWT(t)
+			session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+			calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+			ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+			appRepo := newAppRepositoryMock(t)
+			appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+			appRepo.EXPECT().
+				GetApp(ctx, session.OwnerAppID).
+				Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().
+				GetLatestByAppIdOrResolverMetadataID(ctx, session.OwnerAppID).
+				Return(nil, badgecore.ErrBadgeNotFound)
+
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				appRepo,
+				nil,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
+
+			identity, err := sut.ExtAuthZA2A(ctx, accessToken, []byte(tc.body), "application/json")
+
+			assert.NoError(t, err)
+			assert.Equal(t, session.OwnerAppID, identity.AppID)
+		})
+	}
+}
+
+func TestAuthService_ExtAuthZA2A_should_return_err_for_invalid_requests(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body    string
+		errorID string
+	}{
+		"message without params": {
+			body:    `{"jsonrpc":"2.0","id":1,"method":"message/send"}`,
+			errorID: "auth.invalidA2AMessage",
+		},
+		"unknown method": {
+			body:    `{"jsonrpc":"2.0","id":1,"method":"agent/unknown"}`,
+			errorID: "auth.a2aMethodNotAllowed",
+		},
+		"message without skill": {
+			body: `{"jsonrpc":"2.0","id":1,"method":"message/stream",` +
+				`"params":{"message":{"role":"user","parts":[]}}}`,
+			errorID: "auth.invalidA2AMessage",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := sut.ExtAuthZA2A(context.Background(), uuid.NewString(), []byte(tc.body), "")
+
+			var domainErr *errutil.DomainError
+			assert.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, tc.errorID, domainErr.ID)
+		})
+	}
+}
+
+func TestAuthService_FilterA2ASkills_should_return_allowed_skills(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{OwnerAppID: uuid.NewString()}
+	calledApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	ctx := identitycontext.InsertAppID(context.Background(), calledApp.ID)
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, calledApp.ID).Return(calledApp, nil)
+	appRepo.EXPECT().GetApp(ctx, session.OwnerAppID).Return(&apptypes.App{ID: session.OwnerAppID}, nil)
+
+	policyEva := policymocks.NewEvaluator(t)
+	policyEva.EXPECT().Evaluate(ctx, calledApp, session.OwnerAppID, "skill_a").Return(&policytypes.Rule{}, nil)
+	policyEva.EXPECT().
+		Evaluate(ctx, calledApp, session.OwnerAppID, "skill_b").
+		Return(nil, errutil.Unauthorized("policy.unauthorized", "denied"))
+
+	credStore := idpmocks.NewCredentialStore(t)
+	settingsRepo := settingsmocks.NewRepository(t)
+	tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+	sut := bff.NewAuthService(
+		authRepo,
+		credStore,
+		nil,
+		appRepo,
+		policyEva,
+		nil,
+		nil,
+		settingsRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		tokenVerifier,
+		nil,
+	)
+
+	skills, err := sut.FilterA2ASkills(ctx, accessToken, []string{"skill_a", "skill_b"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"skill_a"}, skills)
+}
+
+// acceptAccessTokens makes the credential store and the settings repository accept the access
+// tokens of all the apps and returns a verifier accepting them, the verification of the tokens
+// is covered by the jwtutil tests
+func acceptAccessTokens(
+	t *testing.T,
+	credStore *idpmocks.CredentialStore,
+	settingsRepo *settingsmocks.Repository,
+) jwtutil.Verifier {
+	t.Helper()
+
+	credStore.EXPECT().
+		Get(mock.Anything, mock.Anything).
+		Return(&idpcore.ClientCredentials{ClientID: "client_id", Issuer: "issuer"}, nil).
+		Maybe()
+
+	settingsRepo.EXPECT().
+		GetIssuerSettings(mock.Anything).
+		Return(&settingstypes.IssuerSettings{IdpType: settingstypes.IDP_TYPE_OKTA}, nil).
+		Maybe()
+
+	tokenVerifier := jwtutilmocks.NewVerifier(t)
+	tokenVerifier.EXPECT().
+		Verify(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Maybe()
+
+	return tokenVerifier
+}
+
+// newAppRepositoryMock returns an app repository where no app is suspended
+func newAppRepositoryMock(t *testing.T) *appmocks.Repository {
+	t.Helper()
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().
+		GetAppStatuses(mock.Anything, mock.Anything).
+		Return(map[string]apptypes.AppStatus{}, nil).
+		Maybe()
+
+	return appRepo
+}
+
+func generateValidJWT(t *testing.T) string {
+	t.Helper()
+
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	accessToken, _ := oidc.SelfIssueJWT(uuid.NewString(), uuid.NewString(), priv)
+
+	return accessToken
+}
+
+func generateDPoPKey(t *testing.T) jwxjwk.Key {
+	t.Helper()
+
+	raw, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	key, _ := jwxjwk.Import(raw)
+
+	return key
+}
+
+func dpopThumbprint(t *testing.T, key jwxjwk.Key) string {
+	t.Helper()
+
+	thumbprint, _ := key.Thumbprint(crypto.SHA256)
+
+	return base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+func createDPoPProof(t *testing.T, key jwxjwk.Key, method, url, accessToken string) string {
+	t.Helper()
+
+	nonce := dpop.NewVerifier(testAPIURL, testDPoPNonceSecret, nil).Nonce()
+
+	return createDPoPProofWithNonce(t, key, method, url, accessToken, nonce)
+}
+
+func createDPoPProofWithNonce(t *testing.T, key jwxjwk.Key, method, url, accessToken, nonce string) string {
+	t.Helper()
+
+	pub, _ := key.PublicKey()
+	headers := jws.NewHeaders()
+	_ = headers.Set(jws.TypeKey, "dpop+jwt")
+	_ = headers.Set(jws.JWKKey, pub)
+
+	claims := map[string]any{
+		"jti": uuid.NewString(),
+		"htm": method,
+		"htu": url,
+		"iat": time.Now().Unix(),
+	}
+	if accessToken != "" {
+		claims["ath"] = dpop.HashAccessToken(accessToken)
+	}
+
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	payload, _ := json.Marshal(claims)
+	proof, _ := jws.Sign(payload, jws.WithKey(jwa.ES256(), key, jws.WithProtectedHeaders(headers)))
+
+	return string(proof)
+}
+
+// Introspect
+
+func TestAuthService_Introspect_should_return_active_token(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{
+		ID:          uuid.NewString(),
+		OwnerAppID:  validOwnerAppID,
+		AppID:       ptrutil.Ptr("called_app_id"),
+		ToolName:    ptrutil.Ptr("cool_tool"),
+		AccessToken: &accessToken,
+		CreatedAt:   time.Now().Unix(),
+	}
+
+	testCases := map[string]*struct {
+		appID string
+	}{
+		"owner app": {
+			appID: validOwnerAppID,
+		},
+		"called app": {
+			appID: "called_app_id",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := identitycontext.InsertAppID(context.Background(), tc.appID)
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+			credStore := idpmocks.NewCredentialStore(t)
+			settingsRepo := settingsmocks.NewRepository(t)
+			tokenVerifier := acceptAccessTokens(t, credStore, settingsRepo)
+			sut := bff.NewAuthService(
+				authRepo,
+				credStore,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				settingsRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				tokenVerifier,
+				nil,
+			)
+
+			introspection, err := sut.Introspect(ctx, accessToken)
+
+			assert.NoError(t, err)
+			assert.True(t, introspection.Active)
+			assert.Equal(t, &session.OwnerAppID, introspection.ClientID)
+			assert.Equal(t, session.ToolName, introspection.Scope)
+			assert.Equal(t, session.AppID, introspection.Audience)
+			assert.Equal(t, &session.ID, introspection.SessionID)
+			assert.Equal(t, ptrutil.Ptr(authtypes.TokenTypeBearer), introspection.TokenType)
+			assert.NotNil(t, introspection.ExpiresAt)
+		})
+	}
+}
+
+func TestAuthService_Introspect_should_return_inactive_token(t *testing.T) {
+	t.Parallel()
+
+	accessToken := generateValidJWT(t)
+
+	testCases := map[string]*struct {
+		session *authtypes.Session
+		err     error
+	}{
+		"unknown token": {
+			err: authcore.ErrSessionNotFound,
+		},
+		"expired session": {
+			session: &authtypes.Session{
+				OwnerAppID: validOwnerAppID,
+				ExpiresAt:  ptrutil.Ptr(time.Now().Add(-time.Minute).Unix()),
+			},
+		},
+		"session of another app": {
+			session: &authtypes.Session{
+				OwnerAppID: uuid.NewString(),
+				AppID:      ptrutil.Ptr(uuid.NewString()),
+			},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(tc.session, tc.err)
+			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			introspection, err := sut.Introspect(ctx, accessToken)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &authtypes.TokenIntrospection{Active: false}, introspection)
+		})
+	}
+}
+
+func TestAuthService_Introspect_should_return_inactive_token_when_session_is_revoked(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "keyId")
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: validOwnerAppID}
+	accessToken, _, _ := sessiontoken.Issue("issuer", session, generateValidJWT(t), priv)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{
+		IssuerID: "issuer",
+		KeyID:    "keyId",
+	}, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(ctx, "keyId").Return(priv.PublicKey(), nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().IsDenied(ctx, session.ID).Return(true, nil)
+	sut := bff.NewAuthService(nil, nil, nil, nil, nil, nil, nil, settingsRepo, keyStore, nil, nil, denyList, nil, nil)
+
+	introspection, err := sut.Introspect(ctx, accessToken)
+
+	assert.NoError(t, err)
+	assert.False(t, introspection.Active)
+}
+
+// Revoke
+
+func TestAuthService_Revoke_should_expire_the_session(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	accessToken := generateValidJWT(t)
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: validOwnerAppID}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := sut.Revoke(ctx, accessToken)
+
+	assert.NoError(t, err)
+	assert.True(t, session.HasExpired())
+}
+
+func TestAuthService_Revoke_should_deny_self_contained_session_tokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	accessToken := uuid.NewString()
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: validOwnerAppID, ExpiresAt: &expiresAt}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, accessToken).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().Deny(ctx, session.ID, expiresAt).Return(nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, denyList, nil, nil)
+
+	err := sut.Revoke(ctx, accessToken)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthService_Revoke_should_ignore_unknown_tokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, mock.Anything).Return(nil, authcore.ErrSessionNotFound)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := sut.Revoke(ctx, uuid.NewString())
+
+	assert.NoError(t, err)
+}
+
+func TestAuthService_Revoke_should_return_err_when_token_not_issued_to_app(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertAppID(context.Background(), validOwnerAppID)
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByAccessToken(ctx, mock.Anything).Return(session, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := sut.Revoke(ctx, uuid.NewString())
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.Unauthorized("auth.tokenNotIssuedToApp", "The token was not issued to the application."),
+	)
+}
+
+// ListSessions
+
+func TestAuthService_ListSessions_should_redact_the_tokens(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	paginationFilter := pagination.PaginationFilter{}
+	filter := &authtypes.SessionFilter{Active: ptrutil.Ptr(true)}
+	sessions := &pagination.Pageable[authtypes.Session]{
+		Items: []*authtypes.Session{
+			{
+				ID:                uuid.NewString(),
+				AccessToken:       ptrutil.Ptr("access-token-1234"),
+				AuthorizationCode: ptrutil.Ptr("code"),
+			},
+		},
+		Total: 1,
+	}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().ListSessions(ctx, paginationFilter, filter).Return(sessions, nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	ret, err := sut.ListSessions(ctx, paginationFilter, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "****1234", *ret.Items[0].AccessToken)
+	assert.Equal(t, "****", *ret.Items[0].AuthorizationCode)
+}
+
+// RevokeSession
+
+func TestAuthService_RevokeSession_should_expire_and_deny_the_session(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByID(ctx, session.ID).Return(session, nil)
+	authRepo.EXPECT().UpdateSession(ctx, session).Return(nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().Deny(ctx, session.ID, mock.Anything).Return(nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, denyList, nil, nil)
+
+	err := sut.RevokeSession(ctx, session.ID)
+
+	assert.NoError(t, err)
+	assert.True(t, session.HasExpired())
+}
+
+func TestAuthService_RevokeSession_should_return_err_when_session_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetSessionByID(ctx, mock.Anything).Return(nil, authcore.ErrSessionNotFound)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := sut.RevokeSession(ctx, uuid.NewString())
+
+	assert.ErrorIs(t, err, errutil.NotFound("auth.sessionNotFound", "Session not found."))
+}
+
+// RevokeAllSessionsForApp
+
+func TestAuthService_RevokeAllSessionsForApp_should_revoke_the_active_sessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	app := &apptypes.App{ID: uuid.NewString()}
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	sessions := []*authtypes.Session{
+		{ID: uuid.NewString(), OwnerAppID: app.ID, ExpiresAt: &expiresAt},
+		{ID: uuid.NewString(), OwnerAppID: app.ID, ExpiresAt: &expiresAt},
+	}
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, app.ID).Return(app, nil)
+
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetActiveSessionsForApp(ctx, app.ID).Return(sessions, nil)
+	authRepo.EXPECT().ExpireSessions(ctx, []string{sessions[0].ID, sessions[1].ID}).Return(nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().Deny(ctx, sessions[0].ID, expiresAt).Return(nil)
+	denyList.EXPECT().Deny(ctx, sessions[1].ID, expiresAt).Return(nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, denyList, nil, nil)
+
+	revoked, err := sut.RevokeAllSessionsForApp(ctx, app.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, revoked)
+}
+
+func TestAuthService_RevokeAllSessionsForApp_should_not_expire_sessions_when_deny_fails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	app := &apptypes.App{ID: uuid.NewString()}
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	sessions := []*authtypes.Session{
+		{ID: uuid.NewString(), OwnerAppID: app.ID, ExpiresAt: &expiresAt},
+		{ID: uuid.NewString(), OwnerAppID: app.ID, ExpiresAt: &expiresAt},
+	}
+
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, app.ID).Return(app, nil)
+
+	// The sessions are not expired so they can be revoked again
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().GetActiveSessionsForApp(ctx, app.ID).Return(sessions, nil)
+
+	denyList := authmocks.NewDenyList(t)
+	denyList.EXPECT().Deny(ctx, sessions[0].ID, expiresAt).Return(nil)
+	denyList.EXPECT().Deny(ctx, sessions[1].ID, expiresAt).Return(errors.New("failed"))
+	sut := bff.NewAuthService(authRepo, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, denyList, nil, nil)
+
+	_, err := sut.RevokeAllSessionsForApp(ctx, app.ID)
+
+	assert.Error(t, err)
+}
+
+func TestAuthService_RevokeAllSessionsForApp_should_return_err_when_app_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appRepo := newAppRepositoryMock(t)
+	appRepo.EXPECT().GetApp(ctx, mock.Anything).Return(nil, appcore.ErrAppNotFound)
+	sut := bff.NewAuthService(nil, nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := sut.RevokeAllSessionsForApp(ctx, uuid.NewString())
+
+	assert.ErrorIs(t, err, errutil.NotFound("auth.appNotFound", "Application not found."))
+}
+
+// ApproveToken
+
+func TestAuthService_ApproveToken_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	otp := &authtypes.SessionDeviceOTP{
+		DeviceID:  uuid.NewString(),
+		SessionID: uuid.NewString(),
+		Value:     uuid.NewString(),
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		Used:      false,
+	}
+	authRepo := authmocks.NewRepository(t)
+	authRepo.EXPECT().
+		GetDeviceOTPByValue(ctx, otp.DeviceID, otp.SessionID, otp.Value).
+		Return(otp, nil)
+	authRepo.EXPECT().UpdateDeviceOTP(ctx, otp).Return(nil)
+	sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	err := sut.ApproveToken(ctx, otp.DeviceID, otp.SessionID, otp.Value, true)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthService_ApproveToken_should_fail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		otp *authtypes.SessionDeviceOTP
+		err error
+	}{
+		"OTP already expired": {
+			otp: &authtypes.SessionDeviceOTP{
+				DeviceID:  uuid.NewString(),
+				SessionID: uuid.NewString(),
+				Value:     uuid.NewString(),
+				ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+			},
+			err: errutil.InvalidRequest("auth.otpExpired", "The device OTP is expired."),
+		},
+		"OTP already used": {
+			otp: &authtypes.SessionDeviceOTP{
+				DeviceID:  uuid.NewString(),
+				SessionID: uuid.NewString(),
+				Value:     uuid.NewString(),
+				ExpiresAt: time.Now().Add(time.Minute).Unix(),
+				Used:      true,
+			},
+			err: errutil.InvalidRequest("auth.otpAlreadyUsed", "The device OTP is already used."),
+		},
+		"OTP already approved": {
+			otp: &authtypes.SessionDeviceOTP{
+				DeviceID:  uuid.NewString(),
 				SessionID: uuid.NewString(),
 				Value:     uuid.NewString(),
 				ExpiresAt: time.Now().Add(time.Minute).Unix(),
@@ -1192,7 +3754,7 @@ func TestAuthService_ApproveToken_should_fail(t *testing.T) {
 			authRepo.EXPECT().
 				GetDeviceOTPByValue(ctx, tc.otp.DeviceID, tc.otp.SessionID, tc.otp.Value).
 				Return(tc.otp, nil)
-			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			err := sut.ApproveToken(ctx, tc.otp.DeviceID, tc.otp.SessionID, tc.otp.Value, true)
 
@@ -1201,3 +3763,83 @@ func TestAuthService_ApproveToken_should_fail(t *testing.T) {
 		})
 	}
 }
+
+// GetReceipt
+
+func TestAuthService_GetReceipt_should_return_receipt(t *testing.T) {
+	t.Parallel()
+
+	rcpt := &authtypes.Receipt{
+		ID:          uuid.NewString(),
+		CallerAppID: uuid.NewString(),
+		CalleeAppID: uuid.NewString(),
+	}
+
+	testCases := map[string]*struct {
+		ctx context.Context
+	}{
+		"tenant": {
+			ctx: context.Background(),
+		},
+		"caller app": {
+			ctx: identitycontext.InsertAppID(context.Background(), rcpt.CallerAppID),
+		},
+		"callee app": {
+			ctx: identitycontext.InsertAppID(context.Background(), rcpt.CalleeAppID),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetReceiptByID(tc.ctx, rcpt.ID).Return(rcpt, nil)
+			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			actual, err := sut.GetReceipt(tc.ctx, rcpt.ID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, rcpt, actual)
+		})
+	}
+}
+
+func TestAuthService_GetReceipt_should_return_not_found(t *testing.T) {
+	t.Parallel()
+
+	rcpt := &authtypes.Receipt{
+		ID:          uuid.NewString(),
+		CallerAppID: uuid.NewString(),
+		CalleeAppID: uuid.NewString(),
+	}
+
+	testCases := map[string]*struct {
+		ctx     context.Context
+		receipt *authtypes.Receipt
+		repoErr error
+	}{
+		"receipt not stored": {
+			ctx:     context.Background(),
+			repoErr: authcore.ErrReceiptNotFound,
+		},
+		"receipt of other apps": {
+			ctx:     identitycontext.InsertAppID(context.Background(), uuid.NewString()),
+			receipt: rcpt,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			authRepo := authmocks.NewRepository(t)
+			authRepo.EXPECT().GetReceiptByID(tc.ctx, rcpt.ID).Return(tc.receipt, tc.repoErr)
+			sut := bff.NewAuthService(authRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			_, err := sut.GetReceipt(tc.ctx, rcpt.ID)
+
+			assert.ErrorIs(t, err, errutil.NotFound("auth.receiptNotFound", "Receipt not found."))
+		})
+	}
+}
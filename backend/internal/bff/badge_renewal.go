@@ -0,0 +1,194 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package bff
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	appcore "github.com/agntcy/identity-service/internal/core/app"
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
+	devicecore "github.com/agntcy/identity-service/internal/core/device"
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+type badgeRenewalTask struct {
+	badgeRepository     badgecore.Repository
+	appRepository       appcore.Repository
+	deviceRepository    devicecore.Repository
+	badgeService        BadgeService
+	notificationService NotificationService
+	renewalWindow       time.Duration
+	retryInterval       time.Duration
+	maxAttempts         int
+	batchSize           int
+}
+
+// NewBadgeRenewalTask returns the maintenance task reissuing the badges
+// entering their renewal window. The claims are discovered again when the badge
+// was issued from a well-known URL or an MCP server, the claims of the current badge
+// are reused otherwise. When the renewal fails, the devices of the tenant are notified
+// and the renewal is retried after retryInterval, up to maxAttempts times.
+// The expired badges and the badges of deleted apps are not renewed.
+func NewBadgeRenewalTask(
+	badgeRepository badgecore.Repository,
+	appRepository appcore.Repository,
+	deviceRepository devicecore.Repository,
+	badgeService BadgeService,
+	notificationService NotificationService,
+	renewalWindow time.Duration,
+	retryInterval time.Duration,
+	maxAttempts int,
+	batchSize int,
+) maintenance.Task {
+	return &badgeRenewalTask{
+		badgeRepository:     badgeRepository,
+		appRepository:       appRepository,
+		deviceRepository:    deviceRepository,
+		badgeService:        badgeService,
+		notificationService: notificationService,
+		renewalWindow:       renewalWindow,
+		retryInterval:       retryInterval,
+		maxAttempts:         maxAttempts,
+		batchSize:           batchSize,
+	}
+}
+
+func (*badgeRenewalTask) Name() string {
+	return "badge_renewal"
+}
+
+func (t *badgeRenewalTask) Run(ctx context.Context) (int64, error) {
+	badges, err := t.badgeRepository.GetBadgesToRenew(
+		ctx,
+		t.renewalWindow,
+		time.Now().Add(-t.retryInterval),
+		t.maxAttempts,
+		t.batchSize,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("repository failed to fetch the badges to renew: %w", err)
+	}
+
+	var renewed int64
+
+	for _, badge := range badges {
+		if ctx.Err() != nil {
+			break
+		}
+
+		tenantCtx := identitycontext.InsertTenantID(ctx, badge.TenantID)
+
+		app, err := t.renew(tenantCtx, badge)
+		if err != nil {
+			log.FromContext(ctx).
+				WithError(err).
+				Errorf("failed to renew the badge %s of the app %s", badge.ID, badge.AppID)
+
+			t.reportFailure(tenantCtx, badge, app)
+
+			continue
+		}
+
+		renewed++
+	}
+
+	return renewed, nil
+}
+
+func (t *badgeRenewalTask) renew(
+	ctx context.Context,
+	badge *badgetypes.Badge,
+) (*apptypes.App, error) {
+	app, err := t.appRepository.GetApp(ctx, badge.AppID)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to get the application: %w", err)
+	}
+
+	var (
+		discovery = ptrutil.Derefrence(badge.Discovery, badgetypes.BadgeDiscovery{})
+		schema    *string
+		option    IssueOption
+	)
+
+	if badge.CredentialSubject != nil {
+		schema = ptrutil.Ptr(base64.StdEncoding.EncodeToString([]byte(badge.CredentialSubject.Badge)))
+	}
+
+	switch app.Type {
+	case apptypes.APP_TYPE_AGENT_A2A:
+		if discovery.A2AWellKnownUrl != "" {
+			option = WithA2A(&discovery.A2AWellKnownUrl, nil)
+		} else {
+			option = WithA2A(nil, schema)
+		}
+	case apptypes.APP_TYPE_AGENT_OASF:
+		option = WithOASF(ptrutil.DerefStr(schema))
+	case apptypes.APP_TYPE_MCP_SERVER:
+		if discovery.McpName != "" && discovery.McpUrl != "" {
+			option = WithMCP(&discovery.McpName, &discovery.McpUrl, nil)
+		} else {
+			option = WithMCP(nil, nil, schema)
+		}
+	default:
+		return app, fmt.Errorf("the badges of the %s apps cannot be renewed", app.Type)
+	}
+
+	options := []IssueOption{option}
+
+	// Keep the renewed SD-JWT VC badges bound to their holder
+	if holderKey := badgecore.HolderKey(badge); holderKey != "" {
+		options = append(options, WithProofFormat(badgetypes.PROOF_FORMAT_SD_JWT_VC), WithHolderKey(holderKey))
+	}
+
+	_, err = t.badgeService.IssueBadge(ctx, app.ID, options...)
+	if err != nil {
+		return app, fmt.Errorf("badge service failed to reissue the badge: %w", err)
+	}
+
+	return app, nil
+}
+
+func (t *badgeRenewalTask) reportFailure(
+	ctx context.Context,
+	badge *badgetypes.Badge,
+	app *apptypes.App,
+) {
+	err := t.badgeRepository.SetRenewalFailed(ctx, badge.ID)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Errorf("failed to record the renewal failure of the badge %s", badge.ID)
+	}
+
+	devices, err := t.deviceRepository.GetDevices(ctx, nil)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("failed to get the devices to notify about the badge renewal")
+
+		return
+	}
+
+	appName := badge.AppID
+	if app != nil && app.Name != nil {
+		appName = *app.Name
+	}
+
+	message := fmt.Sprintf(
+		"The badge of %s could not be renewed. It expires on %s.",
+		appName,
+		badge.ExpirationDate,
+	)
+
+	for _, device := range devices {
+		err := t.notificationService.SendInfoNotification(device, message)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warnf("failed to notify the device %s", device.ID)
+		}
+	}
+}
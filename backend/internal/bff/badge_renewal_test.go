@@ -0,0 +1,152 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package bff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/bff"
+	bffmocks "github.com/agntcy/identity-service/internal/bff/mocks"
+	appmocks "github.com/agntcy/identity-service/internal/core/app/mocks"
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
+	devicemocks "github.com/agntcy/identity-service/internal/core/device/mocks"
+	devicetypes "github.com/agntcy/identity-service/internal/core/device/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newBadgeToRenew() *badgetypes.Badge {
+	return &badgetypes.Badge{
+		VerifiableCredential: badgetypes.VerifiableCredential{
+			ID:                uuid.NewString(),
+			CredentialSubject: &badgetypes.BadgeClaims{Badge: "a2a_agent"},
+			ExpirationDate:    time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+		AppID:    uuid.NewString(),
+		TenantID: uuid.NewString(),
+		Discovery: &badgetypes.BadgeDiscovery{
+			A2AWellKnownUrl: "agent_card_wellknown_url",
+		},
+	}
+}
+
+func tenantContext(tenantID string) any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		actual, ok := identitycontext.GetTenantID(ctx)
+		return ok && actual == tenantID
+	})
+}
+
+func TestBadgeRenewalTask_should_reissue_the_badges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	badge := newBadgeToRenew()
+	app := &apptypes.App{ID: badge.AppID, Type: apptypes.APP_TYPE_AGENT_A2A}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetBadgesToRenew(ctx, 24*time.Hour, mock.Anything, 3, 10).
+		Return([]*badgetypes.Badge{badge}, nil)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(tenantContext(badge.TenantID), badge.AppID).Return(app, nil)
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().
+		IssueBadge(tenantContext(badge.TenantID), app.ID, mock.AnythingOfType("bff.IssueOption")).
+		Return(&badgetypes.Badge{}, nil)
+
+	sut := bff.NewBadgeRenewalTask(badgeRepo, appRepo, nil, badgeSrv, nil, 24*time.Hour, time.Hour, 3, 10)
+
+	renewed, err := sut.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), renewed)
+}
+
+func TestBadgeRenewalTask_should_notify_the_admins_when_the_renewal_fails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	badge := newBadgeToRenew()
+	app := &apptypes.App{ID: badge.AppID, Type: apptypes.APP_TYPE_AGENT_A2A}
+	device := &devicetypes.Device{ID: uuid.NewString()}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetBadgesToRenew(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*badgetypes.Badge{badge}, nil)
+	badgeRepo.EXPECT().SetRenewalFailed(tenantContext(badge.TenantID), badge.ID).Return(nil)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(tenantContext(badge.TenantID), badge.AppID).Return(app, nil)
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().
+		IssueBadge(mock.Anything, app.ID, mock.AnythingOfType("bff.IssueOption")).
+		Return(nil, errors.New("discovery failed"))
+
+	deviceRepo := devicemocks.NewRepository(t)
+	deviceRepo.EXPECT().
+		GetDevices(tenantContext(badge.TenantID), (*string)(nil)).
+		Return([]*devicetypes.Device{device}, nil)
+
+	notificationSrv := bffmocks.NewNotificationService(t)
+	notificationSrv.EXPECT().SendInfoNotification(device, mock.Anything).Return(nil)
+
+	sut := bff.NewBadgeRenewalTask(
+		badgeRepo,
+		appRepo,
+		deviceRepo,
+		badgeSrv,
+		notificationSrv,
+		24*time.Hour,
+		time.Hour,
+		3,
+		10,
+	)
+
+	renewed, err := sut.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), renewed)
+}
+
+func TestBadgeRenewalTask_should_report_a_failure_when_the_app_type_is_not_supported(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	badge := newBadgeToRenew()
+	app := &apptypes.App{ID: badge.AppID, Type: apptypes.APP_TYPE_UNSPECIFIED}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetBadgesToRenew(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*badgetypes.Badge{badge}, nil)
+	badgeRepo.EXPECT().SetRenewalFailed(tenantContext(badge.TenantID), badge.ID).Return(nil)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(tenantContext(badge.TenantID), badge.AppID).Return(app, nil)
+
+	deviceRepo := devicemocks.NewRepository(t)
+	deviceRepo.EXPECT().GetDevices(tenantContext(badge.TenantID), (*string)(nil)).Return(nil, nil)
+
+	// The badge service is not called
+	badgeSrv := bffmocks.NewBadgeService(t)
+
+	sut := bff.NewBadgeRenewalTask(badgeRepo, appRepo, deviceRepo, badgeSrv, nil, 24*time.Hour, time.Hour, 3, 10)
+
+	renewed, err := sut.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), renewed)
+}
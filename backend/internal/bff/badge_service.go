@@ -9,21 +9,32 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	appcore "github.com/agntcy/identity-service/internal/core/app"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	badgea2a "github.com/agntcy/identity-service/internal/core/badge/a2a"
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
 	badgemcp "github.com/agntcy/identity-service/internal/core/badge/mcp"
+	"github.com/agntcy/identity-service/internal/core/badge/sdjwt"
 	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	identitycore "github.com/agntcy/identity-service/internal/core/identity"
 	idpcore "github.com/agntcy/identity-service/internal/core/idp"
 	policycore "github.com/agntcy/identity-service/internal/core/policy"
 	settingscore "github.com/agntcy/identity-service/internal/core/settings"
+	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
 )
 
 type issueInput struct {
@@ -41,6 +52,27 @@ type issueInput struct {
 	oasf struct {
 		SchemaBase64 string `validate:"required"`
 	}
+
+	proofFormat badgetypes.ProofFormat
+	holderKey   string
+}
+
+// discovery returns where the claims are discovered, used to renew the badge
+func (in *issueInput) discovery() *badgetypes.BadgeDiscovery {
+	if in.a2a.WellKnownUrl != nil {
+		return &badgetypes.BadgeDiscovery{
+			A2AWellKnownUrl: *in.a2a.WellKnownUrl,
+		}
+	}
+
+	if in.mcp.Name != nil && in.mcp.Url != nil {
+		return &badgetypes.BadgeDiscovery{
+			McpName: *in.mcp.Name,
+			McpUrl:  *in.mcp.Url,
+		}
+	}
+
+	return nil
 }
 
 type IssueOption func(in *issueInput)
@@ -66,6 +98,118 @@ func WithOASF(schemaBase64 string) IssueOption {
 	}
 }
 
+// WithProofFormat overrides the proof format set by the tenant
+func WithProofFormat(proofFormat badgetypes.ProofFormat) IssueOption {
+	return func(in *issueInput) {
+		in.proofFormat = proofFormat
+	}
+}
+
+// WithHolderKey binds an SD-JWT VC badge to the public JWK of its holder
+func WithHolderKey(holderKey string) IssueOption {
+	return func(in *issueInput) {
+		in.holderKey = holderKey
+	}
+}
+
+const (
+	// MaxVerifyBatchSize is the maximum number of badges verified in a batch
+	MaxVerifyBatchSize = 100
+
+	// The number of badges of a batch verified concurrently
+	verifyBatchConcurrency = 8
+)
+
+type verifyInput struct {
+	audience    string
+	nonce       string
+	trustPolicy string
+	lookups     *verifyLookups
+}
+
+type VerifyOption func(in *verifyInput)
+
+// WithKeyBinding requires the key binding JWT of an SD-JWT VC presentation
+// to be intended for the audience and to carry the nonce. Both are mandatory
+// to verify the presentations bound to a holder key
+func WithKeyBinding(audience, nonce string) VerifyOption {
+	return func(in *verifyInput) {
+		in.audience = audience
+		in.nonce = nonce
+	}
+}
+
+// WithTrustPolicy applies the checks of the named trust policy of the tenant to the badge
+func WithTrustPolicy(name string) VerifyOption {
+	return func(in *verifyInput) {
+		in.trustPolicy = name
+	}
+}
+
+// withLookups shares the lookups between the verifications of a batch
+func withLookups(lookups *verifyLookups) VerifyOption {
+	return func(in *verifyInput) {
+		in.lookups = lookups
+	}
+}
+
+// verifyLookups holds the tenants of the badges, the keys, the trust policies and the statuses
+// of the Apps fetched while verifying badges, the concurrent lookups of the same value wait
+// for the first one
+type verifyLookups struct {
+	tenants       lookup[string]
+	pubKeys       lookup[jwxjwk.Key]
+	trustPolicies lookup[*badgetypes.TrustPolicy]
+	appStatuses   lookup[*apptypes.AppStatus]
+}
+
+type lookup[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*lookupEntry[T]
+}
+
+type lookupEntry[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+// get returns the value of the key, fetched by the first lookup of the key
+func (l *lookup[T]) get(key string, fetch func() (T, error)) (T, error) {
+	l.mu.Lock()
+
+	if l.entries == nil {
+		l.entries = make(map[string]*lookupEntry[T])
+	}
+
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &lookupEntry[T]{}
+		l.entries[key] = entry
+	}
+
+	l.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = fetch()
+	})
+
+	return entry.value, entry.err
+}
+
+// BadgeToVerify is a badge of a batch with the options of its verification
+type BadgeToVerify struct {
+	Badge   string
+	Options []VerifyOption
+}
+
+// BadgeVerification is the result of the verification of a badge of a batch,
+// or the error that prevented the verification
+type BadgeVerification struct {
+	Result *badgetypes.VerificationResult
+	Err    error
+}
+
 type BadgeService interface {
 	IssueBadge(
 		ctx context.Context,
@@ -75,27 +219,85 @@ type BadgeService interface {
 	VerifyBadge(
 		ctx context.Context,
 		badge *string,
+		options ...VerifyOption,
 	) (*badgetypes.VerificationResult, error)
+	VerifyBadges(
+		ctx context.Context,
+		badges []*BadgeToVerify,
+	) ([]*BadgeVerification, error)
 	GetBadge(
 		ctx context.Context,
 		appID string,
 	) (*badgetypes.Badge, error)
+	ListBadges(
+		ctx context.Context,
+		appID string,
+		paginationFilter pagination.PaginationFilter,
+	) (*pagination.Pageable[badgetypes.Badge], error)
+	GetBadgeByID(
+		ctx context.Context,
+		id string,
+	) (*badgetypes.Badge, error)
+	DiffBadges(
+		ctx context.Context,
+		fromBadgeID string,
+		toBadgeID string,
+	) (*badgetypes.BadgeDiff, error)
+	GetStatusList(
+		ctx context.Context,
+		id string,
+	) (*badgetypes.StatusListCredential, error)
+	GetIssuerDocument(
+		ctx context.Context,
+		issuer string,
+	) (*badgetypes.IssuerDocument, error)
+	RevokeBadge(
+		ctx context.Context,
+		appID string,
+		badgeID string,
+		reason badgetypes.RevocationReason,
+		comment string,
+	) error
+	SuspendBadge(
+		ctx context.Context,
+		appID string,
+		reason string,
+	) error
+	ResumeBadge(
+		ctx context.Context,
+		appID string,
+	) error
+	ListTrustPolicies(ctx context.Context) ([]*badgetypes.TrustPolicy, error)
+	CreateTrustPolicy(ctx context.Context, policy *badgetypes.TrustPolicy) (*badgetypes.TrustPolicy, error)
+	UpdateTrustPolicy(ctx context.Context, policy *badgetypes.TrustPolicy) (*badgetypes.TrustPolicy, error)
+	DeleteTrustPolicy(ctx context.Context, id string) error
 }
 
 type badgeService struct {
-	settingsRepository settingscore.Repository
-	appRepository      appcore.Repository
-	badgeRepository    badgecore.Repository
-	validator          *validator.Validate
-	a2aClient          badgea2a.DiscoveryClient
-	mcpClient          badgemcp.DiscoveryClient
-	keyStore           identitycore.KeyStore
-	identityService    identitycore.Service
-	credentialStore    idpcore.CredentialStore
-	taskService        policycore.TaskService
-	badgeRevoker       badgecore.Revoker
+	settingsRepository    settingscore.Repository
+	appRepository         appcore.Repository
+	badgeRepository       badgecore.Repository
+	validator             *validator.Validate
+	a2aClient             badgea2a.DiscoveryClient
+	mcpClient             badgemcp.DiscoveryClient
+	keyStore              identitycore.KeyStore
+	identityService       identitycore.Service
+	credentialStore       idpcore.CredentialStore
+	taskService           policycore.TaskService
+	badgeRevoker          badgecore.Revoker
+	statusListRepository  badgecore.StatusListRepository
+	statusListService     badgecore.StatusListService
+	badgeSuspender        badgecore.Suspender
+	trustPolicyRepository badgecore.TrustPolicyRepository
+	issuerResolver        badgecore.IssuerResolver
+	badgeLifetime         time.Duration
+	apiURL                string
 }
 
+// NewBadgeService returns a BadgeService. Without an issuerResolver only the badges issued
+// by the tenants are verified locally. The badgeLifetime applies when the badge settings
+// of the tenant do not set one. The apiURL is the public URL of the service, where the
+// issuers publish the keys of the Data Integrity proofs.
 func NewBadgeService(
 	settingsRepository settingscore.Repository,
 	appRepository appcore.Repository,
@@ -107,19 +309,33 @@ func NewBadgeService(
 	credentialStore idpcore.CredentialStore,
 	taskService policycore.TaskService,
 	badgeRevoker badgecore.Revoker,
+	statusListRepository badgecore.StatusListRepository,
+	statusListService badgecore.StatusListService,
+	badgeSuspender badgecore.Suspender,
+	trustPolicyRepository badgecore.TrustPolicyRepository,
+	issuerResolver badgecore.IssuerResolver,
+	badgeLifetime time.Duration,
+	apiURL string,
 ) BadgeService {
 	return &badgeService{
-		settingsRepository: settingsRepository,
-		appRepository:      appRepository,
-		badgeRepository:    badgeRepository,
-		validator:          validator.New(validator.WithRequiredStructEnabled()),
-		a2aClient:          a2aClient,
-		mcpClient:          mcpClient,
-		keyStore:           keyStore,
-		identityService:    identityService,
-		credentialStore:    credentialStore,
-		taskService:        taskService,
-		badgeRevoker:       badgeRevoker,
+		settingsRepository:    settingsRepository,
+		appRepository:         appRepository,
+		badgeRepository:       badgeRepository,
+		validator:             validator.New(validator.WithRequiredStructEnabled()),
+		a2aClient:             a2aClient,
+		mcpClient:             mcpClient,
+		keyStore:              keyStore,
+		identityService:       identityService,
+		credentialStore:       credentialStore,
+		taskService:           taskService,
+		badgeRevoker:          badgeRevoker,
+		statusListRepository:  statusListRepository,
+		statusListService:     statusListService,
+		badgeSuspender:        badgeSuspender,
+		trustPolicyRepository: trustPolicyRepository,
+		issuerResolver:        issuerResolver,
+		badgeLifetime:         badgeLifetime,
+		apiURL:                apiURL,
 	}
 }
 
@@ -142,11 +358,54 @@ func (s *badgeService) IssueBadge(
 		return nil, fmt.Errorf("repository in IssueBadge failed to get the application: %w", err)
 	}
 
+	// A new badge would revoke the suspended ones and lift the suspension
+	statuses, err := s.appRepository.GetAppStatuses(ctx, app.ID)
+	if err != nil {
+		return nil, fmt.Errorf("repository in IssueBadge failed to get the application status: %w", err)
+	}
+
+	if statuses[app.ID] == apptypes.APP_STATUS_SUSPENDED {
+		return nil, errutil.InvalidRequest(
+			"badge.appSuspended",
+			"The application is suspended, resume its badge before issuing a new one.",
+		)
+	}
+
 	var in issueInput
 	for _, opt := range options {
 		opt(&in)
 	}
 
+	if !in.proofFormat.IsValid() {
+		return nil, errutil.ValidationFailed("badge.invalidProofFormat", "Invalid proof format.")
+	}
+
+	badgeSettings, err := s.settingsRepository.GetBadgeSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository in IssueBadge failed to fetch badge settings: %w", err)
+	}
+
+	lifetime := s.getBadgeLifetime(badgeSettings, app.Type)
+
+	proofFormat := in.proofFormat
+	if proofFormat == badgetypes.PROOF_FORMAT_UNSPECIFIED {
+		proofFormat = badgeSettings.ProofFormat
+	}
+
+	if in.holderKey != "" {
+		if proofFormat != badgetypes.PROOF_FORMAT_SD_JWT_VC {
+			return nil, errutil.ValidationFailed(
+				"badge.holderKeyNotSupported",
+				"Only the SD-JWT VC badges can be bound to a holder key.",
+			)
+		}
+
+		_, err = badgecore.ParseHolderKey(in.holderKey)
+		if err != nil {
+			return nil, errutil.ValidationFailed("badge.invalidHolderKey", "The holder key must be a public JWK.")
+		}
+	}
+
 	claims, badgeType, err := s.createBadgeClaims(ctx, app, &in)
 	if err != nil {
 		return nil, err
@@ -165,56 +424,155 @@ func (s *badgeService) IssueBadge(
 
 	log.FromContext(ctx).Debug("Using private key: ", privKey)
 
-	badge, err := badgecore.Issue(
-		app.ID,
-		settings.IssuerID,
-		badgeType,
-		claims,
-		privKey,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("unable to issue badge: %w", err)
-	}
+	var badge *badgetypes.Badge
+
+	// The status list entries are allocated in the transaction storing the badge,
+	// they are released when the issuance fails
+	err = s.badgeRepository.Transaction(ctx, func(ctx context.Context) error {
+		revocationStatus, err := s.statusListService.Allocate(
+			ctx,
+			badgetypes.StatusPurposeRevocation,
+			settings.IssuerID,
+			privKey,
+		)
+		if err != nil {
+			return fmt.Errorf("status list service in IssueBadge failed to allocate a status: %w", err)
+		}
 
-	log.FromContext(ctx).Debug("Issued badge: ", badge)
+		suspensionStatus, err := s.statusListService.Allocate(
+			ctx,
+			badgetypes.StatusPurposeSuspension,
+			settings.IssuerID,
+			privKey,
+		)
+		if err != nil {
+			return fmt.Errorf("status list service in IssueBadge failed to allocate a status: %w", err)
+		}
+
+		badge, err = badgecore.Issue(
+			app.ID,
+			settings.IssuerID,
+			badgecore.IssuerDocumentURL(s.apiURL, settings.IssuerID),
+			badgeType,
+			claims,
+			privKey,
+			proofFormat,
+			in.holderKey,
+			lifetime,
+			revocationStatus,
+			suspensionStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to issue badge: %w", err)
+		}
+
+		badge.Discovery = in.discovery()
+
+		err = s.publishVerificationMethod(ctx, settings.IssuerID, badge.Proof)
+		if err != nil {
+			return err
+		}
+
+		log.FromContext(ctx).Debug("Issued badge: ", badge)
+
+		clientCredentials, err := s.credentialStore.Get(ctx, app.ID)
+		if err != nil {
+			return fmt.Errorf("unable to get client credentials in IssueBadge for app %s: %w", app.ID, err)
+		}
+
+		issuer := identitycore.Issuer{
+			CommonName: settings.IssuerID,
+			KeyID:      settings.KeyID,
+		}
+
+		err = s.identityService.PublishVerifiableCredential(
+			ctx,
+			clientCredentials,
+			&badge.VerifiableCredential,
+			&issuer,
+		)
+		if err != nil {
+			return fmt.Errorf("identity service failed to publish the badge: %w", err)
+		}
+
+		err = s.createTasks(ctx, app, claims)
+		if err != nil {
+			return err
+		}
 
-	clientCredentials, err := s.credentialStore.Get(ctx, app.ID)
+		// revoke all active badges
+		err = s.badgeRevoker.RevokeAll(
+			ctx,
+			app.ID,
+			&badgecore.Revocation{Reason: badgetypes.REVOCATION_REASON_SUPERSEDED},
+			clientCredentials,
+			&issuer,
+			privKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to revoke current badges for app %s: %w", app.ID, err)
+		}
+
+		err = s.badgeRepository.Create(ctx, badge)
+		if err != nil {
+			return fmt.Errorf("repository in IssueBadge failed to store the badge: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to get client credentials in IssueBadge for app %s: %w", app.ID, err)
+		return nil, err
 	}
 
-	issuer := identitycore.Issuer{
-		CommonName: settings.IssuerID,
-		KeyID:      settings.KeyID,
+	return badge, nil
+}
+
+// publishVerificationMethod adds the key of a Data Integrity proof
+// to the document of the issuer, the verifiers resolve the key from there
+func (s *badgeService) publishVerificationMethod(ctx context.Context, issuer string, proof *badgetypes.Proof) error {
+	if !proof.IsDataIntegrity() {
+		return nil
 	}
 
-	err = s.identityService.PublishVerifiableCredential(
-		ctx,
-		clientCredentials,
-		&badge.VerifiableCredential,
-		&issuer,
-	)
+	method, err := dataintegrity.NewMultikey(proof.VerificationMethod)
 	if err != nil {
-		return nil, fmt.Errorf("identity service failed to publish the badge: %w", err)
+		return fmt.Errorf("unable to publish the verification method: %w", err)
 	}
 
-	err = s.createTasks(ctx, app, claims)
+	err = s.badgeRepository.SaveVerificationMethod(ctx, issuer, method)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("repository in IssueBadge failed to store the verification method: %w", err)
 	}
 
-	// revoke all active badges
-	err = s.badgeRevoker.RevokeAll(ctx, app.ID, clientCredentials, &issuer, privKey)
-	if err != nil {
-		return nil, fmt.Errorf("unable to revoke current badges for app %s: %w", app.ID, err)
+	return nil
+}
+
+// getBadgeLifetime returns the lifetime set by the tenant for the app type,
+// falling back to the default lifetime of the tenant then of the deployment.
+func (s *badgeService) getBadgeLifetime(
+	badgeSettings *settingstypes.BadgeSettings,
+	appType apptypes.AppType,
+) time.Duration {
+	var lifetimeSeconds int64
+
+	switch appType {
+	case apptypes.APP_TYPE_AGENT_A2A:
+		lifetimeSeconds = badgeSettings.AgentA2ALifetimeSeconds
+	case apptypes.APP_TYPE_AGENT_OASF:
+		lifetimeSeconds = badgeSettings.AgentOASFLifetimeSeconds
+	case apptypes.APP_TYPE_MCP_SERVER:
+		lifetimeSeconds = badgeSettings.McpServerLifetimeSeconds
 	}
 
-	err = s.badgeRepository.Create(ctx, badge)
-	if err != nil {
-		return nil, fmt.Errorf("repository in IssueBadge failed to store the badge: %w", err)
+	if lifetimeSeconds == 0 {
+		lifetimeSeconds = badgeSettings.DefaultLifetimeSeconds
 	}
 
-	return badge, nil
+	if lifetimeSeconds == 0 {
+		return s.badgeLifetime
+	}
+
+	return time.Duration(lifetimeSeconds) * time.Second
 }
 
 func (s *badgeService) createBadgeClaims(
@@ -373,7 +731,12 @@ func (s *badgeService) createTasks(
 	claims *badgetypes.BadgeClaims,
 ) error {
 	switch app.Type {
-	case apptypes.APP_TYPE_AGENT_A2A, apptypes.APP_TYPE_AGENT_OASF:
+	case apptypes.APP_TYPE_AGENT_A2A:
+		_, err := s.taskService.CreateForA2A(ctx, app.ID, ptrutil.DerefStr(app.Name), claims.Badge)
+		if err != nil {
+			return fmt.Errorf("error trying to create tasks for agent %s: %w", app.ID, err)
+		}
+	case apptypes.APP_TYPE_AGENT_OASF:
 		_, err := s.taskService.UpdateOrCreateForAgent(ctx, app.ID, ptrutil.DerefStr(app.Name))
 		if err != nil {
 			return fmt.Errorf("error trying to create tasks for agent %s: %w", app.ID, err)
@@ -391,34 +754,773 @@ func (s *badgeService) createTasks(
 func (s *badgeService) VerifyBadge(
 	ctx context.Context,
 	badge *string,
+	options ...VerifyOption,
 ) (*badgetypes.VerificationResult, error) {
 	if badge == nil || *badge == "" {
 		return nil, errutil.ValidationFailed("badge.emptyBadge", "Badge or Verifiable Credential is empty")
 	}
 
-	// Use the identity service to verify the VC
-	return s.identityService.VerifyVerifiableCredential(
-		ctx,
-		badge,
+	var in verifyInput
+	for _, opt := range options {
+		opt(&in)
+	}
+
+	if in.lookups == nil {
+		in.lookups = &verifyLookups{}
+	}
+
+	var (
+		policy *badgetypes.TrustPolicy
+		result *badgetypes.VerificationResult
+		err    error
 	)
+
+	if in.trustPolicy != "" {
+		policy, err = s.getTrustPolicy(ctx, in.trustPolicy, in.lookups)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case badgecore.IsDataIntegrityCredential(*badge):
+		result, err = s.verifyIssuedProof(ctx, badgecore.VerifyDataIntegrity(*badge))
+	case badgecore.IsSdJwt(*badge):
+		result, err = s.verifyIssuedProof(
+			ctx,
+			badgecore.VerifySdJwt(*badge, sdjwt.WithAudience(in.audience), sdjwt.WithNonce(in.nonce)),
+		)
+	default:
+		result, err = s.verifyJose(ctx, *badge, in.lookups)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.addRevocationReason(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Document != nil && result.Document.IsExpired() {
+		result.Status = false
+		result.Errors = append(result.Errors, &badgetypes.ErrorInfo{
+			Reason:  badgetypes.ErrorReasonVerifiableCredentialExpired,
+			Message: fmt.Sprintf("The badge expired on %s.", result.Document.ExpirationDate),
+		})
+	}
+
+	if policy != nil && result.Document != nil {
+		var appStatus *apptypes.AppStatus
+
+		if policy.RequireActiveApp {
+			appStatus, err = s.getBadgeAppStatus(ctx, result.Document.ID, in.lookups)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		badgecore.ApplyTrustPolicy(result, policy, appStatus, time.Now())
+	}
+
+	return result, nil
 }
 
-func (s *badgeService) GetBadge(
+// VerifyBadges verifies the badges concurrently, sharing the lookups of the keys, the trust policies
+// and the statuses between the verifications. The results are returned in the order of the badges.
+func (s *badgeService) VerifyBadges(
 	ctx context.Context,
-	appID string,
-) (*badgetypes.Badge, error) {
-	if appID == "" {
-		return nil, errutil.ValidationFailed("badge.invalidAppID", "Invalid application ID.")
+	badges []*BadgeToVerify,
+) ([]*BadgeVerification, error) {
+	if len(badges) == 0 {
+		return nil, errutil.ValidationFailed("badge.emptyBatch", "No badge to verify.")
 	}
 
-	badge, err := s.badgeRepository.GetLatestByAppIdOrResolverMetadataID(ctx, appID)
+	if len(badges) > MaxVerifyBatchSize {
+		return nil, errutil.ValidationFailed(
+			"badge.batchTooLarge",
+			"A batch cannot contain more than %d badges.",
+			MaxVerifyBatchSize,
+		)
+	}
+
+	var (
+		lookups   verifyLookups
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, verifyBatchConcurrency)
+		results   = make([]*BadgeVerification, len(badges))
+	)
+
+	for i, badge := range badges {
+		semaphore <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			options := append(slices.Clone(badge.Options), withLookups(&lookups))
+			result, err := s.VerifyBadge(ctx, &badge.Badge, options...)
+
+			results[i] = &BadgeVerification{Result: result, Err: err}
+		}()
+	}
+
+	wg.Wait()
+
+	return results, nil
+}
+
+// getTrustPolicy returns the trust policy of the tenant of the authenticated request
+func (s *badgeService) getTrustPolicy(
+	ctx context.Context,
+	name string,
+	lookups *verifyLookups,
+) (*badgetypes.TrustPolicy, error) {
+	if _, ok := identitycontext.GetTenantID(ctx); !ok {
+		return nil, errutil.Unauthorized(
+			"badge.trustPolicyUnauthorized",
+			"The request must be authenticated to verify a badge with a trust policy.",
+		)
+	}
+
+	policy, err := lookups.trustPolicies.get(name, func() (*badgetypes.TrustPolicy, error) {
+		return s.trustPolicyRepository.GetByName(ctx, name)
+	})
+	if err != nil {
+		if errors.Is(err, badgecore.ErrTrustPolicyNotFound) {
+			return nil, errutil.NotFound("badge.trustPolicyNotFound", "Trust policy %s not found.", name)
+		}
+
+		return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the trust policy %s: %w", name, err)
+	}
+
+	return policy, nil
+}
+
+// getBadgeAppStatus returns the status of the App of a badge issued by any tenant,
+// nil is returned when the badge was not issued by the service
+func (s *badgeService) getBadgeAppStatus(
+	ctx context.Context,
+	badgeID string,
+	lookups *verifyLookups,
+) (*apptypes.AppStatus, error) {
+	tenantID, err := s.getBadgeTenantID(ctx, badgeID, lookups)
 	if err != nil {
 		if errors.Is(err, badgecore.ErrBadgeNotFound) {
-			return nil, errutil.NotFound("badge.notFound", "No badge found for the application.")
+			return nil, nil //nolint:nilnil // the badge was issued by another service
 		}
 
-		return nil, fmt.Errorf("repository in GetBadge failed to fetch latest badge for app %s: %w", appID, err)
+		return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the tenant of the badge: %w", err)
 	}
 
-	return badge, nil
+	tenantCtx := identitycontext.InsertTenantID(ctx, tenantID)
+
+	badge, err := s.badgeRepository.GetByID(tenantCtx, badgeID)
+	if err != nil {
+		return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the badge: %w", err)
+	}
+
+	return lookups.appStatuses.get(tenantID+"/"+badge.AppID, func() (*apptypes.AppStatus, error) {
+		statuses, err := s.appRepository.GetAppStatuses(tenantCtx, badge.AppID)
+		if err != nil {
+			return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the status of the app: %w", err)
+		}
+
+		status, ok := statuses[badge.AppID]
+		if !ok {
+			return nil, nil //nolint:nilnil // the app was deleted
+		}
+
+		return &status, nil
+	})
+}
+
+// getBadgeTenantID returns the tenant that issued a badge of any tenant
+func (s *badgeService) getBadgeTenantID(ctx context.Context, badgeID string, lookups *verifyLookups) (string, error) {
+	return lookups.tenants.get(badgeID, func() (string, error) {
+		return s.badgeRepository.GetTenantID(ctx, badgeID)
+	})
+}
+
+// verifyIssuedProof completes the local verification of a Data Integrity proof or an SD-JWT VC.
+// Any key can sign them, the key of the proof is compared to the key of the issued badge.
+func (s *badgeService) verifyIssuedProof(
+	ctx context.Context,
+	result *badgetypes.VerificationResult,
+) (*badgetypes.VerificationResult, error) {
+	result.Source = badgetypes.VERIFICATION_SOURCE_LOCAL
+
+	if !result.Status {
+		return result, nil
+	}
+
+	proof, err := s.badgeRepository.GetProof(ctx, result.Document.ID)
+	if err != nil && !errors.Is(err, badgecore.ErrBadgeNotFound) {
+		return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the proof: %w", err)
+	}
+
+	if proof == nil || proof.VerificationMethod != result.Document.Proof.VerificationMethod {
+		result.Status = false
+		result.Errors = append(result.Errors, &badgetypes.ErrorInfo{
+			Reason:  badgetypes.ErrorReasonVerifiableCredentialInvalidProof,
+			Message: "The badge was not issued with the key of the proof.",
+		})
+	}
+
+	return result, nil
+}
+
+// verifyJose verifies a JOSE enveloped badge locally when the keys and the statuses of its issuer
+// can be resolved, it is verified by the identity node otherwise
+func (s *badgeService) verifyJose(
+	ctx context.Context,
+	badge string,
+	lookups *verifyLookups,
+) (*badgetypes.VerificationResult, error) {
+	result, err := s.verifyJoseLocally(ctx, badge, lookups)
+	if err == nil {
+		result.Source = badgetypes.VERIFICATION_SOURCE_LOCAL
+		return result, nil
+	}
+
+	log.FromContext(ctx).WithError(err).Debug("unable to verify the badge locally, using the identity node")
+
+	// Use the identity service to verify the VC
+	result, err = s.identityService.VerifyVerifiableCredential(ctx, &badge)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Source = badgetypes.VERIFICATION_SOURCE_REMOTE
+
+	return result, nil
+}
+
+// verifyJoseLocally verifies the badges issued by a tenant of the service with the keys of the tenant,
+// their revocation is checked with the repository. The badges of the other issuers are verified
+// with the cached keys and status lists of the issuers.
+func (s *badgeService) verifyJoseLocally(
+	ctx context.Context,
+	badge string,
+	lookups *verifyLookups,
+) (*badgetypes.VerificationResult, error) {
+	vc, keyID, err := badgecore.ParseJose(badge)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := s.getBadgeTenantID(ctx, vc.ID, lookups)
+	if err != nil && !errors.Is(err, badgecore.ErrBadgeNotFound) {
+		return nil, fmt.Errorf("repository in VerifyBadge failed to fetch the tenant of the badge: %w", err)
+	}
+
+	if tenantID != "" {
+		key, err := lookups.pubKeys.get(tenantID+"/"+keyID, func() (jwxjwk.Key, error) {
+			return s.getTenantPubKey(ctx, tenantID, keyID)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return badgecore.VerifyJose(badge, key), nil
+	}
+
+	if s.issuerResolver == nil {
+		return nil, errors.New("the keys of the other issuers are not resolved")
+	}
+
+	key, err := s.issuerResolver.ResolveKey(ctx, vc.Issuer, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := badgecore.VerifyJose(badge, key)
+	if !result.Status {
+		return result, nil
+	}
+
+	for _, status := range result.Document.Status {
+		if !status.IsStatusListEntry() || status.StatusPurpose != badgetypes.StatusPurposeRevocation {
+			continue
+		}
+
+		revoked, err := s.issuerResolver.ResolveStatus(ctx, vc.Issuer, status)
+		if err != nil {
+			return nil, err
+		}
+
+		if revoked {
+			result.Status = false
+			result.Errors = append(result.Errors, &badgetypes.ErrorInfo{
+				Reason:  badgetypes.ErrorReasonVerifiableCredentialRevoked,
+				Message: "The badge is revoked in the status list of its issuer.",
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// getTenantPubKey returns the public key of a tenant, used to verify the badges it issued
+func (s *badgeService) getTenantPubKey(ctx context.Context, tenantID, keyID string) (jwxjwk.Key, error) {
+	pubKey, err := s.keyStore.RetrievePubKey(identitycontext.InsertTenantID(ctx, tenantID), keyID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve the key of the badge: %w", err)
+	}
+
+	data, err := json.Marshal(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the key of the badge: %w", err)
+	}
+
+	key, err := jwxjwk.ParseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the key of the badge: %w", err)
+	}
+
+	return key, nil
+}
+
+// addRevocationReason fails the verification of the revoked badges
+// and returns the reason of the revocation
+func (s *badgeService) addRevocationReason(ctx context.Context, result *badgetypes.VerificationResult) error {
+	if result.Document == nil || result.Document.ID == "" {
+		return nil
+	}
+
+	status, err := s.badgeRepository.GetRevocationStatus(ctx, result.Document.ID)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrCredentialStatusNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("repository in VerifyBadge failed to fetch the revocation status: %w", err)
+	}
+
+	result.Status = false
+	result.RevocationReason = status.RevocationReason
+
+	revoked := slices.ContainsFunc(result.Errors, func(info *badgetypes.ErrorInfo) bool {
+		return info.Reason == badgetypes.ErrorReasonVerifiableCredentialRevoked
+	})
+	if !revoked {
+		result.Errors = append(result.Errors, &badgetypes.ErrorInfo{
+			Reason:  badgetypes.ErrorReasonVerifiableCredentialRevoked,
+			Message: fmt.Sprintf("The badge was revoked on %s.", status.CreatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	return nil
+}
+
+func (s *badgeService) GetBadge(
+	ctx context.Context,
+	appID string,
+) (*badgetypes.Badge, error) {
+	if appID == "" {
+		return nil, errutil.ValidationFailed("badge.invalidAppID", "Invalid application ID.")
+	}
+
+	badge, err := s.badgeRepository.GetLatestByAppIdOrResolverMetadataID(ctx, appID)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrBadgeNotFound) {
+			return nil, errutil.NotFound("badge.notFound", "No badge found for the application.")
+		}
+
+		return nil, fmt.Errorf("repository in GetBadge failed to fetch latest badge for app %s: %w", appID, err)
+	}
+
+	return badge, nil
+}
+
+func (s *badgeService) ListBadges(
+	ctx context.Context,
+	appID string,
+	paginationFilter pagination.PaginationFilter,
+) (*pagination.Pageable[badgetypes.Badge], error) {
+	_, err := s.appRepository.GetApp(ctx, appID)
+	if err != nil {
+		if errors.Is(err, appcore.ErrAppNotFound) {
+			return nil, errutil.NotFound("badge.appNotFound", "Application not found.")
+		}
+
+		return nil, fmt.Errorf("repository in ListBadges failed to get the application %s: %w", appID, err)
+	}
+
+	badges, err := s.badgeRepository.ListByAppID(ctx, appID, paginationFilter)
+	if err != nil {
+		return nil, fmt.Errorf("repository in ListBadges failed to fetch the badges of app %s: %w", appID, err)
+	}
+
+	return badges, nil
+}
+
+func (s *badgeService) GetBadgeByID(
+	ctx context.Context,
+	id string,
+) (*badgetypes.Badge, error) {
+	if id == "" {
+		return nil, errutil.ValidationFailed("badge.invalidBadgeID", "Invalid badge ID.")
+	}
+
+	badge, err := s.badgeRepository.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrBadgeNotFound) {
+			return nil, errutil.NotFound("badge.badgeNotFound", "Badge not found.")
+		}
+
+		return nil, fmt.Errorf("repository in GetBadgeByID failed to fetch the badge %s: %w", id, err)
+	}
+
+	return badge, nil
+}
+
+func (s *badgeService) DiffBadges(
+	ctx context.Context,
+	fromBadgeID string,
+	toBadgeID string,
+) (*badgetypes.BadgeDiff, error) {
+	from, err := s.GetBadgeByID(ctx, fromBadgeID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.GetBadgeByID(ctx, toBadgeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.AppID != to.AppID {
+		return nil, errutil.ValidationFailed(
+			"badge.differentApps",
+			"Only the badges of the same application can be compared.",
+		)
+	}
+
+	return badgecore.Diff(from, to), nil
+}
+
+func (s *badgeService) GetStatusList(
+	ctx context.Context,
+	id string,
+) (*badgetypes.StatusListCredential, error) {
+	if id == "" {
+		return nil, errutil.ValidationFailed("badge.invalidStatusListID", "Invalid status list ID.")
+	}
+
+	statusList, err := s.statusListRepository.GetStatusList(ctx, id)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrStatusListNotFound) {
+			return nil, errutil.NotFound("badge.statusListNotFound", "Status list not found.")
+		}
+
+		return nil, fmt.Errorf("repository in GetStatusList failed to fetch the status list %s: %w", id, err)
+	}
+
+	if statusList.Credential == nil {
+		return nil, errutil.NotFound("badge.statusListNotFound", "Status list not found.")
+	}
+
+	return statusList.Credential, nil
+}
+
+// GetIssuerDocument returns the controlled identifier document of an issuer of any tenant,
+// with the keys of the Data Integrity proofs of its badges
+func (s *badgeService) GetIssuerDocument(
+	ctx context.Context,
+	issuer string,
+) (*badgetypes.IssuerDocument, error) {
+	if issuer == "" {
+		return nil, errutil.ValidationFailed("badge.invalidIssuer", "Invalid issuer.")
+	}
+
+	methods, err := s.badgeRepository.GetVerificationMethods(ctx, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("repository in GetIssuerDocument failed to fetch the verification methods: %w", err)
+	}
+
+	if len(methods) == 0 {
+		return nil, errutil.NotFound("badge.issuerNotFound", "Issuer not found.")
+	}
+
+	assertionMethod := make([]string, 0, len(methods))
+	for _, method := range methods {
+		assertionMethod = append(assertionMethod, method.ID)
+	}
+
+	return &badgetypes.IssuerDocument{
+		Context:            []string{badgetypes.ControlledIdentifierContext},
+		ID:                 badgecore.IssuerDocumentURL(s.apiURL, issuer),
+		VerificationMethod: methods,
+		AssertionMethod:    assertionMethod,
+	}, nil
+}
+
+func (s *badgeService) RevokeBadge(
+	ctx context.Context,
+	appID string,
+	badgeID string,
+	reason badgetypes.RevocationReason,
+	comment string,
+) error {
+	if reason == badgetypes.REVOCATION_REASON_UNSPECIFIED {
+		return errutil.ValidationFailed("badge.invalidRevocationReason", "The reason of the revocation is required.")
+	}
+
+	settings, privKey, err := s.getIssuerKey(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	clientCredentials, err := s.credentialStore.Get(ctx, appID)
+	if err != nil {
+		return fmt.Errorf("unable to get client credentials in RevokeBadge for app %s: %w", appID, err)
+	}
+
+	issuer := identitycore.Issuer{
+		CommonName: settings.IssuerID,
+		KeyID:      settings.KeyID,
+	}
+
+	userID, _ := identitycontext.GetUserID(ctx)
+	revocation := &badgecore.Revocation{
+		Reason:    reason,
+		Comment:   comment,
+		RevokedBy: userID,
+	}
+
+	if badgeID == "" {
+		err = s.badgeRevoker.RevokeAll(ctx, appID, revocation, clientCredentials, &issuer, privKey)
+	} else {
+		err = s.badgeRevoker.Revoke(ctx, appID, badgeID, revocation, clientCredentials, &issuer, privKey)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, badgecore.ErrBadgeNotFound):
+			return errutil.NotFound("badge.badgeNotFound", "Badge not found.")
+		case errors.Is(err, badgecore.ErrBadgeAlreadyRevoked):
+			return errutil.InvalidRequest("badge.alreadyRevoked", "The badge is already revoked.")
+		}
+
+		return fmt.Errorf("unable to revoke the badges of the app %s: %w", appID, err)
+	}
+
+	log.FromContext(ctx).Infof("revoked the badges of the app %s (reason: %s)", appID, reason)
+
+	return nil
+}
+
+func (s *badgeService) SuspendBadge(
+	ctx context.Context,
+	appID string,
+	reason string,
+) error {
+	if reason == "" {
+		return errutil.ValidationFailed("badge.emptySuspensionReason", "The reason of the suspension is required.")
+	}
+
+	settings, privKey, err := s.getIssuerKey(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	userID, _ := identitycontext.GetUserID(ctx)
+
+	err = s.badgeSuspender.SuspendAll(ctx, appID, userID, reason, settings.IssuerID, privKey)
+	if err != nil {
+		switch {
+		case errors.Is(err, badgecore.ErrBadgeNotFound):
+			return errutil.NotFound("badge.activeBadgeNotFound", "The application has no active badge.")
+		case errors.Is(err, badgecore.ErrBadgeAlreadySuspended):
+			return errutil.InvalidRequest(
+				"badge.alreadySuspended",
+				"The badge of the application is already suspended.",
+			)
+		}
+
+		return fmt.Errorf("unable to suspend the badges of the app %s: %w", appID, err)
+	}
+
+	log.FromContext(ctx).Infof("suspended the badges of the app %s", appID)
+
+	return nil
+}
+
+func (s *badgeService) ResumeBadge(
+	ctx context.Context,
+	appID string,
+) error {
+	settings, privKey, err := s.getIssuerKey(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	err = s.badgeSuspender.ResumeAll(ctx, appID, settings.IssuerID, privKey)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrBadgeNotSuspended) {
+			return errutil.InvalidRequest("badge.notSuspended", "The badge of the application is not suspended.")
+		}
+
+		return fmt.Errorf("unable to resume the badges of the app %s: %w", appID, err)
+	}
+
+	log.FromContext(ctx).Infof("resumed the badges of the app %s", appID)
+
+	return nil
+}
+
+// getIssuerKey makes sure the app exists and returns the issuer settings with the signing key
+func (s *badgeService) getIssuerKey(
+	ctx context.Context,
+	appID string,
+) (*settingstypes.IssuerSettings, *jwk.Jwk, error) {
+	_, err := s.appRepository.GetApp(ctx, appID)
+	if err != nil {
+		if errors.Is(err, appcore.ErrAppNotFound) {
+			return nil, nil, errutil.NotFound("badge.appNotFound", "Application not found.")
+		}
+
+		return nil, nil, fmt.Errorf("repository failed to get the application: %w", err)
+	}
+
+	settings, err := s.settingsRepository.GetIssuerSettings(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("repository failed to fetch settings: %w", err)
+	}
+
+	privKey, err := s.keyStore.RetrievePrivKey(ctx, settings.KeyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("key store failed to retrieve private key (%s): %w", settings.KeyID, err)
+	}
+
+	return settings, privKey, nil
+}
+
+func (s *badgeService) ListTrustPolicies(ctx context.Context) ([]*badgetypes.TrustPolicy, error) {
+	policies, err := s.trustPolicyRepository.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository in ListTrustPolicies failed to fetch the trust policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+func (s *badgeService) CreateTrustPolicy(
+	ctx context.Context,
+	policy *badgetypes.TrustPolicy,
+) (*badgetypes.TrustPolicy, error) {
+	err := s.validateTrustPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.ID = uuid.NewString()
+
+	err = s.trustPolicyRepository.Create(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("repository in CreateTrustPolicy failed to create the trust policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (s *badgeService) UpdateTrustPolicy(
+	ctx context.Context,
+	policy *badgetypes.TrustPolicy,
+) (*badgetypes.TrustPolicy, error) {
+	existing, err := s.getTrustPolicyByID(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateTrustPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.CreatedAt = existing.CreatedAt
+
+	err = s.trustPolicyRepository.Update(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("repository in UpdateTrustPolicy failed to update the trust policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (s *badgeService) DeleteTrustPolicy(ctx context.Context, id string) error {
+	policy, err := s.getTrustPolicyByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.trustPolicyRepository.Delete(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("repository in DeleteTrustPolicy failed to delete the trust policy: %w", err)
+	}
+
+	return nil
+}
+
+func (s *badgeService) getTrustPolicyByID(ctx context.Context, id string) (*badgetypes.TrustPolicy, error) {
+	if id == "" {
+		return nil, errutil.ValidationFailed("badge.invalidTrustPolicyID", "Invalid trust policy ID.")
+	}
+
+	policy, err := s.trustPolicyRepository.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, badgecore.ErrTrustPolicyNotFound) {
+			return nil, errutil.NotFound("badge.trustPolicyNotFound", "Trust policy not found.")
+		}
+
+		return nil, fmt.Errorf("repository failed to fetch the trust policy %s: %w", id, err)
+	}
+
+	return policy, nil
+}
+
+// validateTrustPolicy validates the checks of the trust policy
+// and the uniqueness of its name in the tenant
+func (s *badgeService) validateTrustPolicy(ctx context.Context, policy *badgetypes.TrustPolicy) error {
+	if policy.Name == "" {
+		return errutil.ValidationFailed("badge.invalidTrustPolicyName", "The name of the trust policy is required.")
+	}
+
+	if policy.IssuedWithinDays < 0 {
+		return errutil.ValidationFailed(
+			"badge.invalidTrustPolicyIssuedWithinDays",
+			"The maximum age of the trusted badges cannot be negative.",
+		)
+	}
+
+	for _, typ := range policy.BadgeTypes {
+		if typ != badgetypes.BADGE_TYPE_AGENT_BADGE && typ != badgetypes.BADGE_TYPE_MCP_BADGE {
+			return errutil.ValidationFailed(
+				"badge.invalidTrustPolicyBadgeType",
+				"Invalid badge type %s.",
+				typ.String(),
+			)
+		}
+	}
+
+	existing, err := s.trustPolicyRepository.GetByName(ctx, policy.Name)
+	if err != nil && !errors.Is(err, badgecore.ErrTrustPolicyNotFound) {
+		return fmt.Errorf("repository failed to fetch the trust policy %s: %w", policy.Name, err)
+	}
+
+	if existing != nil && existing.ID != policy.ID {
+		return errutil.ValidationFailed(
+			"badge.trustPolicyAlreadyExists",
+			"A trust policy named %s already exists.",
+			policy.Name,
+		)
+	}
+
+	return nil
 }
@@ -5,15 +5,21 @@ package bff_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/bff"
+	appcore "github.com/agntcy/identity-service/internal/core/app"
 	appmocks "github.com/agntcy/identity-service/internal/core/app/mocks"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	badgea2amocks "github.com/agntcy/identity-service/internal/core/badge/a2a/mocks"
 	"github.com/agntcy/identity-service/internal/core/badge/mcp"
 	badgemcpmocks "github.com/agntcy/identity-service/internal/core/badge/mcp/mocks"
 	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	"github.com/agntcy/identity-service/internal/core/badge/sdjwt"
 	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	identitycore "github.com/agntcy/identity-service/internal/core/identity"
 	identitymocks "github.com/agntcy/identity-service/internal/core/identity/mocks"
@@ -22,28 +28,34 @@ import (
 	policymocks "github.com/agntcy/identity-service/internal/core/policy/mocks"
 	settingsmocks "github.com/agntcy/identity-service/internal/core/settings/mocks"
 	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/google/uuid"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // IssueBadge
 
 type issueBadgeSuccessFixture struct {
-	ctx          context.Context //nolint:containedctx // to simplify the test cases
-	app          *apptypes.App
-	settingsRepo *settingsmocks.Repository
-	appRepo      *appmocks.Repository
-	keyStore     *identitymocks.KeyStore
-	credStore    *idpmocks.CredentialStore
-	identityServ *identitymocks.Service
-	tasksServ    *policymocks.TaskService
-	badgeRevoker *badgemocks.Revoker
-	badgeRepo    *badgemocks.Repository
+	ctx           context.Context //nolint:containedctx // to simplify the test cases
+	app           *apptypes.App
+	settingsRepo  *settingsmocks.Repository
+	appRepo       *appmocks.Repository
+	keyStore      *identitymocks.KeyStore
+	credStore     *idpmocks.CredentialStore
+	identityServ  *identitymocks.Service
+	tasksServ     *policymocks.TaskService
+	badgeRevoker  *badgemocks.Revoker
+	badgeRepo     *badgemocks.Repository
+	statusListSrv *badgemocks.StatusListService
+	badgeSettings *settingstypes.BadgeSettings
 }
 
 func initTestServiceIssueBadgeSuccessFixture(t *testing.T) *issueBadgeSuccessFixture {
@@ -65,8 +77,21 @@ func initTestServiceIssueBadgeSuccessFixture(t *testing.T) *issueBadgeSuccessFix
 	settingsRepo := settingsmocks.NewRepository(t)
 	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(issSettings, nil)
 
+	fixture := &issueBadgeSuccessFixture{
+		badgeSettings: &settingstypes.BadgeSettings{},
+	}
+
+	settingsRepo.EXPECT().
+		GetBadgeSettings(ctx).
+		RunAndReturn(func(ctx context.Context) (*settingstypes.BadgeSettings, error) {
+			return fixture.badgeSettings, nil
+		})
+
 	appRepo := appmocks.NewRepository(t)
 	appRepo.EXPECT().GetApp(ctx, app.ID).Return(app, nil)
+	appRepo.EXPECT().
+		GetAppStatuses(ctx, app.ID).
+		Return(map[string]apptypes.AppStatus{app.ID: apptypes.APP_STATUS_ACTIVE}, nil)
 
 	keyStore := identitymocks.NewKeyStore(t)
 	keyStore.EXPECT().
@@ -93,23 +118,46 @@ func initTestServiceIssueBadgeSuccessFixture(t *testing.T) *issueBadgeSuccessFix
 		Return(nil, nil)
 
 	badgeRevoker := badgemocks.NewRevoker(t)
-	badgeRevoker.EXPECT().RevokeAll(ctx, app.ID, mock.Anything, issuer, mock.Anything).Return(nil)
+	badgeRevoker.EXPECT().
+		RevokeAll(
+			ctx,
+			app.ID,
+			&badgecore.Revocation{Reason: badgetypes.REVOCATION_REASON_SUPERSEDED},
+			mock.Anything,
+			issuer,
+			mock.Anything,
+		).
+		Return(nil)
 
 	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		Transaction(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
 	badgeRepo.EXPECT().Create(ctx, mock.Anything).Return(nil)
 
-	return &issueBadgeSuccessFixture{
-		ctx:          ctx,
-		app:          app,
-		settingsRepo: settingsRepo,
-		appRepo:      appRepo,
-		keyStore:     keyStore,
-		credStore:    credStore,
-		identityServ: identityServ,
-		tasksServ:    tasksServ,
-		badgeRevoker: badgeRevoker,
-		badgeRepo:    badgeRepo,
-	}
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		Allocate(ctx, badgetypes.StatusPurposeRevocation, issSettings.IssuerID, mock.Anything).
+		Return(&badgetypes.CredentialStatus{Type: badgetypes.BitstringStatusListEntryType}, nil)
+	statusListSrv.EXPECT().
+		Allocate(ctx, badgetypes.StatusPurposeSuspension, issSettings.IssuerID, mock.Anything).
+		Return(&badgetypes.CredentialStatus{Type: badgetypes.BitstringStatusListEntryType}, nil)
+
+	fixture.ctx = ctx
+	fixture.app = app
+	fixture.settingsRepo = settingsRepo
+	fixture.appRepo = appRepo
+	fixture.keyStore = keyStore
+	fixture.credStore = credStore
+	fixture.identityServ = identityServ
+	fixture.tasksServ = tasksServ
+	fixture.badgeRevoker = badgeRevoker
+	fixture.badgeRepo = badgeRepo
+	fixture.statusListSrv = statusListSrv
+
+	return fixture
 }
 
 func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
@@ -120,6 +168,7 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 		appType    apptypes.AppType
 		sutFactory func(t *testing.T, fixture *issueBadgeSuccessFixture) bff.BadgeService
 		claims     string
+		discovery  *badgetypes.BadgeDiscovery
 	}{
 		"issue A2A badge from a base64 schema": {
 			options: bff.WithA2A(nil, ptrutil.Ptr("YTJhX2FnZW50")), // base64 value is a2a_agent
@@ -128,6 +177,10 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 			sutFactory: func(t *testing.T, fixture *issueBadgeSuccessFixture) bff.BadgeService {
 				t.Helper()
 
+				fixture.tasksServ.EXPECT().
+					CreateForA2A(fixture.ctx, fixture.app.ID, ptrutil.DerefStr(fixture.app.Name), "a2a_agent").
+					Return(nil, nil)
+
 				return bff.NewBadgeService(
 					fixture.settingsRepo,
 					fixture.appRepo,
@@ -139,6 +192,13 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 					fixture.credStore,
 					fixture.tasksServ,
 					fixture.badgeRevoker,
+					nil,
+					fixture.statusListSrv,
+					nil,
+					nil,
+					nil,
+					0,
+					"",
 				)
 			},
 		},
@@ -146,12 +206,19 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 			options: bff.WithA2A(ptrutil.Ptr("agent_card_wellknown_url"), nil),
 			appType: apptypes.APP_TYPE_AGENT_A2A,
 			claims:  "a2a_agent",
+			discovery: &badgetypes.BadgeDiscovery{
+				A2AWellKnownUrl: "agent_card_wellknown_url",
+			},
 			sutFactory: func(t *testing.T, fixture *issueBadgeSuccessFixture) bff.BadgeService {
 				t.Helper()
 
 				a2aClient := badgea2amocks.NewDiscoveryClient(t)
 				a2aClient.EXPECT().Discover(fixture.ctx, mock.Anything).Return("a2a_agent", nil)
 
+				fixture.tasksServ.EXPECT().
+					CreateForA2A(fixture.ctx, fixture.app.ID, ptrutil.DerefStr(fixture.app.Name), "a2a_agent").
+					Return(nil, nil)
+
 				return bff.NewBadgeService(
 					fixture.settingsRepo,
 					fixture.appRepo,
@@ -163,6 +230,13 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 					fixture.credStore,
 					fixture.tasksServ,
 					fixture.badgeRevoker,
+					nil,
+					fixture.statusListSrv,
+					nil,
+					nil,
+					nil,
+					0,
+					"",
 				)
 			},
 		},
@@ -184,6 +258,13 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 					fixture.credStore,
 					fixture.tasksServ,
 					fixture.badgeRevoker,
+					nil,
+					fixture.statusListSrv,
+					nil,
+					nil,
+					nil,
+					0,
+					"",
 				)
 			},
 		},
@@ -191,6 +272,10 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 			options: bff.WithMCP(ptrutil.Ptr("mcp_server"), ptrutil.Ptr("mcp_url"), nil),
 			appType: apptypes.APP_TYPE_MCP_SERVER,
 			claims:  `{"name":"mcp_server","url":""}`,
+			discovery: &badgetypes.BadgeDiscovery{
+				McpName: "mcp_server",
+				McpUrl:  "mcp_url",
+			},
 			sutFactory: func(t *testing.T, fixture *issueBadgeSuccessFixture) bff.BadgeService {
 				t.Helper()
 
@@ -214,6 +299,13 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 					fixture.credStore,
 					fixture.tasksServ,
 					fixture.badgeRevoker,
+					nil,
+					fixture.statusListSrv,
+					nil,
+					nil,
+					nil,
+					0,
+					"",
 				)
 			},
 		},
@@ -243,6 +335,13 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 					fixture.credStore,
 					fixture.tasksServ,
 					fixture.badgeRevoker,
+					nil,
+					fixture.statusListSrv,
+					nil,
+					nil,
+					nil,
+					0,
+					"",
 				)
 			},
 		},
@@ -260,6 +359,290 @@ func TestBadgeService_IssueBadge_should_succeed(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Equal(t, &badgetypes.BadgeClaims{Badge: tc.claims}, badge.CredentialSubject)
+			assert.Equal(t, tc.discovery, badge.Discovery)
+			assert.Equal(t, badgetypes.BitstringStatusListEntryType, badge.Status[0].Type)
+		})
+	}
+}
+
+func TestBadgeService_IssueBadge_should_set_the_badge_lifetime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		badgeSettings *settingstypes.BadgeSettings
+		defaultValue  time.Duration
+		expected      time.Duration
+	}{
+		"lifetime of the app type": {
+			badgeSettings: &settingstypes.BadgeSettings{
+				DefaultLifetimeSeconds:   7200,
+				AgentOASFLifetimeSeconds: 3600,
+			},
+			defaultValue: 3 * time.Hour,
+			expected:     time.Hour,
+		},
+		"default lifetime of the tenant": {
+			badgeSettings: &settingstypes.BadgeSettings{
+				DefaultLifetimeSeconds:   7200,
+				McpServerLifetimeSeconds: 3600,
+			},
+			defaultValue: 3 * time.Hour,
+			expected:     2 * time.Hour,
+		},
+		"default lifetime of the deployment": {
+			badgeSettings: &settingstypes.BadgeSettings{},
+			defaultValue:  3 * time.Hour,
+			expected:      3 * time.Hour,
+		},
+	}
+
+	//nolint:paralleltest // running the tests in parallel will cause memory issues
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			fixture := initTestServiceIssueBadgeSuccessFixture(t)
+			fixture.app.Type = apptypes.APP_TYPE_AGENT_OASF
+			fixture.badgeSettings = tc.badgeSettings
+
+			sut := bff.NewBadgeService(
+				fixture.settingsRepo,
+				fixture.appRepo,
+				fixture.badgeRepo,
+				nil,
+				nil,
+				fixture.keyStore,
+				fixture.identityServ,
+				fixture.credStore,
+				fixture.tasksServ,
+				fixture.badgeRevoker,
+				nil,
+				fixture.statusListSrv,
+				nil,
+				nil,
+				nil,
+				tc.defaultValue,
+				"",
+			)
+
+			badge, err := sut.IssueBadge(fixture.ctx, fixture.app.ID, bff.WithOASF("b2FzZl9hZ2VudA=="))
+
+			assert.NoError(t, err)
+
+			expiresAt, ok := badge.VerifiableCredential.ExpiresAt()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(tc.expected), expiresAt, time.Minute)
+		})
+	}
+}
+
+func TestBadgeService_IssueBadge_should_return_err_when_app_is_suspended(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	app := &apptypes.App{ID: uuid.NewString()}
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{}, nil)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, app.ID).Return(app, nil)
+	appRepo.EXPECT().
+		GetAppStatuses(ctx, app.ID).
+		Return(map[string]apptypes.AppStatus{app.ID: apptypes.APP_STATUS_SUSPENDED}, nil)
+
+	sut := bff.NewBadgeService(
+		settingsRepo,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	_, err := sut.IssueBadge(ctx, app.ID, bff.WithOASF("b2FzZl9hZ2VudA=="))
+
+	assert.ErrorIs(t, err, errutil.InvalidRequest(
+		"badge.appSuspended",
+		"The application is suspended, resume its badge before issuing a new one.",
+	))
+}
+
+func TestBadgeService_IssueBadge_should_issue_an_sd_jwt_vc_bound_to_the_holder(t *testing.T) {
+	t.Parallel()
+
+	fixture := initTestServiceIssueBadgeSuccessFixture(t)
+	fixture.app.Type = apptypes.APP_TYPE_AGENT_OASF
+
+	sut := bff.NewBadgeService(
+		fixture.settingsRepo,
+		fixture.appRepo,
+		fixture.badgeRepo,
+		nil,
+		nil,
+		fixture.keyStore,
+		fixture.identityServ,
+		fixture.credStore,
+		fixture.tasksServ,
+		fixture.badgeRevoker,
+		nil,
+		fixture.statusListSrv,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+	holderKey := `{"kty":"EC","crv":"P-256",` +
+		`"x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}`
+
+	badge, err := sut.IssueBadge(
+		fixture.ctx,
+		fixture.app.ID,
+		bff.WithOASF("b2FzZl9hZ2VudA=="),
+		bff.WithProofFormat(badgetypes.PROOF_FORMAT_SD_JWT_VC),
+		bff.WithHolderKey(holderKey),
+	)
+
+	assert.NoError(t, err)
+	assert.True(t, badge.Proof.IsSdJwt())
+	assert.JSONEq(t, holderKey, badgecore.HolderKey(badge))
+}
+
+func TestBadgeService_IssueBadge_should_publish_the_key_of_a_data_integrity_proof(t *testing.T) {
+	t.Parallel()
+
+	fixture := initTestServiceIssueBadgeSuccessFixture(t)
+	fixture.app.Type = apptypes.APP_TYPE_AGENT_OASF
+
+	var published *badgetypes.VerificationMethod
+
+	fixture.badgeRepo.EXPECT().
+		SaveVerificationMethod(fixture.ctx, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ string, method *badgetypes.VerificationMethod) {
+			published = method
+		}).
+		Return(nil)
+
+	sut := bff.NewBadgeService(
+		fixture.settingsRepo,
+		fixture.appRepo,
+		fixture.badgeRepo,
+		nil,
+		nil,
+		fixture.keyStore,
+		fixture.identityServ,
+		fixture.credStore,
+		fixture.tasksServ,
+		fixture.badgeRevoker,
+		nil,
+		fixture.statusListSrv,
+		nil,
+		nil,
+		nil,
+		0,
+		"https://api",
+	)
+
+	badge, err := sut.IssueBadge(
+		fixture.ctx,
+		fixture.app.ID,
+		bff.WithOASF("b2FzZl9hZ2VudA=="),
+		bff.WithProofFormat(badgetypes.PROOF_FORMAT_EDDSA_JCS_2022),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, badge.Proof.VerificationMethod, published.ID)
+	assert.Equal(t, badgecore.IssuerDocumentURL("https://api", badge.Issuer), published.Controller)
+
+	credential, _ := json.Marshal(badge.VerifiableCredential)
+	assert.True(t, badgecore.VerifyDataIntegrity(string(credential)).Status)
+}
+
+func TestBadgeService_IssueBadge_should_return_err_when_holder_key_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		proofFormat badgetypes.ProofFormat
+		holderKey   string
+		err         error
+	}{
+		"holder key of a jose badge": {
+			proofFormat: badgetypes.PROOF_FORMAT_JOSE,
+			holderKey:   `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`,
+			err: errutil.ValidationFailed(
+				"badge.holderKeyNotSupported",
+				"Only the SD-JWT VC badges can be bound to a holder key.",
+			),
+		},
+		"private holder key": {
+			proofFormat: badgetypes.PROOF_FORMAT_SD_JWT_VC,
+			holderKey: `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",` +
+				`"d":"nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A"}`,
+			err: errutil.ValidationFailed("badge.invalidHolderKey", "The holder key must be a public JWK."),
+		},
+		"malformed holder key": {
+			proofFormat: badgetypes.PROOF_FORMAT_SD_JWT_VC,
+			holderKey:   "holder_key",
+			err:         errutil.ValidationFailed("badge.invalidHolderKey", "The holder key must be a public JWK."),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			app := &apptypes.App{ID: uuid.NewString()}
+
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{}, nil)
+			settingsRepo.EXPECT().GetBadgeSettings(ctx).Return(&settingstypes.BadgeSettings{}, nil)
+
+			appRepo := appmocks.NewRepository(t)
+			appRepo.EXPECT().GetApp(ctx, app.ID).Return(app, nil)
+			appRepo.EXPECT().
+				GetAppStatuses(ctx, app.ID).
+				Return(map[string]apptypes.AppStatus{app.ID: apptypes.APP_STATUS_ACTIVE}, nil)
+
+			sut := bff.NewBadgeService(
+				settingsRepo,
+				appRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				0,
+				"",
+			)
+
+			_, err := sut.IssueBadge(
+				ctx,
+				app.ID,
+				bff.WithOASF("b2FzZl9hZ2VudA=="),
+				bff.WithProofFormat(tc.proofFormat),
+				bff.WithHolderKey(tc.holderKey),
+			)
+
+			assert.ErrorIs(t, err, tc.err)
 		})
 	}
 }
@@ -275,25 +658,1413 @@ func TestBadgeService_VerifyBadge_should_not_return_an_error(t *testing.T) {
 	identityServ.EXPECT().
 		VerifyVerifiableCredential(ctx, &validBadge).
 		Return(&badgetypes.VerificationResult{}, nil)
-	sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, identityServ, nil, nil, nil)
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		identityServ,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
 
 	_, err := sut.VerifyBadge(ctx, &validBadge)
 
 	assert.NoError(t, err)
 }
 
-func TestBadgeService_VerifyBadge_should_return_err_when_badge_is_null(t *testing.T) {
+func TestBadgeService_VerifyBadge_should_reject_an_expired_badge(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	expiredBadge := "expired_badge"
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		VerifyVerifiableCredential(ctx, &expiredBadge).
+		Return(&badgetypes.VerificationResult{
+			Status: true,
+			Document: &badgetypes.VerifiableCredential{
+				ExpirationDate: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+		}, nil)
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		identityServ,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	result, err := sut.VerifyBadge(ctx, &expiredBadge)
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, badgetypes.ErrorReasonVerifiableCredentialExpired, result.Errors[0].Reason)
+}
+
+func TestBadgeService_VerifyBadge_should_return_the_revocation_reason(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	revokedBadge := "revoked_badge"
+	badgeID := uuid.NewString()
 
-	_, err := sut.VerifyBadge(ctx, nil)
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		VerifyVerifiableCredential(ctx, &revokedBadge).
+		Return(&badgetypes.VerificationResult{
+			Status:   true,
+			Document: &badgetypes.VerifiableCredential{ID: badgeID},
+		}, nil)
 
-	assert.Error(t, err)
-	assert.ErrorIs(
-		t,
-		err,
-		errutil.ValidationFailed("badge.emptyBadge", "Badge or Verifiable Credential is empty"),
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetRevocationStatus(ctx, badgeID).
+		Return(&badgetypes.CredentialStatus{
+			Purpose:          badgetypes.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+			RevocationReason: badgetypes.REVOCATION_REASON_KEY_COMPROMISE,
+		}, nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		nil,
+		identityServ,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	result, err := sut.VerifyBadge(ctx, &revokedBadge)
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, badgetypes.REVOCATION_REASON_KEY_COMPROMISE, result.RevocationReason)
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, badgetypes.ErrorReasonVerifiableCredentialRevoked, result.Errors[0].Reason)
+}
+
+func TestBadgeService_VerifyBadge_should_verify_a_data_integrity_badge(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherPrivKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+	issue := func(key *jwk.Jwk) *badgetypes.Badge {
+		b, _ := badgecore.Issue(
+			uuid.NewString(),
+			"issuer",
+			badgecore.IssuerDocumentURL("https://api", "issuer"),
+			badgetypes.BADGE_TYPE_AGENT_BADGE,
+			&badgetypes.BadgeClaims{ID: uuid.NewString(), Badge: "{}"},
+			key,
+			badgetypes.PROOF_FORMAT_EDDSA_JCS_2022,
+			"",
+			0,
+		)
+
+		return b
+	}
+
+	testCases := map[string]*struct {
+		storedProof func(b *badgetypes.Badge) (*badgetypes.Proof, error)
+		status      bool
+	}{
+		"issued with the key of the proof": {
+			storedProof: func(b *badgetypes.Badge) (*badgetypes.Proof, error) {
+				return b.Proof, nil
+			},
+			status: true,
+		},
+		"issued with another key": {
+			storedProof: func(b *badgetypes.Badge) (*badgetypes.Proof, error) {
+				return issue(otherPrivKey).Proof, nil
+			},
+			status: false,
+		},
+		"not issued by the service": {
+			storedProof: func(b *badgetypes.Badge) (*badgetypes.Proof, error) {
+				return nil, badgecore.ErrBadgeNotFound
+			},
+			status: false,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			b := issue(privKey)
+			credential, _ := json.Marshal(b.VerifiableCredential)
+			badge := string(credential)
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().GetProof(ctx, b.ID).Return(tc.storedProof(b))
+			badgeRepo.EXPECT().
+				GetRevocationStatus(ctx, b.ID).
+				Return(nil, badgecore.ErrCredentialStatusNotFound)
+
+			sut := bff.NewBadgeService(
+				nil,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				0,
+				"",
+			)
+
+			result, err := sut.VerifyBadge(ctx, &badge)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, result.Status)
+
+			if !tc.status {
+				assert.Len(t, result.Errors, 1)
+				assert.Equal(t, badgetypes.ErrorReasonVerifiableCredentialInvalidProof, result.Errors[0].Reason)
+			}
+		})
+	}
+}
+
+func issueJoseBadge(t *testing.T, privKey *jwk.Jwk) *badgetypes.Badge {
+	t.Helper()
+
+	b, err := badgecore.Issue(
+		uuid.NewString(),
+		"issuer",
+		"",
+		badgetypes.BADGE_TYPE_AGENT_BADGE,
+		&badgetypes.BadgeClaims{ID: uuid.NewString(), Badge: "{}"},
+		privKey,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
+	)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestBadgeService_VerifyBadge_should_verify_a_badge_of_the_service_locally(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	b := issueJoseBadge(t, privKey)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("tenant_id", nil)
+	badgeRepo.EXPECT().
+		GetRevocationStatus(ctx, b.ID).
+		Return(nil, badgecore.ErrCredentialStatusNotFound)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().
+		RetrievePubKey(mock.Anything, "key_id").
+		RunAndReturn(func(ctx context.Context, id string) (*jwk.Jwk, error) {
+			tenantID, _ := identitycontext.GetTenantID(ctx)
+			assert.Equal(t, "tenant_id", tenantID)
+
+			return privKey.PublicKey(), nil
+		})
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	result, err := sut.VerifyBadge(ctx, &b.Proof.ProofValue)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Status)
+	assert.Equal(t, badgetypes.VERIFICATION_SOURCE_LOCAL, result.Source)
+	assert.Equal(t, b.ID, result.Document.ID)
+}
+
+func TestBadgeService_VerifyBadge_should_reject_a_badge_of_the_service_signed_with_another_key(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	b := issueJoseBadge(t, privKey)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("tenant_id", nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(mock.Anything, "key_id").Return(otherKey.PublicKey(), nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
 	)
+
+	result, err := sut.VerifyBadge(ctx, &b.Proof.ProofValue)
+
+	assert.NoError(t, err)
+	assert.False(t, result.Status)
+	assert.Equal(t, badgetypes.VERIFICATION_SOURCE_LOCAL, result.Source)
+	assert.Equal(t, badgetypes.ErrorReasonVerifiableCredentialInvalidProof, result.Errors[0].Reason)
+}
+
+func TestBadgeService_VerifyBadge_should_verify_a_badge_of_another_issuer_with_its_cached_keys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		revoked        bool
+		expectedStatus bool
+	}{
+		"active badge": {
+			revoked:        false,
+			expectedStatus: true,
+		},
+		"revoked badge": {
+			revoked:        true,
+			expectedStatus: false,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+			status := &badgetypes.CredentialStatus{
+				Type:                 badgetypes.BitstringStatusListEntryType,
+				StatusPurpose:        badgetypes.StatusPurposeRevocation,
+				StatusListIndex:      "7",
+				StatusListCredential: "https://issuer.example/status-lists/1",
+			}
+			b, _ := badgecore.Issue(
+				uuid.NewString(),
+				"issuer",
+				"",
+				badgetypes.BADGE_TYPE_AGENT_BADGE,
+				&badgetypes.BadgeClaims{ID: uuid.NewString(), Badge: "{}"},
+				privKey,
+				badgetypes.PROOF_FORMAT_JOSE,
+				"",
+				0,
+				status,
+			)
+			pubKey, _ := json.Marshal(privKey.PublicKey())
+			key, _ := jwxjwk.ParseKey(pubKey)
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("", badgecore.ErrBadgeNotFound)
+			badgeRepo.EXPECT().
+				GetRevocationStatus(ctx, b.ID).
+				Return(nil, badgecore.ErrCredentialStatusNotFound)
+
+			issuerResolver := badgemocks.NewIssuerResolver(t)
+			issuerResolver.EXPECT().ResolveKey(ctx, "issuer", "key_id").Return(key, nil)
+			issuerResolver.EXPECT().ResolveStatus(ctx, "issuer", status).Return(tc.revoked, nil)
+
+			sut := bff.NewBadgeService(
+				nil,
+				nil,
+				badgeRepo,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				issuerResolver,
+				0,
+				"",
+			)
+
+			result, err := sut.VerifyBadge(ctx, &b.Proof.ProofValue)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, result.Status)
+			assert.Equal(t, badgetypes.VERIFICATION_SOURCE_LOCAL, result.Source)
+		})
+	}
+}
+
+func TestBadgeService_VerifyBadge_should_use_the_identity_node_when_the_issuer_key_is_not_resolved(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	b := issueJoseBadge(t, privKey)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("", badgecore.ErrBadgeNotFound)
+	badgeRepo.EXPECT().
+		GetRevocationStatus(ctx, b.ID).
+		Return(nil, badgecore.ErrCredentialStatusNotFound)
+
+	issuerResolver := badgemocks.NewIssuerResolver(t)
+	issuerResolver.EXPECT().
+		ResolveKey(ctx, "issuer", "key_id").
+		Return(nil, errors.New("the identity node is unreachable"))
+
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		VerifyVerifiableCredential(ctx, &b.Proof.ProofValue).
+		Return(&badgetypes.VerificationResult{Status: true, Document: &b.VerifiableCredential}, nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		nil,
+		identityServ,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		issuerResolver,
+		0,
+		"",
+	)
+
+	result, err := sut.VerifyBadge(ctx, &b.Proof.ProofValue)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Status)
+	assert.Equal(t, badgetypes.VERIFICATION_SOURCE_REMOTE, result.Source)
+}
+
+func TestBadgeService_VerifyBadge_should_verify_an_sd_jwt_vc_presentation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	b, _ := badgecore.Issue(
+		uuid.NewString(),
+		"issuer",
+		"",
+		badgetypes.BADGE_TYPE_MCP_BADGE,
+		&badgetypes.BadgeClaims{
+			ID:    uuid.NewString(),
+			Badge: `{"name":"weather","url":"https://weather.example","tools":[{"name":"get_weather"}]}`,
+		},
+		privKey,
+		badgetypes.PROOF_FORMAT_SD_JWT_VC,
+		"",
+		0,
+	)
+	presentation, _ := sdjwt.Present(b.Proof.ProofValue, sdjwt.SelectClaims("badge.url"), nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetProof(ctx, b.ID).Return(b.Proof, nil)
+	badgeRepo.EXPECT().
+		GetRevocationStatus(ctx, b.ID).
+		Return(nil, badgecore.ErrCredentialStatusNotFound)
+
+	sut := bff.NewBadgeService(nil, nil, badgeRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	result, err := sut.VerifyBadge(ctx, &presentation)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Status)
+	assert.JSONEq(t, `{"name":"weather","url":"https://weather.example"}`, result.Document.CredentialSubject.Badge)
+}
+
+func TestBadgeService_VerifyBadges_should_return_the_results_in_order(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	badges := []*badgetypes.Badge{issueJoseBadge(t, privKey), issueJoseBadge(t, privKey)}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePubKey(mock.Anything, "key_id").Return(privKey.PublicKey(), nil).Once()
+
+	for _, b := range badges {
+		badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("tenant_id", nil)
+		badgeRepo.EXPECT().
+			GetRevocationStatus(ctx, b.ID).
+			Return(nil, badgecore.ErrCredentialStatusNotFound)
+	}
+
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		VerifyVerifiableCredential(ctx, ptrutil.Ptr("invalid")).
+		Return(nil, errors.New("invalid badge"))
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		keyStore,
+		identityServ,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	results, err := sut.VerifyBadges(ctx, []*bff.BadgeToVerify{
+		{Badge: badges[0].Proof.ProofValue},
+		{Badge: "invalid"},
+		{Badge: badges[1].Proof.ProofValue},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, badges[0].ID, results[0].Result.Document.ID)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, badges[1].ID, results[2].Result.Document.ID)
+}
+
+func TestBadgeService_VerifyBadges_should_return_err_when_batch_size_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		size        int
+		expectedErr error
+	}{
+		"empty batch": {
+			size:        0,
+			expectedErr: errutil.ValidationFailed("badge.emptyBatch", "No badge to verify."),
+		},
+		"batch too large": {
+			size: bff.MaxVerifyBatchSize + 1,
+			expectedErr: errutil.ValidationFailed(
+				"badge.batchTooLarge",
+				"A batch cannot contain more than %d badges.",
+				bff.MaxVerifyBatchSize,
+			),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			badges := make([]*bff.BadgeToVerify, tc.size)
+			for i := range badges {
+				badges[i] = &bff.BadgeToVerify{Badge: "badge"}
+			}
+
+			sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+			_, err := sut.VerifyBadges(context.Background(), badges)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestBadgeService_VerifyBadge_should_return_err_when_badge_is_null(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	_, err := sut.VerifyBadge(ctx, nil)
+
+	assert.Error(t, err)
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.ValidationFailed("badge.emptyBadge", "Badge or Verifiable Credential is empty"),
+	)
+}
+
+// GetStatusList
+
+func TestBadgeService_GetStatusList_should_return_the_signed_credential(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	statusList := &badgetypes.StatusList{
+		ID:         uuid.NewString(),
+		Credential: &badgetypes.StatusListCredential{ID: "status_list_url"},
+	}
+
+	statusListRepo := badgemocks.NewStatusListRepository(t)
+	statusListRepo.EXPECT().GetStatusList(ctx, statusList.ID).Return(statusList, nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		statusListRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	credential, err := sut.GetStatusList(ctx, statusList.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, statusList.Credential, credential)
+}
+
+func TestBadgeService_GetStatusList_should_return_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	statusListRepo := badgemocks.NewStatusListRepository(t)
+	statusListRepo.EXPECT().GetStatusList(ctx, mock.Anything).Return(nil, badgecore.ErrStatusListNotFound)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		statusListRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	_, err := sut.GetStatusList(ctx, uuid.NewString())
+
+	assert.ErrorIs(t, err, errutil.NotFound("badge.statusListNotFound", "Status list not found."))
+}
+
+// GetIssuerDocument
+
+func TestBadgeService_GetIssuerDocument_should_return_the_keys_of_the_issuer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	controller := badgecore.IssuerDocumentURL("https://api", "issuer")
+	methods := []*badgetypes.VerificationMethod{
+		{ID: controller + "#z1", Type: badgetypes.MultikeyVerificationMethod, Controller: controller},
+		{ID: controller + "#z2", Type: badgetypes.MultikeyVerificationMethod, Controller: controller},
+	}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetVerificationMethods(ctx, "issuer").Return(methods, nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		badgeRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"https://api",
+	)
+
+	document, err := sut.GetIssuerDocument(ctx, "issuer")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &badgetypes.IssuerDocument{
+		Context:            []string{badgetypes.ControlledIdentifierContext},
+		ID:                 controller,
+		VerificationMethod: methods,
+		AssertionMethod:    []string{controller + "#z1", controller + "#z2"},
+	}, document)
+}
+
+func TestBadgeService_GetIssuerDocument_should_return_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetVerificationMethods(ctx, "issuer").Return(nil, nil)
+
+	sut := bff.NewBadgeService(nil, nil, badgeRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	_, err := sut.GetIssuerDocument(ctx, "issuer")
+
+	assert.ErrorIs(t, err, errutil.NotFound("badge.issuerNotFound", "Issuer not found."))
+}
+
+// ListBadges
+
+func TestBadgeService_ListBadges_should_return_the_badges_of_the_app(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	paginationFilter := pagination.PaginationFilter{DefaultSize: 20}
+	badges := &pagination.Pageable[badgetypes.Badge]{
+		Items: []*badgetypes.Badge{{AppID: appID}, {AppID: appID}},
+		Total: 2,
+	}
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, appID).Return(&apptypes.App{ID: appID}, nil)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().ListByAppID(ctx, appID, paginationFilter).Return(badges, nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		appRepo,
+		badgeRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	ret, err := sut.ListBadges(ctx, appID, paginationFilter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, badges, ret)
+}
+
+func TestBadgeService_ListBadges_should_return_err_when_app_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, mock.Anything).Return(nil, appcore.ErrAppNotFound)
+
+	sut := bff.NewBadgeService(nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	_, err := sut.ListBadges(ctx, uuid.NewString(), pagination.PaginationFilter{})
+
+	assert.ErrorIs(t, err, errutil.NotFound("badge.appNotFound", "Application not found."))
+}
+
+// GetBadgeByID
+
+func TestBadgeService_GetBadgeByID_should_return_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetByID(ctx, mock.Anything).Return(nil, badgecore.ErrBadgeNotFound)
+
+	sut := bff.NewBadgeService(nil, nil, badgeRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	_, err := sut.GetBadgeByID(ctx, uuid.NewString())
+
+	assert.ErrorIs(t, err, errutil.NotFound("badge.badgeNotFound", "Badge not found."))
+}
+
+// DiffBadges
+
+func TestBadgeService_DiffBadges_should_return_the_changed_claims(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	from := &badgetypes.Badge{
+		AppID: appID,
+		VerifiableCredential: badgetypes.VerifiableCredential{
+			ID:                uuid.NewString(),
+			CredentialSubject: &badgetypes.BadgeClaims{Badge: `{"name":"agent","version":"1.0.0"}`},
+		},
+	}
+	to := &badgetypes.Badge{
+		AppID: appID,
+		VerifiableCredential: badgetypes.VerifiableCredential{
+			ID:                uuid.NewString(),
+			CredentialSubject: &badgetypes.BadgeClaims{Badge: `{"name":"agent","version":"1.1.0"}`},
+		},
+	}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetByID(ctx, from.ID).Return(from, nil)
+	badgeRepo.EXPECT().GetByID(ctx, to.ID).Return(to, nil)
+
+	sut := bff.NewBadgeService(nil, nil, badgeRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	diff, err := sut.DiffBadges(ctx, from.ID, to.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, from.ID, diff.FromBadgeID)
+	assert.Equal(t, to.ID, diff.ToBadgeID)
+	assert.Equal(t, []*badgetypes.ClaimChange{
+		{
+			Path:     "version",
+			Type:     badgetypes.CLAIM_CHANGE_TYPE_MODIFIED,
+			OldValue: ptrutil.Ptr(`"1.0.0"`),
+			NewValue: ptrutil.Ptr(`"1.1.0"`),
+		},
+	}, diff.Changes)
+}
+
+func TestBadgeService_DiffBadges_should_return_err_when_badges_belong_to_different_apps(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	from := &badgetypes.Badge{AppID: uuid.NewString()}
+	to := &badgetypes.Badge{AppID: uuid.NewString()}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetByID(ctx, "from").Return(from, nil)
+	badgeRepo.EXPECT().GetByID(ctx, "to").Return(to, nil)
+
+	sut := bff.NewBadgeService(nil, nil, badgeRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	_, err := sut.DiffBadges(ctx, "from", "to")
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.ValidationFailed(
+			"badge.differentApps",
+			"Only the badges of the same application can be compared.",
+		),
+	)
+}
+
+// RevokeBadge
+
+func TestBadgeService_RevokeBadge_should_revoke_the_badge_with_the_reason(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertUserID(context.Background(), "user_id")
+	appID := uuid.NewString()
+	badgeID := uuid.NewString()
+	issSettings := &settingstypes.IssuerSettings{
+		IssuerID: uuid.NewString(),
+		KeyID:    uuid.NewString(),
+	}
+	issuer := &identitycore.Issuer{
+		CommonName: issSettings.IssuerID,
+		KeyID:      issSettings.KeyID,
+	}
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, appID).Return(&apptypes.App{ID: appID}, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(issSettings, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePrivKey(ctx, issSettings.KeyID).Return(&jwk.Jwk{}, nil)
+
+	credStore := idpmocks.NewCredentialStore(t)
+	credStore.EXPECT().Get(ctx, appID).Return(&idp.ClientCredentials{}, nil)
+
+	badgeRevoker := badgemocks.NewRevoker(t)
+	badgeRevoker.EXPECT().
+		Revoke(
+			ctx,
+			appID,
+			badgeID,
+			&badgecore.Revocation{
+				Reason:    badgetypes.REVOCATION_REASON_POLICY_VIOLATION,
+				Comment:   "comment",
+				RevokedBy: "user_id",
+			},
+			mock.Anything,
+			issuer,
+			mock.Anything,
+		).
+		Return(nil)
+
+	sut := bff.NewBadgeService(
+		settingsRepo,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		keyStore,
+		nil,
+		credStore,
+		nil,
+		badgeRevoker,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	err := sut.RevokeBadge(ctx, appID, badgeID, badgetypes.REVOCATION_REASON_POLICY_VIOLATION, "comment")
+
+	assert.NoError(t, err)
+}
+
+func TestBadgeService_RevokeBadge_should_return_err_when_reason_is_unspecified(t *testing.T) {
+	t.Parallel()
+
+	sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	err := sut.RevokeBadge(
+		context.Background(),
+		uuid.NewString(),
+		"",
+		badgetypes.REVOCATION_REASON_UNSPECIFIED,
+		"",
+	)
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.ValidationFailed("badge.invalidRevocationReason", "The reason of the revocation is required."),
+	)
+}
+
+// SuspendBadge
+
+func TestBadgeService_SuspendBadge_should_record_the_user_and_the_reason(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertUserID(context.Background(), "user_id")
+	appID := uuid.NewString()
+	issSettings := &settingstypes.IssuerSettings{
+		IssuerID: uuid.NewString(),
+		KeyID:    uuid.NewString(),
+	}
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", issSettings.KeyID)
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, appID).Return(&apptypes.App{ID: appID}, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(issSettings, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePrivKey(ctx, issSettings.KeyID).Return(privKey, nil)
+
+	badgeSuspender := badgemocks.NewSuspender(t)
+	badgeSuspender.EXPECT().
+		SuspendAll(ctx, appID, "user_id", "reason", issSettings.IssuerID, privKey).
+		Return(nil)
+
+	sut := bff.NewBadgeService(
+		settingsRepo,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		badgeSuspender,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	err := sut.SuspendBadge(ctx, appID, "reason")
+
+	assert.NoError(t, err)
+}
+
+func TestBadgeService_SuspendBadge_should_return_err_when_reason_is_empty(t *testing.T) {
+	t.Parallel()
+
+	sut := bff.NewBadgeService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, "")
+
+	err := sut.SuspendBadge(context.Background(), uuid.NewString(), "")
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.ValidationFailed("badge.emptySuspensionReason", "The reason of the suspension is required."),
+	)
+}
+
+// ResumeBadge
+
+func TestBadgeService_ResumeBadge_should_return_err_when_badge_not_suspended(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	issSettings := &settingstypes.IssuerSettings{
+		IssuerID: uuid.NewString(),
+		KeyID:    uuid.NewString(),
+	}
+
+	appRepo := appmocks.NewRepository(t)
+	appRepo.EXPECT().GetApp(ctx, appID).Return(&apptypes.App{ID: appID}, nil)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(issSettings, nil)
+
+	keyStore := identitymocks.NewKeyStore(t)
+	keyStore.EXPECT().RetrievePrivKey(ctx, issSettings.KeyID).Return(&jwk.Jwk{}, nil)
+
+	badgeSuspender := badgemocks.NewSuspender(t)
+	badgeSuspender.EXPECT().
+		ResumeAll(ctx, appID, issSettings.IssuerID, mock.Anything).
+		Return(badgecore.ErrBadgeNotSuspended)
+
+	sut := bff.NewBadgeService(
+		settingsRepo,
+		appRepo,
+		nil,
+		nil,
+		nil,
+		keyStore,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		badgeSuspender,
+		nil,
+		nil,
+		0,
+		"",
+	)
+
+	err := sut.ResumeBadge(ctx, appID)
+
+	assert.ErrorIs(
+		t,
+		err,
+		errutil.InvalidRequest("badge.notSuspended", "The badge of the application is not suspended."),
+	)
+}
+
+// Trust policies
+
+func TestBadgeService_VerifyBadge_should_apply_the_trust_policy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		appStatus        *apptypes.AppStatus
+		expectedStatus   bool
+		expectedErrors   []string
+		expectedWarnings int
+	}{
+		"active app": {
+			appStatus:        ptrutil.Ptr(apptypes.APP_STATUS_ACTIVE),
+			expectedStatus:   true,
+			expectedWarnings: 3,
+		},
+		"revoked app": {
+			appStatus:        ptrutil.Ptr(apptypes.APP_STATUS_REVOKED),
+			expectedStatus:   false,
+			expectedErrors:   []string{badgetypes.ErrorReasonTrustPolicyAppNotActive},
+			expectedWarnings: 2,
+		},
+		"deleted app": {
+			expectedStatus:   false,
+			expectedErrors:   []string{badgetypes.ErrorReasonTrustPolicyAppNotActive},
+			expectedWarnings: 2,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := identitycontext.InsertTenantID(context.Background(), "relying_tenant_id")
+			privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+			b := issueJoseBadge(t, privKey)
+
+			trustPolicyRepo := badgemocks.NewTrustPolicyRepository(t)
+			trustPolicyRepo.EXPECT().GetByName(ctx, "policy").Return(&badgetypes.TrustPolicy{
+				Name:             "policy",
+				Issuers:          []string{"issuer"},
+				BadgeTypes:       []badgetypes.BadgeType{badgetypes.BADGE_TYPE_AGENT_BADGE},
+				RequireActiveApp: true,
+			}, nil)
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().GetTenantID(ctx, b.ID).Return("tenant_id", nil)
+			badgeRepo.EXPECT().
+				GetRevocationStatus(ctx, b.ID).
+				Return(nil, badgecore.ErrCredentialStatusNotFound)
+			badgeRepo.EXPECT().GetByID(mock.Anything, b.ID).Return(b, nil)
+
+			keyStore := identitymocks.NewKeyStore(t)
+			keyStore.EXPECT().RetrievePubKey(mock.Anything, "key_id").Return(privKey.PublicKey(), nil)
+
+			appRepo := appmocks.NewRepository(t)
+			appRepo.EXPECT().
+				GetAppStatuses(mock.Anything, b.AppID).
+				RunAndReturn(func(ctx context.Context, appIDs ...string) (map[string]apptypes.AppStatus, error) {
+					tenantID, _ := identitycontext.GetTenantID(ctx)
+					assert.Equal(t, "tenant_id", tenantID)
+
+					statuses := map[string]apptypes.AppStatus{}
+					if tc.appStatus != nil {
+						statuses[b.AppID] = *tc.appStatus
+					}
+
+					return statuses, nil
+				})
+
+			sut := bff.NewBadgeService(
+				nil,
+				appRepo,
+				badgeRepo,
+				nil,
+				nil,
+				keyStore,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				trustPolicyRepo,
+				nil,
+				0,
+				"",
+			)
+
+			result, err := sut.VerifyBadge(ctx, &b.Proof.ProofValue, bff.WithTrustPolicy("policy"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, result.Status)
+			assert.Len(t, result.Warnings, tc.expectedWarnings)
+
+			var errorReasons []string
+			for _, info := range result.Errors {
+				errorReasons = append(errorReasons, info.Reason)
+			}
+
+			assert.Equal(t, tc.expectedErrors, errorReasons)
+		})
+	}
+}
+
+func TestBadgeService_VerifyBadge_should_return_err_when_trust_policy_is_unavailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		ctx         context.Context //nolint:containedctx // to simplify the test cases
+		expectedErr error
+	}{
+		"request without tenant": {
+			ctx: context.Background(),
+			expectedErr: errutil.Unauthorized(
+				"badge.trustPolicyUnauthorized",
+				"The request must be authenticated to verify a badge with a trust policy.",
+			),
+		},
+		"unknown trust policy": {
+			ctx:         identitycontext.InsertTenantID(context.Background(), "tenant_id"),
+			expectedErr: errutil.NotFound("badge.trustPolicyNotFound", "Trust policy %s not found.", "policy"),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			trustPolicyRepo := badgemocks.NewTrustPolicyRepository(t)
+			trustPolicyRepo.EXPECT().
+				GetByName(tc.ctx, "policy").
+				Return(nil, badgecore.ErrTrustPolicyNotFound).
+				Maybe()
+
+			sut := bff.NewBadgeService(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				trustPolicyRepo,
+				nil,
+				0,
+				"",
+			)
+
+			_, err := sut.VerifyBadge(tc.ctx, ptrutil.Ptr("badge"), bff.WithTrustPolicy("policy"))
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestBadgeService_CreateTrustPolicy_should_create_the_policy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &badgetypes.TrustPolicy{
+		Name:             "policy",
+		BadgeTypes:       []badgetypes.BadgeType{badgetypes.BADGE_TYPE_MCP_BADGE},
+		IssuedWithinDays: 30,
+	}
+
+	trustPolicyRepo := badgemocks.NewTrustPolicyRepository(t)
+	trustPolicyRepo.EXPECT().GetByName(ctx, "policy").Return(nil, badgecore.ErrTrustPolicyNotFound)
+	trustPolicyRepo.EXPECT().Create(ctx, policy).Return(nil)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		trustPolicyRepo,
+		nil,
+		0,
+		"",
+	)
+
+	actual, err := sut.CreateTrustPolicy(ctx, policy)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, actual.ID)
+}
+
+func TestBadgeService_CreateTrustPolicy_should_return_err_when_policy_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		policy      *badgetypes.TrustPolicy
+		expectedErr error
+	}{
+		"empty name": {
+			policy: &badgetypes.TrustPolicy{},
+			expectedErr: errutil.ValidationFailed(
+				"badge.invalidTrustPolicyName",
+				"The name of the trust policy is required.",
+			),
+		},
+		"negative age": {
+			policy: &badgetypes.TrustPolicy{Name: "policy", IssuedWithinDays: -1},
+			expectedErr: errutil.ValidationFailed(
+				"badge.invalidTrustPolicyIssuedWithinDays",
+				"The maximum age of the trusted badges cannot be negative.",
+			),
+		},
+		"unspecified badge type": {
+			policy: &badgetypes.TrustPolicy{
+				Name:       "policy",
+				BadgeTypes: []badgetypes.BadgeType{badgetypes.BADGE_TYPE_UNSPECIFIED},
+			},
+			expectedErr: errutil.ValidationFailed(
+				"badge.invalidTrustPolicyBadgeType",
+				"Invalid badge type %s.",
+				badgetypes.BADGE_TYPE_UNSPECIFIED.String(),
+			),
+		},
+		"duplicate name": {
+			policy: &badgetypes.TrustPolicy{Name: "existing"},
+			expectedErr: errutil.ValidationFailed(
+				"badge.trustPolicyAlreadyExists",
+				"A trust policy named %s already exists.",
+				"existing",
+			),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			trustPolicyRepo := badgemocks.NewTrustPolicyRepository(t)
+			trustPolicyRepo.EXPECT().
+				GetByName(mock.Anything, "existing").
+				Return(&badgetypes.TrustPolicy{ID: uuid.NewString(), Name: "existing"}, nil).
+				Maybe()
+
+			sut := bff.NewBadgeService(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				trustPolicyRepo,
+				nil,
+				0,
+				"",
+			)
+
+			_, err := sut.CreateTrustPolicy(context.Background(), tc.policy)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestBadgeService_DeleteTrustPolicy_should_return_not_found(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	trustPolicyRepo := badgemocks.NewTrustPolicyRepository(t)
+	trustPolicyRepo.EXPECT().GetByID(ctx, id).Return(nil, badgecore.ErrTrustPolicyNotFound)
+
+	sut := bff.NewBadgeService(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		trustPolicyRepo,
+		nil,
+		0,
+		"",
+	)
+
+	err := sut.DeleteTrustPolicy(ctx, id)
+
+	assert.ErrorIs(t, err, errutil.NotFound("badge.trustPolicyNotFound", "Trust policy not found."))
 }
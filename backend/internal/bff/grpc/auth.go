@@ -9,9 +9,16 @@ import (
 	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
 	"github.com/agntcy/identity-service/internal/bff"
 	"github.com/agntcy/identity-service/internal/bff/grpc/converters"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/convertutil"
+	"github.com/agntcy/identity-service/internal/pkg/errutil"
 	"github.com/agntcy/identity-service/internal/pkg/grpcutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -55,11 +62,18 @@ func (s *authService) Authorize(
 	ctx context.Context,
 	req *identity_service_sdk_go.AuthorizeRequest,
 ) (*identity_service_sdk_go.AuthorizeResponse, error) {
+	if req.GetDpopProof() != "" {
+		s.sendDPoPNonce(ctx)
+	}
+
 	session, err := s.authSrv.Authorize(
 		ctx,
 		req.ResolverMetadataId,
 		req.ToolName,
 		req.UserToken,
+		req.DpopProof,
+		req.CodeChallenge,
+		req.CodeChallengeMethod,
 	)
 	if err != nil {
 		return nil, grpcutil.Error(err)
@@ -74,10 +88,16 @@ func (s *authService) Token(
 	ctx context.Context,
 	req *identity_service_sdk_go.TokenRequest,
 ) (*identity_service_sdk_go.TokenResponse, error) {
+	if req.GetDpopProof() != "" {
+		s.sendDPoPNonce(ctx)
+	}
+
 	// Get the session with token
 	session, err := s.authSrv.Token(
 		ctx,
 		req.AuthorizationCode,
+		req.DpopProof,
+		req.CodeVerifier,
 	)
 	if err != nil {
 		return nil, grpcutil.Error(err)
@@ -85,23 +105,133 @@ func (s *authService) Token(
 
 	return &identity_service_sdk_go.TokenResponse{
 		AccessToken: ptrutil.DerefStr(session.AccessToken),
+		TokenType:   session.TokenType(),
 	}, nil
 }
 
 func (s *authService) ExtAuthz(
 	ctx context.Context,
 	req *identity_service_sdk_go.ExtAuthzRequest,
-) (*emptypb.Empty, error) {
-	err := s.authSrv.ExtAuthZ(
+) (*identity_service_sdk_go.ExtAuthzResponse, error) {
+	if req.ToolName != nil && req.SkillId != nil {
+		return nil, grpcutil.BadRequestError(
+			errutil.ValidationFailed(
+				"auth.invalidExtAuthzRequest",
+				"Only one of tool name or skill ID can be specified.",
+			),
+		)
+	}
+
+	// A2A skills are stored as tasks using the skill ID as their tool name
+	toolName := req.GetToolName()
+	if req.SkillId != nil {
+		toolName = req.GetSkillId()
+	}
+
+	opts := make([]bff.ExtAuthZOption, 0)
+	if req.GetIssueReceipt() {
+		opts = append(opts, bff.WithReceipt([]byte(req.GetBody())))
+	}
+
+	if req.TransactionToken != nil || req.TransactionPurpose != nil {
+		opts = append(opts, bff.WithTransaction(req.GetTransactionToken(), req.GetTransactionPurpose()))
+	}
+
+	opts = append(opts, s.dpopOptions(ctx, req.GetDpop())...)
+
+	identity, err := s.authSrv.ExtAuthZ(
 		ctx,
 		req.AccessToken,
-		req.GetToolName(),
+		toolName,
+		opts...,
 	)
 	if err != nil {
 		return nil, grpcutil.Error(err)
 	}
 
-	return &emptypb.Empty{}, nil
+	return newExtAuthzResponse(identity), nil
+}
+
+func (s *authService) ExtAuthzMcp(
+	ctx context.Context,
+	req *identity_service_sdk_go.ExtAuthzMcpRequest,
+) (*identity_service_sdk_go.ExtAuthzResponse, error) {
+	opts := append(transactionOptions(req.TransactionToken), s.dpopOptions(ctx, req.GetDpop())...)
+	if req.GetIssueReceipt() {
+		opts = append(opts, bff.WithReceipt([]byte(req.GetBody())))
+	}
+
+	identity, err := s.authSrv.ExtAuthZMcp(
+		ctx,
+		req.GetAccessToken(),
+		[]byte(req.GetBody()),
+		req.GetContentType(),
+		opts...,
+	)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return newExtAuthzResponse(identity), nil
+}
+
+func (s *authService) ExtAuthzMcpTools(
+	ctx context.Context,
+	req *identity_service_sdk_go.ExtAuthzMcpToolsRequest,
+) (*identity_service_sdk_go.ExtAuthzMcpToolsResponse, error) {
+	toolNames, err := s.authSrv.FilterMcpTools(
+		ctx,
+		req.GetAccessToken(),
+		req.GetToolNames(),
+	)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.ExtAuthzMcpToolsResponse{
+		ToolNames: toolNames,
+	}, nil
+}
+
+func (s *authService) ExtAuthzA2A(
+	ctx context.Context,
+	req *identity_service_sdk_go.ExtAuthzA2ARequest,
+) (*identity_service_sdk_go.ExtAuthzResponse, error) {
+	opts := append(transactionOptions(req.TransactionToken), s.dpopOptions(ctx, req.GetDpop())...)
+	if req.GetIssueReceipt() {
+		opts = append(opts, bff.WithReceipt([]byte(req.GetBody())))
+	}
+
+	identity, err := s.authSrv.ExtAuthZA2A(
+		ctx,
+		req.GetAccessToken(),
+		[]byte(req.GetBody()),
+		req.GetContentType(),
+		opts...,
+	)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return newExtAuthzResponse(identity), nil
+}
+
+func (s *authService) ExtAuthzA2ASkills(
+	ctx context.Context,
+	req *identity_service_sdk_go.ExtAuthzA2ASkillsRequest,
+) (*identity_service_sdk_go.ExtAuthzA2ASkillsResponse, error) {
+	skillIDs, err := s.authSrv.FilterA2ASkills(
+		ctx,
+		req.GetAccessToken(),
+		req.GetSkillIds(),
+	)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.ExtAuthzA2ASkillsResponse{
+		SkillIds: skillIDs,
+	}, nil
 }
 
 func (s *authService) ApproveToken(
@@ -121,3 +251,150 @@ func (s *authService) ApproveToken(
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *authService) GetReceipt(
+	ctx context.Context,
+	req *identity_service_sdk_go.GetReceiptRequest,
+) (*identity_service_sdk_go.Receipt, error) {
+	rcpt, err := s.authSrv.GetReceipt(ctx, req.GetReceiptId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromReceipt(rcpt), nil
+}
+
+func (s *authService) Introspect(
+	ctx context.Context,
+	req *identity_service_sdk_go.IntrospectRequest,
+) (*identity_service_sdk_go.IntrospectResponse, error) {
+	introspection, err := s.authSrv.Introspect(ctx, req.GetToken())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromTokenIntrospection(introspection), nil
+}
+
+func (s *authService) Revoke(
+	ctx context.Context,
+	req *identity_service_sdk_go.RevokeRequest,
+) (*emptypb.Empty, error) {
+	err := s.authSrv.Revoke(ctx, req.GetToken())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authService) ListSessions(
+	ctx context.Context,
+	req *identity_service_sdk_go.ListSessionsRequest,
+) (*identity_service_sdk_go.ListSessionsResponse, error) {
+	paginationFilter := pagination.PaginationFilter{
+		Page:        req.Page,
+		Size:        req.Size,
+		DefaultSize: defaultPageSize,
+	}
+
+	sessions, err := s.authSrv.ListSessions(ctx, paginationFilter, &authtypes.SessionFilter{
+		OwnerAppID: req.OwnerAppId,
+		AppID:      req.AppId,
+		ToolName:   req.ToolName,
+		UserID:     req.UserId,
+		Active:     req.Active,
+	})
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.ListSessionsResponse{
+		Sessions:   convertutil.ConvertSlice(sessions.Items, converters.FromSession),
+		Pagination: pagination.ConvertToPagedResponse(paginationFilter, sessions),
+	}, nil
+}
+
+func (s *authService) GetSession(
+	ctx context.Context,
+	req *identity_service_sdk_go.GetSessionRequest,
+) (*identity_service_sdk_go.Session, error) {
+	session, err := s.authSrv.GetSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromSession(session), nil
+}
+
+func (s *authService) RevokeSession(
+	ctx context.Context,
+	req *identity_service_sdk_go.RevokeSessionRequest,
+) (*emptypb.Empty, error) {
+	err := s.authSrv.RevokeSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *authService) RevokeAllSessionsForApp(
+	ctx context.Context,
+	req *identity_service_sdk_go.RevokeAllSessionsForAppRequest,
+) (*identity_service_sdk_go.RevokeAllSessionsForAppResponse, error) {
+	revoked, err := s.authSrv.RevokeAllSessionsForApp(ctx, req.GetAppId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.RevokeAllSessionsForAppResponse{
+		RevokedSessions: int32(revoked),
+	}, nil
+}
+
+// transactionOptions propagates the transaction of the token, if any.
+func transactionOptions(transactionToken *string) []bff.ExtAuthZOption {
+	if transactionToken == nil || *transactionToken == "" {
+		return nil
+	}
+
+	return []bff.ExtAuthZOption{bff.WithTransaction(*transactionToken, "")}
+}
+
+// newExtAuthzResponse returns an empty response when no identity was resolved,
+// which happens when all the forwarded messages are allowed without authentication.
+func newExtAuthzResponse(
+	identity *authtypes.CallerIdentity,
+) *identity_service_sdk_go.ExtAuthzResponse {
+	if identity == nil {
+		return &identity_service_sdk_go.ExtAuthzResponse{}
+	}
+
+	return converters.FromCallerIdentity(identity)
+}
+
+// dpopOptions forwards the DPoP proof of the caller, if any,
+// and sends a new nonce for the next proof.
+func (s *authService) dpopOptions(
+	ctx context.Context,
+	proof *identity_service_sdk_go.DPoPProof,
+) []bff.ExtAuthZOption {
+	if proof.GetProof() == "" {
+		return nil
+	}
+
+	s.sendDPoPNonce(ctx)
+
+	return []bff.ExtAuthZOption{
+		bff.WithDPoP(proof.GetProof(), proof.GetHttpMethod(), proof.GetHttpUrl()),
+	}
+}
+
+// sendDPoPNonce sets the nonce for the next DPoP proof in the response headers,
+// including when the request fails because the proof lacks a valid nonce.
+func (s *authService) sendDPoPNonce(ctx context.Context) {
+	if nonce := s.authSrv.DPoPNonce(); nonce != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(dpop.NonceHeader, nonce))
+	}
+}
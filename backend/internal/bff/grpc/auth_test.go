@@ -10,13 +10,17 @@ import (
 
 	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
 	"github.com/agntcy/identity-service/internal/bff/grpc"
+	grpctesting "github.com/agntcy/identity-service/internal/bff/grpc/testing"
 	bffmocks "github.com/agntcy/identity-service/internal/bff/mocks"
 	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 )
 
 var errAuthUnexpected = errors.New("failed")
@@ -76,7 +80,15 @@ func TestAuthService_Authorize_should_succeed(t *testing.T) {
 
 	authSrv := bffmocks.NewAuthService(t)
 	authSrv.EXPECT().
-		Authorize(t.Context(), &resolverMetaDataID, &toolName, &userToken).
+		Authorize(
+			t.Context(),
+			&resolverMetaDataID,
+			&toolName,
+			&userToken,
+			(*string)(nil),
+			(*string)(nil),
+			(*string)(nil),
+		).
 		Return(&authtypes.Session{AuthorizationCode: &authCode}, nil)
 
 	sut := grpc.NewAuthService(authSrv, nil)
@@ -96,7 +108,15 @@ func TestAuthService_Authorize_should_propagate_error_when_core_service_fails(t
 
 	authSrv := bffmocks.NewAuthService(t)
 	authSrv.EXPECT().
-		Authorize(t.Context(), mock.Anything, mock.Anything, mock.Anything).
+		Authorize(
+			t.Context(),
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).
 		Return(nil, errAuthUnexpected)
 
 	sut := grpc.NewAuthService(authSrv, nil)
@@ -113,7 +133,9 @@ func TestAuthService_Token_should_succeed(t *testing.T) {
 	accessToken := uuid.NewString()
 
 	authSrv := bffmocks.NewAuthService(t)
-	authSrv.EXPECT().Token(t.Context(), authCode).Return(&authtypes.Session{AccessToken: &accessToken}, nil)
+	authSrv.EXPECT().
+		Token(t.Context(), authCode, (*string)(nil), (*string)(nil)).
+		Return(&authtypes.Session{AccessToken: &accessToken}, nil)
 
 	sut := grpc.NewAuthService(authSrv, nil)
 
@@ -121,13 +143,14 @@ func TestAuthService_Token_should_succeed(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, accessToken, ret.AccessToken)
+	assert.Equal(t, "Bearer", ret.TokenType)
 }
 
 func TestAuthService_Token_should_propagate_when_core_service_fails(t *testing.T) {
 	t.Parallel()
 
 	authSrv := bffmocks.NewAuthService(t)
-	authSrv.EXPECT().Token(t.Context(), mock.Anything).Return(nil, errAuthUnexpected)
+	authSrv.EXPECT().Token(t.Context(), mock.Anything, mock.Anything, mock.Anything).Return(nil, errAuthUnexpected)
 
 	sut := grpc.NewAuthService(authSrv, nil)
 
@@ -142,24 +165,104 @@ func TestAuthService_ExtAuthz_should_succeed(t *testing.T) {
 	accessToken := uuid.NewString()
 	toolName := uuid.NewString()
 
+	identity := &authtypes.CallerIdentity{
+		AppID:     uuid.NewString(),
+		AppType:   apptypes.APP_TYPE_AGENT_A2A,
+		SessionID: uuid.NewString(),
+		RuleID:    ptrutil.Ptr(uuid.NewString()),
+	}
+
 	authSrv := bffmocks.NewAuthService(t)
-	authSrv.EXPECT().ExtAuthZ(t.Context(), accessToken, toolName).Return(nil)
+	authSrv.EXPECT().ExtAuthZ(t.Context(), accessToken, toolName).Return(identity, nil)
 
 	sut := grpc.NewAuthService(authSrv, nil)
 
-	_, err := sut.ExtAuthz(t.Context(), &identity_service_sdk_go.ExtAuthzRequest{
+	resp, err := sut.ExtAuthz(t.Context(), &identity_service_sdk_go.ExtAuthzRequest{
 		AccessToken: accessToken,
 		ToolName:    &toolName,
 	})
 
 	assert.NoError(t, err)
+	assert.Equal(t, identity.AppID, resp.GetAppId())
+	assert.Equal(t, identity_service_sdk_go.AppType_APP_TYPE_AGENT_A2A, resp.GetAppType())
+	assert.Equal(t, identity.SessionID, resp.GetSessionId())
+	assert.Equal(t, *identity.RuleID, resp.GetRuleId())
+}
+
+func TestAuthService_ExtAuthz_should_return_receipt_when_requested(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	toolName := uuid.NewString()
+
+	identity := &authtypes.CallerIdentity{
+		AppID: uuid.NewString(),
+		Receipt: &authtypes.Receipt{
+			ID:    uuid.NewString(),
+			Token: uuid.NewString(),
+		},
+	}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().ExtAuthZ(t.Context(), accessToken, toolName, mock.Anything).Return(identity, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ExtAuthz(t.Context(), &identity_service_sdk_go.ExtAuthzRequest{
+		AccessToken:  accessToken,
+		ToolName:     &toolName,
+		IssueReceipt: ptrutil.Ptr(true),
+		Body:         ptrutil.Ptr(`{"key":"value"}`),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, identity.Receipt.ID, resp.GetReceipt().GetId())
+	assert.Equal(t, identity.Receipt.Token, resp.GetReceipt().GetToken())
+}
+
+func TestAuthService_ExtAuthz_should_use_skill_id_as_tool_name(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	skillID := uuid.NewString()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().ExtAuthZ(t.Context(), accessToken, skillID).Return(&authtypes.CallerIdentity{}, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.ExtAuthz(t.Context(), &identity_service_sdk_go.ExtAuthzRequest{
+		AccessToken: accessToken,
+		SkillId:     &skillID,
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestAuthService_ExtAuthz_should_return_badrequest_when_tool_and_skill_are_set(t *testing.T) {
+	t.Parallel()
+
+	sut := grpc.NewAuthService(nil, nil)
+
+	_, err := sut.ExtAuthz(t.Context(), &identity_service_sdk_go.ExtAuthzRequest{
+		AccessToken: uuid.NewString(),
+		ToolName:    ptrutil.Ptr(uuid.NewString()),
+		SkillId:     ptrutil.Ptr(uuid.NewString()),
+	})
+
+	grpctesting.AssertGrpcError(
+		t,
+		err,
+		codes.InvalidArgument,
+		"Only one of tool name or skill ID can be specified.",
+	)
 }
 
 func TestAuthService_ExtAuthz_should_propagate_when_core_service_fails(t *testing.T) {
 	t.Parallel()
 
 	authSrv := bffmocks.NewAuthService(t)
-	authSrv.EXPECT().ExtAuthZ(t.Context(), mock.Anything, mock.Anything).Return(errAuthUnexpected)
+	authSrv.EXPECT().ExtAuthZ(t.Context(), mock.Anything, mock.Anything).Return(nil, errAuthUnexpected)
 
 	sut := grpc.NewAuthService(authSrv, nil)
 
@@ -170,6 +273,171 @@ func TestAuthService_ExtAuthz_should_propagate_when_core_service_fails(t *testin
 	assert.ErrorIs(t, err, errAuthUnexpected)
 }
 
+func TestAuthService_ExtAuthzMcp_should_propagate_transaction(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	txnToken := uuid.NewString()
+	identity := &authtypes.CallerIdentity{
+		AppID:            uuid.NewString(),
+		TransactionID:    ptrutil.Ptr(uuid.NewString()),
+		TransactionToken: &txnToken,
+	}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		ExtAuthZMcp(t.Context(), accessToken, mock.Anything, mock.Anything, mock.Anything).
+		Return(identity, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ExtAuthzMcp(t.Context(), &identity_service_sdk_go.ExtAuthzMcpRequest{
+		AccessToken:      accessToken,
+		Body:             `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool"}}`,
+		TransactionToken: &txnToken,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, *identity.TransactionID, resp.GetTransactionId())
+	assert.Equal(t, txnToken, resp.GetTransactionToken())
+}
+
+func TestAuthService_ExtAuthzMcp_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool"}}`
+	contentType := "application/json"
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().ExtAuthZMcp(t.Context(), accessToken, []byte(body), contentType).Return(nil, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ExtAuthzMcp(t.Context(), &identity_service_sdk_go.ExtAuthzMcpRequest{
+		AccessToken: accessToken,
+		Body:        body,
+		ContentType: &contentType,
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func TestAuthService_ExtAuthzMcp_should_propagate_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		ExtAuthZMcp(t.Context(), mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errAuthUnexpected)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.ExtAuthzMcp(t.Context(), &identity_service_sdk_go.ExtAuthzMcpRequest{
+		AccessToken: uuid.NewString(),
+	})
+
+	assert.ErrorIs(t, err, errAuthUnexpected)
+}
+
+func TestAuthService_ExtAuthzMcpTools_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	toolNames := []string{"tool_a", "tool_b"}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().FilterMcpTools(t.Context(), accessToken, toolNames).Return([]string{"tool_b"}, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ExtAuthzMcpTools(t.Context(), &identity_service_sdk_go.ExtAuthzMcpToolsRequest{
+		AccessToken: accessToken,
+		ToolNames:   toolNames,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tool_b"}, resp.GetToolNames())
+}
+
+func TestAuthService_ExtAuthzMcpTools_should_propagate_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		FilterMcpTools(t.Context(), mock.Anything, mock.Anything).
+		Return(nil, errAuthUnexpected)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.ExtAuthzMcpTools(t.Context(), &identity_service_sdk_go.ExtAuthzMcpToolsRequest{
+		AccessToken: uuid.NewString(),
+	})
+
+	assert.ErrorIs(t, err, errAuthUnexpected)
+}
+
+func TestAuthService_ExtAuthzA2A_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":1,"method":"message/send","params":{"message":{}}}`
+	contentType := "application/json"
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		ExtAuthZA2A(t.Context(), accessToken, []byte(body), contentType).
+		Return(&authtypes.CallerIdentity{}, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.ExtAuthzA2A(t.Context(), &identity_service_sdk_go.ExtAuthzA2ARequest{
+		AccessToken: accessToken,
+		Body:        body,
+		ContentType: &contentType,
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestAuthService_ExtAuthzA2A_should_propagate_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		ExtAuthZA2A(t.Context(), mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errAuthUnexpected)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.ExtAuthzA2A(t.Context(), &identity_service_sdk_go.ExtAuthzA2ARequest{
+		AccessToken: uuid.NewString(),
+	})
+
+	assert.ErrorIs(t, err, errAuthUnexpected)
+}
+
+func TestAuthService_ExtAuthzA2ASkills_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	skillIDs := []string{"skill_a", "skill_b"}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().FilterA2ASkills(t.Context(), accessToken, skillIDs).Return([]string{"skill_a"}, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ExtAuthzA2ASkills(t.Context(), &identity_service_sdk_go.ExtAuthzA2ASkillsRequest{
+		AccessToken: accessToken,
+		SkillIds:    skillIDs,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"skill_a"}, resp.GetSkillIds())
+}
+
 func TestAuthService_ApproveToken_should_succeed(t *testing.T) {
 	t.Parallel()
 
@@ -207,3 +475,136 @@ func TestAuthService_ApproveToken_should_propagate_error_when_core_service_fails
 
 	assert.ErrorIs(t, err, errAuthUnexpected)
 }
+
+func TestAuthService_GetReceipt_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	rcpt := &authtypes.Receipt{
+		ID:          uuid.NewString(),
+		CallerAppID: uuid.NewString(),
+		CalleeAppID: uuid.NewString(),
+		Token:       uuid.NewString(),
+	}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().GetReceipt(t.Context(), rcpt.ID).Return(rcpt, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.GetReceipt(t.Context(), &identity_service_sdk_go.GetReceiptRequest{
+		ReceiptId: rcpt.ID,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, rcpt.ID, resp.GetId())
+	assert.Equal(t, rcpt.CallerAppID, resp.GetCallerAppId())
+	assert.Equal(t, rcpt.CalleeAppID, resp.GetCalleeAppId())
+	assert.Equal(t, rcpt.Token, resp.GetToken())
+}
+
+func TestAuthService_GetReceipt_should_propagate_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().GetReceipt(t.Context(), mock.Anything).Return(nil, errAuthUnexpected)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.GetReceipt(t.Context(), &identity_service_sdk_go.GetReceiptRequest{
+		ReceiptId: uuid.NewString(),
+	})
+
+	assert.ErrorIs(t, err, errAuthUnexpected)
+}
+
+func TestAuthService_Introspect_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	token := uuid.NewString()
+	introspection := &authtypes.TokenIntrospection{
+		Active:    true,
+		ClientID:  ptrutil.Ptr(uuid.NewString()),
+		TokenType: ptrutil.Ptr(authtypes.TokenTypeBearer),
+		ExpiresAt: ptrutil.Ptr(int64(1234)),
+	}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().Introspect(t.Context(), token).Return(introspection, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.Introspect(t.Context(), &identity_service_sdk_go.IntrospectRequest{Token: token})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.GetActive())
+	assert.Equal(t, *introspection.ClientID, resp.GetClientId())
+	assert.Equal(t, authtypes.TokenTypeBearer, resp.GetTokenType())
+	assert.Equal(t, int64(1234), resp.GetExp())
+}
+
+func TestAuthService_Revoke_should_propagate_error_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().Revoke(t.Context(), mock.Anything).Return(errAuthUnexpected)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	_, err := sut.Revoke(t.Context(), &identity_service_sdk_go.RevokeRequest{Token: uuid.NewString()})
+
+	assert.ErrorIs(t, err, errAuthUnexpected)
+}
+
+func TestAuthService_ListSessions_should_parse_parameters(t *testing.T) {
+	t.Parallel()
+
+	pf := pagination.PaginationFilter{
+		Page:        ptrutil.Ptr(int32(2)),
+		Size:        ptrutil.Ptr(int32(10)),
+		DefaultSize: int32(20),
+	}
+	filter := &authtypes.SessionFilter{
+		OwnerAppID: ptrutil.Ptr(uuid.NewString()),
+		Active:     ptrutil.Ptr(false),
+	}
+
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().
+		ListSessions(t.Context(), pf, filter).
+		Return(&pagination.Pageable[authtypes.Session]{
+			Items: []*authtypes.Session{{ID: uuid.NewString(), OwnerAppID: *filter.OwnerAppID}},
+			Total: 1,
+		}, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.ListSessions(t.Context(), &identity_service_sdk_go.ListSessionsRequest{
+		Page:       pf.Page,
+		Size:       pf.Size,
+		OwnerAppId: filter.OwnerAppID,
+		Active:     filter.Active,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetSessions(), 1)
+	assert.True(t, resp.GetSessions()[0].GetActive())
+	assert.Equal(t, int64(1), resp.GetPagination().GetTotal())
+}
+
+func TestAuthService_RevokeAllSessionsForApp_should_return_the_number_of_revoked_sessions(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+	authSrv := bffmocks.NewAuthService(t)
+	authSrv.EXPECT().RevokeAllSessionsForApp(t.Context(), appID).Return(3, nil)
+
+	sut := grpc.NewAuthService(authSrv, nil)
+
+	resp, err := sut.RevokeAllSessionsForApp(
+		t.Context(),
+		&identity_service_sdk_go.RevokeAllSessionsForAppRequest{AppId: appID},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.GetRevokedSessions())
+}
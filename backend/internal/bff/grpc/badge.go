@@ -9,8 +9,13 @@ import (
 	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
 	"github.com/agntcy/identity-service/internal/bff"
 	"github.com/agntcy/identity-service/internal/bff/grpc/converters"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/convertutil"
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
 	"github.com/agntcy/identity-service/internal/pkg/grpcutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type BadgeService struct {
@@ -47,6 +52,14 @@ func (s *BadgeService) IssueBadge(
 		options = append(options, bff.WithOASF(in.Oasf.SchemaBase64))
 	}
 
+	if in.ProofFormat != identity_service_sdk_go.ProofFormat_PROOF_FORMAT_UNSPECIFIED {
+		options = append(options, bff.WithProofFormat(badgetypes.ProofFormat(in.ProofFormat)))
+	}
+
+	if in.HolderKey != nil {
+		options = append(options, bff.WithHolderKey(in.GetHolderKey()))
+	}
+
 	badge, err := s.badgeService.IssueBadge(ctx, in.AppId, options...)
 	if err != nil {
 		return nil, grpcutil.Error(err)
@@ -62,6 +75,7 @@ func (s *BadgeService) VerifyBadge(
 	result, err := s.badgeService.VerifyBadge(
 		ctx,
 		&in.Badge,
+		verifyOptions(in)...,
 	)
 	if err != nil {
 		return nil, grpcutil.Error(err)
@@ -69,3 +83,226 @@ func (s *BadgeService) VerifyBadge(
 
 	return converters.FromVerificationResult(result), nil
 }
+
+func (s *BadgeService) VerifyBadges(
+	ctx context.Context,
+	in *identity_service_sdk_go.VerifyBadgesRequest,
+) (*identity_service_sdk_go.VerifyBadgesResponse, error) {
+	badges := make([]*bff.BadgeToVerify, 0, len(in.GetBadges()))
+	for _, badge := range in.GetBadges() {
+		badges = append(badges, &bff.BadgeToVerify{
+			Badge:   badge.GetBadge(),
+			Options: verifyOptions(badge),
+		})
+	}
+
+	verifications, err := s.badgeService.VerifyBadges(ctx, badges)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	results := make([]*identity_service_sdk_go.VerifyBadgesResult, 0, len(verifications))
+	for _, verification := range verifications {
+		result := &identity_service_sdk_go.VerifyBadgesResult{
+			Result: converters.FromVerificationResult(verification.Result),
+		}
+
+		if verification.Err != nil {
+			result.Error = status.Convert(grpcutil.Error(verification.Err)).Proto()
+		}
+
+		results = append(results, result)
+	}
+
+	return &identity_service_sdk_go.VerifyBadgesResponse{
+		Results: results,
+	}, nil
+}
+
+func verifyOptions(in *identity_service_sdk_go.VerifyBadgeRequest) []bff.VerifyOption {
+	options := make([]bff.VerifyOption, 0)
+
+	if in.Audience != nil || in.Nonce != nil {
+		options = append(options, bff.WithKeyBinding(in.GetAudience(), in.GetNonce()))
+	}
+
+	if in.TrustPolicy != nil {
+		options = append(options, bff.WithTrustPolicy(in.GetTrustPolicy()))
+	}
+
+	return options
+}
+
+func (s *BadgeService) ListBadges(
+	ctx context.Context,
+	in *identity_service_sdk_go.ListBadgesRequest,
+) (*identity_service_sdk_go.ListBadgesResponse, error) {
+	paginationFilter := pagination.PaginationFilter{
+		Page:        in.Page,
+		Size:        in.Size,
+		DefaultSize: defaultPageSize,
+	}
+
+	badges, err := s.badgeService.ListBadges(ctx, in.GetAppId(), paginationFilter)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.ListBadgesResponse{
+		Badges:     convertutil.ConvertSlice(badges.Items, converters.FromBadge),
+		Pagination: pagination.ConvertToPagedResponse(paginationFilter, badges),
+	}, nil
+}
+
+func (s *BadgeService) GetBadgeByID(
+	ctx context.Context,
+	in *identity_service_sdk_go.GetBadgeByIDRequest,
+) (*identity_service_sdk_go.Badge, error) {
+	badge, err := s.badgeService.GetBadgeByID(ctx, in.GetId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromBadge(badge), nil
+}
+
+func (s *BadgeService) DiffBadges(
+	ctx context.Context,
+	in *identity_service_sdk_go.DiffBadgesRequest,
+) (*identity_service_sdk_go.BadgeDiff, error) {
+	diff, err := s.badgeService.DiffBadges(ctx, in.GetFromBadgeId(), in.GetToBadgeId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromBadgeDiff(diff), nil
+}
+
+func (s *BadgeService) GetStatusList(
+	ctx context.Context,
+	in *identity_service_sdk_go.GetStatusListRequest,
+) (*identity_service_sdk_go.StatusListCredential, error) {
+	statusList, err := s.badgeService.GetStatusList(ctx, in.GetId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromStatusListCredential(statusList), nil
+}
+
+func (s *BadgeService) GetIssuerDocument(
+	ctx context.Context,
+	in *identity_service_sdk_go.GetIssuerDocumentRequest,
+) (*identity_service_sdk_go.IssuerDocument, error) {
+	document, err := s.badgeService.GetIssuerDocument(ctx, in.GetIssuer())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromIssuerDocument(document), nil
+}
+
+func (s *BadgeService) RevokeBadge(
+	ctx context.Context,
+	in *identity_service_sdk_go.RevokeBadgeRequest,
+) (*emptypb.Empty, error) {
+	err := s.badgeService.RevokeBadge(
+		ctx,
+		in.GetAppId(),
+		in.GetBadgeId(),
+		badgetypes.RevocationReason(in.GetReason()),
+		in.GetComment(),
+	)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BadgeService) SuspendBadge(
+	ctx context.Context,
+	in *identity_service_sdk_go.SuspendBadgeRequest,
+) (*emptypb.Empty, error) {
+	err := s.badgeService.SuspendBadge(ctx, in.GetAppId(), in.GetReason())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BadgeService) ResumeBadge(
+	ctx context.Context,
+	in *identity_service_sdk_go.ResumeBadgeRequest,
+) (*emptypb.Empty, error) {
+	err := s.badgeService.ResumeBadge(ctx, in.GetAppId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *BadgeService) ListTrustPolicies(
+	ctx context.Context,
+	in *identity_service_sdk_go.ListTrustPoliciesRequest,
+) (*identity_service_sdk_go.ListTrustPoliciesResponse, error) {
+	policies, err := s.badgeService.ListTrustPolicies(ctx)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &identity_service_sdk_go.ListTrustPoliciesResponse{
+		TrustPolicies: convertutil.ConvertSlice(policies, converters.FromTrustPolicy),
+	}, nil
+}
+
+func (s *BadgeService) CreateTrustPolicy(
+	ctx context.Context,
+	in *identity_service_sdk_go.CreateTrustPolicyRequest,
+) (*identity_service_sdk_go.TrustPolicy, error) {
+	policy, err := s.badgeService.CreateTrustPolicy(ctx, &badgetypes.TrustPolicy{
+		Name:             in.GetName(),
+		Issuers:          in.GetIssuers(),
+		BadgeTypes:       converters.ToBadgeTypes(in.GetBadgeTypes()),
+		IssuedWithinDays: in.GetIssuedWithinDays(),
+		RequireActiveApp: in.GetRequireActiveApp(),
+	})
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromTrustPolicy(policy), nil
+}
+
+func (s *BadgeService) UpdateTrustPolicy(
+	ctx context.Context,
+	in *identity_service_sdk_go.UpdateTrustPolicyRequest,
+) (*identity_service_sdk_go.TrustPolicy, error) {
+	policy, err := s.badgeService.UpdateTrustPolicy(ctx, &badgetypes.TrustPolicy{
+		ID:               in.GetTrustPolicyId(),
+		Name:             in.GetName(),
+		Issuers:          in.GetIssuers(),
+		BadgeTypes:       converters.ToBadgeTypes(in.GetBadgeTypes()),
+		IssuedWithinDays: in.GetIssuedWithinDays(),
+		RequireActiveApp: in.GetRequireActiveApp(),
+	})
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromTrustPolicy(policy), nil
+}
+
+func (s *BadgeService) DeleteTrustPolicy(
+	ctx context.Context,
+	in *identity_service_sdk_go.DeleteTrustPolicyRequest,
+) (*emptypb.Empty, error) {
+	err := s.badgeService.DeleteTrustPolicy(ctx, in.GetTrustPolicyId())
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,14 +4,19 @@
 package grpc_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/bff"
 	"github.com/agntcy/identity-service/internal/bff/grpc"
 	grpctesting "github.com/agntcy/identity-service/internal/bff/grpc/testing"
 	bffmocks "github.com/agntcy/identity-service/internal/bff/mocks"
 	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -115,3 +120,229 @@ func TestBadgeService_VerifyBadge_should_propagate_error_when_core_service_fails
 
 	assert.ErrorIs(t, err, errBadgeUnexpected)
 }
+
+func TestBadgeService_VerifyBadges_should_return_the_results_in_order(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().
+		VerifyBadges(t.Context(), mock.Anything).
+		RunAndReturn(func(ctx context.Context, badges []*bff.BadgeToVerify) ([]*bff.BadgeVerification, error) {
+			assert.Equal(t, "first", badges[0].Badge)
+			assert.Equal(t, "second", badges[1].Badge)
+			assert.Len(t, badges[1].Options, 1)
+
+			return []*bff.BadgeVerification{
+				{Result: &badgetypes.VerificationResult{Status: true}},
+				{Err: errutil.NotFound("badge.trustPolicyNotFound", "Trust policy not found.")},
+			}, nil
+		})
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	ret, err := sut.VerifyBadges(t.Context(), &identity_service_sdk_go.VerifyBadgesRequest{
+		Badges: []*identity_service_sdk_go.VerifyBadgeRequest{
+			{Badge: "first"},
+			{Badge: "second", TrustPolicy: ptrutil.Ptr("policy")},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, ret.Results, 2)
+	assert.True(t, ret.Results[0].GetResult().GetStatus())
+	assert.Nil(t, ret.Results[0].Error)
+	assert.Nil(t, ret.Results[1].Result)
+	assert.Equal(t, int32(codes.NotFound), ret.Results[1].GetError().GetCode())
+}
+
+func TestBadgeService_VerifyBadges_should_propagate_error_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().VerifyBadges(t.Context(), mock.Anything).Return(nil, errBadgeUnexpected)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.VerifyBadges(t.Context(), &identity_service_sdk_go.VerifyBadgesRequest{})
+
+	assert.ErrorIs(t, err, errBadgeUnexpected)
+}
+
+func TestBadgeService_ListBadges_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+	badgeID := uuid.NewString()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().
+		ListBadges(t.Context(), appID, mock.Anything).
+		Return(&pagination.Pageable[badgetypes.Badge]{
+			Items: []*badgetypes.Badge{
+				{AppID: appID, VerifiableCredential: badgetypes.VerifiableCredential{ID: badgeID}},
+			},
+			Total: 1,
+			Page:  1,
+			Size:  1,
+		}, nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	ret, err := sut.ListBadges(t.Context(), &identity_service_sdk_go.ListBadgesRequest{AppId: appID})
+
+	assert.NoError(t, err)
+	assert.Len(t, ret.GetBadges(), 1)
+	assert.Equal(t, badgeID, ret.GetBadges()[0].GetVerifiableCredential().GetId())
+	assert.Equal(t, int64(1), ret.GetPagination().GetTotal())
+}
+
+func TestBadgeService_DiffBadges_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().DiffBadges(t.Context(), "from", "to").Return(&badgetypes.BadgeDiff{
+		FromBadgeID: "from",
+		ToBadgeID:   "to",
+		Changes: []*badgetypes.ClaimChange{
+			{Path: "skills[id=search]", Type: badgetypes.CLAIM_CHANGE_TYPE_ADDED, NewValue: ptrutil.Ptr("{}")},
+		},
+	}, nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	ret, err := sut.DiffBadges(t.Context(), &identity_service_sdk_go.DiffBadgesRequest{
+		FromBadgeId: "from",
+		ToBadgeId:   "to",
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, ret.GetChanges(), 1)
+	assert.Equal(t, "skills[id=search]", ret.GetChanges()[0].GetPath())
+	assert.Equal(t, identity_service_sdk_go.ClaimChangeType_CLAIM_CHANGE_TYPE_ADDED, ret.GetChanges()[0].GetType())
+	assert.Nil(t, ret.GetChanges()[0].OldValue)
+}
+
+func TestBadgeService_GetBadgeByID_should_propagate_error_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().GetBadgeByID(t.Context(), mock.Anything).Return(nil, errBadgeUnexpected)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.GetBadgeByID(t.Context(), &identity_service_sdk_go.GetBadgeByIDRequest{})
+
+	assert.ErrorIs(t, err, errBadgeUnexpected)
+}
+
+func TestBadgeService_GetStatusList_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.NewString()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().GetStatusList(t.Context(), id).Return(&badgetypes.StatusListCredential{
+		ID: "status_list_url",
+		CredentialSubject: &badgetypes.BitstringStatusList{
+			StatusPurpose: badgetypes.StatusPurposeRevocation,
+			EncodedList:   "encoded_list",
+		},
+	}, nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	ret, err := sut.GetStatusList(t.Context(), &identity_service_sdk_go.GetStatusListRequest{Id: id})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "status_list_url", ret.GetId())
+	assert.Equal(t, "encoded_list", ret.GetCredentialSubject().GetEncodedList())
+}
+
+func TestBadgeService_GetStatusList_should_propagate_error_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().GetStatusList(t.Context(), mock.Anything).Return(nil, errBadgeUnexpected)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.GetStatusList(t.Context(), &identity_service_sdk_go.GetStatusListRequest{})
+
+	assert.ErrorIs(t, err, errBadgeUnexpected)
+}
+
+func TestBadgeService_GetIssuerDocument_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().GetIssuerDocument(t.Context(), "issuer").Return(&badgetypes.IssuerDocument{
+		ID: "issuer_url",
+		VerificationMethod: []*badgetypes.VerificationMethod{
+			{ID: "issuer_url#key", Type: badgetypes.MultikeyVerificationMethod, PublicKeyMultibase: "key"},
+		},
+		AssertionMethod: []string{"issuer_url#key"},
+	}, nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	ret, err := sut.GetIssuerDocument(t.Context(), &identity_service_sdk_go.GetIssuerDocumentRequest{Issuer: "issuer"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "issuer_url", ret.GetId())
+	assert.Equal(t, "key", ret.GetVerificationMethod()[0].GetPublicKeyMultibase())
+	assert.Equal(t, []string{"issuer_url#key"}, ret.GetAssertionMethod())
+}
+
+func TestBadgeService_SuspendBadge_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().SuspendBadge(t.Context(), appID, "reason").Return(nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.SuspendBadge(t.Context(), &identity_service_sdk_go.SuspendBadgeRequest{
+		AppId:  appID,
+		Reason: "reason",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestBadgeService_ResumeBadge_should_propagate_error_when_core_service_fails(t *testing.T) {
+	t.Parallel()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().ResumeBadge(t.Context(), mock.Anything).Return(errBadgeUnexpected)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.ResumeBadge(t.Context(), &identity_service_sdk_go.ResumeBadgeRequest{})
+
+	assert.ErrorIs(t, err, errBadgeUnexpected)
+}
+
+func TestBadgeService_RevokeBadge_should_succeed(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+	badgeID := uuid.NewString()
+
+	badgeSrv := bffmocks.NewBadgeService(t)
+	badgeSrv.EXPECT().
+		RevokeBadge(t.Context(), appID, badgeID, badgetypes.REVOCATION_REASON_POLICY_VIOLATION, "comment").
+		Return(nil)
+
+	sut := grpc.NewBadgeService(badgeSrv)
+
+	_, err := sut.RevokeBadge(t.Context(), &identity_service_sdk_go.RevokeBadgeRequest{
+		AppId:   appID,
+		BadgeId: &badgeID,
+		Reason:  identity_service_sdk_go.RevocationReason_REVOCATION_REASON_POLICY_VIOLATION,
+		Comment: "comment",
+	})
+
+	assert.NoError(t, err)
+}
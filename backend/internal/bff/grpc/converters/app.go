@@ -26,6 +26,7 @@ func FromApp(src *apptypes.App) *identity_service_sdk_go.App {
 		ApiKey:             ptrutil.Ptr(src.ApiKey),
 		CreatedAt:          newTimestamp(&src.CreatedAt),
 		ResolverMetadataId: ptrutil.Ptr(src.ResolverMetadataID),
+		RequireDpop:        src.RequireDPoP,
 	}
 }
 
@@ -35,11 +36,13 @@ func ToApp(src *identity_service_sdk_go.App) *apptypes.App {
 	}
 
 	return &apptypes.App{
-		ID:          src.GetId(),
-		Name:        ptrutil.Ptr(src.GetName()),
-		Description: ptrutil.Ptr(src.GetDescription()),
-		Type:        apptypes.AppType(src.GetType()),
-		Status:      apptypes.AppStatus(src.GetStatus()),
+		ID:                 src.GetId(),
+		Name:               ptrutil.Ptr(src.GetName()),
+		Description:        ptrutil.Ptr(src.GetDescription()),
+		Type:               apptypes.AppType(src.GetType()),
+		Status:             apptypes.AppStatus(src.GetStatus()),
+		ResolverMetadataID: src.GetResolverMetadataId(),
+		RequireDPoP:        src.RequireDpop,
 	}
 }
 
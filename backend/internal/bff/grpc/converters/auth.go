@@ -0,0 +1,140 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package converters
+
+import (
+	"time"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func FromCallerIdentity(src *authtypes.CallerIdentity) *identity_service_sdk_go.ExtAuthzResponse {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.ExtAuthzResponse{
+		AppId:              src.AppID,
+		AppName:            src.AppName,
+		AppType:            identity_service_sdk_go.AppType(src.AppType),
+		ResolverMetadataId: src.ResolverMetadataID,
+		BadgeId:            src.BadgeID,
+		UserId:             src.UserID,
+		SessionId:          src.SessionID,
+		RuleId:             src.RuleID,
+		Receipt:            FromReceipt(src.Receipt),
+		TransactionId:      src.TransactionID,
+		TransactionToken:   src.TransactionToken,
+	}
+}
+
+func ToCallerIdentity(src *identity_service_sdk_go.ExtAuthzResponse) *authtypes.CallerIdentity {
+	if src == nil {
+		return nil
+	}
+
+	return &authtypes.CallerIdentity{
+		AppID:              src.GetAppId(),
+		AppName:            src.AppName,
+		AppType:            apptypes.AppType(src.GetAppType()),
+		ResolverMetadataID: src.GetResolverMetadataId(),
+		BadgeID:            src.BadgeId,
+		UserID:             src.UserId,
+		SessionID:          src.GetSessionId(),
+		RuleID:             src.RuleId,
+		Receipt:            ToReceipt(src.Receipt),
+		TransactionID:      src.TransactionId,
+		TransactionToken:   src.TransactionToken,
+	}
+}
+
+func FromReceipt(src *authtypes.Receipt) *identity_service_sdk_go.Receipt {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.Receipt{
+		Id:          src.ID,
+		CallerAppId: src.CallerAppID,
+		CallerDid:   src.CallerDID,
+		CalleeAppId: src.CalleeAppID,
+		CalleeDid:   src.CalleeDID,
+		ToolName:    src.ToolName,
+		RuleId:      src.RuleID,
+		ApprovalId:  src.ApprovalID,
+		BodyHash:    src.BodyHash,
+		Token:       src.Token,
+		CreatedAt:   timestamppb.New(time.Unix(src.CreatedAt, 0)),
+		ExpiresAt:   timestamppb.New(time.Unix(src.ExpiresAt, 0)),
+	}
+}
+
+func ToReceipt(src *identity_service_sdk_go.Receipt) *authtypes.Receipt {
+	if src == nil {
+		return nil
+	}
+
+	return &authtypes.Receipt{
+		ID:          src.GetId(),
+		CallerAppID: src.GetCallerAppId(),
+		CallerDID:   src.GetCallerDid(),
+		CalleeAppID: src.GetCalleeAppId(),
+		CalleeDID:   src.GetCalleeDid(),
+		ToolName:    src.GetToolName(),
+		RuleID:      src.GetRuleId(),
+		ApprovalID:  src.ApprovalId,
+		BodyHash:    src.BodyHash,
+		Token:       src.GetToken(),
+		CreatedAt:   src.GetCreatedAt().GetSeconds(),
+		ExpiresAt:   src.GetExpiresAt().GetSeconds(),
+	}
+}
+
+func FromTokenIntrospection(src *authtypes.TokenIntrospection) *identity_service_sdk_go.IntrospectResponse {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.IntrospectResponse{
+		Active:    src.Active,
+		ClientId:  src.ClientID,
+		Scope:     src.Scope,
+		TokenType: src.TokenType,
+		Exp:       src.ExpiresAt,
+		Iat:       src.IssuedAt,
+		Sub:       src.Subject,
+		Aud:       src.Audience,
+		Jti:       src.SessionID,
+		DpopJkt:   src.DPoPThumbprint,
+	}
+}
+
+func FromSession(src *authtypes.Session) *identity_service_sdk_go.Session {
+	if src == nil {
+		return nil
+	}
+
+	var expiresAt *timestamppb.Timestamp
+	if src.ExpiresAt != nil {
+		expiresAt = timestamppb.New(time.Unix(*src.ExpiresAt, 0))
+	}
+
+	return &identity_service_sdk_go.Session{
+		Id:                src.ID,
+		OwnerAppId:        src.OwnerAppID,
+		AppId:             src.AppID,
+		ToolName:          src.ToolName,
+		UserId:            src.UserID,
+		AccessToken:       src.AccessToken,
+		AuthorizationCode: src.AuthorizationCode,
+		CreatedAt:         timestamppb.New(time.Unix(src.CreatedAt, 0)),
+		ExpiresAt:         expiresAt,
+		TransactionId:     src.TransactionID,
+		DpopJkt:           src.DPoPThumbprint,
+		Active:            !src.HasExpired(),
+	}
+}
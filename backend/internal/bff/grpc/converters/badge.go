@@ -42,10 +42,16 @@ func FromCredentialStatus(
 	}
 
 	return &identity_service_sdk_go.CredentialStatus{
-		Id:        ptrutil.Ptr(src.ID),
-		Type:      ptrutil.Ptr(src.Type),
-		CreatedAt: newTimestamp(&src.CreatedAt),
-		Purpose:   ptrutil.Ptr(identity_service_sdk_go.CredentialStatusPurpose(src.Purpose)),
+		Id:                   ptrutil.Ptr(src.ID),
+		Type:                 ptrutil.Ptr(src.Type),
+		CreatedAt:            newTimestamp(&src.CreatedAt),
+		Purpose:              ptrutil.Ptr(identity_service_sdk_go.CredentialStatusPurpose(src.Purpose)),
+		StatusPurpose:        ptrutil.Ptr(src.StatusPurpose),
+		StatusListIndex:      ptrutil.Ptr(src.StatusListIndex),
+		StatusListCredential: ptrutil.Ptr(src.StatusListCredential),
+		CreatedBy:            ptrutil.Ptr(src.CreatedBy),
+		Comment:              ptrutil.Ptr(src.Comment),
+		RevocationReason:     ptrutil.Ptr(identity_service_sdk_go.RevocationReason(src.RevocationReason)),
 	}
 }
 
@@ -99,6 +105,31 @@ func FromBadgeClaims(src *badgetypes.BadgeClaims) *identity_service_sdk_go.Badge
 	}
 }
 
+func FromBadgeDiff(src *badgetypes.BadgeDiff) *identity_service_sdk_go.BadgeDiff {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.BadgeDiff{
+		FromBadgeId: ptrutil.Ptr(src.FromBadgeID),
+		ToBadgeId:   ptrutil.Ptr(src.ToBadgeID),
+		Changes:     convertutil.ConvertSlice(src.Changes, FromClaimChange),
+	}
+}
+
+func FromClaimChange(src *badgetypes.ClaimChange) *identity_service_sdk_go.ClaimChange {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.ClaimChange{
+		Path:     ptrutil.Ptr(src.Path),
+		Type:     ptrutil.Ptr(identity_service_sdk_go.ClaimChangeType(src.Type)),
+		OldValue: src.OldValue,
+		NewValue: src.NewValue,
+	}
+}
+
 func FromVerificationResult(src *badgetypes.VerificationResult) *identity_service_sdk_go.VerificationResult {
 	if src == nil {
 		return nil
@@ -112,6 +143,8 @@ func FromVerificationResult(src *badgetypes.VerificationResult) *identity_servic
 		ControlledIdentifierDocument: ptrutil.Ptr(src.ControlledIdentifierDocument),
 		Warnings:                     convertutil.ConvertSlice(src.Warnings, FromErrorInfo),
 		Errors:                       convertutil.ConvertSlice(src.Errors, FromErrorInfo),
+		RevocationReason:             ptrutil.Ptr(identity_service_sdk_go.RevocationReason(src.RevocationReason)),
+		Source:                       ptrutil.Ptr(identity_service_sdk_go.VerificationSource(src.Source)),
 	}
 }
 
@@ -125,3 +158,97 @@ func FromErrorInfo(src *badgetypes.ErrorInfo) *identity_service_sdk_go.ErrorInfo
 		Message: ptrutil.Ptr(src.Message),
 	}
 }
+
+func FromStatusListCredential(
+	src *badgetypes.StatusListCredential,
+) *identity_service_sdk_go.StatusListCredential {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.StatusListCredential{
+		Context:           src.Context,
+		Id:                ptrutil.Ptr(src.ID),
+		Type:              src.Type,
+		Issuer:            ptrutil.Ptr(src.Issuer),
+		ValidFrom:         ptrutil.Ptr(src.ValidFrom),
+		CredentialSubject: FromBitstringStatusList(src.CredentialSubject),
+		Proof:             FromProof(src.Proof),
+	}
+}
+
+func FromBitstringStatusList(
+	src *badgetypes.BitstringStatusList,
+) *identity_service_sdk_go.BitstringStatusList {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.BitstringStatusList{
+		Id:            ptrutil.Ptr(src.ID),
+		Type:          ptrutil.Ptr(src.Type),
+		StatusPurpose: ptrutil.Ptr(src.StatusPurpose),
+		EncodedList:   ptrutil.Ptr(src.EncodedList),
+	}
+}
+
+func FromIssuerDocument(src *badgetypes.IssuerDocument) *identity_service_sdk_go.IssuerDocument {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.IssuerDocument{
+		Context: src.Context,
+		Id:      ptrutil.Ptr(src.ID),
+		VerificationMethod: convertutil.ConvertSlice(
+			src.VerificationMethod,
+			FromVerificationMethod,
+		),
+		AssertionMethod: src.AssertionMethod,
+	}
+}
+
+func FromVerificationMethod(
+	src *badgetypes.VerificationMethod,
+) *identity_service_sdk_go.VerificationMethod {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.VerificationMethod{
+		Id:                 ptrutil.Ptr(src.ID),
+		Type:               ptrutil.Ptr(src.Type),
+		Controller:         ptrutil.Ptr(src.Controller),
+		PublicKeyMultibase: ptrutil.Ptr(src.PublicKeyMultibase),
+	}
+}
+
+func FromTrustPolicy(src *badgetypes.TrustPolicy) *identity_service_sdk_go.TrustPolicy {
+	if src == nil {
+		return nil
+	}
+
+	badgeTypes := make([]identity_service_sdk_go.BadgeType, 0, len(src.BadgeTypes))
+	for _, typ := range src.BadgeTypes {
+		badgeTypes = append(badgeTypes, identity_service_sdk_go.BadgeType(typ))
+	}
+
+	return &identity_service_sdk_go.TrustPolicy{
+		Id:               ptrutil.Ptr(src.ID),
+		Name:             ptrutil.Ptr(src.Name),
+		Issuers:          src.Issuers,
+		BadgeTypes:       badgeTypes,
+		IssuedWithinDays: ptrutil.Ptr(src.IssuedWithinDays),
+		RequireActiveApp: ptrutil.Ptr(src.RequireActiveApp),
+		CreatedAt:        newTimestamp(&src.CreatedAt),
+	}
+}
+
+func ToBadgeTypes(src []identity_service_sdk_go.BadgeType) []badgetypes.BadgeType {
+	badgeTypes := make([]badgetypes.BadgeType, 0, len(src))
+	for _, typ := range src {
+		badgeTypes = append(badgeTypes, badgetypes.BadgeType(typ))
+	}
+
+	return badgeTypes
+}
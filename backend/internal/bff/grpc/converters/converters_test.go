@@ -23,6 +23,7 @@ var testData []any = []any{
 	converters.FromApp,
 	converters.FromBadge,
 	converters.FromBadgeClaims,
+	converters.FromCallerIdentity,
 	converters.FromCredentialSchema,
 	converters.FromCredentialStatus,
 	converters.FromDevice,
@@ -30,15 +31,18 @@ var testData []any = []any{
 	converters.FromIssuerSettings,
 	converters.FromPolicy,
 	converters.FromProof,
+	converters.FromReceipt,
 	converters.FromRule,
 	converters.FromTask,
 	converters.FromVerifiableCredential,
 	converters.FromVerificationResult,
 	converters.ToApp,
+	converters.ToCallerIdentity,
 	converters.ToDevice,
 	converters.ToOktaIdpSettings,
 	converters.ToDuoIdpSettings,
 	converters.ToOryIdpSettings,
+	converters.ToReceipt,
 	converters.ToKeycloakIdpSettings,
 	converters.ToIssuerSettings,
 }
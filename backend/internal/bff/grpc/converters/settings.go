@@ -5,6 +5,7 @@ package converters
 
 import (
 	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity-service/internal/pkg/strutil"
@@ -229,3 +230,89 @@ func FromApiKey(
 		ApiKey: ptrutil.Ptr(src.ApiKey),
 	}
 }
+
+func FromRateLimit(
+	src *settingstypes.RateLimit,
+) *identity_service_sdk_go.RateLimit {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.RateLimit{
+		RequestsPerSecond: ptrutil.Ptr(src.RequestsPerSecond),
+		Burst:             ptrutil.Ptr(src.Burst),
+	}
+}
+
+func ToRateLimit(
+	src *identity_service_sdk_go.RateLimit,
+) *settingstypes.RateLimit {
+	if src == nil {
+		return nil
+	}
+
+	return &settingstypes.RateLimit{
+		RequestsPerSecond: src.GetRequestsPerSecond(),
+		Burst:             src.GetBurst(),
+	}
+}
+
+func FromRateLimitSettings(
+	src *settingstypes.RateLimitSettings,
+) *identity_service_sdk_go.RateLimitSettings {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.RateLimitSettings{
+		Tenant:    FromRateLimit(src.Tenant),
+		CallerApp: FromRateLimit(src.CallerApp),
+		CalleeApp: FromRateLimit(src.CalleeApp),
+	}
+}
+
+func ToRateLimitSettings(
+	src *identity_service_sdk_go.RateLimitSettings,
+) *settingstypes.RateLimitSettings {
+	if src == nil {
+		return nil
+	}
+
+	return &settingstypes.RateLimitSettings{
+		Tenant:    ToRateLimit(src.GetTenant()),
+		CallerApp: ToRateLimit(src.GetCallerApp()),
+		CalleeApp: ToRateLimit(src.GetCalleeApp()),
+	}
+}
+
+func FromBadgeSettings(
+	src *settingstypes.BadgeSettings,
+) *identity_service_sdk_go.BadgeSettings {
+	if src == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.BadgeSettings{
+		DefaultLifetimeSeconds:   ptrutil.Ptr(src.DefaultLifetimeSeconds),
+		AgentA2ALifetimeSeconds:  ptrutil.Ptr(src.AgentA2ALifetimeSeconds),
+		AgentOasfLifetimeSeconds: ptrutil.Ptr(src.AgentOASFLifetimeSeconds),
+		McpServerLifetimeSeconds: ptrutil.Ptr(src.McpServerLifetimeSeconds),
+		ProofFormat:              ptrutil.Ptr(identity_service_sdk_go.ProofFormat(src.ProofFormat)),
+	}
+}
+
+func ToBadgeSettings(
+	src *identity_service_sdk_go.BadgeSettings,
+) *settingstypes.BadgeSettings {
+	if src == nil {
+		return nil
+	}
+
+	return &settingstypes.BadgeSettings{
+		DefaultLifetimeSeconds:   src.GetDefaultLifetimeSeconds(),
+		AgentA2ALifetimeSeconds:  src.GetAgentA2ALifetimeSeconds(),
+		AgentOASFLifetimeSeconds: src.GetAgentOasfLifetimeSeconds(),
+		McpServerLifetimeSeconds: src.GetMcpServerLifetimeSeconds(),
+		ProofFormat:              badgetypes.ProofFormat(src.GetProofFormat()),
+	}
+}
@@ -26,16 +26,31 @@ const (
 
 var allowedServicesWithoutAuth = []string{
 	identity_service_sdk_go.DeviceService_RegisterDevice_FullMethodName,
-	identity_service_sdk_go.BadgeService_VerifyBadge_FullMethodName,
+	identity_service_sdk_go.BadgeService_GetStatusList_FullMethodName,
+	identity_service_sdk_go.BadgeService_GetIssuerDocument_FullMethodName,
 	identity_service_sdk_go.AuthService_ApproveToken_FullMethodName,
 	"/grpc.health.v1.Health/Check",
 }
 
+// The services authenticating the requests sent with credentials,
+// the requests without credentials are handled without authentication
+var allowedServicesWithOptionalAuth = []string{
+	identity_service_sdk_go.BadgeService_VerifyBadge_FullMethodName,
+	identity_service_sdk_go.BadgeService_VerifyBadges_FullMethodName,
+}
+
 var allowedServicesWithAppAuth = []string{
 	identity_service_sdk_go.AuthService_AppInfo_FullMethodName,
 	identity_service_sdk_go.AuthService_Authorize_FullMethodName,
 	identity_service_sdk_go.AuthService_Token_FullMethodName,
 	identity_service_sdk_go.AuthService_ExtAuthz_FullMethodName,
+	identity_service_sdk_go.AuthService_ExtAuthzMcp_FullMethodName,
+	identity_service_sdk_go.AuthService_ExtAuthzMcpTools_FullMethodName,
+	identity_service_sdk_go.AuthService_ExtAuthzA2A_FullMethodName,
+	identity_service_sdk_go.AuthService_ExtAuthzA2ASkills_FullMethodName,
+	identity_service_sdk_go.AuthService_GetReceipt_FullMethodName,
+	identity_service_sdk_go.AuthService_Introspect_FullMethodName,
+	identity_service_sdk_go.AuthService_Revoke_FullMethodName,
 	identity_service_sdk_go.BadgeService_IssueBadge_FullMethodName,
 }
 
@@ -66,6 +81,10 @@ func (ti *AuthInterceptor) Unary(
 		return handler(ctx, req)
 	}
 
+	if slices.Contains(allowedServicesWithOptionalAuth, info.FullMethod) && !hasCredentials(ctx) {
+		return handler(ctx, req)
+	}
+
 	log.Debug("Auth Interceptor: ", info.FullMethod)
 
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -122,3 +141,15 @@ func (ti *AuthInterceptor) Unary(
 
 	return handler(aCtx, req)
 }
+
+func hasCredentials(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	_, okAPIKeyV1 := md[APIKeyHeaderKey]
+	_, okAuth := md[AuthorizationHeaderKey]
+
+	return okAuth || okAPIKeyV1
+}
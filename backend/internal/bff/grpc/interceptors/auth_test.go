@@ -83,6 +83,30 @@ func TestAuthInterceptor_Unary_should_validate_jwt(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAuthInterceptor_Unary_should_authenticate_verify_badge_with_credentials(t *testing.T) {
+	t.Parallel()
+
+	jwt := uuid.NewString()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+		interceptors.AuthorizationHeaderKey: []string{jwt},
+	})
+
+	handler := mockHandler{}
+	handler.On("Handle", ctx, mock.Anything).Return(nil, nil)
+
+	iamClient := iammocks.NewClient(t)
+	iamClient.EXPECT().AuthJwt(ctx, jwt).Return(ctx, nil)
+
+	sut := interceptors.NewAuthInterceptor(iamClient)
+
+	_, err := sut.Unary(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: identity_service_sdk_go.BadgeService_VerifyBadge_FullMethodName,
+	}, handler.Handle)
+
+	assert.NoError(t, err)
+	handler.AssertExpectations(t)
+}
+
 func TestAuthInterceptor_Unary_should_validate_api_key(t *testing.T) {
 	t.Parallel()
 
@@ -198,6 +222,19 @@ func TestAuthInterceptor_Unary_should_return_unauthorized(t *testing.T) {
 			},
 			fullMethod: identity_service_sdk_go.AuthService_Authorize_FullMethodName,
 		},
+		"request to verify a badge with JWT": {
+			configureIamClient: func(t *testing.T, client *iammocks.Client) {
+				t.Helper()
+
+				client.EXPECT().
+					AuthJwt(mock.Anything, mock.Anything).
+					Return(context.Background(), errors.New("invalid"))
+			},
+			md: metadata.MD{
+				interceptors.AuthorizationHeaderKey: []string{uuid.NewString()},
+			},
+			fullMethod: identity_service_sdk_go.BadgeService_VerifyBadge_FullMethodName,
+		},
 		"request without auth headers": {
 			configureIamClient: func(t *testing.T, client *iammocks.Client) {
 				t.Helper()
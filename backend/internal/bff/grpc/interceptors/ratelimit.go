@@ -0,0 +1,129 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptors
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/errutil"
+	"github.com/agntcy/identity-service/internal/pkg/grpcutil"
+	"github.com/agntcy/identity-service/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const RetryAfterHeaderKey string = "retry-after"
+
+// The app in the context is the caller for Authorize and Token,
+// and the callee for the ExtAuthz endpoints. The caller of the ExtAuthz
+// endpoints is the owner of the access token in the request
+var rateLimitedServices = map[string]ratelimit.Scope{
+	identity_service_sdk_go.AuthService_Authorize_FullMethodName:         ratelimit.ScopeCallerApp,
+	identity_service_sdk_go.AuthService_Token_FullMethodName:             ratelimit.ScopeCallerApp,
+	identity_service_sdk_go.AuthService_ExtAuthz_FullMethodName:          ratelimit.ScopeCalleeApp,
+	identity_service_sdk_go.AuthService_ExtAuthzMcp_FullMethodName:       ratelimit.ScopeCalleeApp,
+	identity_service_sdk_go.AuthService_ExtAuthzMcpTools_FullMethodName:  ratelimit.ScopeCalleeApp,
+	identity_service_sdk_go.AuthService_ExtAuthzA2A_FullMethodName:       ratelimit.ScopeCalleeApp,
+	identity_service_sdk_go.AuthService_ExtAuthzA2ASkills_FullMethodName: ratelimit.ScopeCalleeApp,
+}
+
+// The CallerResolver returns the ID of the app the access token was issued to.
+// The returned context is passed to the handler, it can carry the state resolved
+// along the way so the handler does not resolve the access token again.
+type CallerResolver func(ctx context.Context, accessToken string) (context.Context, string, error)
+
+type accessTokenRequest interface {
+	GetAccessToken() string
+}
+
+type RateLimitInterceptor struct {
+	enforcer       ratelimit.Enforcer
+	callerResolver CallerResolver
+}
+
+func NewRateLimitInterceptor(
+	enforcer ratelimit.Enforcer,
+	callerResolver CallerResolver,
+) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		enforcer:       enforcer,
+		callerResolver: callerResolver,
+	}
+}
+
+// The unary interceptor must run after the AuthInterceptor
+// to find the app and the tenant in the context
+func (ri *RateLimitInterceptor) Unary(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	scope, ok := rateLimitedServices[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	// The requests authenticated with a user JWT only count for the tenant
+	appID, _ := identitycontext.GetAppID(ctx)
+
+	apps := []ratelimit.AppBucket{{Scope: scope, AppID: appID}}
+	if scope == ratelimit.ScopeCalleeApp {
+		var callerAppID string
+
+		ctx, callerAppID = ri.resolveCaller(ctx, req)
+		apps = append([]ratelimit.AppBucket{{
+			Scope: ratelimit.ScopeCallerApp,
+			AppID: callerAppID,
+		}}, apps...)
+	}
+
+	result, err := ri.enforcer.Allow(ctx, apps...)
+	if err != nil {
+		// Let the request through, the authorization endpoints
+		// should not fail because the limits cannot be checked
+		log.FromContext(ctx).WithError(err).Warn("rate limit interceptor failed to check the limits")
+
+		return handler(ctx, req)
+	}
+
+	if !result.Allowed {
+		retryAfter := max(1, int(math.Ceil(result.RetryAfter.Seconds())))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeaderKey, strconv.Itoa(retryAfter)))
+
+		return nil, grpcutil.ResourceExhaustedError(
+			errutil.TooManyRequests(
+				"auth.rateLimitExceeded",
+				"Too many requests. Retry in %d seconds.",
+				retryAfter,
+			),
+			result.RetryAfter,
+		)
+	}
+
+	return handler(ctx, req)
+}
+
+// resolveCaller returns the app owning the access token of the request, or an empty
+// string when it cannot be resolved. The ExtAuthz endpoints reject these requests,
+// they still count for the callee app and the tenant.
+func (ri *RateLimitInterceptor) resolveCaller(ctx context.Context, req any) (context.Context, string) {
+	tokenReq, ok := req.(accessTokenRequest)
+	if !ok || tokenReq.GetAccessToken() == "" {
+		return ctx, ""
+	}
+
+	resolvedCtx, callerAppID, err := ri.callerResolver(ctx, tokenReq.GetAccessToken())
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("rate limit interceptor failed to resolve the caller app")
+		return ctx, ""
+	}
+
+	return resolvedCtx, callerAppID
+}
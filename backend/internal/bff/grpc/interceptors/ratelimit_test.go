@@ -0,0 +1,207 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptors_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/bff/grpc/interceptors"
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	ratelimitmocks "github.com/agntcy/identity-service/internal/core/ratelimit/mocks"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitInterceptor_Unary_should_skip_the_other_services(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	handler := mockHandler{}
+	handler.On("Handle", ctx, mock.Anything).Return(nil, nil)
+
+	sut := interceptors.NewRateLimitInterceptor(ratelimitmocks.NewEnforcer(t), nil)
+
+	_, err := sut.Unary(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: identity_service_sdk_go.AppService_ListApps_FullMethodName,
+	}, handler.Handle)
+
+	assert.NoError(t, err)
+	handler.AssertExpectations(t)
+}
+
+func TestRateLimitInterceptor_Unary_should_allow_the_request(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+	callerAppID := uuid.NewString()
+	accessToken := uuid.NewString()
+
+	testCases := map[string]*struct {
+		method         string
+		req            any
+		callerResolver interceptors.CallerResolver
+		apps           []any
+	}{
+		"caller app for Token": {
+			method: identity_service_sdk_go.AuthService_Token_FullMethodName,
+			req:    &identity_service_sdk_go.TokenRequest{},
+			apps:   []any{ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: appID}},
+		},
+		"caller and callee apps for ExtAuthzMcp": {
+			method: identity_service_sdk_go.AuthService_ExtAuthzMcp_FullMethodName,
+			req:    &identity_service_sdk_go.ExtAuthzMcpRequest{AccessToken: accessToken},
+			callerResolver: func(ctx context.Context, token string) (context.Context, string, error) {
+				if token != accessToken {
+					return ctx, "", errors.New("unknown token")
+				}
+
+				return ctx, callerAppID, nil
+			},
+			apps: []any{
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: callerAppID},
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp, AppID: appID},
+			},
+		},
+		"callee app for ExtAuthz with an unknown access token": {
+			method: identity_service_sdk_go.AuthService_ExtAuthz_FullMethodName,
+			req:    &identity_service_sdk_go.ExtAuthzRequest{AccessToken: accessToken},
+			callerResolver: func(ctx context.Context, _ string) (context.Context, string, error) {
+				return ctx, "", errors.New("session not found")
+			},
+			apps: []any{
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp},
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp, AppID: appID},
+			},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := identitycontext.InsertAppID(context.Background(), appID)
+
+			handler := mockHandler{}
+			handler.On("Handle", ctx, mock.Anything).Return(nil, nil)
+
+			enforcer := ratelimitmocks.NewEnforcer(t)
+			enforcer.EXPECT().Allow(ctx, tc.apps...).Return(&ratelimit.Result{Allowed: true}, nil)
+
+			sut := interceptors.NewRateLimitInterceptor(enforcer, tc.callerResolver)
+
+			_, err := sut.Unary(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler.Handle)
+
+			assert.NoError(t, err)
+			handler.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRateLimitInterceptor_Unary_should_pass_the_context_of_the_caller_resolver_to_the_handler(t *testing.T) {
+	t.Parallel()
+
+	type resolvedKey struct{}
+
+	appID := uuid.NewString()
+	callerAppID := uuid.NewString()
+	ctx := identitycontext.InsertAppID(context.Background(), appID)
+	resolvedCtx := context.WithValue(ctx, resolvedKey{}, callerAppID)
+
+	handler := mockHandler{}
+	handler.On("Handle", resolvedCtx, mock.Anything).Return(nil, nil)
+
+	enforcer := ratelimitmocks.NewEnforcer(t)
+	enforcer.EXPECT().
+		Allow(resolvedCtx, mock.Anything, mock.Anything).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+
+	sut := interceptors.NewRateLimitInterceptor(
+		enforcer,
+		func(context.Context, string) (context.Context, string, error) {
+			return resolvedCtx, callerAppID, nil
+		},
+	)
+
+	_, err := sut.Unary(
+		ctx,
+		&identity_service_sdk_go.ExtAuthzMcpRequest{AccessToken: uuid.NewString()},
+		&grpc.UnaryServerInfo{FullMethod: identity_service_sdk_go.AuthService_ExtAuthzMcp_FullMethodName},
+		handler.Handle,
+	)
+
+	assert.NoError(t, err)
+	handler.AssertExpectations(t)
+}
+
+func TestRateLimitInterceptor_Unary_should_return_resource_exhausted_when_denied(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	handler := mockHandler{}
+
+	enforcer := ratelimitmocks.NewEnforcer(t)
+	enforcer.EXPECT().
+		Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp}).
+		Return(&ratelimit.Result{RetryAfter: 1500 * time.Millisecond}, nil)
+
+	sut := interceptors.NewRateLimitInterceptor(enforcer, nil)
+
+	_, err := sut.Unary(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: identity_service_sdk_go.AuthService_Authorize_FullMethodName,
+	}, handler.Handle)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, "Too many requests. Retry in 2 seconds.", st.Message())
+
+	var retryInfo *epb.RetryInfo
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*epb.RetryInfo); ok {
+			retryInfo = info
+		}
+	}
+
+	require.NotNil(t, retryInfo)
+	assert.Equal(t, 1500*time.Millisecond, retryInfo.GetRetryDelay().AsDuration())
+	handler.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+}
+
+func TestRateLimitInterceptor_Unary_should_allow_the_request_when_the_enforcer_fails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	handler := mockHandler{}
+	handler.On("Handle", ctx, mock.Anything).Return(nil, nil)
+
+	enforcer := ratelimitmocks.NewEnforcer(t)
+	enforcer.EXPECT().
+		Allow(
+			ctx,
+			ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp},
+			ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp},
+		).
+		Return(nil, errors.New("failed"))
+
+	sut := interceptors.NewRateLimitInterceptor(enforcer, nil)
+
+	_, err := sut.Unary(ctx, nil, &grpc.UnaryServerInfo{
+		FullMethod: identity_service_sdk_go.AuthService_ExtAuthz_FullMethodName,
+	}, handler.Handle)
+
+	assert.NoError(t, err)
+	handler.AssertExpectations(t)
+}
@@ -34,8 +34,10 @@ func (s *settingsService) GetSettings(
 	}
 
 	return &identity_service_sdk_go.Settings{
-		IssuerSettings: converters.FromIssuerSettings(settings.IssuerSettings),
-		ApiKey:         converters.FromApiKey(settings.ApiKey),
+		IssuerSettings:    converters.FromIssuerSettings(settings.IssuerSettings),
+		ApiKey:            converters.FromApiKey(settings.ApiKey),
+		RateLimitSettings: converters.FromRateLimitSettings(settings.RateLimitSettings),
+		BadgeSettings:     converters.FromBadgeSettings(settings.BadgeSettings),
 	}, nil
 }
 
@@ -64,3 +66,31 @@ func (s *settingsService) SetIssuer(
 
 	return converters.FromIssuerSettings(updatedIssuerSettings), nil
 }
+
+func (s *settingsService) SetRateLimits(
+	ctx context.Context,
+	req *identity_service_sdk_go.SetRateLimitsRequest,
+) (*identity_service_sdk_go.RateLimitSettings, error) {
+	rateLimitSettings := converters.ToRateLimitSettings(req.GetRateLimitSettings())
+
+	updatedRateLimitSettings, err := s.settingsSrv.SetRateLimitSettings(ctx, rateLimitSettings)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromRateLimitSettings(updatedRateLimitSettings), nil
+}
+
+func (s *settingsService) SetBadgeSettings(
+	ctx context.Context,
+	req *identity_service_sdk_go.SetBadgeSettingsRequest,
+) (*identity_service_sdk_go.BadgeSettings, error) {
+	badgeSettings := converters.ToBadgeSettings(req.GetBadgeSettings())
+
+	updatedBadgeSettings, err := s.settingsSrv.SetBadgeSettings(ctx, badgeSettings)
+	if err != nil {
+		return nil, grpcutil.Error(err)
+	}
+
+	return converters.FromBadgeSettings(updatedBadgeSettings), nil
+}
@@ -139,3 +139,94 @@ func TestSettingsService_SetIssuer(t *testing.T) {
 		assert.ErrorIs(t, err, errSettingsUnexpected)
 	})
 }
+
+func TestSettingsService_SetRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should set the rate limits", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimitSettings := &settingstypes.RateLimitSettings{
+			CallerApp: &settingstypes.RateLimit{RequestsPerSecond: 10, Burst: 20},
+		}
+
+		settingsSrv := bffmocks.NewSettingsService(t)
+		settingsSrv.EXPECT().SetRateLimitSettings(ctx, rateLimitSettings).Return(rateLimitSettings, nil)
+
+		sut := grpc.NewSettingsService(settingsSrv)
+
+		ret, err := sut.SetRateLimits(ctx, &identity_service_sdk_go.SetRateLimitsRequest{
+			RateLimitSettings: &identity_service_sdk_go.RateLimitSettings{
+				CallerApp: &identity_service_sdk_go.RateLimit{
+					RequestsPerSecond: ptrutil.Ptr(10.0),
+					Burst:             ptrutil.Ptr(int32(20)),
+				},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Nil(t, ret.Tenant)
+		assert.Equal(t, 10.0, ret.CallerApp.GetRequestsPerSecond())
+		assert.Equal(t, int32(20), ret.CallerApp.GetBurst())
+	})
+
+	t.Run("should propagate error when core service fails", func(t *testing.T) {
+		t.Parallel()
+
+		settingsSrv := bffmocks.NewSettingsService(t)
+		settingsSrv.EXPECT().SetRateLimitSettings(ctx, mock.Anything).Return(nil, errSettingsUnexpected)
+
+		sut := grpc.NewSettingsService(settingsSrv)
+
+		_, err := sut.SetRateLimits(ctx, &identity_service_sdk_go.SetRateLimitsRequest{})
+
+		assert.ErrorIs(t, err, errSettingsUnexpected)
+	})
+}
+
+func TestSettingsService_SetBadgeSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should set the badge settings", func(t *testing.T) {
+		t.Parallel()
+
+		badgeSettings := &settingstypes.BadgeSettings{
+			DefaultLifetimeSeconds:  86400,
+			AgentA2ALifetimeSeconds: 3600,
+		}
+
+		settingsSrv := bffmocks.NewSettingsService(t)
+		settingsSrv.EXPECT().SetBadgeSettings(ctx, badgeSettings).Return(badgeSettings, nil)
+
+		sut := grpc.NewSettingsService(settingsSrv)
+
+		ret, err := sut.SetBadgeSettings(ctx, &identity_service_sdk_go.SetBadgeSettingsRequest{
+			BadgeSettings: &identity_service_sdk_go.BadgeSettings{
+				DefaultLifetimeSeconds:  ptrutil.Ptr(int64(86400)),
+				AgentA2ALifetimeSeconds: ptrutil.Ptr(int64(3600)),
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(86400), ret.GetDefaultLifetimeSeconds())
+		assert.Equal(t, int64(3600), ret.GetAgentA2ALifetimeSeconds())
+		assert.Equal(t, int64(0), ret.GetMcpServerLifetimeSeconds())
+	})
+
+	t.Run("should propagate error when core service fails", func(t *testing.T) {
+		t.Parallel()
+
+		settingsSrv := bffmocks.NewSettingsService(t)
+		settingsSrv.EXPECT().SetBadgeSettings(ctx, mock.Anything).Return(nil, errSettingsUnexpected)
+
+		sut := grpc.NewSettingsService(settingsSrv)
+
+		_, err := sut.SetBadgeSettings(ctx, &identity_service_sdk_go.SetBadgeSettingsRequest{})
+
+		assert.ErrorIs(t, err, errSettingsUnexpected)
+	})
+}
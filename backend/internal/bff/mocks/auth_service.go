@@ -7,7 +7,9 @@ package mocks
 import (
 	"context"
 
+	"github.com/agntcy/identity-service/internal/bff"
 	"github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -114,8 +116,8 @@ func (_c *AuthService_ApproveToken_Call) RunAndReturn(run func(ctx context.Conte
 }
 
 // Authorize provides a mock function for the type AuthService
-func (_mock *AuthService) Authorize(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string) (*types.Session, error) {
-	ret := _mock.Called(ctx, resolverMetadataID, toolName, userToken)
+func (_mock *AuthService) Authorize(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string, dpopProof *string, codeChallenge *string, codeChallengeMethod *string) (*types.Session, error) {
+	ret := _mock.Called(ctx, resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Authorize")
@@ -123,18 +125,18 @@ func (_mock *AuthService) Authorize(ctx context.Context, resolverMetadataID *str
 
 	var r0 *types.Session
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, *string, *string) (*types.Session, error)); ok {
-		return returnFunc(ctx, resolverMetadataID, toolName, userToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, *string, *string, *string, *string, *string) (*types.Session, error)); ok {
+		return returnFunc(ctx, resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, *string, *string) *types.Session); ok {
-		r0 = returnFunc(ctx, resolverMetadataID, toolName, userToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, *string, *string, *string, *string, *string) *types.Session); ok {
+		r0 = returnFunc(ctx, resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Session)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *string, *string, *string) error); ok {
-		r1 = returnFunc(ctx, resolverMetadataID, toolName, userToken)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *string, *string, *string, *string, *string, *string) error); ok {
+		r1 = returnFunc(ctx, resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -151,11 +153,14 @@ type AuthService_Authorize_Call struct {
 //   - resolverMetadataID *string
 //   - toolName *string
 //   - userToken *string
-func (_e *AuthService_Expecter) Authorize(ctx interface{}, resolverMetadataID interface{}, toolName interface{}, userToken interface{}) *AuthService_Authorize_Call {
-	return &AuthService_Authorize_Call{Call: _e.mock.On("Authorize", ctx, resolverMetadataID, toolName, userToken)}
+//   - dpopProof *string
+//   - codeChallenge *string
+//   - codeChallengeMethod *string
+func (_e *AuthService_Expecter) Authorize(ctx interface{}, resolverMetadataID interface{}, toolName interface{}, userToken interface{}, dpopProof interface{}, codeChallenge interface{}, codeChallengeMethod interface{}) *AuthService_Authorize_Call {
+	return &AuthService_Authorize_Call{Call: _e.mock.On("Authorize", ctx, resolverMetadataID, toolName, userToken, dpopProof, codeChallenge, codeChallengeMethod)}
 }
 
-func (_c *AuthService_Authorize_Call) Run(run func(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string)) *AuthService_Authorize_Call {
+func (_c *AuthService_Authorize_Call) Run(run func(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string, dpopProof *string, codeChallenge *string, codeChallengeMethod *string)) *AuthService_Authorize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -173,11 +178,26 @@ func (_c *AuthService_Authorize_Call) Run(run func(ctx context.Context, resolver
 		if args[3] != nil {
 			arg3 = args[3].(*string)
 		}
+		var arg4 *string
+		if args[4] != nil {
+			arg4 = args[4].(*string)
+		}
+		var arg5 *string
+		if args[5] != nil {
+			arg5 = args[5].(*string)
+		}
+		var arg6 *string
+		if args[6] != nil {
+			arg6 = args[6].(*string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
+			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -188,26 +208,89 @@ func (_c *AuthService_Authorize_Call) Return(session *types.Session, err error)
 	return _c
 }
 
-func (_c *AuthService_Authorize_Call) RunAndReturn(run func(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string) (*types.Session, error)) *AuthService_Authorize_Call {
+func (_c *AuthService_Authorize_Call) RunAndReturn(run func(ctx context.Context, resolverMetadataID *string, toolName *string, userToken *string, dpopProof *string, codeChallenge *string, codeChallengeMethod *string) (*types.Session, error)) *AuthService_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DPoPNonce provides a mock function for the type AuthService
+func (_mock *AuthService) DPoPNonce() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DPoPNonce")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// AuthService_DPoPNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DPoPNonce'
+type AuthService_DPoPNonce_Call struct {
+	*mock.Call
+}
+
+// DPoPNonce is a helper method to define mock.On call
+func (_e *AuthService_Expecter) DPoPNonce() *AuthService_DPoPNonce_Call {
+	return &AuthService_DPoPNonce_Call{Call: _e.mock.On("DPoPNonce")}
+}
+
+func (_c *AuthService_DPoPNonce_Call) Run(run func()) *AuthService_DPoPNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AuthService_DPoPNonce_Call) Return(s string) *AuthService_DPoPNonce_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *AuthService_DPoPNonce_Call) RunAndReturn(run func() string) *AuthService_DPoPNonce_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ExtAuthZ provides a mock function for the type AuthService
-func (_mock *AuthService) ExtAuthZ(ctx context.Context, accessToken string, toolName string) error {
-	ret := _mock.Called(ctx, accessToken, toolName)
+func (_mock *AuthService) ExtAuthZ(ctx context.Context, accessToken string, toolName string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error) {
+	// bff.ExtAuthZOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, accessToken, toolName)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExtAuthZ")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, accessToken, toolName)
+	var r0 *types.CallerIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, ...bff.ExtAuthZOption) (*types.CallerIdentity, error)); ok {
+		return returnFunc(ctx, accessToken, toolName, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, ...bff.ExtAuthZOption) *types.CallerIdentity); ok {
+		r0 = returnFunc(ctx, accessToken, toolName, opts...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CallerIdentity)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, ...bff.ExtAuthZOption) error); ok {
+		r1 = returnFunc(ctx, accessToken, toolName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // AuthService_ExtAuthZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtAuthZ'
@@ -219,11 +302,13 @@ type AuthService_ExtAuthZ_Call struct {
 //   - ctx context.Context
 //   - accessToken string
 //   - toolName string
-func (_e *AuthService_Expecter) ExtAuthZ(ctx interface{}, accessToken interface{}, toolName interface{}) *AuthService_ExtAuthZ_Call {
-	return &AuthService_ExtAuthZ_Call{Call: _e.mock.On("ExtAuthZ", ctx, accessToken, toolName)}
+//   - opts ...bff.ExtAuthZOption
+func (_e *AuthService_Expecter) ExtAuthZ(ctx interface{}, accessToken interface{}, toolName interface{}, opts ...interface{}) *AuthService_ExtAuthZ_Call {
+	return &AuthService_ExtAuthZ_Call{Call: _e.mock.On("ExtAuthZ",
+		append([]interface{}{ctx, accessToken, toolName}, opts...)...)}
 }
 
-func (_c *AuthService_ExtAuthZ_Call) Run(run func(ctx context.Context, accessToken string, toolName string)) *AuthService_ExtAuthZ_Call {
+func (_c *AuthService_ExtAuthZ_Call) Run(run func(ctx context.Context, accessToken string, toolName string, opts ...bff.ExtAuthZOption)) *AuthService_ExtAuthZ_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -237,66 +322,348 @@ func (_c *AuthService_ExtAuthZ_Call) Run(run func(ctx context.Context, accessTok
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 []bff.ExtAuthZOption
+		variadicArgs := make([]bff.ExtAuthZOption, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(bff.ExtAuthZOption)
+			}
+		}
+		arg3 = variadicArgs
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3...,
 		)
 	})
 	return _c
 }
 
-func (_c *AuthService_ExtAuthZ_Call) Return(err error) *AuthService_ExtAuthZ_Call {
-	_c.Call.Return(err)
+func (_c *AuthService_ExtAuthZ_Call) Return(callerIdentity *types.CallerIdentity, err error) *AuthService_ExtAuthZ_Call {
+	_c.Call.Return(callerIdentity, err)
 	return _c
 }
 
-func (_c *AuthService_ExtAuthZ_Call) RunAndReturn(run func(ctx context.Context, accessToken string, toolName string) error) *AuthService_ExtAuthZ_Call {
+func (_c *AuthService_ExtAuthZ_Call) RunAndReturn(run func(ctx context.Context, accessToken string, toolName string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error)) *AuthService_ExtAuthZ_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Token provides a mock function for the type AuthService
-func (_mock *AuthService) Token(ctx context.Context, authorizationCode string) (*types.Session, error) {
-	ret := _mock.Called(ctx, authorizationCode)
+// ExtAuthZA2A provides a mock function for the type AuthService
+func (_mock *AuthService) ExtAuthZA2A(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error) {
+	// bff.ExtAuthZOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, accessToken, body, contentType)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Token")
+		panic("no return value specified for ExtAuthZA2A")
 	}
 
-	var r0 *types.Session
+	var r0 *types.CallerIdentity
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Session, error)); ok {
-		return returnFunc(ctx, authorizationCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) (*types.CallerIdentity, error)); ok {
+		return returnFunc(ctx, accessToken, body, contentType, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Session); ok {
-		r0 = returnFunc(ctx, authorizationCode)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) *types.CallerIdentity); ok {
+		r0 = returnFunc(ctx, accessToken, body, contentType, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Session)
+			r0 = ret.Get(0).(*types.CallerIdentity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, authorizationCode)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) error); ok {
+		r1 = returnFunc(ctx, accessToken, body, contentType, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// AuthService_Token_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Token'
-type AuthService_Token_Call struct {
+// AuthService_ExtAuthZA2A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtAuthZA2A'
+type AuthService_ExtAuthZA2A_Call struct {
 	*mock.Call
 }
 
-// Token is a helper method to define mock.On call
+// ExtAuthZA2A is a helper method to define mock.On call
 //   - ctx context.Context
-//   - authorizationCode string
-func (_e *AuthService_Expecter) Token(ctx interface{}, authorizationCode interface{}) *AuthService_Token_Call {
-	return &AuthService_Token_Call{Call: _e.mock.On("Token", ctx, authorizationCode)}
+//   - accessToken string
+//   - body []byte
+//   - contentType string
+//   - opts ...bff.ExtAuthZOption
+func (_e *AuthService_Expecter) ExtAuthZA2A(ctx interface{}, accessToken interface{}, body interface{}, contentType interface{}, opts ...interface{}) *AuthService_ExtAuthZA2A_Call {
+	return &AuthService_ExtAuthZA2A_Call{Call: _e.mock.On("ExtAuthZA2A",
+		append([]interface{}{ctx, accessToken, body, contentType}, opts...)...)}
+}
+
+func (_c *AuthService_ExtAuthZA2A_Call) Run(run func(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption)) *AuthService_ExtAuthZA2A_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []bff.ExtAuthZOption
+		variadicArgs := make([]bff.ExtAuthZOption, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(bff.ExtAuthZOption)
+			}
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ExtAuthZA2A_Call) Return(callerIdentity *types.CallerIdentity, err error) *AuthService_ExtAuthZA2A_Call {
+	_c.Call.Return(callerIdentity, err)
+	return _c
+}
+
+func (_c *AuthService_ExtAuthZA2A_Call) RunAndReturn(run func(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error)) *AuthService_ExtAuthZA2A_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtAuthZMcp provides a mock function for the type AuthService
+func (_mock *AuthService) ExtAuthZMcp(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error) {
+	// bff.ExtAuthZOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, accessToken, body, contentType)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtAuthZMcp")
+	}
+
+	var r0 *types.CallerIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) (*types.CallerIdentity, error)); ok {
+		return returnFunc(ctx, accessToken, body, contentType, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) *types.CallerIdentity); ok {
+		r0 = returnFunc(ctx, accessToken, body, contentType, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CallerIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte, string, ...bff.ExtAuthZOption) error); ok {
+		r1 = returnFunc(ctx, accessToken, body, contentType, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_ExtAuthZMcp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtAuthZMcp'
+type AuthService_ExtAuthZMcp_Call struct {
+	*mock.Call
+}
+
+// ExtAuthZMcp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - body []byte
+//   - contentType string
+//   - opts ...bff.ExtAuthZOption
+func (_e *AuthService_Expecter) ExtAuthZMcp(ctx interface{}, accessToken interface{}, body interface{}, contentType interface{}, opts ...interface{}) *AuthService_ExtAuthZMcp_Call {
+	return &AuthService_ExtAuthZMcp_Call{Call: _e.mock.On("ExtAuthZMcp",
+		append([]interface{}{ctx, accessToken, body, contentType}, opts...)...)}
+}
+
+func (_c *AuthService_ExtAuthZMcp_Call) Run(run func(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption)) *AuthService_ExtAuthZMcp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []bff.ExtAuthZOption
+		variadicArgs := make([]bff.ExtAuthZOption, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(bff.ExtAuthZOption)
+			}
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ExtAuthZMcp_Call) Return(callerIdentity *types.CallerIdentity, err error) *AuthService_ExtAuthZMcp_Call {
+	_c.Call.Return(callerIdentity, err)
+	return _c
+}
+
+func (_c *AuthService_ExtAuthZMcp_Call) RunAndReturn(run func(ctx context.Context, accessToken string, body []byte, contentType string, opts ...bff.ExtAuthZOption) (*types.CallerIdentity, error)) *AuthService_ExtAuthZMcp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterA2ASkills provides a mock function for the type AuthService
+func (_mock *AuthService) FilterA2ASkills(ctx context.Context, accessToken string, skillIDs []string) ([]string, error) {
+	ret := _mock.Called(ctx, accessToken, skillIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterA2ASkills")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return returnFunc(ctx, accessToken, skillIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = returnFunc(ctx, accessToken, skillIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, accessToken, skillIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_FilterA2ASkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterA2ASkills'
+type AuthService_FilterA2ASkills_Call struct {
+	*mock.Call
+}
+
+// FilterA2ASkills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - skillIDs []string
+func (_e *AuthService_Expecter) FilterA2ASkills(ctx interface{}, accessToken interface{}, skillIDs interface{}) *AuthService_FilterA2ASkills_Call {
+	return &AuthService_FilterA2ASkills_Call{Call: _e.mock.On("FilterA2ASkills", ctx, accessToken, skillIDs)}
+}
+
+func (_c *AuthService_FilterA2ASkills_Call) Run(run func(ctx context.Context, accessToken string, skillIDs []string)) *AuthService_FilterA2ASkills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_FilterA2ASkills_Call) Return(strings []string, err error) *AuthService_FilterA2ASkills_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *AuthService_FilterA2ASkills_Call) RunAndReturn(run func(ctx context.Context, accessToken string, skillIDs []string) ([]string, error)) *AuthService_FilterA2ASkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterMcpTools provides a mock function for the type AuthService
+func (_mock *AuthService) FilterMcpTools(ctx context.Context, accessToken string, toolNames []string) ([]string, error) {
+	ret := _mock.Called(ctx, accessToken, toolNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterMcpTools")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return returnFunc(ctx, accessToken, toolNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = returnFunc(ctx, accessToken, toolNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, accessToken, toolNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_FilterMcpTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterMcpTools'
+type AuthService_FilterMcpTools_Call struct {
+	*mock.Call
+}
+
+// FilterMcpTools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - toolNames []string
+func (_e *AuthService_Expecter) FilterMcpTools(ctx interface{}, accessToken interface{}, toolNames interface{}) *AuthService_FilterMcpTools_Call {
+	return &AuthService_FilterMcpTools_Call{Call: _e.mock.On("FilterMcpTools", ctx, accessToken, toolNames)}
 }
 
-func (_c *AuthService_Token_Call) Run(run func(ctx context.Context, authorizationCode string)) *AuthService_Token_Call {
+func (_c *AuthService_FilterMcpTools_Call) Run(run func(ctx context.Context, accessToken string, toolNames []string)) *AuthService_FilterMcpTools_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -306,9 +673,626 @@ func (_c *AuthService_Token_Call) Run(run func(ctx context.Context, authorizatio
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_FilterMcpTools_Call) Return(strings []string, err error) *AuthService_FilterMcpTools_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *AuthService_FilterMcpTools_Call) RunAndReturn(run func(ctx context.Context, accessToken string, toolNames []string) ([]string, error)) *AuthService_FilterMcpTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReceipt provides a mock function for the type AuthService
+func (_mock *AuthService) GetReceipt(ctx context.Context, id string) (*types.Receipt, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceipt")
+	}
+
+	var r0 *types.Receipt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Receipt, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Receipt); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Receipt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_GetReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceipt'
+type AuthService_GetReceipt_Call struct {
+	*mock.Call
+}
+
+// GetReceipt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AuthService_Expecter) GetReceipt(ctx interface{}, id interface{}) *AuthService_GetReceipt_Call {
+	return &AuthService_GetReceipt_Call{Call: _e.mock.On("GetReceipt", ctx, id)}
+}
+
+func (_c *AuthService_GetReceipt_Call) Run(run func(ctx context.Context, id string)) *AuthService_GetReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_GetReceipt_Call) Return(receipt *types.Receipt, err error) *AuthService_GetReceipt_Call {
+	_c.Call.Return(receipt, err)
+	return _c
+}
+
+func (_c *AuthService_GetReceipt_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Receipt, error)) *AuthService_GetReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function for the type AuthService
+func (_mock *AuthService) GetSession(ctx context.Context, id string) (*types.Session, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSession")
+	}
+
+	var r0 *types.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Session, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Session); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type AuthService_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AuthService_Expecter) GetSession(ctx interface{}, id interface{}) *AuthService_GetSession_Call {
+	return &AuthService_GetSession_Call{Call: _e.mock.On("GetSession", ctx, id)}
+}
+
+func (_c *AuthService_GetSession_Call) Run(run func(ctx context.Context, id string)) *AuthService_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_GetSession_Call) Return(session *types.Session, err error) *AuthService_GetSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *AuthService_GetSession_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Session, error)) *AuthService_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Introspect provides a mock function for the type AuthService
+func (_mock *AuthService) Introspect(ctx context.Context, token string) (*types.TokenIntrospection, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Introspect")
+	}
+
+	var r0 *types.TokenIntrospection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.TokenIntrospection, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.TokenIntrospection); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TokenIntrospection)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_Introspect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Introspect'
+type AuthService_Introspect_Call struct {
+	*mock.Call
+}
+
+// Introspect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthService_Expecter) Introspect(ctx interface{}, token interface{}) *AuthService_Introspect_Call {
+	return &AuthService_Introspect_Call{Call: _e.mock.On("Introspect", ctx, token)}
+}
+
+func (_c *AuthService_Introspect_Call) Run(run func(ctx context.Context, token string)) *AuthService_Introspect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_Introspect_Call) Return(tokenIntrospection *types.TokenIntrospection, err error) *AuthService_Introspect_Call {
+	_c.Call.Return(tokenIntrospection, err)
+	return _c
+}
+
+func (_c *AuthService_Introspect_Call) RunAndReturn(run func(ctx context.Context, token string) (*types.TokenIntrospection, error)) *AuthService_Introspect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type AuthService
+func (_mock *AuthService) ListSessions(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter) (*pagination.Pageable[types.Session], error) {
+	ret := _mock.Called(ctx, paginationFilter, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *pagination.Pageable[types.Session]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) (*pagination.Pageable[types.Session], error)); ok {
+		return returnFunc(ctx, paginationFilter, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) *pagination.Pageable[types.Session]); ok {
+		r0 = returnFunc(ctx, paginationFilter, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pageable[types.Session])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) error); ok {
+		r1 = returnFunc(ctx, paginationFilter, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paginationFilter pagination.PaginationFilter
+//   - filter *types.SessionFilter
+func (_e *AuthService_Expecter) ListSessions(ctx interface{}, paginationFilter interface{}, filter interface{}) *AuthService_ListSessions_Call {
+	return &AuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, paginationFilter, filter)}
+}
+
+func (_c *AuthService_ListSessions_Call) Run(run func(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter)) *AuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pagination.PaginationFilter
+		if args[1] != nil {
+			arg1 = args[1].(pagination.PaginationFilter)
+		}
+		var arg2 *types.SessionFilter
+		if args[2] != nil {
+			arg2 = args[2].(*types.SessionFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) Return(pageable *pagination.Pageable[types.Session], err error) *AuthService_ListSessions_Call {
+	_c.Call.Return(pageable, err)
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) RunAndReturn(run func(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter) (*pagination.Pageable[types.Session], error)) *AuthService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveCaller provides a mock function for the type AuthService
+func (_mock *AuthService) ResolveCaller(ctx context.Context, accessToken string) (context.Context, string, error) {
+	ret := _mock.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCaller")
+	}
+
+	var r0 context.Context
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (context.Context, string, error)); ok {
+		return returnFunc(ctx, accessToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) context.Context); ok {
+		r0 = returnFunc(ctx, accessToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = returnFunc(ctx, accessToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, accessToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// AuthService_ResolveCaller_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveCaller'
+type AuthService_ResolveCaller_Call struct {
+	*mock.Call
+}
+
+// ResolveCaller is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+func (_e *AuthService_Expecter) ResolveCaller(ctx interface{}, accessToken interface{}) *AuthService_ResolveCaller_Call {
+	return &AuthService_ResolveCaller_Call{Call: _e.mock.On("ResolveCaller", ctx, accessToken)}
+}
+
+func (_c *AuthService_ResolveCaller_Call) Run(run func(ctx context.Context, accessToken string)) *AuthService_ResolveCaller_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_ResolveCaller_Call) Return(context1 context.Context, s string, err error) *AuthService_ResolveCaller_Call {
+	_c.Call.Return(context1, s, err)
+	return _c
+}
+
+func (_c *AuthService_ResolveCaller_Call) RunAndReturn(run func(ctx context.Context, accessToken string) (context.Context, string, error)) *AuthService_ResolveCaller_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type AuthService
+func (_mock *AuthService) Revoke(ctx context.Context, token string) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type AuthService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthService_Expecter) Revoke(ctx interface{}, token interface{}) *AuthService_Revoke_Call {
+	return &AuthService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, token)}
+}
+
+func (_c *AuthService_Revoke_Call) Run(run func(ctx context.Context, token string)) *AuthService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_Revoke_Call) Return(err error) *AuthService_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_Revoke_Call) RunAndReturn(run func(ctx context.Context, token string) error) *AuthService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessionsForApp provides a mock function for the type AuthService
+func (_mock *AuthService) RevokeAllSessionsForApp(ctx context.Context, appID string) (int, error) {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessionsForApp")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, appID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_RevokeAllSessionsForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessionsForApp'
+type AuthService_RevokeAllSessionsForApp_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessionsForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *AuthService_Expecter) RevokeAllSessionsForApp(ctx interface{}, appID interface{}) *AuthService_RevokeAllSessionsForApp_Call {
+	return &AuthService_RevokeAllSessionsForApp_Call{Call: _e.mock.On("RevokeAllSessionsForApp", ctx, appID)}
+}
+
+func (_c *AuthService_RevokeAllSessionsForApp_Call) Run(run func(ctx context.Context, appID string)) *AuthService_RevokeAllSessionsForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessionsForApp_Call) Return(n int, err error) *AuthService_RevokeAllSessionsForApp_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessionsForApp_Call) RunAndReturn(run func(ctx context.Context, appID string) (int, error)) *AuthService_RevokeAllSessionsForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type AuthService
+func (_mock *AuthService) RevokeSession(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AuthService_Expecter) RevokeSession(ctx interface{}, id interface{}) *AuthService_RevokeSession_Call {
+	return &AuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, id)}
+}
+
+func (_c *AuthService_RevokeSession_Call) Run(run func(ctx context.Context, id string)) *AuthService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) Return(err error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, id string) error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Token provides a mock function for the type AuthService
+func (_mock *AuthService) Token(ctx context.Context, authorizationCode string, dpopProof *string, codeVerifier *string) (*types.Session, error) {
+	ret := _mock.Called(ctx, authorizationCode, dpopProof, codeVerifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Token")
+	}
+
+	var r0 *types.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string, *string) (*types.Session, error)); ok {
+		return returnFunc(ctx, authorizationCode, dpopProof, codeVerifier)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string, *string) *types.Session); ok {
+		r0 = returnFunc(ctx, authorizationCode, dpopProof, codeVerifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *string, *string) error); ok {
+		r1 = returnFunc(ctx, authorizationCode, dpopProof, codeVerifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthService_Token_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Token'
+type AuthService_Token_Call struct {
+	*mock.Call
+}
+
+// Token is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorizationCode string
+//   - dpopProof *string
+//   - codeVerifier *string
+func (_e *AuthService_Expecter) Token(ctx interface{}, authorizationCode interface{}, dpopProof interface{}, codeVerifier interface{}) *AuthService_Token_Call {
+	return &AuthService_Token_Call{Call: _e.mock.On("Token", ctx, authorizationCode, dpopProof, codeVerifier)}
+}
+
+func (_c *AuthService_Token_Call) Run(run func(ctx context.Context, authorizationCode string, dpopProof *string, codeVerifier *string)) *AuthService_Token_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -319,7 +1303,7 @@ func (_c *AuthService_Token_Call) Return(session *types.Session, err error) *Aut
 	return _c
 }
 
-func (_c *AuthService_Token_Call) RunAndReturn(run func(ctx context.Context, authorizationCode string) (*types.Session, error)) *AuthService_Token_Call {
+func (_c *AuthService_Token_Call) RunAndReturn(run func(ctx context.Context, authorizationCode string, dpopProof *string, codeVerifier *string) (*types.Session, error)) *AuthService_Token_Call {
 	_c.Call.Return(run)
 	return _c
 }
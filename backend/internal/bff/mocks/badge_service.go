@@ -9,6 +9,7 @@ import (
 
 	"github.com/agntcy/identity-service/internal/bff"
 	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -39,6 +40,205 @@ func (_m *BadgeService) EXPECT() *BadgeService_Expecter {
 	return &BadgeService_Expecter{mock: &_m.Mock}
 }
 
+// CreateTrustPolicy provides a mock function for the type BadgeService
+func (_mock *BadgeService) CreateTrustPolicy(ctx context.Context, policy *types.TrustPolicy) (*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTrustPolicy")
+	}
+
+	var r0 *types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) (*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) *types.TrustPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.TrustPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_CreateTrustPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTrustPolicy'
+type BadgeService_CreateTrustPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateTrustPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *types.TrustPolicy
+func (_e *BadgeService_Expecter) CreateTrustPolicy(ctx interface{}, policy interface{}) *BadgeService_CreateTrustPolicy_Call {
+	return &BadgeService_CreateTrustPolicy_Call{Call: _e.mock.On("CreateTrustPolicy", ctx, policy)}
+}
+
+func (_c *BadgeService_CreateTrustPolicy_Call) Run(run func(ctx context.Context, policy *types.TrustPolicy)) *BadgeService_CreateTrustPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.TrustPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*types.TrustPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_CreateTrustPolicy_Call) Return(trustPolicy *types.TrustPolicy, err error) *BadgeService_CreateTrustPolicy_Call {
+	_c.Call.Return(trustPolicy, err)
+	return _c
+}
+
+func (_c *BadgeService_CreateTrustPolicy_Call) RunAndReturn(run func(ctx context.Context, policy *types.TrustPolicy) (*types.TrustPolicy, error)) *BadgeService_CreateTrustPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTrustPolicy provides a mock function for the type BadgeService
+func (_mock *BadgeService) DeleteTrustPolicy(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTrustPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// BadgeService_DeleteTrustPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTrustPolicy'
+type BadgeService_DeleteTrustPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteTrustPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *BadgeService_Expecter) DeleteTrustPolicy(ctx interface{}, id interface{}) *BadgeService_DeleteTrustPolicy_Call {
+	return &BadgeService_DeleteTrustPolicy_Call{Call: _e.mock.On("DeleteTrustPolicy", ctx, id)}
+}
+
+func (_c *BadgeService_DeleteTrustPolicy_Call) Run(run func(ctx context.Context, id string)) *BadgeService_DeleteTrustPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_DeleteTrustPolicy_Call) Return(err error) *BadgeService_DeleteTrustPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *BadgeService_DeleteTrustPolicy_Call) RunAndReturn(run func(ctx context.Context, id string) error) *BadgeService_DeleteTrustPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffBadges provides a mock function for the type BadgeService
+func (_mock *BadgeService) DiffBadges(ctx context.Context, fromBadgeID string, toBadgeID string) (*types.BadgeDiff, error) {
+	ret := _mock.Called(ctx, fromBadgeID, toBadgeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffBadges")
+	}
+
+	var r0 *types.BadgeDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*types.BadgeDiff, error)); ok {
+		return returnFunc(ctx, fromBadgeID, toBadgeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *types.BadgeDiff); ok {
+		r0 = returnFunc(ctx, fromBadgeID, toBadgeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BadgeDiff)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, fromBadgeID, toBadgeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_DiffBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffBadges'
+type BadgeService_DiffBadges_Call struct {
+	*mock.Call
+}
+
+// DiffBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBadgeID string
+//   - toBadgeID string
+func (_e *BadgeService_Expecter) DiffBadges(ctx interface{}, fromBadgeID interface{}, toBadgeID interface{}) *BadgeService_DiffBadges_Call {
+	return &BadgeService_DiffBadges_Call{Call: _e.mock.On("DiffBadges", ctx, fromBadgeID, toBadgeID)}
+}
+
+func (_c *BadgeService_DiffBadges_Call) Run(run func(ctx context.Context, fromBadgeID string, toBadgeID string)) *BadgeService_DiffBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_DiffBadges_Call) Return(badgeDiff *types.BadgeDiff, err error) *BadgeService_DiffBadges_Call {
+	_c.Call.Return(badgeDiff, err)
+	return _c
+}
+
+func (_c *BadgeService_DiffBadges_Call) RunAndReturn(run func(ctx context.Context, fromBadgeID string, toBadgeID string) (*types.BadgeDiff, error)) *BadgeService_DiffBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBadge provides a mock function for the type BadgeService
 func (_mock *BadgeService) GetBadge(ctx context.Context, appID string) (*types.Badge, error) {
 	ret := _mock.Called(ctx, appID)
@@ -107,57 +307,47 @@ func (_c *BadgeService_GetBadge_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
-// IssueBadge provides a mock function for the type BadgeService
-func (_mock *BadgeService) IssueBadge(ctx context.Context, appID string, options ...bff.IssueOption) (*types.Badge, error) {
-	// bff.IssueOption
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, ctx, appID)
-	_ca = append(_ca, _va...)
-	ret := _mock.Called(_ca...)
+// GetBadgeByID provides a mock function for the type BadgeService
+func (_mock *BadgeService) GetBadgeByID(ctx context.Context, id string) (*types.Badge, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IssueBadge")
+		panic("no return value specified for GetBadgeByID")
 	}
 
 	var r0 *types.Badge
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...bff.IssueOption) (*types.Badge, error)); ok {
-		return returnFunc(ctx, appID, options...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Badge, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...bff.IssueOption) *types.Badge); ok {
-		r0 = returnFunc(ctx, appID, options...)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Badge); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Badge)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ...bff.IssueOption) error); ok {
-		r1 = returnFunc(ctx, appID, options...)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// BadgeService_IssueBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueBadge'
-type BadgeService_IssueBadge_Call struct {
+// BadgeService_GetBadgeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBadgeByID'
+type BadgeService_GetBadgeByID_Call struct {
 	*mock.Call
 }
 
-// IssueBadge is a helper method to define mock.On call
+// GetBadgeByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - appID string
-//   - options ...bff.IssueOption
-func (_e *BadgeService_Expecter) IssueBadge(ctx interface{}, appID interface{}, options ...interface{}) *BadgeService_IssueBadge_Call {
-	return &BadgeService_IssueBadge_Call{Call: _e.mock.On("IssueBadge",
-		append([]interface{}{ctx, appID}, options...)...)}
+//   - id string
+func (_e *BadgeService_Expecter) GetBadgeByID(ctx interface{}, id interface{}) *BadgeService_GetBadgeByID_Call {
+	return &BadgeService_GetBadgeByID_Call{Call: _e.mock.On("GetBadgeByID", ctx, id)}
 }
 
-func (_c *BadgeService_IssueBadge_Call) Run(run func(ctx context.Context, appID string, options ...bff.IssueOption)) *BadgeService_IssueBadge_Call {
+func (_c *BadgeService_GetBadgeByID_Call) Run(run func(ctx context.Context, id string)) *BadgeService_GetBadgeByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -167,82 +357,73 @@ func (_c *BadgeService_IssueBadge_Call) Run(run func(ctx context.Context, appID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 []bff.IssueOption
-		variadicArgs := make([]bff.IssueOption, len(args)-2)
-		for i, a := range args[2:] {
-			if a != nil {
-				variadicArgs[i] = a.(bff.IssueOption)
-			}
-		}
-		arg2 = variadicArgs
 		run(
 			arg0,
 			arg1,
-			arg2...,
 		)
 	})
 	return _c
 }
 
-func (_c *BadgeService_IssueBadge_Call) Return(badge *types.Badge, err error) *BadgeService_IssueBadge_Call {
+func (_c *BadgeService_GetBadgeByID_Call) Return(badge *types.Badge, err error) *BadgeService_GetBadgeByID_Call {
 	_c.Call.Return(badge, err)
 	return _c
 }
 
-func (_c *BadgeService_IssueBadge_Call) RunAndReturn(run func(ctx context.Context, appID string, options ...bff.IssueOption) (*types.Badge, error)) *BadgeService_IssueBadge_Call {
+func (_c *BadgeService_GetBadgeByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Badge, error)) *BadgeService_GetBadgeByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// VerifyBadge provides a mock function for the type BadgeService
-func (_mock *BadgeService) VerifyBadge(ctx context.Context, badge *string) (*types.VerificationResult, error) {
-	ret := _mock.Called(ctx, badge)
+// GetIssuerDocument provides a mock function for the type BadgeService
+func (_mock *BadgeService) GetIssuerDocument(ctx context.Context, issuer string) (*types.IssuerDocument, error) {
+	ret := _mock.Called(ctx, issuer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for VerifyBadge")
+		panic("no return value specified for GetIssuerDocument")
 	}
 
-	var r0 *types.VerificationResult
+	var r0 *types.IssuerDocument
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *string) (*types.VerificationResult, error)); ok {
-		return returnFunc(ctx, badge)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.IssuerDocument, error)); ok {
+		return returnFunc(ctx, issuer)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *string) *types.VerificationResult); ok {
-		r0 = returnFunc(ctx, badge)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.IssuerDocument); ok {
+		r0 = returnFunc(ctx, issuer)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.VerificationResult)
+			r0 = ret.Get(0).(*types.IssuerDocument)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *string) error); ok {
-		r1 = returnFunc(ctx, badge)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, issuer)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// BadgeService_VerifyBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBadge'
-type BadgeService_VerifyBadge_Call struct {
+// BadgeService_GetIssuerDocument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIssuerDocument'
+type BadgeService_GetIssuerDocument_Call struct {
 	*mock.Call
 }
 
-// VerifyBadge is a helper method to define mock.On call
+// GetIssuerDocument is a helper method to define mock.On call
 //   - ctx context.Context
-//   - badge *string
-func (_e *BadgeService_Expecter) VerifyBadge(ctx interface{}, badge interface{}) *BadgeService_VerifyBadge_Call {
-	return &BadgeService_VerifyBadge_Call{Call: _e.mock.On("VerifyBadge", ctx, badge)}
+//   - issuer string
+func (_e *BadgeService_Expecter) GetIssuerDocument(ctx interface{}, issuer interface{}) *BadgeService_GetIssuerDocument_Call {
+	return &BadgeService_GetIssuerDocument_Call{Call: _e.mock.On("GetIssuerDocument", ctx, issuer)}
 }
 
-func (_c *BadgeService_VerifyBadge_Call) Run(run func(ctx context.Context, badge *string)) *BadgeService_VerifyBadge_Call {
+func (_c *BadgeService_GetIssuerDocument_Call) Run(run func(ctx context.Context, issuer string)) *BadgeService_GetIssuerDocument_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *string
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*string)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -252,12 +433,721 @@ func (_c *BadgeService_VerifyBadge_Call) Run(run func(ctx context.Context, badge
 	return _c
 }
 
-func (_c *BadgeService_VerifyBadge_Call) Return(verificationResult *types.VerificationResult, err error) *BadgeService_VerifyBadge_Call {
-	_c.Call.Return(verificationResult, err)
+func (_c *BadgeService_GetIssuerDocument_Call) Return(issuerDocument *types.IssuerDocument, err error) *BadgeService_GetIssuerDocument_Call {
+	_c.Call.Return(issuerDocument, err)
+	return _c
+}
+
+func (_c *BadgeService_GetIssuerDocument_Call) RunAndReturn(run func(ctx context.Context, issuer string) (*types.IssuerDocument, error)) *BadgeService_GetIssuerDocument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatusList provides a mock function for the type BadgeService
+func (_mock *BadgeService) GetStatusList(ctx context.Context, id string) (*types.StatusListCredential, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusList")
+	}
+
+	var r0 *types.StatusListCredential
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.StatusListCredential, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.StatusListCredential); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StatusListCredential)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_GetStatusList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusList'
+type BadgeService_GetStatusList_Call struct {
+	*mock.Call
+}
+
+// GetStatusList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *BadgeService_Expecter) GetStatusList(ctx interface{}, id interface{}) *BadgeService_GetStatusList_Call {
+	return &BadgeService_GetStatusList_Call{Call: _e.mock.On("GetStatusList", ctx, id)}
+}
+
+func (_c *BadgeService_GetStatusList_Call) Run(run func(ctx context.Context, id string)) *BadgeService_GetStatusList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_GetStatusList_Call) Return(statusListCredential *types.StatusListCredential, err error) *BadgeService_GetStatusList_Call {
+	_c.Call.Return(statusListCredential, err)
+	return _c
+}
+
+func (_c *BadgeService_GetStatusList_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.StatusListCredential, error)) *BadgeService_GetStatusList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueBadge provides a mock function for the type BadgeService
+func (_mock *BadgeService) IssueBadge(ctx context.Context, appID string, options ...bff.IssueOption) (*types.Badge, error) {
+	// bff.IssueOption
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, appID)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueBadge")
+	}
+
+	var r0 *types.Badge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...bff.IssueOption) (*types.Badge, error)); ok {
+		return returnFunc(ctx, appID, options...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...bff.IssueOption) *types.Badge); ok {
+		r0 = returnFunc(ctx, appID, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Badge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ...bff.IssueOption) error); ok {
+		r1 = returnFunc(ctx, appID, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_IssueBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueBadge'
+type BadgeService_IssueBadge_Call struct {
+	*mock.Call
+}
+
+// IssueBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - options ...bff.IssueOption
+func (_e *BadgeService_Expecter) IssueBadge(ctx interface{}, appID interface{}, options ...interface{}) *BadgeService_IssueBadge_Call {
+	return &BadgeService_IssueBadge_Call{Call: _e.mock.On("IssueBadge",
+		append([]interface{}{ctx, appID}, options...)...)}
+}
+
+func (_c *BadgeService_IssueBadge_Call) Run(run func(ctx context.Context, appID string, options ...bff.IssueOption)) *BadgeService_IssueBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []bff.IssueOption
+		variadicArgs := make([]bff.IssueOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(bff.IssueOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_IssueBadge_Call) Return(badge *types.Badge, err error) *BadgeService_IssueBadge_Call {
+	_c.Call.Return(badge, err)
+	return _c
+}
+
+func (_c *BadgeService_IssueBadge_Call) RunAndReturn(run func(ctx context.Context, appID string, options ...bff.IssueOption) (*types.Badge, error)) *BadgeService_IssueBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBadges provides a mock function for the type BadgeService
+func (_mock *BadgeService) ListBadges(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error) {
+	ret := _mock.Called(ctx, appID, paginationFilter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBadges")
+	}
+
+	var r0 *pagination.Pageable[types.Badge]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error)); ok {
+		return returnFunc(ctx, appID, paginationFilter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, pagination.PaginationFilter) *pagination.Pageable[types.Badge]); ok {
+		r0 = returnFunc(ctx, appID, paginationFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pageable[types.Badge])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, pagination.PaginationFilter) error); ok {
+		r1 = returnFunc(ctx, appID, paginationFilter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_ListBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBadges'
+type BadgeService_ListBadges_Call struct {
+	*mock.Call
+}
+
+// ListBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - paginationFilter pagination.PaginationFilter
+func (_e *BadgeService_Expecter) ListBadges(ctx interface{}, appID interface{}, paginationFilter interface{}) *BadgeService_ListBadges_Call {
+	return &BadgeService_ListBadges_Call{Call: _e.mock.On("ListBadges", ctx, appID, paginationFilter)}
+}
+
+func (_c *BadgeService_ListBadges_Call) Run(run func(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter)) *BadgeService_ListBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 pagination.PaginationFilter
+		if args[2] != nil {
+			arg2 = args[2].(pagination.PaginationFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_ListBadges_Call) Return(pageable *pagination.Pageable[types.Badge], err error) *BadgeService_ListBadges_Call {
+	_c.Call.Return(pageable, err)
+	return _c
+}
+
+func (_c *BadgeService_ListBadges_Call) RunAndReturn(run func(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error)) *BadgeService_ListBadges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrustPolicies provides a mock function for the type BadgeService
+func (_mock *BadgeService) ListTrustPolicies(ctx context.Context) ([]*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrustPolicies")
+	}
+
+	var r0 []*types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*types.TrustPolicy); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_ListTrustPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrustPolicies'
+type BadgeService_ListTrustPolicies_Call struct {
+	*mock.Call
+}
+
+// ListTrustPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BadgeService_Expecter) ListTrustPolicies(ctx interface{}) *BadgeService_ListTrustPolicies_Call {
+	return &BadgeService_ListTrustPolicies_Call{Call: _e.mock.On("ListTrustPolicies", ctx)}
+}
+
+func (_c *BadgeService_ListTrustPolicies_Call) Run(run func(ctx context.Context)) *BadgeService_ListTrustPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_ListTrustPolicies_Call) Return(trustPolicys []*types.TrustPolicy, err error) *BadgeService_ListTrustPolicies_Call {
+	_c.Call.Return(trustPolicys, err)
+	return _c
+}
+
+func (_c *BadgeService_ListTrustPolicies_Call) RunAndReturn(run func(ctx context.Context) ([]*types.TrustPolicy, error)) *BadgeService_ListTrustPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeBadge provides a mock function for the type BadgeService
+func (_mock *BadgeService) ResumeBadge(ctx context.Context, appID string) error {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeBadge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// BadgeService_ResumeBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeBadge'
+type BadgeService_ResumeBadge_Call struct {
+	*mock.Call
+}
+
+// ResumeBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *BadgeService_Expecter) ResumeBadge(ctx interface{}, appID interface{}) *BadgeService_ResumeBadge_Call {
+	return &BadgeService_ResumeBadge_Call{Call: _e.mock.On("ResumeBadge", ctx, appID)}
+}
+
+func (_c *BadgeService_ResumeBadge_Call) Run(run func(ctx context.Context, appID string)) *BadgeService_ResumeBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_ResumeBadge_Call) Return(err error) *BadgeService_ResumeBadge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *BadgeService_ResumeBadge_Call) RunAndReturn(run func(ctx context.Context, appID string) error) *BadgeService_ResumeBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeBadge provides a mock function for the type BadgeService
+func (_mock *BadgeService) RevokeBadge(ctx context.Context, appID string, badgeID string, reason types.RevocationReason, comment string) error {
+	ret := _mock.Called(ctx, appID, badgeID, reason, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeBadge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, types.RevocationReason, string) error); ok {
+		r0 = returnFunc(ctx, appID, badgeID, reason, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// BadgeService_RevokeBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeBadge'
+type BadgeService_RevokeBadge_Call struct {
+	*mock.Call
+}
+
+// RevokeBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - badgeID string
+//   - reason types.RevocationReason
+//   - comment string
+func (_e *BadgeService_Expecter) RevokeBadge(ctx interface{}, appID interface{}, badgeID interface{}, reason interface{}, comment interface{}) *BadgeService_RevokeBadge_Call {
+	return &BadgeService_RevokeBadge_Call{Call: _e.mock.On("RevokeBadge", ctx, appID, badgeID, reason, comment)}
+}
+
+func (_c *BadgeService_RevokeBadge_Call) Run(run func(ctx context.Context, appID string, badgeID string, reason types.RevocationReason, comment string)) *BadgeService_RevokeBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 types.RevocationReason
+		if args[3] != nil {
+			arg3 = args[3].(types.RevocationReason)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_RevokeBadge_Call) Return(err error) *BadgeService_RevokeBadge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *BadgeService_RevokeBadge_Call) RunAndReturn(run func(ctx context.Context, appID string, badgeID string, reason types.RevocationReason, comment string) error) *BadgeService_RevokeBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuspendBadge provides a mock function for the type BadgeService
+func (_mock *BadgeService) SuspendBadge(ctx context.Context, appID string, reason string) error {
+	ret := _mock.Called(ctx, appID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuspendBadge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, appID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// BadgeService_SuspendBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendBadge'
+type BadgeService_SuspendBadge_Call struct {
+	*mock.Call
+}
+
+// SuspendBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - reason string
+func (_e *BadgeService_Expecter) SuspendBadge(ctx interface{}, appID interface{}, reason interface{}) *BadgeService_SuspendBadge_Call {
+	return &BadgeService_SuspendBadge_Call{Call: _e.mock.On("SuspendBadge", ctx, appID, reason)}
+}
+
+func (_c *BadgeService_SuspendBadge_Call) Run(run func(ctx context.Context, appID string, reason string)) *BadgeService_SuspendBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_SuspendBadge_Call) Return(err error) *BadgeService_SuspendBadge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *BadgeService_SuspendBadge_Call) RunAndReturn(run func(ctx context.Context, appID string, reason string) error) *BadgeService_SuspendBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTrustPolicy provides a mock function for the type BadgeService
+func (_mock *BadgeService) UpdateTrustPolicy(ctx context.Context, policy *types.TrustPolicy) (*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTrustPolicy")
+	}
+
+	var r0 *types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) (*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) *types.TrustPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.TrustPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_UpdateTrustPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTrustPolicy'
+type BadgeService_UpdateTrustPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateTrustPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *types.TrustPolicy
+func (_e *BadgeService_Expecter) UpdateTrustPolicy(ctx interface{}, policy interface{}) *BadgeService_UpdateTrustPolicy_Call {
+	return &BadgeService_UpdateTrustPolicy_Call{Call: _e.mock.On("UpdateTrustPolicy", ctx, policy)}
+}
+
+func (_c *BadgeService_UpdateTrustPolicy_Call) Run(run func(ctx context.Context, policy *types.TrustPolicy)) *BadgeService_UpdateTrustPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.TrustPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*types.TrustPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_UpdateTrustPolicy_Call) Return(trustPolicy *types.TrustPolicy, err error) *BadgeService_UpdateTrustPolicy_Call {
+	_c.Call.Return(trustPolicy, err)
+	return _c
+}
+
+func (_c *BadgeService_UpdateTrustPolicy_Call) RunAndReturn(run func(ctx context.Context, policy *types.TrustPolicy) (*types.TrustPolicy, error)) *BadgeService_UpdateTrustPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyBadge provides a mock function for the type BadgeService
+func (_mock *BadgeService) VerifyBadge(ctx context.Context, badge *string, options ...bff.VerifyOption) (*types.VerificationResult, error) {
+	// bff.VerifyOption
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, badge)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBadge")
+	}
+
+	var r0 *types.VerificationResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, ...bff.VerifyOption) (*types.VerificationResult, error)); ok {
+		return returnFunc(ctx, badge, options...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *string, ...bff.VerifyOption) *types.VerificationResult); ok {
+		r0 = returnFunc(ctx, badge, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.VerificationResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *string, ...bff.VerifyOption) error); ok {
+		r1 = returnFunc(ctx, badge, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_VerifyBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBadge'
+type BadgeService_VerifyBadge_Call struct {
+	*mock.Call
+}
+
+// VerifyBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badge *string
+//   - options ...bff.VerifyOption
+func (_e *BadgeService_Expecter) VerifyBadge(ctx interface{}, badge interface{}, options ...interface{}) *BadgeService_VerifyBadge_Call {
+	return &BadgeService_VerifyBadge_Call{Call: _e.mock.On("VerifyBadge",
+		append([]interface{}{ctx, badge}, options...)...)}
+}
+
+func (_c *BadgeService_VerifyBadge_Call) Run(run func(ctx context.Context, badge *string, options ...bff.VerifyOption)) *BadgeService_VerifyBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *string
+		if args[1] != nil {
+			arg1 = args[1].(*string)
+		}
+		var arg2 []bff.VerifyOption
+		variadicArgs := make([]bff.VerifyOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(bff.VerifyOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_VerifyBadge_Call) Return(verificationResult *types.VerificationResult, err error) *BadgeService_VerifyBadge_Call {
+	_c.Call.Return(verificationResult, err)
+	return _c
+}
+
+func (_c *BadgeService_VerifyBadge_Call) RunAndReturn(run func(ctx context.Context, badge *string, options ...bff.VerifyOption) (*types.VerificationResult, error)) *BadgeService_VerifyBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyBadges provides a mock function for the type BadgeService
+func (_mock *BadgeService) VerifyBadges(ctx context.Context, badges []*bff.BadgeToVerify) ([]*bff.BadgeVerification, error) {
+	ret := _mock.Called(ctx, badges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyBadges")
+	}
+
+	var r0 []*bff.BadgeVerification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*bff.BadgeToVerify) ([]*bff.BadgeVerification, error)); ok {
+		return returnFunc(ctx, badges)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*bff.BadgeToVerify) []*bff.BadgeVerification); ok {
+		r0 = returnFunc(ctx, badges)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*bff.BadgeVerification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*bff.BadgeToVerify) error); ok {
+		r1 = returnFunc(ctx, badges)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// BadgeService_VerifyBadges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBadges'
+type BadgeService_VerifyBadges_Call struct {
+	*mock.Call
+}
+
+// VerifyBadges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badges []*bff.BadgeToVerify
+func (_e *BadgeService_Expecter) VerifyBadges(ctx interface{}, badges interface{}) *BadgeService_VerifyBadges_Call {
+	return &BadgeService_VerifyBadges_Call{Call: _e.mock.On("VerifyBadges", ctx, badges)}
+}
+
+func (_c *BadgeService_VerifyBadges_Call) Run(run func(ctx context.Context, badges []*bff.BadgeToVerify)) *BadgeService_VerifyBadges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*bff.BadgeToVerify
+		if args[1] != nil {
+			arg1 = args[1].([]*bff.BadgeToVerify)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *BadgeService_VerifyBadges_Call) Return(badgeVerifications []*bff.BadgeVerification, err error) *BadgeService_VerifyBadges_Call {
+	_c.Call.Return(badgeVerifications, err)
 	return _c
 }
 
-func (_c *BadgeService_VerifyBadge_Call) RunAndReturn(run func(ctx context.Context, badge *string) (*types.VerificationResult, error)) *BadgeService_VerifyBadge_Call {
+func (_c *BadgeService_VerifyBadges_Call) RunAndReturn(run func(ctx context.Context, badges []*bff.BadgeToVerify) ([]*bff.BadgeVerification, error)) *BadgeService_VerifyBadges_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -162,6 +162,74 @@ func (_c *SettingsService_SetApiKey_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// SetBadgeSettings provides a mock function for the type SettingsService
+func (_mock *SettingsService) SetBadgeSettings(ctx context.Context, badgeSettings *types.BadgeSettings) (*types.BadgeSettings, error) {
+	ret := _mock.Called(ctx, badgeSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBadgeSettings")
+	}
+
+	var r0 *types.BadgeSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.BadgeSettings) (*types.BadgeSettings, error)); ok {
+		return returnFunc(ctx, badgeSettings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.BadgeSettings) *types.BadgeSettings); ok {
+		r0 = returnFunc(ctx, badgeSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BadgeSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.BadgeSettings) error); ok {
+		r1 = returnFunc(ctx, badgeSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SettingsService_SetBadgeSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBadgeSettings'
+type SettingsService_SetBadgeSettings_Call struct {
+	*mock.Call
+}
+
+// SetBadgeSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeSettings *types.BadgeSettings
+func (_e *SettingsService_Expecter) SetBadgeSettings(ctx interface{}, badgeSettings interface{}) *SettingsService_SetBadgeSettings_Call {
+	return &SettingsService_SetBadgeSettings_Call{Call: _e.mock.On("SetBadgeSettings", ctx, badgeSettings)}
+}
+
+func (_c *SettingsService_SetBadgeSettings_Call) Run(run func(ctx context.Context, badgeSettings *types.BadgeSettings)) *SettingsService_SetBadgeSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.BadgeSettings
+		if args[1] != nil {
+			arg1 = args[1].(*types.BadgeSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SettingsService_SetBadgeSettings_Call) Return(badgeSettings1 *types.BadgeSettings, err error) *SettingsService_SetBadgeSettings_Call {
+	_c.Call.Return(badgeSettings1, err)
+	return _c
+}
+
+func (_c *SettingsService_SetBadgeSettings_Call) RunAndReturn(run func(ctx context.Context, badgeSettings *types.BadgeSettings) (*types.BadgeSettings, error)) *SettingsService_SetBadgeSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetIssuerSettings provides a mock function for the type SettingsService
 func (_mock *SettingsService) SetIssuerSettings(ctx context.Context, issuerSettings *types.IssuerSettings) (*types.IssuerSettings, error) {
 	ret := _mock.Called(ctx, issuerSettings)
@@ -229,3 +297,71 @@ func (_c *SettingsService_SetIssuerSettings_Call) RunAndReturn(run func(ctx cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetRateLimitSettings provides a mock function for the type SettingsService
+func (_mock *SettingsService) SetRateLimitSettings(ctx context.Context, rateLimitSettings *types.RateLimitSettings) (*types.RateLimitSettings, error) {
+	ret := _mock.Called(ctx, rateLimitSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRateLimitSettings")
+	}
+
+	var r0 *types.RateLimitSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.RateLimitSettings) (*types.RateLimitSettings, error)); ok {
+		return returnFunc(ctx, rateLimitSettings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.RateLimitSettings) *types.RateLimitSettings); ok {
+		r0 = returnFunc(ctx, rateLimitSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RateLimitSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.RateLimitSettings) error); ok {
+		r1 = returnFunc(ctx, rateLimitSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SettingsService_SetRateLimitSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRateLimitSettings'
+type SettingsService_SetRateLimitSettings_Call struct {
+	*mock.Call
+}
+
+// SetRateLimitSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rateLimitSettings *types.RateLimitSettings
+func (_e *SettingsService_Expecter) SetRateLimitSettings(ctx interface{}, rateLimitSettings interface{}) *SettingsService_SetRateLimitSettings_Call {
+	return &SettingsService_SetRateLimitSettings_Call{Call: _e.mock.On("SetRateLimitSettings", ctx, rateLimitSettings)}
+}
+
+func (_c *SettingsService_SetRateLimitSettings_Call) Run(run func(ctx context.Context, rateLimitSettings *types.RateLimitSettings)) *SettingsService_SetRateLimitSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.RateLimitSettings
+		if args[1] != nil {
+			arg1 = args[1].(*types.RateLimitSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *SettingsService_SetRateLimitSettings_Call) Return(rateLimitSettings1 *types.RateLimitSettings, err error) *SettingsService_SetRateLimitSettings_Call {
+	_c.Call.Return(rateLimitSettings1, err)
+	return _c
+}
+
+func (_c *SettingsService_SetRateLimitSettings_Call) RunAndReturn(run func(ctx context.Context, rateLimitSettings *types.RateLimitSettings) (*types.RateLimitSettings, error)) *SettingsService_SetRateLimitSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,6 +6,7 @@ package bff
 import (
 	"context"
 	"fmt"
+	"math"
 
 	iamtypes "github.com/agntcy/identity-service/internal/core/iam/types"
 	idpcore "github.com/agntcy/identity-service/internal/core/idp"
@@ -24,6 +25,14 @@ type SettingsService interface {
 		ctx context.Context,
 		issuerSettings *settingstypes.IssuerSettings,
 	) (*settingstypes.IssuerSettings, error)
+	SetRateLimitSettings(
+		ctx context.Context,
+		rateLimitSettings *settingstypes.RateLimitSettings,
+	) (*settingstypes.RateLimitSettings, error)
+	SetBadgeSettings(
+		ctx context.Context,
+		badgeSettings *settingstypes.BadgeSettings,
+	) (*settingstypes.BadgeSettings, error)
 }
 
 type settingsService struct {
@@ -55,6 +64,16 @@ func (s *settingsService) GetSettings(
 		return nil, fmt.Errorf("repository in GetSettings failed to fetch issuer settings: %w", err)
 	}
 
+	rateLimitSettings, err := s.settingsRepository.GetRateLimitSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository in GetSettings failed to fetch rate limit settings: %w", err)
+	}
+
+	badgeSettings, err := s.settingsRepository.GetBadgeSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository in GetSettings failed to fetch badge settings: %w", err)
+	}
+
 	// Get the API key from the IAM client.
 	apiKey, err := s.iamClient.GetTenantAPIKey(ctx)
 	if err != nil {
@@ -72,6 +91,8 @@ func (s *settingsService) GetSettings(
 		ApiKey: &settingstypes.ApiKey{
 			ApiKey: ptrutil.DerefStr(apiKey.Secret),
 		},
+		RateLimitSettings: rateLimitSettings,
+		BadgeSettings:     badgeSettings,
 	}, nil
 }
 
@@ -130,6 +151,62 @@ func (s *settingsService) SetIssuerSettings(
 	return updatedSettings, nil
 }
 
+func (s *settingsService) SetRateLimitSettings(
+	ctx context.Context,
+	rateLimitSettings *settingstypes.RateLimitSettings,
+) (*settingstypes.RateLimitSettings, error) {
+	if rateLimitSettings == nil {
+		return nil, errutil.ValidationFailed("settings.invalidPayload", "Invalid rate limit settings payload.")
+	}
+
+	for _, limit := range []*settingstypes.RateLimit{
+		rateLimitSettings.Tenant,
+		rateLimitSettings.CallerApp,
+		rateLimitSettings.CalleeApp,
+	} {
+		if err := s.validateRateLimit(limit); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedSettings, err := s.settingsRepository.UpdateRateLimitSettings(ctx, rateLimitSettings)
+	if err != nil {
+		return nil, fmt.Errorf("repository in SetRateLimitSettings failed to update rate limit settings: %w", err)
+	}
+
+	return updatedSettings, nil
+}
+
+func (s *settingsService) SetBadgeSettings(
+	ctx context.Context,
+	badgeSettings *settingstypes.BadgeSettings,
+) (*settingstypes.BadgeSettings, error) {
+	if badgeSettings == nil {
+		return nil, errutil.ValidationFailed("settings.invalidPayload", "Invalid badge settings payload.")
+	}
+
+	if badgeSettings.DefaultLifetimeSeconds < 0 ||
+		badgeSettings.AgentA2ALifetimeSeconds < 0 ||
+		badgeSettings.AgentOASFLifetimeSeconds < 0 ||
+		badgeSettings.McpServerLifetimeSeconds < 0 {
+		return nil, errutil.ValidationFailed(
+			"settings.invalidBadgeLifetime",
+			"Invalid badge lifetime. The lifetimes must be positive numbers.",
+		)
+	}
+
+	if !badgeSettings.ProofFormat.IsValid() {
+		return nil, errutil.ValidationFailed("settings.invalidProofFormat", "Invalid proof format.")
+	}
+
+	updatedSettings, err := s.settingsRepository.UpdateBadgeSettings(ctx, badgeSettings)
+	if err != nil {
+		return nil, fmt.Errorf("repository in SetBadgeSettings failed to update badge settings: %w", err)
+	}
+
+	return updatedSettings, nil
+}
+
 func (s *settingsService) updateIssuerSettings(
 	ctx context.Context,
 	issuerSettings *settingstypes.IssuerSettings,
@@ -256,3 +333,22 @@ func (*settingsService) validatePingUpdateSettings(
 
 	return nil
 }
+
+func (*settingsService) validateRateLimit(
+	limit *settingstypes.RateLimit,
+) error {
+	// A missing limit falls back to the default one
+	if limit == nil {
+		return nil
+	}
+
+	rps := limit.RequestsPerSecond
+	if rps < 0 || math.IsNaN(rps) || math.IsInf(rps, 0) || limit.Burst < 0 {
+		return errutil.ValidationFailed(
+			"settings.invalidRateLimit",
+			"Invalid rate limit. The requests per second and the burst must be positive numbers.",
+		)
+	}
+
+	return nil
+}
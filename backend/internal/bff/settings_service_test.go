@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/agntcy/identity-service/internal/bff"
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	iamtypes "github.com/agntcy/identity-service/internal/core/iam/types"
 	idpmocks "github.com/agntcy/identity-service/internal/core/idp/mocks"
 	settingsmocks "github.com/agntcy/identity-service/internal/core/settings/mocks"
@@ -35,8 +36,18 @@ func TestSettingsService_GetSettings(t *testing.T) {
 			ApiKey: uuid.NewString(),
 		}
 
+		rateLimitSettings := &settingstypes.RateLimitSettings{
+			Tenant: &settingstypes.RateLimit{RequestsPerSecond: 10},
+		}
+
+		badgeSettings := &settingstypes.BadgeSettings{
+			DefaultLifetimeSeconds: 3600,
+		}
+
 		settingsRepo := settingsmocks.NewRepository(t)
 		settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(issuerSettings, nil)
+		settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(rateLimitSettings, nil)
+		settingsRepo.EXPECT().GetBadgeSettings(ctx).Return(badgeSettings, nil)
 
 		iamClient := iammocks.NewClient(t)
 		iamClient.EXPECT().GetTenantAPIKey(ctx).Return(&iamtypes.APIKey{Secret: &apiKey.ApiKey}, nil)
@@ -48,6 +59,8 @@ func TestSettingsService_GetSettings(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, issuerSettings, ret.IssuerSettings)
 		assert.Equal(t, apiKey, ret.ApiKey)
+		assert.Equal(t, rateLimitSettings, ret.RateLimitSettings)
+		assert.Equal(t, badgeSettings, ret.BadgeSettings)
 	})
 
 	t.Run("should return an error when settings repo fails", func(t *testing.T) {
@@ -69,6 +82,8 @@ func TestSettingsService_GetSettings(t *testing.T) {
 
 		settingsRepo := settingsmocks.NewRepository(t)
 		settingsRepo.EXPECT().GetIssuerSettings(ctx).Return(&settingstypes.IssuerSettings{}, nil)
+		settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{}, nil)
+		settingsRepo.EXPECT().GetBadgeSettings(ctx).Return(&settingstypes.BadgeSettings{}, nil)
 
 		iamClient := iammocks.NewClient(t)
 		iamClient.EXPECT().GetTenantAPIKey(ctx).Return(nil, nil)
@@ -547,3 +562,136 @@ func naiveDeepCopy[T any](p *T) (*T, error) {
 
 	return &obj, nil
 }
+
+func TestSettingsService_SetRateLimitSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should update the rate limit settings", func(t *testing.T) {
+		t.Parallel()
+
+		rateLimitSettings := &settingstypes.RateLimitSettings{
+			Tenant:    &settingstypes.RateLimit{RequestsPerSecond: 100, Burst: 200},
+			CallerApp: &settingstypes.RateLimit{RequestsPerSecond: 0.5},
+		}
+
+		settingsRepo := settingsmocks.NewRepository(t)
+		settingsRepo.EXPECT().UpdateRateLimitSettings(ctx, rateLimitSettings).Return(rateLimitSettings, nil)
+
+		sut := bff.NewSettingsService(nil, nil, settingsRepo, nil)
+
+		ret, err := sut.SetRateLimitSettings(ctx, rateLimitSettings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rateLimitSettings, ret)
+	})
+
+	t.Run("should return an error when the repository fails", func(t *testing.T) {
+		t.Parallel()
+
+		settingsRepo := settingsmocks.NewRepository(t)
+		settingsRepo.EXPECT().UpdateRateLimitSettings(ctx, mock.Anything).Return(nil, errors.New("failed"))
+
+		sut := bff.NewSettingsService(nil, nil, settingsRepo, nil)
+
+		_, err := sut.SetRateLimitSettings(ctx, &settingstypes.RateLimitSettings{})
+
+		assert.ErrorContains(t, err, "failed")
+	})
+}
+
+func TestSettingsService_SetRateLimitSettings_should_return_err_for_invalid_payloads(t *testing.T) {
+	t.Parallel()
+
+	invalidLimitErr := errutil.ValidationFailed(
+		"settings.invalidRateLimit",
+		"Invalid rate limit. The requests per second and the burst must be positive numbers.",
+	)
+
+	testCases := map[string]*struct {
+		payload *settingstypes.RateLimitSettings
+		err     error
+	}{
+		"nil payload": {
+			payload: nil,
+			err:     errutil.ValidationFailed("settings.invalidPayload", "Invalid rate limit settings payload."),
+		},
+		"negative rate": {
+			payload: &settingstypes.RateLimitSettings{
+				Tenant: &settingstypes.RateLimit{RequestsPerSecond: -1},
+			},
+			err: invalidLimitErr,
+		},
+		"negative burst": {
+			payload: &settingstypes.RateLimitSettings{
+				CalleeApp: &settingstypes.RateLimit{RequestsPerSecond: 1, Burst: -1},
+			},
+			err: invalidLimitErr,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			sut := bff.NewSettingsService(nil, nil, settingsmocks.NewRepository(t), nil)
+
+			_, err := sut.SetRateLimitSettings(context.Background(), tc.payload)
+
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestSettingsService_SetBadgeSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("should update the badge settings", func(t *testing.T) {
+		t.Parallel()
+
+		badgeSettings := &settingstypes.BadgeSettings{
+			DefaultLifetimeSeconds:   86400,
+			McpServerLifetimeSeconds: 3600,
+		}
+
+		settingsRepo := settingsmocks.NewRepository(t)
+		settingsRepo.EXPECT().UpdateBadgeSettings(ctx, badgeSettings).Return(badgeSettings, nil)
+
+		sut := bff.NewSettingsService(nil, nil, settingsRepo, nil)
+
+		ret, err := sut.SetBadgeSettings(ctx, badgeSettings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, badgeSettings, ret)
+	})
+
+	t.Run("should return an error for a negative lifetime", func(t *testing.T) {
+		t.Parallel()
+
+		sut := bff.NewSettingsService(nil, nil, settingsmocks.NewRepository(t), nil)
+
+		_, err := sut.SetBadgeSettings(ctx, &settingstypes.BadgeSettings{AgentA2ALifetimeSeconds: -1})
+
+		assert.ErrorIs(
+			t,
+			err,
+			errutil.ValidationFailed(
+				"settings.invalidBadgeLifetime",
+				"Invalid badge lifetime. The lifetimes must be positive numbers.",
+			),
+		)
+	})
+
+	t.Run("should return an error for an invalid proof format", func(t *testing.T) {
+		t.Parallel()
+
+		sut := bff.NewSettingsService(nil, nil, settingsmocks.NewRepository(t), nil)
+
+		_, err := sut.SetBadgeSettings(ctx, &settingstypes.BadgeSettings{ProofFormat: badgetypes.ProofFormat(42)})
+
+		assert.ErrorIs(t, err, errutil.ValidationFailed("settings.invalidProofFormat", "Invalid proof format."))
+	})
+}
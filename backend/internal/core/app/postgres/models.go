@@ -9,6 +9,7 @@ import (
 
 	"github.com/agntcy/identity-service/internal/core/app/types"
 	"github.com/agntcy/identity-service/internal/pkg/pgutil"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -20,6 +21,7 @@ type App struct {
 	Description        *string       `gorm:"not null;type:varchar(256);"`
 	Type               types.AppType `gorm:"not null;type:uint;default:0;"`
 	ResolverMetadataID string        `gorm:"not null;type:varchar(256);index:did_idx,unique;"`
+	RequireDPoP        bool          `gorm:"not null;default:false;"`
 	CreatedAt          time.Time
 	UpdatedAt          sql.NullTime
 	DeletedAt          gorm.DeletedAt `gorm:"index"`
@@ -32,6 +34,7 @@ func (i *App) ToCoreType() *types.App {
 		Description:        i.Description,
 		Type:               i.Type,
 		ResolverMetadataID: i.ResolverMetadataID,
+		RequireDPoP:        ptrutil.Ptr(i.RequireDPoP),
 		CreatedAt:          i.CreatedAt,
 		UpdatedAt:          pgutil.SqlNullTimeToTime(i.UpdatedAt),
 		DeletedAt: pgutil.SqlNullTimeToTime(sql.NullTime{
@@ -49,6 +52,7 @@ func newAppModel(src *types.App, tenantID string) *App {
 		Description:        src.Description,
 		Type:               src.Type,
 		ResolverMetadataID: src.ResolverMetadataID,
+		RequireDPoP:        ptrutil.Derefrence(src.RequireDPoP, false),
 		CreatedAt:          src.CreatedAt,
 		UpdatedAt:          pgutil.TimeToSqlNullTime(src.UpdatedAt),
 		DeletedAt:          gorm.DeletedAt(pgutil.TimeToSqlNullTime(src.DeletedAt)),
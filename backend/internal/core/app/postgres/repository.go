@@ -269,13 +269,27 @@ func (r *repository) GetAppStatuses(
 			SELECT
 				a.id,
 				CASE
-					WHEN COUNT(*) FILTER (WHERE b.id IS NOT NULL AND cs.id IS NULL) > 0 THEN 1 -- active
-					WHEN COUNT(*) FILTER (WHERE b.id IS NOT NULL AND cs.id IS NOT NULL AND cs.purpose = 1) > 0 THEN 3 -- 'revoked'
-					WHEN COUNT(*) FILTER (WHERE b.id IS NULL AND cs.id IS NULL) > 0 THEN 2 -- 'pending'
+					WHEN COUNT(b.id) FILTER (WHERE NOT b.revoked AND b.suspended) > 0 THEN 4 -- 'suspended'
+					WHEN COUNT(b.id) FILTER (WHERE NOT b.revoked) > 0 THEN 1 -- active
+					WHEN COUNT(b.id) > 0 THEN 3 -- 'revoked'
+					ELSE 2 -- 'pending'
 				END AS status
 			FROM apps AS a
-			LEFT JOIN badges AS b ON b.app_id = a.id
-			LEFT JOIN credential_statuses AS cs ON cs.verifiable_credential_id = b.id
+			LEFT JOIN (
+				-- the badges have several statuses, such as their status list entries
+				SELECT
+					badges.id,
+					badges.app_id,
+					EXISTS (
+						SELECT 1 FROM credential_statuses AS cs
+						WHERE cs.verifiable_credential_id = badges.id AND cs.purpose = 1
+					) AS revoked,
+					EXISTS (
+						SELECT 1 FROM credential_statuses AS cs
+						WHERE cs.verifiable_credential_id = badges.id AND cs.purpose = 2
+					) AS suspended
+				FROM badges
+			) AS b ON b.app_id = a.id
 			WHERE a.tenant_id = ? AND a.id IN (?)
 			GROUP BY a.id
 		`, tenantID, appIDs).
@@ -12,11 +12,12 @@ func _() {
 	_ = x[APP_STATUS_ACTIVE-1]
 	_ = x[APP_STATUS_PENDING-2]
 	_ = x[APP_STATUS_REVOKED-3]
+	_ = x[APP_STATUS_SUSPENDED-4]
 }
 
-const _AppStatus_name = "APP_STATUS_UNSPECIFIEDAPP_STATUS_ACTIVEAPP_STATUS_PENDINGAPP_STATUS_REVOKED"
+const _AppStatus_name = "APP_STATUS_UNSPECIFIEDAPP_STATUS_ACTIVEAPP_STATUS_PENDINGAPP_STATUS_REVOKEDAPP_STATUS_SUSPENDED"
 
-var _AppStatus_index = [...]uint8{0, 22, 39, 57, 75}
+var _AppStatus_index = [...]uint8{0, 22, 39, 57, 75, 95}
 
 func (i AppStatus) String() string {
 	idx := int(i) - 0
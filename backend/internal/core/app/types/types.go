@@ -58,6 +58,9 @@ const (
 
 	// The App has all the badges revoked
 	APP_STATUS_REVOKED
+
+	// The App has an active badge suspended
+	APP_STATUS_SUSPENDED
 )
 
 func (s *AppStatus) UnmarshalText(text []byte) error {
@@ -68,6 +71,8 @@ func (s *AppStatus) UnmarshalText(text []byte) error {
 		*s = APP_STATUS_PENDING
 	case APP_STATUS_REVOKED.String():
 		*s = APP_STATUS_REVOKED
+	case APP_STATUS_SUSPENDED.String():
+		*s = APP_STATUS_SUSPENDED
 	default:
 		*s = APP_STATUS_UNSPECIFIED
 	}
@@ -113,6 +118,10 @@ type App struct {
 	// +field_behavior:OUTPUT_ONLY
 	CreatedAt time.Time `json:"created_at" protobuf:"google.protobuf.Timestamp,8,opt,name=created_at"`
 
+	// Whether the App must use DPoP sender-constrained sessions.
+	// +field_behavior:OPTIONAL
+	RequireDPoP *bool `json:"require_dpop,omitempty" protobuf:"bytes,9,opt,name=require_dpop"`
+
 	// UpdatedAt records the timestamp of the last update to the App
 	UpdatedAt *time.Time `json:"updated_at,omitempty" protobuf:"-"`
 
@@ -0,0 +1,46 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"strings"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+)
+
+// The A2A JSON-RPC methods
+const (
+	MethodMessageSend                  = "message/send"
+	MethodMessageStream                = "message/stream"
+	MethodTasksGet                     = "tasks/get"
+	MethodTasksCancel                  = "tasks/cancel"
+	MethodTasksResubscribe             = "tasks/resubscribe"
+	MethodGetAuthenticatedExtendedCard = "agent/getAuthenticatedExtendedCard"
+
+	// The push notification config methods (set, get, list, delete)
+	methodTasksPushNotificationConfigPrefix = "tasks/pushNotificationConfig/"
+)
+
+// IsInvocation tells whether the message sends a message to the agent,
+// which is what invokes the agent and its skills.
+func IsInvocation(msg *jsonrpc.Message) bool {
+	return msg != nil && (msg.Method == MethodMessageSend || msg.Method == MethodMessageStream)
+}
+
+// IsKnownMethod tells whether the method is part of the A2A protocol.
+// The methods other than the invocations only need a valid access token
+// since they operate on tasks created by previous invocations.
+func IsKnownMethod(method string) bool {
+	switch method {
+	case MethodMessageSend,
+		MethodMessageStream,
+		MethodTasksGet,
+		MethodTasksCancel,
+		MethodTasksResubscribe,
+		MethodGetAuthenticatedExtendedCard:
+		return true
+	default:
+		return strings.HasPrefix(method, methodTasksPushNotificationConfigPrefix)
+	}
+}
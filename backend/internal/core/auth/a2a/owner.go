@@ -0,0 +1,113 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+)
+
+// The metadata key under which the gateways stamp the caller app on the
+// messages they forward. The agents keep the messages in the history of
+// their tasks, which is where the owner of a task is read back from.
+const OwnerMetadataKey = "x-id-caller-app-id"
+
+const userRole = "user"
+
+type taskHistory struct {
+	Kind    string `json:"kind"`
+	History []*struct {
+		Role     string         `json:"role"`
+		Metadata map[string]any `json:"metadata,omitempty"`
+	} `json:"history"`
+}
+
+// SetMessageOwner stamps the caller app on the message of a message/send
+// or message/stream request, replacing any value set by the caller.
+func SetMessageOwner(msg *jsonrpc.Message, appID string) error {
+	if !IsInvocation(msg) {
+		return fmt.Errorf("the message is not a %s or %s request", MethodMessageSend, MethodMessageStream)
+	}
+
+	var params map[string]json.RawMessage
+
+	err := msg.DecodeParams(&params)
+	if err != nil {
+		return fmt.Errorf("invalid %s params: %w", msg.Method, err)
+	}
+
+	var message map[string]json.RawMessage
+
+	err = json.Unmarshal(params["message"], &message)
+	if err != nil || message == nil {
+		return ErrMissingMessage
+	}
+
+	var metadata map[string]json.RawMessage
+
+	if raw, ok := message["metadata"]; ok {
+		err = json.Unmarshal(raw, &metadata)
+		if err != nil {
+			return fmt.Errorf("invalid %s message metadata: %w", msg.Method, err)
+		}
+	}
+
+	if metadata == nil {
+		metadata = make(map[string]json.RawMessage)
+	}
+
+	metadata[OwnerMetadataKey], err = json.Marshal(appID)
+	if err != nil {
+		return err
+	}
+
+	message["metadata"], err = json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	params["message"], err = json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	msg.Params, err = json.Marshal(params)
+
+	return err
+}
+
+// TaskOwner returns the caller app stamped on the user messages in the
+// history of a task result. It fails when no message is stamped or when
+// the messages were sent by different callers.
+func TaskOwner(result json.RawMessage) (string, bool) {
+	var task taskHistory
+
+	err := json.Unmarshal(result, &task)
+	if err != nil || task.Kind != taskKind {
+		return "", false
+	}
+
+	owner := ""
+
+	for _, msg := range task.History {
+		if msg == nil || msg.Role != userRole {
+			continue
+		}
+
+		appID, _ := msg.Metadata[OwnerMetadataKey].(string)
+
+		switch {
+		case appID == "":
+			continue
+		case owner == "":
+			owner = appID
+		case owner != appID:
+			return "", false
+		}
+	}
+
+	return owner, owner != ""
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMessageOwner_should_replace_the_owner_of_the_message(t *testing.T) {
+	t.Parallel()
+
+	msg := &jsonrpc.Message{
+		JSONRPC: jsonrpc.Version,
+		ID:      json.RawMessage("1"),
+		Method:  a2a.MethodMessageSend,
+		Params: json.RawMessage(`{"message":{"role":"user","parts":[],` +
+			`"metadata":{"skillId":"skill_a","x-id-caller-app-id":"other"}}}`),
+	}
+
+	err := a2a.SetMessageOwner(msg, "owner")
+
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"message":{"role":"user","parts":[],"metadata":{"skillId":"skill_a","x-id-caller-app-id":"owner"}}}`,
+		string(msg.Params),
+	)
+}
+
+func TestTaskOwner_should_return_the_owner_stamped_on_the_history(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		result        string
+		expectedOwner string
+		expectedOk    bool
+	}{
+		"stamped history": {
+			result: `{"kind":"task","id":"task","history":[` +
+				`{"role":"user","metadata":{"x-id-caller-app-id":"owner"}},` +
+				`{"role":"agent","metadata":{"x-id-caller-app-id":"other"}},` +
+				`{"role":"user","metadata":{"x-id-caller-app-id":"owner"}}]}`,
+			expectedOwner: "owner",
+			expectedOk:    true,
+		},
+		"several callers": {
+			result: `{"kind":"task","id":"task","history":[` +
+				`{"role":"user","metadata":{"x-id-caller-app-id":"owner"}},` +
+				`{"role":"user","metadata":{"x-id-caller-app-id":"other"}}]}`,
+		},
+		"no history": {
+			result: `{"kind":"task","id":"task"}`,
+		},
+		"not a task": {
+			result: `{"kind":"message","taskId":"task"}`,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			owner, ok := a2a.TaskOwner(json.RawMessage(tc.result))
+
+			assert.Equal(t, tc.expectedOwner, owner)
+			assert.Equal(t, tc.expectedOk, ok)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+)
+
+// The A2A protocol has no dedicated field for the skill targeted by a message,
+// callers set it in the metadata of the request or of the message.
+// The skill is declared by the caller, so authorizing it is only advisory
+// unless the agent dispatches the messages on the same field (or on the
+// X-Id-Skill-Id header set by the gateways) and runs no other skill.
+const SkillMetadataKey = "skillId"
+
+var (
+	ErrMissingMessage      = errors.New("the request has no message")
+	ErrInvalidSkillID      = errors.New("the skill id must be a string")
+	ErrMissingSkillID      = errors.New("the message has no skill id")
+	ErrConflictingSkillIDs = errors.New("the request and the message target different skills")
+)
+
+type messageSendParams struct {
+	Message  *message       `json:"message"`
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+type message struct {
+	Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// ParseSkillID returns the skill targeted by a message/send or message/stream
+// request. A skill is required, otherwise the message would be evaluated
+// against the rules of the agent as a whole while the agent can run any skill.
+func ParseSkillID(msg *jsonrpc.Message) (string, error) {
+	if !IsInvocation(msg) {
+		return "", fmt.Errorf("the message is not a %s or %s request", MethodMessageSend, MethodMessageStream)
+	}
+
+	var params messageSendParams
+
+	err := msg.DecodeParams(&params)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s params: %w", msg.Method, err)
+	}
+
+	if params.Message == nil {
+		return "", ErrMissingMessage
+	}
+
+	skillID := ""
+
+	for _, metadata := range []map[string]any{params.Metadata, params.Message.Metadata} {
+		value, ok := metadata[SkillMetadataKey]
+		if !ok || value == nil {
+			continue
+		}
+
+		id, ok := value.(string)
+		if !ok {
+			return "", ErrInvalidSkillID
+		}
+
+		if id == "" {
+			continue
+		}
+
+		if skillID != "" && skillID != id {
+			return "", ErrConflictingSkillIDs
+		}
+
+		skillID = id
+	}
+
+	if skillID == "" {
+		return "", ErrMissingSkillID
+	}
+
+	return skillID, nil
+}
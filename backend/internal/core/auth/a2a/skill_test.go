@@ -0,0 +1,103 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSkillID_should_return_skill_from_metadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		params   string
+		expected string
+	}{
+		"request metadata": {
+			params:   `{"message":{"role":"user","parts":[]},"metadata":{"skillId":"skill_a"}}`,
+			expected: "skill_a",
+		},
+		"message metadata": {
+			params:   `{"message":{"role":"user","parts":[],"metadata":{"skillId":"skill_b"}}}`,
+			expected: "skill_b",
+		},
+		"same skill in both metadata": {
+			params:   `{"message":{"metadata":{"skillId":"skill_a"}},"metadata":{"skillId":"skill_a"}}`,
+			expected: "skill_a",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			skillID, err := a2a.ParseSkillID(&jsonrpc.Message{
+				JSONRPC: jsonrpc.Version,
+				ID:      json.RawMessage("1"),
+				Method:  a2a.MethodMessageSend,
+				Params:  json.RawMessage(tc.params),
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, skillID)
+		})
+	}
+}
+
+func TestParseSkillID_should_return_err_for_invalid_requests(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		method string
+		params string
+	}{
+		"not an invocation": {
+			method: a2a.MethodTasksGet,
+			params: `{"id":"task"}`,
+		},
+		"missing params": {
+			method: a2a.MethodMessageSend,
+		},
+		"missing message": {
+			method: a2a.MethodMessageStream,
+			params: `{"metadata":{}}`,
+		},
+		"skill id not a string": {
+			method: a2a.MethodMessageSend,
+			params: `{"message":{},"metadata":{"skillId":1}}`,
+		},
+		"no skill": {
+			method: a2a.MethodMessageSend,
+			params: `{"message":{"role":"user","parts":[]}}`,
+		},
+		"empty skill": {
+			method: a2a.MethodMessageSend,
+			params: `{"message":{"role":"user","parts":[]},"metadata":{"skillId":""}}`,
+		},
+		"different skills": {
+			method: a2a.MethodMessageSend,
+			params: `{"message":{"metadata":{"skillId":"skill_b"}},"metadata":{"skillId":"skill_a"}}`,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := a2a.ParseSkillID(&jsonrpc.Message{
+				JSONRPC: jsonrpc.Version,
+				ID:      json.RawMessage("1"),
+				Method:  tc.method,
+				Params:  json.RawMessage(tc.params),
+			})
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+)
+
+// The kind of the results holding a task, the other results
+// (messages and task updates) refer to their task by its ID
+const taskKind = "task"
+
+var ErrMissingTaskID = errors.New("the request has no task id")
+
+type taskParams struct {
+	ID     string `json:"id"`
+	TaskID string `json:"taskId"`
+}
+
+type messageTaskParams struct {
+	Message *struct {
+		TaskID string `json:"taskId"`
+	} `json:"message"`
+}
+
+type taskResult struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	TaskID string `json:"taskId"`
+}
+
+// ParseTaskID returns the task a request operates on. The tasks/* requests
+// always target a task while the messages only do when they continue one.
+// An empty string is returned for the other requests.
+func ParseTaskID(msg *jsonrpc.Message) (string, error) {
+	if msg == nil || msg.Method == "" {
+		return "", nil
+	}
+
+	if IsInvocation(msg) {
+		var params messageTaskParams
+
+		err := msg.DecodeParams(&params)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s params: %w", msg.Method, err)
+		}
+
+		if params.Message == nil {
+			return "", ErrMissingMessage
+		}
+
+		return params.Message.TaskID, nil
+	}
+
+	if !isTaskMethod(msg.Method) {
+		return "", nil
+	}
+
+	var params taskParams
+
+	err := msg.DecodeParams(&params)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s params: %w", msg.Method, err)
+	}
+
+	switch {
+	case params.ID != "":
+		return params.ID, nil
+	case params.TaskID != "":
+		return params.TaskID, nil
+	default:
+		return "", ErrMissingTaskID
+	}
+}
+
+// ResultTaskID returns the task a response result refers to,
+// or an empty string when the result is not related to a task.
+func ResultTaskID(result json.RawMessage) string {
+	var task taskResult
+
+	err := json.Unmarshal(result, &task)
+	if err != nil {
+		return ""
+	}
+
+	if task.Kind == taskKind {
+		return task.ID
+	}
+
+	return task.TaskID
+}
+
+func isTaskMethod(method string) bool {
+	return method == MethodTasksGet ||
+		method == MethodTasksCancel ||
+		method == MethodTasksResubscribe ||
+		strings.HasPrefix(method, methodTasksPushNotificationConfigPrefix)
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTaskID_should_return_the_task_of_the_request(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		method   string
+		params   string
+		expected string
+	}{
+		"tasks/get": {
+			method:   a2a.MethodTasksGet,
+			params:   `{"id":"task_a","historyLength":10}`,
+			expected: "task_a",
+		},
+		"tasks/cancel": {
+			method:   a2a.MethodTasksCancel,
+			params:   `{"id":"task_a"}`,
+			expected: "task_a",
+		},
+		"push notification config": {
+			method:   "tasks/pushNotificationConfig/set",
+			params:   `{"taskId":"task_a","pushNotificationConfig":{"url":"https://example.com"}}`,
+			expected: "task_a",
+		},
+		"message continuing a task": {
+			method:   a2a.MethodMessageSend,
+			params:   `{"message":{"role":"user","parts":[],"taskId":"task_a"}}`,
+			expected: "task_a",
+		},
+		"new message": {
+			method:   a2a.MethodMessageStream,
+			params:   `{"message":{"role":"user","parts":[]}}`,
+			expected: "",
+		},
+		"extended card": {
+			method:   a2a.MethodGetAuthenticatedExtendedCard,
+			expected: "",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			taskID, err := a2a.ParseTaskID(&jsonrpc.Message{
+				JSONRPC: jsonrpc.Version,
+				ID:      json.RawMessage("1"),
+				Method:  tc.method,
+				Params:  json.RawMessage(tc.params),
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, taskID)
+		})
+	}
+}
+
+func TestParseTaskID_should_return_err_when_task_is_missing(t *testing.T) {
+	t.Parallel()
+
+	_, err := a2a.ParseTaskID(&jsonrpc.Message{
+		JSONRPC: jsonrpc.Version,
+		Method:  a2a.MethodTasksCancel,
+		Params:  json.RawMessage(`{}`),
+	})
+
+	assert.ErrorIs(t, err, a2a.ErrMissingTaskID)
+}
+
+func TestResultTaskID_should_return_the_task_of_the_result(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		result   string
+		expected string
+	}{
+		"task": {
+			result:   `{"kind":"task","id":"task_a","contextId":"ctx","status":{"state":"submitted"}}`,
+			expected: "task_a",
+		},
+		"status update": {
+			result:   `{"kind":"status-update","taskId":"task_a","contextId":"ctx","final":false}`,
+			expected: "task_a",
+		},
+		"message without task": {
+			result:   `{"kind":"message","messageId":"msg","role":"agent","parts":[]}`,
+			expected: "",
+		},
+		"not an object": {
+			result:   `[]`,
+			expected: "",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, a2a.ResultTaskID(json.RawMessage(tc.result)))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+// The DenyList holds the revoked sessions whose self-contained tokens
+// have not expired yet. The entries are cached in memory and reloaded
+// from the repository once per refresh interval, so checking a token
+// does not require a database round-trip. A session revoked on another
+// replica is therefore denied within the refresh interval. When the
+// repository cannot be reached the cached entries keep being used until
+// they are older than the maximum staleness, past which the checks fail.
+type DenyList interface {
+	Deny(ctx context.Context, sessionID string, expiresAt int64) error
+	IsDenied(ctx context.Context, sessionID string) (bool, error)
+}
+
+type denyList struct {
+	repository      Repository
+	refreshInterval time.Duration
+	maxStaleness    time.Duration
+
+	// Only one check reloads the entries at a time
+	refreshMu sync.Mutex
+
+	mu          sync.RWMutex
+	entries     map[string]int64
+	refreshedAt time.Time
+	nextRefresh time.Time
+}
+
+func NewDenyList(
+	repository Repository,
+	refreshInterval time.Duration,
+	maxStaleness time.Duration,
+) DenyList {
+	return &denyList{
+		repository:      repository,
+		refreshInterval: refreshInterval,
+		maxStaleness:    max(maxStaleness, refreshInterval),
+		entries:         make(map[string]int64),
+	}
+}
+
+func (d *denyList) Deny(ctx context.Context, sessionID string, expiresAt int64) error {
+	err := d.repository.DenySession(ctx, sessionID, expiresAt)
+	if err != nil {
+		return fmt.Errorf("repository failed to deny the session %s: %w", sessionID, err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.entries[sessionID] = expiresAt
+
+	return nil
+}
+
+func (d *denyList) IsDenied(ctx context.Context, sessionID string) (bool, error) {
+	err := d.refreshIfNecessary(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	expiresAt, ok := d.entries[sessionID]
+
+	return ok && expiresAt > time.Now().Unix(), nil
+}
+
+func (d *denyList) refreshIfNecessary(ctx context.Context) error {
+	if !d.needsRefresh() {
+		return nil
+	}
+
+	d.refreshMu.Lock()
+	defer d.refreshMu.Unlock()
+
+	// The entries may have been reloaded while waiting for the lock
+	if !d.needsRefresh() {
+		return nil
+	}
+
+	denied, err := d.repository.GetDeniedSessions(ctx)
+	if err != nil {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		if time.Since(d.refreshedAt) >= d.maxStaleness {
+			return fmt.Errorf("repository failed to fetch the denied sessions: %w", err)
+		}
+
+		// The cached entries are still recent enough, the reload is
+		// retried after the refresh interval rather than on every check
+		log.FromContext(ctx).WithError(err).Warn("unable to reload the deny-list, using the cached entries")
+
+		d.nextRefresh = time.Now().Add(d.refreshInterval)
+
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.entries = denied
+	d.refreshedAt = time.Now()
+	d.nextRefresh = d.refreshedAt.Add(d.refreshInterval)
+
+	return nil
+}
+
+func (d *denyList) needsRefresh() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return !time.Now().Before(d.nextRefresh)
+}
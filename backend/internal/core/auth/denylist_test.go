@@ -0,0 +1,125 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	authmocks "github.com/agntcy/identity-service/internal/core/auth/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDenyList_should_cache_the_denied_sessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deniedID := uuid.NewString()
+	expiredID := uuid.NewString()
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().GetDeniedSessions(ctx).Return(map[string]int64{
+		deniedID:  time.Now().Add(time.Hour).Unix(),
+		expiredID: time.Now().Add(-time.Hour).Unix(),
+	}, nil).Once()
+
+	sut := authcore.NewDenyList(repo, time.Hour, time.Hour)
+
+	denied, err := sut.IsDenied(ctx, deniedID)
+	assert.NoError(t, err)
+	assert.True(t, denied)
+
+	denied, err = sut.IsDenied(ctx, expiredID)
+	assert.NoError(t, err)
+	assert.False(t, denied)
+
+	denied, err = sut.IsDenied(ctx, uuid.NewString())
+	assert.NoError(t, err)
+	assert.False(t, denied)
+}
+
+func TestDenyList_should_deny_immediately_and_reload_after_the_interval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sessionID := uuid.NewString()
+	otherID := uuid.NewString()
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().GetDeniedSessions(ctx).Return(map[string]int64{}, nil).Once()
+	repo.EXPECT().DenySession(ctx, sessionID, expiresAt).Return(nil)
+	repo.EXPECT().GetDeniedSessions(ctx).Return(map[string]int64{otherID: expiresAt}, nil).Once()
+
+	sut := authcore.NewDenyList(repo, 50*time.Millisecond, time.Hour)
+
+	denied, _ := sut.IsDenied(ctx, sessionID)
+	assert.False(t, denied)
+
+	err := sut.Deny(ctx, sessionID, expiresAt)
+	assert.NoError(t, err)
+
+	denied, err = sut.IsDenied(ctx, sessionID)
+	assert.NoError(t, err)
+	assert.True(t, denied)
+
+	// The session denied through another replica is picked up by the next reload
+	time.Sleep(60 * time.Millisecond)
+
+	denied, err = sut.IsDenied(ctx, otherID)
+	assert.NoError(t, err)
+	assert.True(t, denied)
+}
+
+func TestDenyList_should_use_the_cached_sessions_until_they_are_too_stale(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sessionID := uuid.NewString()
+	errUnexpected := errors.New("failed")
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().
+		GetDeniedSessions(ctx).
+		Return(map[string]int64{sessionID: time.Now().Add(time.Hour).Unix()}, nil).
+		Once()
+	repo.EXPECT().GetDeniedSessions(ctx).Return(nil, errUnexpected)
+
+	sut := authcore.NewDenyList(repo, 10*time.Millisecond, 100*time.Millisecond)
+
+	denied, err := sut.IsDenied(ctx, sessionID)
+	assert.NoError(t, err)
+	assert.True(t, denied)
+
+	time.Sleep(20 * time.Millisecond)
+
+	denied, err = sut.IsDenied(ctx, sessionID)
+	assert.NoError(t, err)
+	assert.True(t, denied)
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = sut.IsDenied(ctx, sessionID)
+	assert.ErrorIs(t, err, errUnexpected)
+}
+
+func TestDenyList_should_return_err_when_the_repository_fails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errUnexpected := errors.New("failed")
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().GetDeniedSessions(ctx).Return(nil, errUnexpected)
+
+	sut := authcore.NewDenyList(repo, time.Minute, time.Minute)
+
+	_, err := sut.IsDenied(ctx, uuid.NewString())
+
+	assert.ErrorIs(t, err, errUnexpected)
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dpop
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// proofType is the typ header of DPoP proofs.
+	proofType = "dpop+jwt"
+
+	// ProofLifetime is the window in which the iat of a proof is accepted.
+	// The proof IDs are tracked for this duration to prevent replays.
+	ProofLifetime = 5 * time.Minute
+
+	// acceptableSkew is the tolerance for proofs issued in the future.
+	acceptableSkew = 30 * time.Second
+)
+
+// algorithms are the asymmetric signature algorithms accepted for proofs.
+var algorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+var (
+	ErrInvalidProof  = errors.New("invalid DPoP proof")
+	ErrReplayedProof = errors.New("the DPoP proof has already been used")
+	ErrUseNonce      = errors.New("the DPoP proof must carry a nonce issued by the server")
+)
+
+// Claims are the claims of a DPoP proof, as defined by RFC 9449.
+type Claims struct {
+	// The unique ID of the proof.
+	ID string `json:"jti"`
+
+	// The HTTP method of the request.
+	Method string `json:"htm"`
+
+	// The HTTP URL of the request, without query and fragment.
+	URL string `json:"htu"`
+
+	// The time at which the proof was created.
+	IssuedAt int64 `json:"iat"`
+
+	// The base64url encoded SHA-256 hash of the access token, if any.
+	AccessTokenHash string `json:"ath,omitempty"`
+
+	// The nonce issued by the server.
+	Nonce string `json:"nonce,omitempty"`
+
+	// The JWK SHA-256 thumbprint of the key that signed the proof.
+	Thumbprint string `json:"-"`
+}
+
+type verifyOptions struct {
+	method      string
+	url         string
+	accessToken string
+	thumbprint  string
+}
+
+type VerifyOption func(opts *verifyOptions)
+
+// WithRequest requires the proof to be created for the HTTP request.
+func WithRequest(method, requestURL string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.method = method
+		opts.url = requestURL
+	}
+}
+
+// WithEndpoint requires the proof to be created for a POST request
+// to the endpoint of the Identity Service with the specified path.
+func WithEndpoint(path string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.method = "POST"
+		opts.url = path
+	}
+}
+
+// WithAccessToken requires the proof to carry the hash of the access token.
+func WithAccessToken(accessToken string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.accessToken = accessToken
+	}
+}
+
+// WithThumbprint requires the proof to be signed with the key of the thumbprint.
+func WithThumbprint(thumbprint string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.thumbprint = thumbprint
+	}
+}
+
+// Verifier verifies DPoP proofs and detects their replay.
+// The proofs must carry a nonce issued by the verifier.
+type Verifier interface {
+	Verify(ctx context.Context, proof string, opts ...VerifyOption) (*Claims, error)
+
+	// Returns a new nonce to be sent to the clients in the DPoP-Nonce header
+	Nonce() string
+}
+
+type verifier struct {
+	apiURL         string
+	nonces         *nonces
+	authRepository authcore.Repository
+}
+
+// NewVerifier returns a Verifier resolving the endpoints against the URL of the API.
+// The nonces are derived from the secret, which must be shared by all the replicas.
+// The ID of each accepted proof is stored in the repository to prevent replays.
+func NewVerifier(apiURL string, nonceSecret []byte, authRepository authcore.Repository) Verifier {
+	return &verifier{
+		apiURL:         strings.TrimSuffix(apiURL, "/"),
+		nonces:         newNonces(nonceSecret),
+		authRepository: authRepository,
+	}
+}
+
+func (v *verifier) Nonce() string {
+	return v.nonces.issue(time.Now())
+}
+
+func (v *verifier) Verify(ctx context.Context, proof string, opts ...VerifyOption) (*Claims, error) {
+	options := verifyOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if strings.HasPrefix(options.url, "/") {
+		options.url = v.apiURL + options.url
+	}
+
+	claims, err := Parse(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	err = claims.validate(&options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The nonce is checked last so that the clients are only asked
+	// to retry with a new nonce when the rest of the proof is valid
+	if !v.nonces.valid(claims.Nonce, time.Now()) {
+		return nil, ErrUseNonce
+	}
+
+	err = v.authRepository.StoreDPoPProof(
+		ctx,
+		claims.Thumbprint,
+		claims.ID,
+		time.Unix(claims.IssuedAt, 0).Add(ProofLifetime).Unix(),
+	)
+	if err != nil {
+		if errors.Is(err, authcore.ErrDPoPProofAlreadyStored) {
+			return nil, ErrReplayedProof
+		}
+
+		return nil, fmt.Errorf("repository failed to store the DPoP proof: %w", err)
+	}
+
+	return claims, nil
+}
+
+// Parse verifies the signature of the proof with the public key
+// in its header and returns its claims.
+func Parse(proof string) (*Claims, error) {
+	msg, err := jws.Parse([]byte(proof))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, ErrInvalidProof
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+
+	if typ, _ := headers.Type(); typ != proofType {
+		return nil, fmt.Errorf("%w: unexpected type", ErrInvalidProof)
+	}
+
+	alg, ok := headers.Algorithm()
+	if !ok || !slices.Contains(algorithms, alg.String()) {
+		return nil, fmt.Errorf("%w: algorithm not allowed", ErrInvalidProof)
+	}
+
+	key, ok := headers.JWK()
+	if !ok {
+		return nil, fmt.Errorf("%w: missing jwk header", ErrInvalidProof)
+	}
+
+	if private, err := jwk.IsPrivateKey(key); err != nil || private {
+		return nil, fmt.Errorf("%w: the jwk header is not a public key", ErrInvalidProof)
+	}
+
+	payload, err := jws.Verify([]byte(proof), jws.WithKey(alg, key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	var claims Claims
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	claims.Thumbprint = base64.RawURLEncoding.EncodeToString(thumbprint)
+
+	return &claims, nil
+}
+
+func (c *Claims) validate(options *verifyOptions) error {
+	if c.ID == "" {
+		return fmt.Errorf("%w: missing jti", ErrInvalidProof)
+	}
+
+	now := time.Now()
+	if c.IssuedAt < now.Add(-ProofLifetime).Unix() || c.IssuedAt > now.Add(acceptableSkew).Unix() {
+		return fmt.Errorf("%w: iat out of the acceptable window", ErrInvalidProof)
+	}
+
+	if !strings.EqualFold(c.Method, options.method) || !sameURL(c.URL, options.url) {
+		return fmt.Errorf("%w: the proof was created for another request", ErrInvalidProof)
+	}
+
+	if options.accessToken != "" && c.AccessTokenHash != HashAccessToken(options.accessToken) {
+		return fmt.Errorf("%w: the proof was created for another access token", ErrInvalidProof)
+	}
+
+	if options.thumbprint != "" && c.Thumbprint != options.thumbprint {
+		return fmt.Errorf("%w: the proof was signed with another key", ErrInvalidProof)
+	}
+
+	return nil
+}
+
+// HashAccessToken returns the base64url encoded SHA-256 hash of the access token.
+func HashAccessToken(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// sameURL compares two URLs ignoring their query and fragment,
+// as well as the case of their scheme and host.
+func sameURL(actual, expected string) bool {
+	a, err := url.Parse(actual)
+	if err != nil || expected == "" {
+		return false
+	}
+
+	e, err := url.Parse(expected)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(a.Scheme, e.Scheme) &&
+		strings.EqualFold(a.Host, e.Host) &&
+		a.Path == e.Path
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dpop_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authmocks "github.com/agntcy/identity-service/internal/core/auth/mocks"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAPIURL      = "https://api.example.com"
+	testAccessToken = "access-token"
+)
+
+var testNonceSecret = []byte("nonce-secret")
+
+func generateKey(t *testing.T) jwk.Key {
+	t.Helper()
+
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := jwk.Import(raw)
+	require.NoError(t, err)
+
+	return key
+}
+
+func thumbprint(t *testing.T, key jwk.Key) string {
+	t.Helper()
+
+	value, err := key.Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func createProof(t *testing.T, key jwk.Key, typ string, claims map[string]any) string {
+	t.Helper()
+
+	pub, err := key.PublicKey()
+	require.NoError(t, err)
+
+	headers := jws.NewHeaders()
+	require.NoError(t, headers.Set(jws.TypeKey, typ))
+	require.NoError(t, headers.Set(jws.JWKKey, pub))
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed, err := jws.Sign(payload, jws.WithKey(jwa.ES256(), key, jws.WithProtectedHeaders(headers)))
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+func validClaims(nonce string) map[string]any {
+	return map[string]any{
+		"jti":   uuid.NewString(),
+		"htm":   "GET",
+		"htu":   "https://mcp.example.com/mcp",
+		"iat":   time.Now().Unix(),
+		"ath":   dpop.HashAccessToken(testAccessToken),
+		"nonce": nonce,
+	}
+}
+
+func TestVerifier_should_verify_a_valid_proof(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := generateKey(t)
+	repo := authmocks.NewRepository(t)
+	sut := dpop.NewVerifier(testAPIURL, testNonceSecret, repo)
+	claims := validClaims(sut.Nonce())
+	claims["htu"] = "https://MCP.example.com/mcp?session=1"
+
+	repo.EXPECT().
+		StoreDPoPProof(ctx, thumbprint(t, key), claims["jti"], mock.Anything).
+		Return(nil)
+
+	ret, err := sut.Verify(
+		ctx,
+		createProof(t, key, "dpop+jwt", claims),
+		dpop.WithRequest("get", "https://mcp.example.com/mcp"),
+		dpop.WithAccessToken(testAccessToken),
+		dpop.WithThumbprint(thumbprint(t, key)),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, thumbprint(t, key), ret.Thumbprint)
+	assert.Equal(t, claims["jti"], ret.ID)
+}
+
+func TestVerifier_should_resolve_the_endpoints_against_the_api_url(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := generateKey(t)
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().StoreDPoPProof(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	sut := dpop.NewVerifier(testAPIURL+"/", testNonceSecret, repo)
+	claims := map[string]any{
+		"jti":   uuid.NewString(),
+		"htm":   "POST",
+		"htu":   testAPIURL + "/v1alpha1/auth/token",
+		"iat":   time.Now().Unix(),
+		"nonce": sut.Nonce(),
+	}
+
+	_, err := sut.Verify(ctx, createProof(t, key, "dpop+jwt", claims), dpop.WithEndpoint("/v1alpha1/auth/token"))
+
+	assert.NoError(t, err)
+}
+
+func TestVerifier_should_return_err_when_the_proof_is_replayed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := generateKey(t)
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().
+		StoreDPoPProof(ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(authcore.ErrDPoPProofAlreadyStored)
+
+	sut := dpop.NewVerifier(testAPIURL, testNonceSecret, repo)
+
+	_, err := sut.Verify(
+		ctx,
+		createProof(t, key, "dpop+jwt", validClaims(sut.Nonce())),
+		dpop.WithRequest("GET", "https://mcp.example.com/mcp"),
+	)
+
+	assert.ErrorIs(t, err, dpop.ErrReplayedProof)
+}
+
+func TestVerifier_should_return_err_for_invalid_proofs(t *testing.T) {
+	t.Parallel()
+
+	key := generateKey(t)
+	otherKey := generateKey(t)
+	sut := dpop.NewVerifier(testAPIURL, testNonceSecret, authmocks.NewRepository(t))
+	validClaims := func() map[string]any {
+		return validClaims(sut.Nonce())
+	}
+
+	withClaim := func(name string, value any) map[string]any {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+
+		return claims
+	}
+
+	testCases := map[string]*struct {
+		proof string
+	}{
+		"malformed proof": {
+			proof: "not-a-jws",
+		},
+		"unexpected type": {
+			proof: createProof(t, key, "JWT", validClaims()),
+		},
+		"missing jti": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("jti", nil)),
+		},
+		"stale iat": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("iat", time.Now().Add(-time.Hour).Unix())),
+		},
+		"iat in the future": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("iat", time.Now().Add(time.Hour).Unix())),
+		},
+		"other method": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("htm", "POST")),
+		},
+		"other url": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("htu", "https://mcp.example.com/other")),
+		},
+		"other access token": {
+			proof: createProof(t, key, "dpop+jwt", withClaim("ath", dpop.HashAccessToken("other"))),
+		},
+		"other key": {
+			proof: createProof(t, otherKey, "dpop+jwt", validClaims()),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sut.Verify(
+				context.Background(),
+				tc.proof,
+				dpop.WithRequest("GET", "https://mcp.example.com/mcp"),
+				dpop.WithAccessToken(testAccessToken),
+				dpop.WithThumbprint(thumbprint(t, key)),
+			)
+
+			assert.ErrorIs(t, err, dpop.ErrInvalidProof)
+		})
+	}
+}
+
+func TestVerifier_should_require_a_nonce_issued_by_the_server(t *testing.T) {
+	t.Parallel()
+
+	key := generateKey(t)
+	sut := dpop.NewVerifier(testAPIURL, testNonceSecret, authmocks.NewRepository(t))
+	otherNonce := dpop.NewVerifier(testAPIURL, []byte("other-secret"), nil).Nonce()
+
+	testCases := map[string]*struct {
+		nonce string
+	}{
+		"missing nonce": {
+			nonce: "",
+		},
+		"malformed nonce": {
+			nonce: "nonce",
+		},
+		"nonce issued with another secret": {
+			nonce: otherNonce,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sut.Verify(
+				context.Background(),
+				createProof(t, key, "dpop+jwt", validClaims(tc.nonce)),
+				dpop.WithRequest("GET", "https://mcp.example.com/mcp"),
+				dpop.WithAccessToken(testAccessToken),
+			)
+
+			assert.ErrorIs(t, err, dpop.ErrUseNonce)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dpop
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"time"
+)
+
+const (
+	// NonceHeader is the header in which the nonces are sent to the clients.
+	NonceHeader = "DPoP-Nonce"
+
+	// NonceLifetime is the duration during which an issued nonce is accepted.
+	NonceLifetime = 5 * time.Minute
+)
+
+// nonceKeyLabel separates the nonce key from the other keys derived from the same secret.
+const nonceKeyLabel = "dpop-nonce"
+
+// The nonces are stateless so that any replica of the Identity Service can verify them:
+// a nonce is the time it was issued at followed by the HMAC of that time.
+type nonces struct {
+	key []byte
+}
+
+func newNonces(secret []byte) *nonces {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(nonceKeyLabel))
+
+	return &nonces{key: mac.Sum(nil)}
+}
+
+func (n *nonces) issue(now time.Time) string {
+	issuedAt := binary.BigEndian.AppendUint64(nil, uint64(now.Unix()))
+
+	return base64.RawURLEncoding.EncodeToString(append(issuedAt, n.sign(issuedAt)...))
+}
+
+// valid returns true when the nonce was issued by a replica sharing the secret
+// within the last NonceLifetime.
+func (n *nonces) valid(nonce string, now time.Time) bool {
+	value, err := base64.RawURLEncoding.DecodeString(nonce)
+	if err != nil || len(value) != 8+sha256.Size {
+		return false
+	}
+
+	issuedAt, signature := value[:8], value[8:]
+	if !hmac.Equal(signature, n.sign(issuedAt)) {
+		return false
+	}
+
+	at := time.Unix(int64(binary.BigEndian.Uint64(issuedAt)), 0)
+
+	return !at.Before(now.Add(-NonceLifetime)) && !at.After(now.Add(acceptableSkew))
+}
+
+func (n *nonces) sign(issuedAt []byte) []byte {
+	mac := hmac.New(sha256.New, n.key)
+	mac.Write(issuedAt)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MethodAction defines how a non tool MCP method is authorized.
+// Tool calls are always evaluated against the policies.
+type MethodAction string
+
+const (
+	// The method is allowed without checking the access token.
+	MethodActionAllow MethodAction = "allow"
+
+	// The method is allowed as long as the access token is valid
+	// for the callee app.
+	MethodActionAuthenticate MethodAction = "authenticate"
+
+	// The method is always denied.
+	MethodActionDeny MethodAction = "deny"
+)
+
+const (
+	MethodNotificationsInitialized = "notifications/initialized"
+
+	// A wildcard key that can be used to override the default action
+	defaultMethodKey = "*"
+)
+
+func ParseMethodAction(value string) (MethodAction, error) {
+	action := MethodAction(strings.ToLower(strings.TrimSpace(value)))
+
+	switch action {
+	case MethodActionAllow, MethodActionAuthenticate, MethodActionDeny:
+		return action, nil
+	default:
+		return "", fmt.Errorf("unknown MCP method action %q", value)
+	}
+}
+
+// MethodRules holds the actions applied to the MCP methods
+// that are not tool calls (initialize, tools/list, ping...).
+type MethodRules struct {
+	actions       map[string]MethodAction
+	defaultAction MethodAction
+}
+
+// NewMethodRules creates method rules from a map of method names to actions.
+// The "*" key can be used to set the action for the methods not in the map,
+// otherwise defaultAction is used.
+func NewMethodRules(actions map[string]string, defaultAction string) (*MethodRules, error) {
+	def, err := ParseMethodAction(defaultAction)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &MethodRules{
+		actions:       make(map[string]MethodAction, len(actions)),
+		defaultAction: def,
+	}
+
+	for method, value := range actions {
+		action, err := ParseMethodAction(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule for MCP method %s: %w", method, err)
+		}
+
+		method = strings.TrimSpace(method)
+		if method == defaultMethodKey {
+			rules.defaultAction = action
+		} else {
+			rules.actions[method] = action
+		}
+	}
+
+	return rules, nil
+}
+
+// DefaultMethodRules lets MCP clients initialize and ping the server without
+// an access token and requires a valid access token for everything else.
+func DefaultMethodRules() *MethodRules {
+	return &MethodRules{
+		actions: map[string]MethodAction{
+			string(mcp.MethodInitialize):   MethodActionAllow,
+			MethodNotificationsInitialized: MethodActionAllow,
+			string(mcp.MethodPing):         MethodActionAllow,
+		},
+		defaultAction: MethodActionAuthenticate,
+	}
+}
+
+func (r *MethodRules) ActionFor(method string) MethodAction {
+	if action, ok := r.actions[method]; ok {
+		return action
+	}
+
+	return r.defaultAction
+}
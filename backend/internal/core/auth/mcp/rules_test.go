@@ -0,0 +1,86 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp_test
+
+import (
+	"testing"
+
+	authmcp "github.com/agntcy/identity-service/internal/core/auth/mcp"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMethodRules_should_return_actions_per_method(t *testing.T) {
+	t.Parallel()
+
+	rules, err := authmcp.NewMethodRules(
+		map[string]string{
+			"initialize":  "allow",
+			"tools/list":  " Authenticate ",
+			"prompts/get": "deny",
+		},
+		"authenticate",
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, authmcp.MethodActionAllow, rules.ActionFor("initialize"))
+	assert.Equal(t, authmcp.MethodActionAuthenticate, rules.ActionFor("tools/list"))
+	assert.Equal(t, authmcp.MethodActionDeny, rules.ActionFor("prompts/get"))
+	assert.Equal(t, authmcp.MethodActionAuthenticate, rules.ActionFor("resources/list"))
+}
+
+func TestNewMethodRules_should_override_default_action_with_wildcard(t *testing.T) {
+	t.Parallel()
+
+	rules, err := authmcp.NewMethodRules(map[string]string{"*": "deny"}, "allow")
+
+	assert.NoError(t, err)
+	assert.Equal(t, authmcp.MethodActionDeny, rules.ActionFor("resources/list"))
+}
+
+func TestNewMethodRules_should_return_err_for_unknown_actions(t *testing.T) {
+	t.Parallel()
+
+	_, err := authmcp.NewMethodRules(map[string]string{"initialize": "maybe"}, "allow")
+	assert.Error(t, err)
+
+	_, err = authmcp.NewMethodRules(nil, "")
+	assert.Error(t, err)
+}
+
+func TestDefaultMethodRules(t *testing.T) {
+	t.Parallel()
+
+	rules := authmcp.DefaultMethodRules()
+
+	assert.Equal(t, authmcp.MethodActionAllow, rules.ActionFor("initialize"))
+	assert.Equal(t, authmcp.MethodActionAllow, rules.ActionFor("notifications/initialized"))
+	assert.Equal(t, authmcp.MethodActionAllow, rules.ActionFor("ping"))
+	assert.Equal(t, authmcp.MethodActionAuthenticate, rules.ActionFor("tools/list"))
+}
+
+func TestParseToolCall(t *testing.T) {
+	t.Parallel()
+
+	messages, _ := jsonrpc.Parse([]byte(`[
+		{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool","arguments":{"a":1}}},
+		{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"arguments":{}}},
+		{"jsonrpc":"2.0","id":3,"method":"tools/call"},
+		{"jsonrpc":"2.0","id":4,"method":"tools/list"}
+	]`), "")
+
+	params, err := authmcp.ParseToolCall(messages[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "tool", params.Name)
+
+	_, err = authmcp.ParseToolCall(messages[1])
+	assert.ErrorIs(t, err, authmcp.ErrEmptyToolName)
+
+	_, err = authmcp.ParseToolCall(messages[2])
+	assert.ErrorIs(t, err, jsonrpc.ErrMissingParams)
+
+	_, err = authmcp.ParseToolCall(messages[3])
+	assert.Error(t, err)
+	assert.False(t, authmcp.IsToolCall(messages[3]))
+}
@@ -0,0 +1,39 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+var ErrEmptyToolName = errors.New("the tool name is empty")
+
+// IsToolCall tells whether the message is a tools/call request.
+func IsToolCall(msg *jsonrpc.Message) bool {
+	return msg != nil && msg.Method == string(mcp.MethodToolsCall)
+}
+
+// ParseToolCall decodes the params of a tools/call request.
+func ParseToolCall(msg *jsonrpc.Message) (*mcp.CallToolParams, error) {
+	if !IsToolCall(msg) {
+		return nil, fmt.Errorf("the message is not a %s request", mcp.MethodToolsCall)
+	}
+
+	var params mcp.CallToolParams
+
+	err := msg.DecodeParams(&params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s params: %w", mcp.MethodToolsCall, err)
+	}
+
+	if params.Name == "" {
+		return nil, ErrEmptyToolName
+	}
+
+	return &params, nil
+}
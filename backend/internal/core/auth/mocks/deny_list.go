@@ -0,0 +1,167 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewDenyList creates a new instance of DenyList. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDenyList(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DenyList {
+	mock := &DenyList{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// DenyList is an autogenerated mock type for the DenyList type
+type DenyList struct {
+	mock.Mock
+}
+
+type DenyList_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DenyList) EXPECT() *DenyList_Expecter {
+	return &DenyList_Expecter{mock: &_m.Mock}
+}
+
+// Deny provides a mock function for the type DenyList
+func (_mock *DenyList) Deny(ctx context.Context, sessionID string, expiresAt int64) error {
+	ret := _mock.Called(ctx, sessionID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deny")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = returnFunc(ctx, sessionID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// DenyList_Deny_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deny'
+type DenyList_Deny_Call struct {
+	*mock.Call
+}
+
+// Deny is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+//   - expiresAt int64
+func (_e *DenyList_Expecter) Deny(ctx interface{}, sessionID interface{}, expiresAt interface{}) *DenyList_Deny_Call {
+	return &DenyList_Deny_Call{Call: _e.mock.On("Deny", ctx, sessionID, expiresAt)}
+}
+
+func (_c *DenyList_Deny_Call) Run(run func(ctx context.Context, sessionID string, expiresAt int64)) *DenyList_Deny_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *DenyList_Deny_Call) Return(err error) *DenyList_Deny_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DenyList_Deny_Call) RunAndReturn(run func(ctx context.Context, sessionID string, expiresAt int64) error) *DenyList_Deny_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsDenied provides a mock function for the type DenyList
+func (_mock *DenyList) IsDenied(ctx context.Context, sessionID string) (bool, error) {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsDenied")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// DenyList_IsDenied_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsDenied'
+type DenyList_IsDenied_Call struct {
+	*mock.Call
+}
+
+// IsDenied is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+func (_e *DenyList_Expecter) IsDenied(ctx interface{}, sessionID interface{}) *DenyList_IsDenied_Call {
+	return &DenyList_IsDenied_Call{Call: _e.mock.On("IsDenied", ctx, sessionID)}
+}
+
+func (_c *DenyList_IsDenied_Call) Run(run func(ctx context.Context, sessionID string)) *DenyList_IsDenied_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *DenyList_IsDenied_Call) Return(b bool, err error) *DenyList_IsDenied_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *DenyList_IsDenied_Call) RunAndReturn(run func(ctx context.Context, sessionID string) (bool, error)) *DenyList_IsDenied_Call {
+	_c.Call.Return(run)
+	return _c
+}
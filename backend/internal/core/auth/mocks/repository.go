@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -95,70 +96,737 @@ func (_c *Repository_CreateDeviceOTP_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// CreateReceipt provides a mock function for the type Repository
+func (_mock *Repository) CreateReceipt(ctx context.Context, receipt *types.Receipt) error {
+	ret := _mock.Called(ctx, receipt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReceipt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.Receipt) error); ok {
+		r0 = returnFunc(ctx, receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_CreateReceipt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReceipt'
+type Repository_CreateReceipt_Call struct {
+	*mock.Call
+}
+
+// CreateReceipt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receipt *types.Receipt
+func (_e *Repository_Expecter) CreateReceipt(ctx interface{}, receipt interface{}) *Repository_CreateReceipt_Call {
+	return &Repository_CreateReceipt_Call{Call: _e.mock.On("CreateReceipt", ctx, receipt)}
+}
+
+func (_c *Repository_CreateReceipt_Call) Run(run func(ctx context.Context, receipt *types.Receipt)) *Repository_CreateReceipt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.Receipt
+		if args[1] != nil {
+			arg1 = args[1].(*types.Receipt)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CreateReceipt_Call) Return(err error) *Repository_CreateReceipt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_CreateReceipt_Call) RunAndReturn(run func(ctx context.Context, receipt *types.Receipt) error) *Repository_CreateReceipt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSession provides a mock function for the type Repository
 func (_mock *Repository) CreateSession(ctx context.Context, session *types.Session) (*types.Session, error) {
 	ret := _mock.Called(ctx, session)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSession")
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 *types.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.Session) (*types.Session, error)); ok {
+		return returnFunc(ctx, session)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.Session) *types.Session); ok {
+		r0 = returnFunc(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.Session) error); ok {
+		r1 = returnFunc(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type Repository_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *types.Session
+func (_e *Repository_Expecter) CreateSession(ctx interface{}, session interface{}) *Repository_CreateSession_Call {
+	return &Repository_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+}
+
+func (_c *Repository_CreateSession_Call) Run(run func(ctx context.Context, session *types.Session)) *Repository_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.Session
+		if args[1] != nil {
+			arg1 = args[1].(*types.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CreateSession_Call) Return(session1 *types.Session, err error) *Repository_CreateSession_Call {
+	_c.Call.Return(session1, err)
+	return _c
+}
+
+func (_c *Repository_CreateSession_Call) RunAndReturn(run func(ctx context.Context, session *types.Session) (*types.Session, error)) *Repository_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredAuthorizationCodes provides a mock function for the type Repository
+func (_mock *Repository) DeleteExpiredAuthorizationCodes(ctx context.Context, expiredBefore int64, limit int) (int64, error) {
+	ret := _mock.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredAuthorizationCodes")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return returnFunc(ctx, expiredBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteExpiredAuthorizationCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredAuthorizationCodes'
+type Repository_DeleteExpiredAuthorizationCodes_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredAuthorizationCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore int64
+//   - limit int
+func (_e *Repository_Expecter) DeleteExpiredAuthorizationCodes(ctx interface{}, expiredBefore interface{}, limit interface{}) *Repository_DeleteExpiredAuthorizationCodes_Call {
+	return &Repository_DeleteExpiredAuthorizationCodes_Call{Call: _e.mock.On("DeleteExpiredAuthorizationCodes", ctx, expiredBefore, limit)}
+}
+
+func (_c *Repository_DeleteExpiredAuthorizationCodes_Call) Run(run func(ctx context.Context, expiredBefore int64, limit int)) *Repository_DeleteExpiredAuthorizationCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredAuthorizationCodes_Call) Return(n int64, err error) *Repository_DeleteExpiredAuthorizationCodes_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredAuthorizationCodes_Call) RunAndReturn(run func(ctx context.Context, expiredBefore int64, limit int) (int64, error)) *Repository_DeleteExpiredAuthorizationCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredDPoPProofs provides a mock function for the type Repository
+func (_mock *Repository) DeleteExpiredDPoPProofs(ctx context.Context, expiredBefore int64, limit int) (int64, error) {
+	ret := _mock.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredDPoPProofs")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return returnFunc(ctx, expiredBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteExpiredDPoPProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredDPoPProofs'
+type Repository_DeleteExpiredDPoPProofs_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredDPoPProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore int64
+//   - limit int
+func (_e *Repository_Expecter) DeleteExpiredDPoPProofs(ctx interface{}, expiredBefore interface{}, limit interface{}) *Repository_DeleteExpiredDPoPProofs_Call {
+	return &Repository_DeleteExpiredDPoPProofs_Call{Call: _e.mock.On("DeleteExpiredDPoPProofs", ctx, expiredBefore, limit)}
+}
+
+func (_c *Repository_DeleteExpiredDPoPProofs_Call) Run(run func(ctx context.Context, expiredBefore int64, limit int)) *Repository_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDPoPProofs_Call) Return(n int64, err error) *Repository_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDPoPProofs_Call) RunAndReturn(run func(ctx context.Context, expiredBefore int64, limit int) (int64, error)) *Repository_DeleteExpiredDPoPProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredDeniedSessions provides a mock function for the type Repository
+func (_mock *Repository) DeleteExpiredDeniedSessions(ctx context.Context, expiredBefore int64, limit int) (int64, error) {
+	ret := _mock.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredDeniedSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return returnFunc(ctx, expiredBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteExpiredDeniedSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredDeniedSessions'
+type Repository_DeleteExpiredDeniedSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredDeniedSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore int64
+//   - limit int
+func (_e *Repository_Expecter) DeleteExpiredDeniedSessions(ctx interface{}, expiredBefore interface{}, limit interface{}) *Repository_DeleteExpiredDeniedSessions_Call {
+	return &Repository_DeleteExpiredDeniedSessions_Call{Call: _e.mock.On("DeleteExpiredDeniedSessions", ctx, expiredBefore, limit)}
+}
+
+func (_c *Repository_DeleteExpiredDeniedSessions_Call) Run(run func(ctx context.Context, expiredBefore int64, limit int)) *Repository_DeleteExpiredDeniedSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDeniedSessions_Call) Return(n int64, err error) *Repository_DeleteExpiredDeniedSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDeniedSessions_Call) RunAndReturn(run func(ctx context.Context, expiredBefore int64, limit int) (int64, error)) *Repository_DeleteExpiredDeniedSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredDeviceOTPs provides a mock function for the type Repository
+func (_mock *Repository) DeleteExpiredDeviceOTPs(ctx context.Context, expiredBefore int64, limit int) (int64, error) {
+	ret := _mock.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredDeviceOTPs")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return returnFunc(ctx, expiredBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteExpiredDeviceOTPs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredDeviceOTPs'
+type Repository_DeleteExpiredDeviceOTPs_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredDeviceOTPs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore int64
+//   - limit int
+func (_e *Repository_Expecter) DeleteExpiredDeviceOTPs(ctx interface{}, expiredBefore interface{}, limit interface{}) *Repository_DeleteExpiredDeviceOTPs_Call {
+	return &Repository_DeleteExpiredDeviceOTPs_Call{Call: _e.mock.On("DeleteExpiredDeviceOTPs", ctx, expiredBefore, limit)}
+}
+
+func (_c *Repository_DeleteExpiredDeviceOTPs_Call) Run(run func(ctx context.Context, expiredBefore int64, limit int)) *Repository_DeleteExpiredDeviceOTPs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDeviceOTPs_Call) Return(n int64, err error) *Repository_DeleteExpiredDeviceOTPs_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredDeviceOTPs_Call) RunAndReturn(run func(ctx context.Context, expiredBefore int64, limit int) (int64, error)) *Repository_DeleteExpiredDeviceOTPs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredSessions provides a mock function for the type Repository
+func (_mock *Repository) DeleteExpiredSessions(ctx context.Context, expiredBefore int64, limit int) (int64, error) {
+	ret := _mock.Called(ctx, expiredBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) (int64, error)); ok {
+		return returnFunc(ctx, expiredBefore, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) int64); ok {
+		r0 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, expiredBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteExpiredSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredSessions'
+type Repository_DeleteExpiredSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expiredBefore int64
+//   - limit int
+func (_e *Repository_Expecter) DeleteExpiredSessions(ctx interface{}, expiredBefore interface{}, limit interface{}) *Repository_DeleteExpiredSessions_Call {
+	return &Repository_DeleteExpiredSessions_Call{Call: _e.mock.On("DeleteExpiredSessions", ctx, expiredBefore, limit)}
+}
+
+func (_c *Repository_DeleteExpiredSessions_Call) Run(run func(ctx context.Context, expiredBefore int64, limit int)) *Repository_DeleteExpiredSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredSessions_Call) Return(n int64, err error) *Repository_DeleteExpiredSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Repository_DeleteExpiredSessions_Call) RunAndReturn(run func(ctx context.Context, expiredBefore int64, limit int) (int64, error)) *Repository_DeleteExpiredSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenySession provides a mock function for the type Repository
+func (_mock *Repository) DenySession(ctx context.Context, sessionID string, expiresAt int64) error {
+	ret := _mock.Called(ctx, sessionID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenySession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = returnFunc(ctx, sessionID, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_DenySession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenySession'
+type Repository_DenySession_Call struct {
+	*mock.Call
+}
+
+// DenySession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID string
+//   - expiresAt int64
+func (_e *Repository_Expecter) DenySession(ctx interface{}, sessionID interface{}, expiresAt interface{}) *Repository_DenySession_Call {
+	return &Repository_DenySession_Call{Call: _e.mock.On("DenySession", ctx, sessionID, expiresAt)}
+}
+
+func (_c *Repository_DenySession_Call) Run(run func(ctx context.Context, sessionID string, expiresAt int64)) *Repository_DenySession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DenySession_Call) Return(err error) *Repository_DenySession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_DenySession_Call) RunAndReturn(run func(ctx context.Context, sessionID string, expiresAt int64) error) *Repository_DenySession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireSessions provides a mock function for the type Repository
+func (_mock *Repository) ExpireSessions(ctx context.Context, sessionIDs []string) error {
+	ret := _mock.Called(ctx, sessionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, sessionIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_ExpireSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireSessions'
+type Repository_ExpireSessions_Call struct {
+	*mock.Call
+}
+
+// ExpireSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionIDs []string
+func (_e *Repository_Expecter) ExpireSessions(ctx interface{}, sessionIDs interface{}) *Repository_ExpireSessions_Call {
+	return &Repository_ExpireSessions_Call{Call: _e.mock.On("ExpireSessions", ctx, sessionIDs)}
+}
+
+func (_c *Repository_ExpireSessions_Call) Run(run func(ctx context.Context, sessionIDs []string)) *Repository_ExpireSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ExpireSessions_Call) Return(err error) *Repository_ExpireSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_ExpireSessions_Call) RunAndReturn(run func(ctx context.Context, sessionIDs []string) error) *Repository_ExpireSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSessionsForApp provides a mock function for the type Repository
+func (_mock *Repository) GetActiveSessionsForApp(ctx context.Context, appID string) ([]*types.Session, error) {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSessionsForApp")
+	}
+
+	var r0 []*types.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*types.Session, error)); ok {
+		return returnFunc(ctx, appID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*types.Session); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetActiveSessionsForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSessionsForApp'
+type Repository_GetActiveSessionsForApp_Call struct {
+	*mock.Call
+}
+
+// GetActiveSessionsForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *Repository_Expecter) GetActiveSessionsForApp(ctx interface{}, appID interface{}) *Repository_GetActiveSessionsForApp_Call {
+	return &Repository_GetActiveSessionsForApp_Call{Call: _e.mock.On("GetActiveSessionsForApp", ctx, appID)}
+}
+
+func (_c *Repository_GetActiveSessionsForApp_Call) Run(run func(ctx context.Context, appID string)) *Repository_GetActiveSessionsForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetActiveSessionsForApp_Call) Return(sessions []*types.Session, err error) *Repository_GetActiveSessionsForApp_Call {
+	_c.Call.Return(sessions, err)
+	return _c
+}
+
+func (_c *Repository_GetActiveSessionsForApp_Call) RunAndReturn(run func(ctx context.Context, appID string) ([]*types.Session, error)) *Repository_GetActiveSessionsForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeniedSessions provides a mock function for the type Repository
+func (_mock *Repository) GetDeniedSessions(ctx context.Context) (map[string]int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeniedSessions")
 	}
 
-	var r0 *types.Session
+	var r0 map[string]int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.Session) (*types.Session, error)); ok {
-		return returnFunc(ctx, session)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]int64, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.Session) *types.Session); ok {
-		r0 = returnFunc(ctx, session)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Session)
+			r0 = ret.Get(0).(map[string]int64)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.Session) error); ok {
-		r1 = returnFunc(ctx, session)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
-type Repository_CreateSession_Call struct {
+// Repository_GetDeniedSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeniedSessions'
+type Repository_GetDeniedSessions_Call struct {
 	*mock.Call
 }
 
-// CreateSession is a helper method to define mock.On call
+// GetDeniedSessions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - session *types.Session
-func (_e *Repository_Expecter) CreateSession(ctx interface{}, session interface{}) *Repository_CreateSession_Call {
-	return &Repository_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+func (_e *Repository_Expecter) GetDeniedSessions(ctx interface{}) *Repository_GetDeniedSessions_Call {
+	return &Repository_GetDeniedSessions_Call{Call: _e.mock.On("GetDeniedSessions", ctx)}
 }
 
-func (_c *Repository_CreateSession_Call) Run(run func(ctx context.Context, session *types.Session)) *Repository_CreateSession_Call {
+func (_c *Repository_GetDeniedSessions_Call) Run(run func(ctx context.Context)) *Repository_GetDeniedSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *types.Session
-		if args[1] != nil {
-			arg1 = args[1].(*types.Session)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_CreateSession_Call) Return(session1 *types.Session, err error) *Repository_CreateSession_Call {
-	_c.Call.Return(session1, err)
+func (_c *Repository_GetDeniedSessions_Call) Return(stringToInt64 map[string]int64, err error) *Repository_GetDeniedSessions_Call {
+	_c.Call.Return(stringToInt64, err)
 	return _c
 }
 
-func (_c *Repository_CreateSession_Call) RunAndReturn(run func(ctx context.Context, session *types.Session) (*types.Session, error)) *Repository_CreateSession_Call {
+func (_c *Repository_GetDeniedSessions_Call) RunAndReturn(run func(ctx context.Context) (map[string]int64, error)) *Repository_GetDeniedSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -311,6 +979,74 @@ func (_c *Repository_GetDeviceOTPByValue_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetReceiptByID provides a mock function for the type Repository
+func (_mock *Repository) GetReceiptByID(ctx context.Context, id string) (*types.Receipt, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReceiptByID")
+	}
+
+	var r0 *types.Receipt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Receipt, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Receipt); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Receipt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetReceiptByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReceiptByID'
+type Repository_GetReceiptByID_Call struct {
+	*mock.Call
+}
+
+// GetReceiptByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetReceiptByID(ctx interface{}, id interface{}) *Repository_GetReceiptByID_Call {
+	return &Repository_GetReceiptByID_Call{Call: _e.mock.On("GetReceiptByID", ctx, id)}
+}
+
+func (_c *Repository_GetReceiptByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetReceiptByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetReceiptByID_Call) Return(receipt *types.Receipt, err error) *Repository_GetReceiptByID_Call {
+	_c.Call.Return(receipt, err)
+	return _c
+}
+
+func (_c *Repository_GetReceiptByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Receipt, error)) *Repository_GetReceiptByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSessionByAccessToken provides a mock function for the type Repository
 func (_mock *Repository) GetSessionByAccessToken(ctx context.Context, accessToken string) (*types.Session, error) {
 	ret := _mock.Called(ctx, accessToken)
@@ -447,6 +1183,217 @@ func (_c *Repository_GetSessionByAuthCode_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetSessionByID provides a mock function for the type Repository
+func (_mock *Repository) GetSessionByID(ctx context.Context, id string) (*types.Session, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionByID")
+	}
+
+	var r0 *types.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Session, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Session); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionByID'
+type Repository_GetSessionByID_Call struct {
+	*mock.Call
+}
+
+// GetSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetSessionByID(ctx interface{}, id interface{}) *Repository_GetSessionByID_Call {
+	return &Repository_GetSessionByID_Call{Call: _e.mock.On("GetSessionByID", ctx, id)}
+}
+
+func (_c *Repository_GetSessionByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetSessionByID_Call) Return(session *types.Session, err error) *Repository_GetSessionByID_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *Repository_GetSessionByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Session, error)) *Repository_GetSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type Repository
+func (_mock *Repository) ListSessions(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter) (*pagination.Pageable[types.Session], error) {
+	ret := _mock.Called(ctx, paginationFilter, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *pagination.Pageable[types.Session]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) (*pagination.Pageable[types.Session], error)); ok {
+		return returnFunc(ctx, paginationFilter, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) *pagination.Pageable[types.Session]); ok {
+		r0 = returnFunc(ctx, paginationFilter, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pageable[types.Session])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pagination.PaginationFilter, *types.SessionFilter) error); ok {
+		r1 = returnFunc(ctx, paginationFilter, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type Repository_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paginationFilter pagination.PaginationFilter
+//   - filter *types.SessionFilter
+func (_e *Repository_Expecter) ListSessions(ctx interface{}, paginationFilter interface{}, filter interface{}) *Repository_ListSessions_Call {
+	return &Repository_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, paginationFilter, filter)}
+}
+
+func (_c *Repository_ListSessions_Call) Run(run func(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter)) *Repository_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pagination.PaginationFilter
+		if args[1] != nil {
+			arg1 = args[1].(pagination.PaginationFilter)
+		}
+		var arg2 *types.SessionFilter
+		if args[2] != nil {
+			arg2 = args[2].(*types.SessionFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListSessions_Call) Return(pageable *pagination.Pageable[types.Session], err error) *Repository_ListSessions_Call {
+	_c.Call.Return(pageable, err)
+	return _c
+}
+
+func (_c *Repository_ListSessions_Call) RunAndReturn(run func(ctx context.Context, paginationFilter pagination.PaginationFilter, filter *types.SessionFilter) (*pagination.Pageable[types.Session], error)) *Repository_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreDPoPProof provides a mock function for the type Repository
+func (_mock *Repository) StoreDPoPProof(ctx context.Context, thumbprint string, id string, expiresAt int64) error {
+	ret := _mock.Called(ctx, thumbprint, id, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreDPoPProof")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = returnFunc(ctx, thumbprint, id, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_StoreDPoPProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreDPoPProof'
+type Repository_StoreDPoPProof_Call struct {
+	*mock.Call
+}
+
+// StoreDPoPProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - thumbprint string
+//   - id string
+//   - expiresAt int64
+func (_e *Repository_Expecter) StoreDPoPProof(ctx interface{}, thumbprint interface{}, id interface{}, expiresAt interface{}) *Repository_StoreDPoPProof_Call {
+	return &Repository_StoreDPoPProof_Call{Call: _e.mock.On("StoreDPoPProof", ctx, thumbprint, id, expiresAt)}
+}
+
+func (_c *Repository_StoreDPoPProof_Call) Run(run func(ctx context.Context, thumbprint string, id string, expiresAt int64)) *Repository_StoreDPoPProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_StoreDPoPProof_Call) Return(err error) *Repository_StoreDPoPProof_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_StoreDPoPProof_Call) RunAndReturn(run func(ctx context.Context, thumbprint string, id string, expiresAt int64) error) *Repository_StoreDPoPProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDeviceOTP provides a mock function for the type Repository
 func (_mock *Repository) UpdateDeviceOTP(ctx context.Context, otp *types.SessionDeviceOTP) error {
 	ret := _mock.Called(ctx, otp)
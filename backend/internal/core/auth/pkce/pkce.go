@@ -0,0 +1,80 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pkce implements the Proof Key for Code Exchange (RFC 7636),
+// binding an authorization code to a secret known only to the client
+// that requested it.
+package pkce
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"regexp"
+)
+
+const (
+	MethodS256  = "S256"
+	MethodPlain = "plain"
+
+	verifierEntropy = 32
+)
+
+var (
+	ErrUnsupportedMethod = errors.New("unsupported code challenge method")
+	ErrInvalidChallenge  = errors.New("invalid code challenge")
+)
+
+// The challenges and verifiers are 43 to 128 unreserved URI characters.
+var format = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// ValidateChallenge checks the code challenge sent to the authorization
+// endpoint and returns its method, which defaults to plain as per RFC 7636.
+func ValidateChallenge(challenge string, method *string) (string, error) {
+	resolvedMethod := MethodPlain
+	if method != nil && *method != "" {
+		resolvedMethod = *method
+	}
+
+	if resolvedMethod != MethodS256 && resolvedMethod != MethodPlain {
+		return "", ErrUnsupportedMethod
+	}
+
+	if !format.MatchString(challenge) {
+		return "", ErrInvalidChallenge
+	}
+
+	return resolvedMethod, nil
+}
+
+// Verify checks that the code verifier sent to the token endpoint
+// matches the challenge sent to the authorization endpoint.
+func Verify(verifier, challenge, method string) bool {
+	if !format.MatchString(verifier) {
+		return false
+	}
+
+	expected := verifier
+	if method == MethodS256 {
+		expected = S256Challenge(verifier)
+	}
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// NewVerifier generates a random code verifier.
+func NewVerifier() string {
+	value := make([]byte, verifierEntropy)
+	_, _ = rand.Read(value)
+
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+// S256Challenge derives the S256 code challenge of a verifier.
+func S256Challenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
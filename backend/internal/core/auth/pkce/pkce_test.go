@@ -0,0 +1,117 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package pkce_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/auth/pkce"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateChallenge(t *testing.T) {
+	t.Parallel()
+
+	challenge := pkce.S256Challenge(pkce.NewVerifier())
+
+	testCases := map[string]*struct {
+		challenge      string
+		method         *string
+		expectedMethod string
+		expectedErr    error
+	}{
+		"S256 challenge": {
+			challenge:      challenge,
+			method:         ptrutil.Ptr(pkce.MethodS256),
+			expectedMethod: pkce.MethodS256,
+		},
+		"method defaults to plain": {
+			challenge:      challenge,
+			expectedMethod: pkce.MethodPlain,
+		},
+		"unsupported method": {
+			challenge:   challenge,
+			method:      ptrutil.Ptr("S512"),
+			expectedErr: pkce.ErrUnsupportedMethod,
+		},
+		"too short challenge": {
+			challenge:   "short",
+			method:      ptrutil.Ptr(pkce.MethodS256),
+			expectedErr: pkce.ErrInvalidChallenge,
+		},
+		"too long challenge": {
+			challenge:   strings.Repeat("a", 129),
+			expectedErr: pkce.ErrInvalidChallenge,
+		},
+		"reserved characters": {
+			challenge:   strings.Repeat("a", 42) + "/",
+			expectedErr: pkce.ErrInvalidChallenge,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			method, err := pkce.ValidateChallenge(tc.challenge, tc.method)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedMethod, method)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	verifier := pkce.NewVerifier()
+
+	testCases := map[string]*struct {
+		verifier       string
+		challenge      string
+		method         string
+		expectedResult bool
+	}{
+		"matching S256 verifier": {
+			verifier:       verifier,
+			challenge:      pkce.S256Challenge(verifier),
+			method:         pkce.MethodS256,
+			expectedResult: true,
+		},
+		"matching plain verifier": {
+			verifier:       verifier,
+			challenge:      verifier,
+			method:         pkce.MethodPlain,
+			expectedResult: true,
+		},
+		"other S256 verifier": {
+			verifier:       pkce.NewVerifier(),
+			challenge:      pkce.S256Challenge(verifier),
+			method:         pkce.MethodS256,
+			expectedResult: false,
+		},
+		"S256 challenge sent as the verifier": {
+			verifier:       pkce.S256Challenge(verifier),
+			challenge:      pkce.S256Challenge(verifier),
+			method:         pkce.MethodS256,
+			expectedResult: false,
+		},
+		"malformed verifier": {
+			verifier:       "short",
+			challenge:      "short",
+			method:         pkce.MethodPlain,
+			expectedResult: false,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectedResult, pkce.Verify(tc.verifier, tc.challenge, tc.method))
+		})
+	}
+}
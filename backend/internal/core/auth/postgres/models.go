@@ -13,46 +13,71 @@ import (
 )
 
 type Session struct {
-	ID                uuid.UUID `gorm:"primaryKey;default:gen_random_uuid()"`
-	CreatedAt         int64     `gorm:"autoCreateTime"`
-	ExpiresAt         *int64
-	OwnerAppID        uuid.UUID `gorm:"foreignKey:ID"`
-	OwnerApp          *apptypes.App
-	AppID             *uuid.UUID `gorm:"foreignKey:ID"`
-	App               *apptypes.App
-	ToolName          *string
-	UserID            *string
-	AccessToken       *secrets.EncryptedString `gorm:"type:varchar(16384);index:at_idx,unique;"`
-	AuthorizationCode *string                  `gorm:"type:varchar(256);index:ac_idx,unique;"`
+	ID                  uuid.UUID `gorm:"primaryKey;default:gen_random_uuid()"`
+	CreatedAt           int64     `gorm:"autoCreateTime"`
+	ExpiresAt           *int64    `gorm:"index:session_exp_idx"`
+	OwnerAppID          uuid.UUID `gorm:"foreignKey:ID"`
+	OwnerApp            *apptypes.App
+	AppID               *uuid.UUID `gorm:"foreignKey:ID"`
+	App                 *apptypes.App
+	ToolName            *string
+	UserID              *string
+	AccessToken         *secrets.EncryptedString `gorm:"type:varchar(16384);index:at_idx,unique;"`
+	AuthorizationCode   *string                  `gorm:"type:varchar(256);index:ac_idx,unique;"`
+	TransactionID       *string                  `gorm:"type:varchar(256);index:txn_idx;"`
+	DPoPThumbprint      *string                  `gorm:"type:varchar(256);"`
+	CodeChallenge       *string                  `gorm:"type:varchar(128);"`
+	CodeChallengeMethod *string                  `gorm:"type:varchar(16);"`
 }
 
 func (i *Session) ToCoreType(crypter secrets.Crypter) *types.Session {
 	return &types.Session{
-		ID:                i.ID.String(),
-		OwnerAppID:        i.OwnerAppID.String(),
-		AppID:             strutil.SafeUuidString(i.AppID),
-		ToolName:          i.ToolName,
-		UserID:            i.UserID,
-		AuthorizationCode: i.AuthorizationCode,
-		AccessToken:       secrets.EncryptedStringToRaw(i.AccessToken, crypter),
-		CreatedAt:         i.CreatedAt,
-		ExpiresAt:         i.ExpiresAt,
+		ID:                  i.ID.String(),
+		OwnerAppID:          i.OwnerAppID.String(),
+		AppID:               strutil.SafeUuidString(i.AppID),
+		ToolName:            i.ToolName,
+		UserID:              i.UserID,
+		AuthorizationCode:   i.AuthorizationCode,
+		AccessToken:         secrets.EncryptedStringToRaw(i.AccessToken, crypter),
+		CreatedAt:           i.CreatedAt,
+		ExpiresAt:           i.ExpiresAt,
+		TransactionID:       i.TransactionID,
+		DPoPThumbprint:      i.DPoPThumbprint,
+		CodeChallenge:       i.CodeChallenge,
+		CodeChallengeMethod: i.CodeChallengeMethod,
 	}
 }
 
 func newSessionModel(src *types.Session, crypter secrets.Crypter) *Session {
 	return &Session{
-		OwnerAppID:        uuid.MustParse(src.OwnerAppID),
-		AppID:             strutil.SafeUuid(src.AppID),
-		ToolName:          src.ToolName,
-		UserID:            src.UserID,
-		AccessToken:       secrets.NewEncryptedString(src.AccessToken, crypter),
-		AuthorizationCode: src.AuthorizationCode,
-		ExpiresAt:         src.ExpiresAt,
-		CreatedAt:         src.CreatedAt,
+		OwnerAppID:          uuid.MustParse(src.OwnerAppID),
+		AppID:               strutil.SafeUuid(src.AppID),
+		ToolName:            src.ToolName,
+		UserID:              src.UserID,
+		AccessToken:         secrets.NewEncryptedString(src.AccessToken, crypter),
+		AuthorizationCode:   src.AuthorizationCode,
+		ExpiresAt:           src.ExpiresAt,
+		CreatedAt:           src.CreatedAt,
+		TransactionID:       src.TransactionID,
+		DPoPThumbprint:      src.DPoPThumbprint,
+		CodeChallenge:       src.CodeChallenge,
+		CodeChallengeMethod: src.CodeChallengeMethod,
 	}
 }
 
+// A revoked session whose self-contained token has not expired yet.
+type DeniedSession struct {
+	SessionID uuid.UUID `gorm:"primaryKey"`
+	ExpiresAt int64     `gorm:"not null;index:denied_exp_idx"`
+}
+
+// A DPoP proof accepted until it leaves the acceptance window.
+type DPoPProof struct {
+	Thumbprint string `gorm:"primaryKey;type:varchar(256)"`
+	ID         string `gorm:"primaryKey;type:varchar(256)"`
+	ExpiresAt  int64  `gorm:"not null;index:dpop_exp_idx"`
+}
+
 type SessionDeviceOTP struct {
 	ID        uuid.UUID `gorm:"primaryKey;default:gen_random_uuid()"`
 	Value     string    `gorm:"uniqueIndex"`
@@ -61,7 +86,7 @@ type SessionDeviceOTP struct {
 	DeviceID  string `gorm:"foreignKey:ID"`
 	Device    *devicetypes.Device
 	CreatedAt int64 `gorm:"autoCreateTime"`
-	ExpiresAt int64
+	ExpiresAt int64 `gorm:"index:otp_exp_idx"`
 	Approved  *bool
 	Used      bool
 }
@@ -93,3 +118,53 @@ func newSessionDeviceOTPModel(src *types.SessionDeviceOTP) *SessionDeviceOTP {
 		Used:      src.Used,
 	}
 }
+
+type Receipt struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	TenantID    string    `gorm:"not null;type:varchar(256);"`
+	CallerAppID string    `gorm:"not null;type:varchar(256);"`
+	CallerDID   string    `gorm:"not null;type:varchar(2048);"`
+	CalleeAppID string    `gorm:"not null;type:varchar(256);"`
+	CalleeDID   string    `gorm:"not null;type:varchar(2048);"`
+	ToolName    string
+	RuleID      string `gorm:"type:varchar(256);"`
+	ApprovalID  *string
+	BodyHash    *string
+	Token       string `gorm:"not null;type:varchar(16384);"`
+	CreatedAt   int64
+	ExpiresAt   int64
+}
+
+func (r *Receipt) ToCoreType() *types.Receipt {
+	return &types.Receipt{
+		ID:          r.ID.String(),
+		CallerAppID: r.CallerAppID,
+		CallerDID:   r.CallerDID,
+		CalleeAppID: r.CalleeAppID,
+		CalleeDID:   r.CalleeDID,
+		ToolName:    r.ToolName,
+		RuleID:      r.RuleID,
+		ApprovalID:  r.ApprovalID,
+		BodyHash:    r.BodyHash,
+		Token:       r.Token,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
+
+func newReceiptModel(src *types.Receipt) *Receipt {
+	return &Receipt{
+		ID:          uuid.MustParse(src.ID),
+		CallerAppID: src.CallerAppID,
+		CallerDID:   src.CallerDID,
+		CalleeAppID: src.CalleeAppID,
+		CalleeDID:   src.CalleeDID,
+		ToolName:    src.ToolName,
+		RuleID:      src.RuleID,
+		ApprovalID:  src.ApprovalID,
+		BodyHash:    src.BodyHash,
+		Token:       src.Token,
+		CreatedAt:   src.CreatedAt,
+		ExpiresAt:   src.ExpiresAt,
+	}
+}
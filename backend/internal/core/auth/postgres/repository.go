@@ -12,9 +12,13 @@ import (
 	authcore "github.com/agntcy/identity-service/internal/core/auth"
 	types "github.com/agntcy/identity-service/internal/core/auth/types/int"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/convertutil"
+	"github.com/agntcy/identity-service/internal/pkg/gormutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"github.com/agntcy/identity-service/internal/pkg/secrets"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresRepository struct {
@@ -99,11 +103,17 @@ func (r *postgresRepository) GetSessionByAccessToken(
 	return model.ToCoreType(r.crypter), nil
 }
 
+// UpdateSession only updates the columns that change during the lifetime of a session.
+// The session can be rebuilt from a self-contained session token, which does not carry
+// the other columns, such as the authorization code.
 func (r *postgresRepository) UpdateSession(ctx context.Context, session *types.Session) error {
 	model := newSessionModel(session, r.crypter)
 	model.ID = uuid.MustParse(session.ID)
 
-	result := r.dbContext.Save(model)
+	result := r.dbContext.WithContext(ctx).
+		Model(model).
+		Select("AccessToken", "ExpiresAt", "TransactionID", "DPoPThumbprint").
+		Updates(model)
 	if result.Error != nil {
 		return fmt.Errorf("there was an error updating the session: %w", result.Error)
 	}
@@ -111,6 +121,333 @@ func (r *postgresRepository) UpdateSession(ctx context.Context, session *types.S
 	return nil
 }
 
+// The sessions are scoped to the tenant of their owner app
+const ownerAppJoin = "JOIN apps ON apps.id = sessions.owner_app_id"
+
+func (r *postgresRepository) ListSessions(
+	ctx context.Context,
+	paginationFilter pagination.PaginationFilter,
+	filter *types.SessionFilter,
+) (*pagination.Pageable[types.Session], error) {
+	dbQuery := r.dbContext.WithContext(ctx).
+		Joins(ownerAppJoin).
+		Scopes(gormutil.BelongsToTenantForTable(ctx, "apps"))
+
+	if filter != nil {
+		dbQuery = filterSessions(dbQuery, filter)
+	}
+
+	dbQuery = dbQuery.Session(
+		&gorm.Session{},
+	) // https://gorm.io/docs/method_chaining.html#Reusability-and-Safety
+
+	var sessions []*Session
+
+	err := dbQuery.
+		Scopes(gormutil.Paginate(paginationFilter)).
+		Order("sessions.created_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error fetching the sessions: %w", err)
+	}
+
+	var totalSessions int64
+
+	err = dbQuery.Model(&Session{}).Count(&totalSessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error counting the sessions: %w", err)
+	}
+
+	return &pagination.Pageable[types.Session]{
+		Items: convertutil.ConvertSlice(sessions, func(session *Session) *types.Session {
+			return session.ToCoreType(r.crypter)
+		}),
+		Total: totalSessions,
+		Page:  paginationFilter.GetPage(),
+		Size:  int32(len(sessions)),
+	}, nil
+}
+
+func filterSessions(dbQuery *gorm.DB, filter *types.SessionFilter) *gorm.DB {
+	if filter.OwnerAppID != nil {
+		dbQuery = dbQuery.Where("sessions.owner_app_id = ?", *filter.OwnerAppID)
+	}
+
+	if filter.AppID != nil {
+		dbQuery = dbQuery.Where("sessions.app_id = ?", *filter.AppID)
+	}
+
+	if filter.ToolName != nil {
+		dbQuery = dbQuery.Where("sessions.tool_name = ?", *filter.ToolName)
+	}
+
+	if filter.UserID != nil {
+		dbQuery = dbQuery.Where("sessions.user_id = ?", *filter.UserID)
+	}
+
+	if filter.Active != nil {
+		now := time.Now().Unix()
+
+		if *filter.Active {
+			dbQuery = dbQuery.Where("(sessions.expires_at IS NULL OR sessions.expires_at > ?)", now)
+		} else {
+			dbQuery = dbQuery.Where("sessions.expires_at <= ?", now)
+		}
+	}
+
+	return dbQuery
+}
+
+func (r *postgresRepository) GetSessionByID(ctx context.Context, id string) (*types.Session, error) {
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, authcore.ErrSessionNotFound
+	}
+
+	var model Session
+
+	result := r.dbContext.WithContext(ctx).
+		Joins(ownerAppJoin).
+		Scopes(gormutil.BelongsToTenantForTable(ctx, "apps")).
+		Where("sessions.id = ?", sessionID).
+		First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, authcore.ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the session: %w", result.Error)
+	}
+
+	return model.ToCoreType(r.crypter), nil
+}
+
+func (r *postgresRepository) GetActiveSessionsForApp(
+	ctx context.Context,
+	appID string,
+) ([]*types.Session, error) {
+	var models []*Session
+
+	err := r.dbContext.WithContext(ctx).
+		Where("owner_app_id = ?", appID).
+		Where("(expires_at IS NULL OR expires_at > ?)", time.Now().Unix()).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error fetching the sessions of the app %s: %w", appID, err)
+	}
+
+	return convertutil.ConvertSlice(models, func(model *Session) *types.Session {
+		return model.ToCoreType(r.crypter)
+	}), nil
+}
+
+func (r *postgresRepository) ExpireSessions(ctx context.Context, sessionIDs []string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(sessionIDs))
+
+	for _, sessionID := range sessionIDs {
+		id, err := uuid.Parse(sessionID)
+		if err != nil {
+			return fmt.Errorf("invalid session ID %s: %w", sessionID, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	now := time.Now().Unix()
+
+	err := r.dbContext.WithContext(ctx).
+		Model(&Session{}).
+		Where("id IN ?", ids).
+		Where("(expires_at IS NULL OR expires_at > ?)", now).
+		Update("expires_at", now-1).Error
+	if err != nil {
+		return fmt.Errorf("there was an error expiring the sessions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DenySession(
+	ctx context.Context,
+	sessionID string,
+	expiresAt int64,
+) error {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return fmt.Errorf("invalid session ID %s: %w", sessionID, err)
+	}
+
+	result := r.dbContext.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&DeniedSession{SessionID: id, ExpiresAt: expiresAt})
+	if result.Error != nil {
+		return fmt.Errorf("there was an error denying the session: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetDeniedSessions(ctx context.Context) (map[string]int64, error) {
+	var models []*DeniedSession
+
+	result := r.dbContext.
+		WithContext(ctx).
+		Where("expires_at > ?", time.Now().Unix()).
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("there was an error fetching the denied sessions: %w", result.Error)
+	}
+
+	denied := make(map[string]int64, len(models))
+	for _, model := range models {
+		denied[model.SessionID.String()] = model.ExpiresAt
+	}
+
+	return denied, nil
+}
+
+func (r *postgresRepository) StoreDPoPProof(
+	ctx context.Context,
+	thumbprint, id string,
+	expiresAt int64,
+) error {
+	result := r.dbContext.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&DPoPProof{Thumbprint: thumbprint, ID: id, ExpiresAt: expiresAt})
+	if result.Error != nil {
+		return fmt.Errorf("there was an error storing the DPoP proof: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return authcore.ErrDPoPProofAlreadyStored
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteExpiredSessions(
+	ctx context.Context,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	deleted, err := r.deleteSessions(ctx, "access_token IS NOT NULL", expiredBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("there was an error deleting the expired sessions: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func (r *postgresRepository) DeleteExpiredAuthorizationCodes(
+	ctx context.Context,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	deleted, err := r.deleteSessions(ctx, "access_token IS NULL", expiredBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("there was an error deleting the expired authorization codes: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// deleteSessions deletes a batch of expired sessions matching the condition.
+// The device OTPs reference the sessions and are deleted first.
+func (r *postgresRepository) deleteSessions(
+	ctx context.Context,
+	condition string,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	var deleted int64
+
+	err := r.dbContext.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []string
+
+		err := tx.Model(&Session{}).
+			Where(condition).
+			Where("expires_at < ?", expiredBefore).
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		err = tx.Where("session_id IN ?", ids).Delete(&SessionDeviceOTP{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("id IN ?", ids).Delete(&Session{})
+		deleted = result.RowsAffected
+
+		return result.Error
+	})
+
+	return deleted, err
+}
+
+func (r *postgresRepository) DeleteExpiredDeviceOTPs(
+	ctx context.Context,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	result := r.dbContext.
+		WithContext(ctx).
+		Where("id IN (?)", r.dbContext.Model(&SessionDeviceOTP{}).
+			Select("id").
+			Where("expires_at < ?", expiredBefore).
+			Limit(limit)).
+		Delete(&SessionDeviceOTP{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("there was an error deleting the expired device OTPs: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *postgresRepository) DeleteExpiredDeniedSessions(
+	ctx context.Context,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	result := r.dbContext.
+		WithContext(ctx).
+		Where("session_id IN (?)", r.dbContext.Model(&DeniedSession{}).
+			Select("session_id").
+			Where("expires_at < ?", expiredBefore).
+			Limit(limit)).
+		Delete(&DeniedSession{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("there was an error deleting the expired denied sessions: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *postgresRepository) DeleteExpiredDPoPProofs(
+	ctx context.Context,
+	expiredBefore int64,
+	limit int,
+) (int64, error) {
+	result := r.dbContext.
+		WithContext(ctx).
+		Where("(thumbprint, id) IN (?)", r.dbContext.Model(&DPoPProof{}).
+			Select("thumbprint", "id").
+			Where("expires_at < ?", expiredBefore).
+			Limit(limit)).
+		Delete(&DPoPProof{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("there was an error deleting the expired DPoP proofs: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (r *postgresRepository) CreateDeviceOTP(
 	ctx context.Context,
 	otp *types.SessionDeviceOTP,
@@ -183,3 +520,49 @@ func (r *postgresRepository) GetDeviceOTPByValue(
 
 	return otp.ToCoreType(), nil
 }
+
+func (r *postgresRepository) CreateReceipt(
+	ctx context.Context,
+	receipt *types.Receipt,
+) error {
+	model := newReceiptModel(receipt)
+
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	model.TenantID = tenantID
+
+	result := r.dbContext.Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("there was an error creating the receipt: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetReceiptByID(
+	ctx context.Context,
+	id string,
+) (*types.Receipt, error) {
+	receiptID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, authcore.ErrReceiptNotFound
+	}
+
+	var model Receipt
+
+	result := r.dbContext.
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		First(&model, receiptID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, authcore.ErrReceiptNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the receipt: %w", result.Error)
+	}
+
+	return model.ToCoreType(), nil
+}
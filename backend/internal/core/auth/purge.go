@@ -0,0 +1,92 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+)
+
+// How long the expired rows are kept before being purged.
+// Keeping the sessions for a while helps when investigating their use.
+type PurgeRetention struct {
+	Sessions           time.Duration
+	AuthorizationCodes time.Duration
+	DeviceOTPs         time.Duration
+}
+
+// NewPurgeTasks returns the maintenance tasks deleting the expired sessions,
+// authorization codes and device OTPs once their retention is over, along with
+// the denied sessions and the DPoP proof IDs that are no longer needed once expired.
+// The rows are deleted in batches of batchSize to keep the transactions short.
+func NewPurgeTasks(
+	repository Repository,
+	retention PurgeRetention,
+	batchSize int,
+) []maintenance.Task {
+	return []maintenance.Task{
+		&purgeTask{
+			name:      "expired_device_otps",
+			retention: retention.DeviceOTPs,
+			batchSize: batchSize,
+			deleteFn:  repository.DeleteExpiredDeviceOTPs,
+		},
+		&purgeTask{
+			name:      "expired_authorization_codes",
+			retention: retention.AuthorizationCodes,
+			batchSize: batchSize,
+			deleteFn:  repository.DeleteExpiredAuthorizationCodes,
+		},
+		&purgeTask{
+			name:      "expired_sessions",
+			retention: retention.Sessions,
+			batchSize: batchSize,
+			deleteFn:  repository.DeleteExpiredSessions,
+		},
+		&purgeTask{
+			name:      "expired_denied_sessions",
+			batchSize: batchSize,
+			deleteFn:  repository.DeleteExpiredDeniedSessions,
+		},
+		&purgeTask{
+			name:      "expired_dpop_proofs",
+			batchSize: batchSize,
+			deleteFn:  repository.DeleteExpiredDPoPProofs,
+		},
+	}
+}
+
+type purgeTask struct {
+	name      string
+	retention time.Duration
+	batchSize int
+	deleteFn  func(ctx context.Context, expiredBefore int64, limit int) (int64, error)
+}
+
+func (t *purgeTask) Name() string {
+	return t.name
+}
+
+func (t *purgeTask) Run(ctx context.Context) (int64, error) {
+	expiredBefore := time.Now().Add(-t.retention).Unix()
+
+	var total int64
+
+	for {
+		deleted, err := t.deleteFn(ctx, expiredBefore, t.batchSize)
+		total += deleted
+
+		if err != nil {
+			return total, fmt.Errorf("repository failed to purge the %s: %w", t.name, err)
+		}
+
+		// A partial batch means there is nothing left to delete
+		if deleted < int64(t.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
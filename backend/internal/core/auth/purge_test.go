@@ -0,0 +1,88 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	authcore "github.com/agntcy/identity-service/internal/core/auth"
+	authmocks "github.com/agntcy/identity-service/internal/core/auth/mocks"
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const purgeBatchSize = 10
+
+func findPurgeTask(t *testing.T, tasks []maintenance.Task, name string) maintenance.Task {
+	t.Helper()
+
+	for _, task := range tasks {
+		if task.Name() == name {
+			return task
+		}
+	}
+
+	require.FailNow(t, "task not found", name)
+
+	return nil
+}
+
+func TestPurgeTasks_should_delete_in_batches_until_a_partial_batch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	retention := authcore.PurgeRetention{Sessions: 24 * time.Hour}
+	expiredBefore := mock.MatchedBy(func(expiredBefore int64) bool {
+		return expiredBefore <= time.Now().Add(-retention.Sessions).Unix()
+	})
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().
+		DeleteExpiredSessions(ctx, expiredBefore, purgeBatchSize).
+		Return(purgeBatchSize, nil).
+		Twice()
+	repo.EXPECT().
+		DeleteExpiredSessions(ctx, expiredBefore, purgeBatchSize).
+		Return(3, nil).
+		Once()
+
+	sut := findPurgeTask(t, authcore.NewPurgeTasks(repo, retention, purgeBatchSize), "expired_sessions")
+
+	removed, err := sut.Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2*purgeBatchSize+3), removed)
+}
+
+func TestPurgeTasks_should_return_the_removed_rows_on_failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	repo := authmocks.NewRepository(t)
+	repo.EXPECT().
+		DeleteExpiredDPoPProofs(ctx, mock.Anything, purgeBatchSize).
+		Return(purgeBatchSize, nil).
+		Once()
+	repo.EXPECT().
+		DeleteExpiredDPoPProofs(ctx, mock.Anything, purgeBatchSize).
+		Return(0, errors.New("failed")).
+		Once()
+
+	sut := findPurgeTask(
+		t,
+		authcore.NewPurgeTasks(repo, authcore.PurgeRetention{}, purgeBatchSize),
+		"expired_dpop_proofs",
+	)
+
+	removed, err := sut.Run(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(purgeBatchSize), removed)
+}
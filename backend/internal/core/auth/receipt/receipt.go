@@ -0,0 +1,217 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package receipt
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	types "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// Duration is the time during which a receipt proves a current authorization.
+	// The receipts remain verifiable after it, as records of the past authorizations.
+	Duration = 5 * time.Minute
+
+	// Type is the typ header of the receipts, it distinguishes them
+	// from the other tokens signed with the issuer key.
+	Type = "receipt+jwt"
+)
+
+var (
+	ErrInvalidReceipt = errors.New("invalid receipt")
+	ErrExpiredReceipt = errors.New("the receipt has expired")
+)
+
+// Claims are the claims of a receipt JWT.
+type Claims struct {
+	// The ID of the receipt.
+	ID string `json:"jti"`
+
+	// The issuer of the receipt.
+	Issuer string `json:"iss"`
+
+	// The DID of the caller.
+	Subject string `json:"sub"`
+
+	// The DID of the callee.
+	Audience string `json:"aud"`
+
+	// The time at which the receipt was issued.
+	IssuedAt int64 `json:"iat"`
+
+	// The time at which the receipt expires.
+	ExpiresAt int64 `json:"exp"`
+
+	// The tool that the caller was authorized to invoke.
+	ToolName string `json:"tool,omitempty"`
+
+	// The ID of the policy rule that granted access.
+	RuleID string `json:"rule_id"`
+
+	// The ID of the device OTP used to approve the call, if any.
+	ApprovalID *string `json:"approval_id,omitempty"`
+
+	// The base64url encoded SHA-256 hash of the request body, if any.
+	BodyHash *string `json:"body_hash,omitempty"`
+}
+
+// MatchesBody tells whether the receipt was issued for the provided request body.
+func (c *Claims) MatchesBody(body []byte) bool {
+	return c.BodyHash != nil && *c.BodyHash == HashBody(body)
+}
+
+// New creates an unsigned receipt for an authorized call.
+// The body hash is only set when a body is provided.
+func New(
+	callerAppID, callerDID string,
+	calleeAppID, calleeDID string,
+	toolName, ruleID string,
+	approvalID *string,
+	body []byte,
+) *types.Receipt {
+	now := time.Now()
+
+	receipt := &types.Receipt{
+		ID:          uuid.NewString(),
+		CallerAppID: callerAppID,
+		CallerDID:   callerDID,
+		CalleeAppID: calleeAppID,
+		CalleeDID:   calleeDID,
+		ToolName:    toolName,
+		RuleID:      ruleID,
+		ApprovalID:  approvalID,
+		CreatedAt:   now.Unix(),
+		ExpiresAt:   now.Add(Duration).Unix(),
+	}
+
+	if len(body) > 0 {
+		hash := HashBody(body)
+		receipt.BodyHash = &hash
+	}
+
+	return receipt
+}
+
+// Sign signs the receipt with the private key of the issuer and sets its token.
+func Sign(receipt *types.Receipt, issuer string, privateKey *jwk.Jwk) error {
+	if receipt == nil {
+		return errors.New("invalid receipt")
+	}
+
+	if privateKey == nil {
+		return errors.New("invalid privateKey argument")
+	}
+
+	payload, err := json.Marshal(&Claims{
+		ID:         receipt.ID,
+		Issuer:     issuer,
+		Subject:    receipt.CallerDID,
+		Audience:   receipt.CalleeDID,
+		IssuedAt:   receipt.CreatedAt,
+		ExpiresAt:  receipt.ExpiresAt,
+		ToolName:   receipt.ToolName,
+		RuleID:     receipt.RuleID,
+		ApprovalID: receipt.ApprovalID,
+		BodyHash:   receipt.BodyHash,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal receipt claims: %w", err)
+	}
+
+	key, err := joseutil.ToJwx(privateKey)
+	if err != nil {
+		return fmt.Errorf("unable to parse the private key: %w", err)
+	}
+
+	headers := jws.NewHeaders()
+	_ = headers.Set(jws.TypeKey, Type)
+	_ = headers.Set(jws.KeyIDKey, privateKey.KID)
+
+	signed, err := jws.Sign(payload, jws.WithKey(jwa.RS256(), key, jws.WithProtectedHeaders(headers)))
+	if err != nil {
+		return fmt.Errorf("unable to sign the receipt: %w", err)
+	}
+
+	receipt.Token = string(signed)
+
+	return nil
+}
+
+// IsExpired tells whether the receipt no longer proves a current authorization.
+func (c *Claims) IsExpired() bool {
+	return c.ExpiresAt <= time.Now().Unix()
+}
+
+// Verify verifies the signature and the type of a receipt token using the public key
+// of the issuer and returns its claims, whether the receipt has expired or not.
+// It does not require any call to the Identity Service.
+func Verify(token string, publicKey *jwk.Jwk) (*Claims, error) {
+	if token == "" {
+		return nil, errors.New("receipt token cannot be empty")
+	}
+
+	if publicKey == nil {
+		return nil, errors.New("invalid publicKey argument")
+	}
+
+	payload, err := joseutil.Verify(publicKey, []byte(token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReceipt, err)
+	}
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, ErrInvalidReceipt
+	}
+
+	if typ, _ := msg.Signatures()[0].ProtectedHeaders().Type(); typ != Type {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidReceipt, typ)
+	}
+
+	var claims Claims
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidReceipt, err)
+	}
+
+	if claims.ID == "" || claims.Subject == "" || claims.Audience == "" || claims.ExpiresAt == 0 {
+		return nil, fmt.Errorf("%w: the receipt is missing required claims", ErrInvalidReceipt)
+	}
+
+	return &claims, nil
+}
+
+// VerifyFresh verifies the receipt token like Verify and also requires the receipt
+// not to be expired, for the callees accepting it as a proof of a current authorization.
+func VerifyFresh(token string, publicKey *jwk.Jwk) (*Claims, error) {
+	claims, err := Verify(token, publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.IsExpired() {
+		return nil, ErrExpiredReceipt
+	}
+
+	return claims, nil
+}
+
+// HashBody returns the base64url encoded SHA-256 hash of a request body.
+func HashBody(body []byte) string {
+	hash := sha256.Sum256(body)
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
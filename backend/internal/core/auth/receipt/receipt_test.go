@@ -0,0 +1,171 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package receipt_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/auth/receipt"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify_should_return_claims(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	body := []byte(`{"jsonrpc":"2.0","method":"tools/call"}`)
+	rcpt := receipt.New(
+		"caller_id",
+		"did:caller",
+		"callee_id",
+		"did:callee",
+		"tool",
+		"rule_id",
+		ptrutil.Ptr("otp_id"),
+		body,
+	)
+
+	err := receipt.Sign(rcpt, "issuer", privKey)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rcpt.Token)
+
+	claims, err := receipt.Verify(rcpt.Token, privKey.PublicKey())
+
+	assert.NoError(t, err)
+	assert.Equal(t, rcpt.ID, claims.ID)
+	assert.Equal(t, "issuer", claims.Issuer)
+	assert.Equal(t, "did:caller", claims.Subject)
+	assert.Equal(t, "did:callee", claims.Audience)
+	assert.Equal(t, "tool", claims.ToolName)
+	assert.Equal(t, "rule_id", claims.RuleID)
+	assert.Equal(t, ptrutil.Ptr("otp_id"), claims.ApprovalID)
+	assert.Equal(t, rcpt.ExpiresAt, claims.ExpiresAt)
+	assert.True(t, claims.MatchesBody(body))
+	assert.False(t, claims.MatchesBody([]byte("other")))
+}
+
+func TestNew_should_not_set_body_hash_without_body(t *testing.T) {
+	t.Parallel()
+
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "", "rule_id", nil, nil)
+
+	assert.Nil(t, rcpt.BodyHash)
+	assert.NotEmpty(t, rcpt.ID)
+	assert.Greater(t, rcpt.ExpiresAt, rcpt.CreatedAt)
+}
+
+func TestVerify_should_return_err_when_signed_with_another_key(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "tool", "rule_id", nil, nil)
+
+	_ = receipt.Sign(rcpt, "issuer", privKey)
+
+	_, err := receipt.Verify(rcpt.Token, otherKey.PublicKey())
+
+	assert.Error(t, err)
+}
+
+func TestVerify_should_return_err_when_token_is_empty(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	_, err := receipt.Verify("", privKey.PublicKey())
+
+	assert.Error(t, err)
+}
+
+func TestSign_should_set_the_receipt_type(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "tool", "rule_id", nil, nil)
+
+	err := receipt.Sign(rcpt, "issuer", privKey)
+	require.NoError(t, err)
+
+	msg, err := jws.Parse([]byte(rcpt.Token))
+	require.NoError(t, err)
+
+	typ, _ := msg.Signatures()[0].ProtectedHeaders().Type()
+	kid, _ := msg.Signatures()[0].ProtectedHeaders().KeyID()
+
+	assert.Equal(t, receipt.Type, typ)
+	assert.Equal(t, "key_id", kid)
+}
+
+func TestVerify_should_return_the_claims_of_an_expired_receipt(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "tool", "rule_id", nil, nil)
+	rcpt.ExpiresAt = time.Now().Add(-time.Second).Unix()
+
+	_ = receipt.Sign(rcpt, "issuer", privKey)
+
+	claims, err := receipt.Verify(rcpt.Token, privKey.PublicKey())
+
+	assert.NoError(t, err)
+	assert.Equal(t, rcpt.ID, claims.ID)
+	assert.True(t, claims.IsExpired())
+}
+
+func TestVerifyFresh_should_return_err_when_the_receipt_has_expired(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "tool", "rule_id", nil, nil)
+	rcpt.ExpiresAt = time.Now().Add(-time.Second).Unix()
+
+	_ = receipt.Sign(rcpt, "issuer", privKey)
+
+	_, err := receipt.VerifyFresh(rcpt.Token, privKey.PublicKey())
+
+	assert.ErrorIs(t, err, receipt.ErrExpiredReceipt)
+}
+
+func TestVerifyFresh_should_return_claims(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	rcpt := receipt.New("caller_id", "did:caller", "callee_id", "did:callee", "tool", "rule_id", nil, nil)
+
+	_ = receipt.Sign(rcpt, "issuer", privKey)
+
+	claims, err := receipt.VerifyFresh(rcpt.Token, privKey.PublicKey())
+
+	assert.NoError(t, err)
+	assert.False(t, claims.IsExpired())
+}
+
+func TestVerify_should_return_err_when_the_token_is_not_a_receipt(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	payload, _ := json.Marshal(&receipt.Claims{
+		ID:        "session_id",
+		Issuer:    "issuer",
+		Subject:   "did:caller",
+		Audience:  "did:callee",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	})
+
+	// Signed with the same key but without the receipt type, as the other tokens
+	token, err := joseutil.Sign(privKey, payload)
+	require.NoError(t, err)
+
+	_, err = receipt.Verify(string(token), privKey.PublicKey())
+
+	assert.ErrorIs(t, err, receipt.ErrInvalidReceipt)
+}
@@ -8,13 +8,33 @@ import (
 	"errors"
 
 	types "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 )
 
 type Repository interface {
 	CreateSession(ctx context.Context, session *types.Session) (*types.Session, error)
 	GetSessionByAuthCode(ctx context.Context, code string) (*types.Session, error)
 	GetSessionByAccessToken(ctx context.Context, accessToken string) (*types.Session, error)
+
+	// Updates the access token, the expiration, the transaction and the DPoP key of the session
 	UpdateSession(ctx context.Context, session *types.Session) error
+
+	// Returns the sessions of the tenant matching the filter, the most recent first
+	ListSessions(
+		ctx context.Context,
+		paginationFilter pagination.PaginationFilter,
+		filter *types.SessionFilter,
+	) (*pagination.Pageable[types.Session], error)
+
+	// Returns a session of the tenant
+	GetSessionByID(ctx context.Context, id string) (*types.Session, error)
+
+	// Returns the active sessions owned by the app
+	GetActiveSessionsForApp(ctx context.Context, appID string) ([]*types.Session, error)
+
+	// Expires the sessions that are still active
+	ExpireSessions(ctx context.Context, sessionIDs []string) error
+
 	CreateDeviceOTP(ctx context.Context, otp *types.SessionDeviceOTP) error
 	GetDeviceOTP(ctx context.Context, id string) (*types.SessionDeviceOTP, error)
 	UpdateDeviceOTP(ctx context.Context, otp *types.SessionDeviceOTP) error
@@ -22,9 +42,41 @@ type Repository interface {
 		ctx context.Context,
 		deviceID, sessionID, value string,
 	) (*types.SessionDeviceOTP, error)
+	CreateReceipt(ctx context.Context, receipt *types.Receipt) error
+	GetReceiptByID(ctx context.Context, id string) (*types.Receipt, error)
+	DenySession(ctx context.Context, sessionID string, expiresAt int64) error
+
+	// Returns the expiration time of the denied sessions that are not expired yet,
+	// indexed by session ID
+	GetDeniedSessions(ctx context.Context) (map[string]int64, error)
+
+	// Stores the ID of a DPoP proof signed with the key of the thumbprint.
+	// Returns ErrDPoPProofAlreadyStored when the proof has already been used.
+	StoreDPoPProof(ctx context.Context, thumbprint, id string, expiresAt int64) error
+
+	// Deletes at most limit exchanged sessions expired before the given time,
+	// along with their device OTPs. Returns the number of deleted sessions
+	DeleteExpiredSessions(ctx context.Context, expiredBefore int64, limit int) (int64, error)
+
+	// Deletes at most limit sessions whose authorization code was never exchanged
+	// and expired before the given time, along with their device OTPs.
+	// Returns the number of deleted sessions
+	DeleteExpiredAuthorizationCodes(ctx context.Context, expiredBefore int64, limit int) (int64, error)
+
+	// Deletes at most limit device OTPs expired before the given time
+	DeleteExpiredDeviceOTPs(ctx context.Context, expiredBefore int64, limit int) (int64, error)
+
+	// Deletes at most limit denied sessions expired before the given time
+	DeleteExpiredDeniedSessions(ctx context.Context, expiredBefore int64, limit int) (int64, error)
+
+	// Deletes at most limit DPoP proof IDs expired before the given time
+	DeleteExpiredDPoPProofs(ctx context.Context, expiredBefore int64, limit int) (int64, error)
 }
 
 var (
 	ErrDeviceOTPNotFound = errors.New("device OTP not found")
 	ErrSessionNotFound   = errors.New("session not found")
+	ErrReceiptNotFound   = errors.New("receipt not found")
+
+	ErrDPoPProofAlreadyStored = errors.New("DPoP proof already stored")
 )
@@ -0,0 +1,224 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	types "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+const (
+	// Duration is the maximum lifetime of a session token, the same as the
+	// sessions stored in the database. The token expires earlier when the
+	// wrapped proof does.
+	Duration = 5 * time.Minute
+
+	// tokenUse distinguishes session tokens from the other
+	// tokens signed with the issuer key.
+	tokenUse = "session"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrExpiredToken = errors.New("the session token has expired")
+)
+
+// Confirmation binds the token to a key, as defined by RFC 7800.
+type Confirmation struct {
+	// The JWK SHA-256 thumbprint of the DPoP key.
+	JWKThumbprint string `json:"jkt"`
+}
+
+// Claims are the claims of a self-contained session token.
+type Claims struct {
+	// The ID of the session.
+	ID string `json:"jti"`
+
+	// The issuer of the token.
+	Issuer string `json:"iss"`
+
+	// The ID of the app owning the session.
+	Subject string `json:"sub"`
+
+	// The ID of the callee app the session is restricted to, if any.
+	Audience *string `json:"aud,omitempty"`
+
+	// The tool the session is restricted to, if any.
+	ToolName *string `json:"tool,omitempty"`
+
+	// The ID of the user on behalf of whom the session was created, if any.
+	UserID *string `json:"user_id,omitempty"`
+
+	// The time at which the token was issued.
+	IssuedAt int64 `json:"iat"`
+
+	// The time at which the token expires.
+	ExpiresAt int64 `json:"exp"`
+
+	// The intended use of the token, always "session".
+	TokenUse string `json:"token_use"`
+
+	// The access token issued by the IdP (or self-issued) for the owner app.
+	Proof string `json:"prf"`
+
+	// The DPoP key the session is bound to, if any.
+	Confirmation *Confirmation `json:"cnf,omitempty"`
+}
+
+// Issue signs a session token for the session, wrapping the access token
+// issued by the IdP as a proof.
+func Issue(
+	issuer string,
+	session *types.Session,
+	proof string,
+	privateKey *jwk.Jwk,
+) (string, *Claims, error) {
+	if session == nil || session.ID == "" {
+		return "", nil, errors.New("invalid session")
+	}
+
+	if privateKey == nil {
+		return "", nil, errors.New("invalid privateKey argument")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(Duration)
+
+	if proofExp, ok := proofExpiration(proof); ok && proofExp.Before(expiresAt) {
+		expiresAt = proofExp
+	}
+
+	claims := &Claims{
+		ID:        session.ID,
+		Issuer:    issuer,
+		Subject:   session.OwnerAppID,
+		Audience:  session.AppID,
+		ToolName:  session.ToolName,
+		UserID:    session.UserID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		TokenUse:  tokenUse,
+		Proof:     proof,
+	}
+
+	if session.DPoPThumbprint != nil {
+		claims.Confirmation = &Confirmation{JWKThumbprint: *session.DPoPThumbprint}
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to marshal session token claims: %w", err)
+	}
+
+	signed, err := joseutil.Sign(privateKey, payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to sign the session token: %w", err)
+	}
+
+	return string(signed), claims, nil
+}
+
+// KeyID returns the ID of the key that signed the token,
+// without verifying the signature.
+func KeyID(token string) (string, error) {
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return "", ErrInvalidToken
+	}
+
+	kid, ok := msg.Signatures()[0].ProtectedHeaders().KeyID()
+	if !ok || kid == "" {
+		return "", ErrInvalidToken
+	}
+
+	return kid, nil
+}
+
+// Expiration returns the time at which the token expires,
+// without verifying the signature.
+func Expiration(token string) (time.Time, error) {
+	msg, err := jws.Parse([]byte(token))
+	if err != nil {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	var claims Claims
+
+	err = json.Unmarshal(msg.Payload(), &claims)
+	if err != nil || claims.TokenUse != tokenUse || claims.ExpiresAt == 0 {
+		return time.Time{}, ErrInvalidToken
+	}
+
+	return time.Unix(claims.ExpiresAt, 0), nil
+}
+
+// Verify verifies the signature, the issuer and the claims of a session token.
+func Verify(token, issuer string, publicKey *jwk.Jwk) (*Claims, error) {
+	if token == "" || publicKey == nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := joseutil.Verify(publicKey, []byte(token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	var claims Claims
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.TokenUse != tokenUse ||
+		claims.ID == "" ||
+		claims.Subject == "" ||
+		claims.Issuer != issuer {
+		return nil, ErrInvalidToken
+	}
+
+	if claims.ExpiresAt <= time.Now().Unix() {
+		return nil, ErrExpiredToken
+	}
+
+	return &claims, nil
+}
+
+// Session rebuilds the session carried by the token.
+func (c *Claims) Session(token string) *types.Session {
+	session := &types.Session{
+		ID:          c.ID,
+		OwnerAppID:  c.Subject,
+		AppID:       c.Audience,
+		ToolName:    c.ToolName,
+		UserID:      c.UserID,
+		AccessToken: ptrutil.Ptr(token),
+		CreatedAt:   c.IssuedAt,
+		ExpiresAt:   ptrutil.Ptr(c.ExpiresAt),
+	}
+
+	if c.Confirmation != nil {
+		session.DPoPThumbprint = &c.Confirmation.JWKThumbprint
+	}
+
+	return session
+}
+
+func proofExpiration(proof string) (time.Time, bool) {
+	parsed, err := jwt.ParseInsecure([]byte(proof))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return parsed.Expiration()
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sessiontoken_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/auth/sessiontoken"
+	"github.com/agntcy/identity-service/internal/core/auth/txntoken"
+	types "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueAndVerify_should_carry_the_session(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	session := &types.Session{
+		ID:             uuid.NewString(),
+		OwnerAppID:     uuid.NewString(),
+		AppID:          ptrutil.Ptr(uuid.NewString()),
+		ToolName:       ptrutil.Ptr("tool"),
+		UserID:         ptrutil.Ptr("user"),
+		DPoPThumbprint: ptrutil.Ptr("jkt"),
+	}
+
+	token, issued, err := sessiontoken.Issue("issuer", session, "idp_token", privKey)
+	assert.NoError(t, err)
+
+	kid, err := sessiontoken.KeyID(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "key_id", kid)
+
+	expiresAt, err := sessiontoken.Expiration(token)
+	assert.NoError(t, err)
+	assert.Equal(t, issued.ExpiresAt, expiresAt.Unix())
+
+	claims, err := sessiontoken.Verify(token, "issuer", privKey.PublicKey())
+	assert.NoError(t, err)
+	assert.Equal(t, issued, claims)
+	assert.Equal(t, "idp_token", claims.Proof)
+	assert.LessOrEqual(t, claims.ExpiresAt, time.Now().Add(sessiontoken.Duration).Unix())
+
+	actual := claims.Session(token)
+	assert.Equal(t, session.ID, actual.ID)
+	assert.Equal(t, session.OwnerAppID, actual.OwnerAppID)
+	assert.Equal(t, session.AppID, actual.AppID)
+	assert.Equal(t, session.ToolName, actual.ToolName)
+	assert.Equal(t, session.UserID, actual.UserID)
+	assert.Equal(t, session.DPoPThumbprint, actual.DPoPThumbprint)
+	assert.Equal(t, &token, actual.AccessToken)
+	assert.False(t, actual.HasExpired())
+}
+
+func TestVerify_should_return_err_for_other_tokens(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+	session := &types.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+	sessionToken, _, _ := sessiontoken.Issue("issuer", session, "idp_token", privKey)
+	txnToken, _, _ := txntoken.Issue("issuer", "app", "did:app", nil, "purpose", privKey)
+
+	_, err := sessiontoken.Verify(sessionToken, "issuer", otherKey.PublicKey())
+	assert.ErrorIs(t, err, sessiontoken.ErrInvalidToken)
+
+	_, err = sessiontoken.Verify(txnToken, "issuer", privKey.PublicKey())
+	assert.ErrorIs(t, err, sessiontoken.ErrInvalidToken)
+
+	_, err = sessiontoken.Verify(sessionToken, "other_issuer", privKey.PublicKey())
+	assert.ErrorIs(t, err, sessiontoken.ErrInvalidToken)
+
+	_, err = sessiontoken.KeyID("not_a_token")
+	assert.ErrorIs(t, err, sessiontoken.ErrInvalidToken)
+
+	_, err = sessiontoken.Expiration(txnToken)
+	assert.ErrorIs(t, err, sessiontoken.ErrInvalidToken)
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package txntoken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/google/uuid"
+)
+
+// Duration is the lifetime of a transaction token.
+// It bounds the duration of a multi-agent workflow.
+const Duration = 15 * time.Minute
+
+var (
+	ErrInvalidToken = errors.New("invalid transaction token")
+	ErrExpiredToken = errors.New("the transaction token has expired")
+)
+
+// Context is the immutable context of the transaction,
+// set when the transaction is started.
+type Context struct {
+	// The ID of the app that started the transaction.
+	InitiatingAppID string `json:"initiating_app_id"`
+
+	// The ID of the user on behalf of whom the transaction was started, if any.
+	InitiatingUserID *string `json:"initiating_user_id,omitempty"`
+}
+
+// Claims are the claims of a transaction token, following
+// the OAuth Transaction Tokens draft.
+type Claims struct {
+	// The ID of the token.
+	ID string `json:"jti"`
+
+	// The issuer of the token.
+	Issuer string `json:"iss"`
+
+	// The trust domain in which the token is valid.
+	Audience string `json:"aud"`
+
+	// The time at which the token was issued.
+	IssuedAt int64 `json:"iat"`
+
+	// The time at which the token expires.
+	ExpiresAt int64 `json:"exp"`
+
+	// The ID of the transaction, kept unchanged across the workflow.
+	TransactionID string `json:"txn"`
+
+	// The initiating user if any, otherwise the DID of the initiating app.
+	Subject string `json:"sub"`
+
+	// The purpose of the transaction.
+	Purpose string `json:"scope"`
+
+	// The context of the transaction.
+	Context *Context `json:"tctx"`
+}
+
+// Issue starts a new transaction and returns its signed token.
+// The issuer is used as both the issuer and the trust domain of the token.
+func Issue(
+	issuer string,
+	initiatingAppID, initiatingAppDID string,
+	initiatingUserID *string,
+	purpose string,
+	privateKey *jwk.Jwk,
+) (string, *Claims, error) {
+	if privateKey == nil {
+		return "", nil, errors.New("invalid privateKey argument")
+	}
+
+	now := time.Now()
+
+	claims := &Claims{
+		ID:            uuid.NewString(),
+		Issuer:        issuer,
+		Audience:      issuer,
+		IssuedAt:      now.Unix(),
+		ExpiresAt:     now.Add(Duration).Unix(),
+		TransactionID: uuid.NewString(),
+		Subject:       initiatingAppDID,
+		Purpose:       purpose,
+		Context: &Context{
+			InitiatingAppID:  initiatingAppID,
+			InitiatingUserID: initiatingUserID,
+		},
+	}
+
+	if initiatingUserID != nil && *initiatingUserID != "" {
+		claims.Subject = *initiatingUserID
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to marshal transaction token claims: %w", err)
+	}
+
+	signed, err := joseutil.Sign(privateKey, payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to sign the transaction token: %w", err)
+	}
+
+	return string(signed), claims, nil
+}
+
+// Verify verifies the signature, the issuer, the trust domain and
+// the expiration of a transaction token and returns its claims.
+func Verify(token, issuer string, publicKey *jwk.Jwk) (*Claims, error) {
+	if token == "" || publicKey == nil {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := joseutil.Verify(publicKey, []byte(token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	var claims Claims
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.TransactionID == "" ||
+		claims.Context == nil ||
+		claims.Issuer != issuer ||
+		claims.Audience != issuer {
+		return nil, ErrInvalidToken
+	}
+
+	if claims.ExpiresAt <= time.Now().Unix() {
+		return nil, ErrExpiredToken
+	}
+
+	return &claims, nil
+}
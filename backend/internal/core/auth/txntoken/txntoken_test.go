@@ -0,0 +1,90 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package txntoken_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/auth/txntoken"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueAndVerify_should_return_claims(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	token, issued, err := txntoken.Issue(
+		"issuer",
+		"app_id",
+		"did:app",
+		ptrutil.Ptr("user_id"),
+		"book a trip",
+		privKey,
+	)
+	assert.NoError(t, err)
+
+	claims, err := txntoken.Verify(token, "issuer", privKey.PublicKey())
+
+	assert.NoError(t, err)
+	assert.Equal(t, issued, claims)
+	assert.NotEmpty(t, claims.TransactionID)
+	assert.Equal(t, "user_id", claims.Subject)
+	assert.Equal(t, "book a trip", claims.Purpose)
+	assert.Equal(t, "app_id", claims.Context.InitiatingAppID)
+}
+
+func TestIssue_should_use_app_as_subject_without_user(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	_, claims, err := txntoken.Issue("issuer", "app_id", "did:app", nil, "purpose", privKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "did:app", claims.Subject)
+}
+
+func TestVerify_should_return_err_for_invalid_tokens(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+	token, _, _ := txntoken.Issue("issuer", "app_id", "did:app", nil, "purpose", privKey)
+
+	testCases := map[string]*struct {
+		token  string
+		issuer string
+		key    *jwk.Jwk
+	}{
+		"empty token": {
+			token:  "",
+			issuer: "issuer",
+			key:    privKey.PublicKey(),
+		},
+		"other issuer": {
+			token:  token,
+			issuer: "other_issuer",
+			key:    privKey.PublicKey(),
+		},
+		"other key": {
+			token:  token,
+			issuer: "issuer",
+			key:    otherKey.PublicKey(),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := txntoken.Verify(tc.token, tc.issuer, tc.key)
+
+			assert.ErrorIs(t, err, txntoken.ErrInvalidToken)
+		})
+	}
+}
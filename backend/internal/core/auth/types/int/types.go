@@ -6,6 +6,7 @@ package types
 import (
 	"time"
 
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
 	"github.com/agntcy/identity-service/internal/pkg/strutil"
 	"github.com/google/uuid"
@@ -39,6 +40,18 @@ type Session struct {
 
 	// The expiration time of the Session.
 	ExpiresAt *int64 `json:"expires_at,omitempty" protobuf:"bytes,9,opt,name=expires_at"`
+
+	// The ID of the last transaction the Session took part in.
+	TransactionID *string `json:"transaction_id,omitempty" protobuf:"bytes,10,opt,name=transaction_id"`
+
+	// The JWK SHA-256 thumbprint of the DPoP key the Session is bound to, if any.
+	DPoPThumbprint *string `json:"dpop_jkt,omitempty" protobuf:"bytes,11,opt,name=dpop_jkt"`
+
+	// The PKCE code challenge the authorization code is bound to, if any.
+	CodeChallenge *string `json:"code_challenge,omitempty"`
+
+	// The method of the PKCE code challenge (S256 or plain).
+	CodeChallengeMethod *string `json:"code_challenge_method,omitempty"`
 }
 
 // If the session has a toolName associated with then this methods
@@ -53,6 +66,35 @@ func (s *Session) ValidateApp(id string) bool {
 	return s.AppID == nil || *s.AppID == id
 }
 
+// TokenType returns the type of the access token of the Session,
+// DPoP when the Session is bound to a DPoP key.
+func (s *Session) TokenType() string {
+	if s.DPoPThumbprint != nil {
+		return TokenTypeDPoP
+	}
+
+	return TokenTypeBearer
+}
+
+// Redact replaces the tokens of the Session with their last characters,
+// so that the Session can be returned to the administrators of the tenant.
+func (s *Session) Redact() {
+	s.AccessToken = redact(s.AccessToken)
+	s.AuthorizationCode = redact(s.AuthorizationCode)
+}
+
+func redact(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	if len(*value) <= 2*redactedSuffixLength {
+		return ptrutil.Ptr(redactedPrefix)
+	}
+
+	return ptrutil.Ptr(redactedPrefix + (*value)[len(*value)-redactedSuffixLength:])
+}
+
 func (s *Session) HasExpired() bool {
 	return s.ExpiresAt != nil && *s.ExpiresAt <= time.Now().Unix()
 }
@@ -110,6 +152,34 @@ func (o *SessionDeviceOTP) IsApproved() bool {
 	return o.Approved != nil && *o.Approved
 }
 
+const (
+	TokenTypeBearer = "Bearer"
+	TokenTypeDPoP   = "DPoP"
+)
+
+const (
+	redactedPrefix       = "****"
+	redactedSuffixLength = 4
+)
+
+// The criteria of the sessions to list. Only the set fields are applied.
+type SessionFilter struct {
+	// The ID of the application owning the sessions.
+	OwnerAppID *string
+
+	// The ID of the application the sessions are restricted to.
+	AppID *string
+
+	// The tool the sessions are restricted to.
+	ToolName *string
+
+	// The ID of the user on behalf of whom the sessions were created.
+	UserID *string
+
+	// Only the active (true) or the expired (false) sessions.
+	Active *bool
+}
+
 const (
 	sessionDeviceOTPLength      = 128
 	SessionDeviceOTPDuration    = 60 * time.Second
@@ -127,3 +197,114 @@ func NewSessionDeviceOTP(sessionID, deviceID string) *SessionDeviceOTP {
 		Approved:  nil,
 	}
 }
+
+// The identity of the caller of a request authorized through the external
+// authorization (ext-authz) endpoints, returned to the protected app.
+type CallerIdentity struct {
+	// The ID of the calling application.
+	AppID string `json:"app_id,omitempty"`
+
+	// The name of the calling application.
+	AppName *string `json:"app_name,omitempty"`
+
+	// The type of the calling application.
+	AppType apptypes.AppType `json:"app_type,omitempty"`
+
+	// The resolver metadata ID (DID) of the calling application.
+	ResolverMetadataID string `json:"resolver_metadata_id,omitempty"`
+
+	// The ID of the current badge of the calling application.
+	BadgeID *string `json:"badge_id,omitempty"`
+
+	// The ID of the end user on behalf of whom the call is made.
+	UserID *string `json:"user_id,omitempty"`
+
+	// The ID of the session the access token belongs to.
+	SessionID string `json:"session_id,omitempty"`
+
+	// The ID of the policy rule that granted access, if any.
+	RuleID *string `json:"rule_id,omitempty"`
+
+	// The signed receipt of the authorization, when requested.
+	Receipt *Receipt `json:"receipt,omitempty"`
+
+	// The ID of the transaction the call is part of, if any.
+	TransactionID *string `json:"transaction_id,omitempty"`
+
+	// The transaction token to propagate to the downstream calls, if any.
+	TransactionToken *string `json:"transaction_token,omitempty"`
+}
+
+// A signed proof that a caller was authorized to invoke
+// a tool of a callee at a specific time.
+type Receipt struct {
+	// A unique identifier for the Receipt.
+	ID string `json:"id,omitempty"`
+
+	// The ID of the calling application.
+	CallerAppID string `json:"caller_app_id,omitempty"`
+
+	// The DID of the calling application.
+	CallerDID string `json:"caller_did,omitempty"`
+
+	// The ID of the called application.
+	CalleeAppID string `json:"callee_app_id,omitempty"`
+
+	// The DID of the called application.
+	CalleeDID string `json:"callee_did,omitempty"`
+
+	// The tool that the caller was authorized to invoke.
+	ToolName string `json:"tool_name,omitempty"`
+
+	// The ID of the policy rule that granted access.
+	RuleID string `json:"rule_id,omitempty"`
+
+	// The ID of the device OTP used to approve the call, if any.
+	ApprovalID *string `json:"approval_id,omitempty"`
+
+	// The base64url encoded SHA-256 hash of the request body, if any.
+	BodyHash *string `json:"body_hash,omitempty"`
+
+	// The signed JWT of the Receipt.
+	Token string `json:"token,omitempty"`
+
+	// The creation time of the Receipt.
+	CreatedAt int64 `json:"created_at,omitempty"`
+
+	// The expiration time of the Receipt.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+// The state of an access token, as defined by RFC 7662.
+// Only Active is set for inactive tokens.
+type TokenIntrospection struct {
+	// Whether the access token is active.
+	Active bool `json:"active"`
+
+	// The ID of the application the access token was issued to.
+	ClientID *string `json:"client_id,omitempty"`
+
+	// The tool the access token is restricted to, if any.
+	Scope *string `json:"scope,omitempty"`
+
+	// The type of the access token, either Bearer or DPoP.
+	TokenType *string `json:"token_type,omitempty"`
+
+	// The expiration time of the access token.
+	ExpiresAt *int64 `json:"exp,omitempty"`
+
+	// The issuance time of the access token.
+	IssuedAt *int64 `json:"iat,omitempty"`
+
+	// The ID of the user on behalf of whom the access token was issued, if any.
+	Subject *string `json:"sub,omitempty"`
+
+	// The ID of the application the access token is restricted to, if any.
+	Audience *string `json:"aud,omitempty"`
+
+	// The ID of the session of the access token.
+	SessionID *string `json:"jti,omitempty"`
+
+	// The JWK SHA-256 thumbprint of the DPoP key the access token is bound to, if any.
+	DPoPThumbprint *string `json:"dpop_jkt,omitempty"`
+}
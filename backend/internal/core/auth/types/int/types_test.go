@@ -249,3 +249,38 @@ func TestSessionDeviceOTP_IsApproved(t *testing.T) {
 		})
 	}
 }
+
+func TestSession_Redact(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		value    *string
+		expected *string
+	}{
+		"nil value should stay nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"short value should be fully redacted": {
+			value:    ptrutil.Ptr("12345678"),
+			expected: ptrutil.Ptr("****"),
+		},
+		"long value should keep its last characters": {
+			value:    ptrutil.Ptr("123456789"),
+			expected: ptrutil.Ptr("****6789"),
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			session := &types.Session{AccessToken: tc.value, AuthorizationCode: tc.value}
+
+			session.Redact()
+
+			assert.Equal(t, tc.expected, session.AccessToken)
+			assert.Equal(t, tc.expected, session.AuthorizationCode)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AgentCard represents the self-describing manifest published by an A2A agent
+// at its well-known URL. Only the fields used by the Identity Service are mapped.
+type AgentCard struct {
+	// Name of the agent.
+	Name string `json:"name"`
+
+	// Description of the agent.
+	Description string `json:"description,omitempty"`
+
+	// Url of the deployed agent.
+	URL string `json:"url,omitempty"`
+
+	// Version of the agent.
+	Version string `json:"version,omitempty"`
+
+	// The skills the agent can perform.
+	Skills []*AgentSkill `json:"skills,omitempty"`
+}
+
+// AgentSkill represents a distinct capability advertised by an A2A agent.
+type AgentSkill struct {
+	// Unique identifier of the skill within the agent.
+	ID string `json:"id"`
+
+	// Name of the skill.
+	Name string `json:"name"`
+
+	// Description of the skill.
+	Description string `json:"description,omitempty"`
+
+	// Keywords describing the skill.
+	Tags []string `json:"tags,omitempty"`
+
+	// Example prompts or scenarios the skill can handle.
+	Examples []string `json:"examples,omitempty"`
+
+	// Media types the skill accepts, overriding the agent defaults.
+	InputModes []string `json:"inputModes,omitempty"`
+
+	// Media types the skill produces, overriding the agent defaults.
+	OutputModes []string `json:"outputModes,omitempty"`
+}
+
+// ParseAgentCard decodes a JSON agent card into its typed model.
+func ParseAgentCard(agentCard string) (*AgentCard, error) {
+	var card AgentCard
+
+	err := json.Unmarshal([]byte(agentCard), &card)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal A2A agent card: %w", err)
+	}
+
+	return &card, nil
+}
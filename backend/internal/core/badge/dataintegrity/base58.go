@@ -0,0 +1,102 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+import (
+	"errors"
+	"strings"
+)
+
+// The multibase prefix of the base58-btc encoding
+const base58BtcPrefix = 'z'
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var errInvalidMultibase = errors.New("invalid base58-btc multibase value")
+
+func encodeMultibase(data []byte) string {
+	return string(base58BtcPrefix) + encodeBase58(data)
+}
+
+func decodeMultibase(value string) ([]byte, error) {
+	if value == "" || value[0] != base58BtcPrefix {
+		return nil, errInvalidMultibase
+	}
+
+	return decodeBase58(value[1:])
+}
+
+func encodeBase58(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// log(256) / log(58) rounded up
+	digits := make([]byte, 0, len(data)*138/100+1)
+
+	for _, b := range data[zeros:] {
+		carry := int(b)
+
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+
+	var sb strings.Builder
+
+	sb.Grow(zeros + len(digits))
+
+	for range zeros {
+		sb.WriteByte(base58Alphabet[0])
+	}
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		sb.WriteByte(base58Alphabet[digits[i]])
+	}
+
+	return sb.String()
+}
+
+func decodeBase58(value string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(value) && value[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	// log(58) / log(256) rounded up
+	decoded := make([]byte, 0, len(value)*733/1000+1)
+
+	for _, r := range value[zeros:] {
+		carry := strings.IndexRune(base58Alphabet, r)
+		if carry < 0 {
+			return nil, errInvalidMultibase
+		}
+
+		for i := range decoded {
+			carry += int(decoded[i]) * 58
+			decoded[i] = byte(carry)
+			carry >>= 8
+		}
+
+		for carry > 0 {
+			decoded = append(decoded, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	ret := make([]byte, zeros+len(decoded))
+	for i, b := range decoded {
+		ret[len(ret)-1-i] = b
+	}
+
+	return ret, nil
+}
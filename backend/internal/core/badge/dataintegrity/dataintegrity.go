@@ -0,0 +1,263 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dataintegrity secures the badges with the W3C Data Integrity [EdDSA] and [ECDSA]
+// cryptosuites using the JSON Canonicalization Scheme
+//
+// [EdDSA]: https://www.w3.org/TR/vc-di-eddsa/#eddsa-jcs-2022
+// [ECDSA]: https://www.w3.org/TR/vc-di-ecdsa/#ecdsa-jcs-2019
+package dataintegrity
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/jcs"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+const (
+	EdDSAJCS2022 = "eddsa-jcs-2022"
+	ECDSAJCS2019 = "ecdsa-jcs-2019"
+)
+
+const assertionMethod = "assertionMethod"
+
+// The size of the r and s values of the P-256 signatures
+const p256ScalarSize = 32
+
+var (
+	ErrUnsupportedCryptosuite = errors.New("unsupported cryptosuite")
+	ErrInvalidProof           = errors.New("invalid data integrity proof")
+)
+
+// Sign creates a Data Integrity proof of the unsecured credential with a key derived
+// from the issuer key. The verification method is the key in the controlled identifier
+// document of the issuer, at the controller URL.
+func Sign(
+	unsecured []byte,
+	cryptosuite string,
+	privateKey *jwk.Jwk,
+	controller string,
+	created time.Time,
+) (*types.Proof, error) {
+	if privateKey == nil {
+		return nil, errors.New("invalid privateKey argument")
+	}
+
+	if controller == "" {
+		return nil, errors.New("invalid controller argument")
+	}
+
+	var (
+		ed25519Key ed25519.PrivateKey
+		p256Key    *ecdsa.PrivateKey
+		err        error
+	)
+
+	proof := &types.Proof{
+		Type:         types.DataIntegrityProof,
+		Cryptosuite:  cryptosuite,
+		Created:      created.UTC().Format(time.RFC3339),
+		ProofPurpose: assertionMethod,
+	}
+
+	switch cryptosuite {
+	case EdDSAJCS2022:
+		ed25519Key, err = deriveEd25519Key(privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		proof.VerificationMethod = ed25519VerificationMethod(controller, ed25519Key.Public().(ed25519.PublicKey))
+	case ECDSAJCS2019:
+		p256Key, err = deriveP256Key(privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		proof.VerificationMethod = p256VerificationMethod(controller, &p256Key.PublicKey)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCryptosuite, cryptosuite)
+	}
+
+	document, err := jcs.Transform(unsecured)
+	if err != nil {
+		return nil, fmt.Errorf("unable to canonicalize the credential: %w", err)
+	}
+
+	config, err := canonicalProofConfig(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	data := hashData(config, document)
+
+	var signature []byte
+
+	if ed25519Key != nil {
+		signature = ed25519.Sign(ed25519Key, data)
+	} else {
+		digest := sha256.Sum256(data)
+
+		r, s, err := ecdsa.Sign(rand.Reader, p256Key, digest[:])
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign the credential: %w", err)
+		}
+
+		signature = make([]byte, 2*p256ScalarSize)
+		r.FillBytes(signature[:p256ScalarSize])
+		s.FillBytes(signature[p256ScalarSize:])
+	}
+
+	proof.ProofValue = encodeMultibase(signature)
+
+	return proof, nil
+}
+
+// Verify checks the Data Integrity proof embedded in the credential, the public key
+// is read from the Multikey of the verification method. The caller makes sure the
+// verification method is published by the issuer.
+func Verify(credential []byte) (*types.Proof, error) {
+	var document map[string]any
+
+	err := json.Unmarshal(credential, &document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	rawProof, ok := document["proof"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: the credential has no proof", ErrInvalidProof)
+	}
+
+	delete(document, "proof")
+
+	proof, err := toProof(rawProof)
+	if err != nil {
+		return nil, err
+	}
+
+	if proof.Type != types.DataIntegrityProof || proof.ProofPurpose != assertionMethod {
+		return nil, fmt.Errorf("%w: unexpected proof type or purpose", ErrInvalidProof)
+	}
+
+	signature, err := decodeMultibase(proof.ProofValue)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	delete(rawProof, "proofValue")
+
+	config, err := jcs.Marshal(rawProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	unsecured, err := jcs.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	data := hashData(config, unsecured)
+
+	switch proof.Cryptosuite {
+	case EdDSAJCS2022:
+		err = verifyEd25519(proof.VerificationMethod, data, signature)
+	case ECDSAJCS2019:
+		err = verifyP256(proof.VerificationMethod, data, signature)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCryptosuite, proof.Cryptosuite)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+func verifyEd25519(verificationMethod string, data, signature []byte) error {
+	publicKey, err := parseEd25519VerificationMethod(verificationMethod)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	if !ed25519.Verify(publicKey, data, signature) {
+		return fmt.Errorf("%w: the signature does not match", ErrInvalidProof)
+	}
+
+	return nil
+}
+
+func verifyP256(verificationMethod string, data, signature []byte) error {
+	publicKey, err := parseP256VerificationMethod(verificationMethod)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	if len(signature) != 2*p256ScalarSize {
+		return fmt.Errorf("%w: unexpected signature size", ErrInvalidProof)
+	}
+
+	digest := sha256.Sum256(data)
+	r := new(big.Int).SetBytes(signature[:p256ScalarSize])
+	s := new(big.Int).SetBytes(signature[p256ScalarSize:])
+
+	if !ecdsa.Verify(publicKey, digest[:], r, s) {
+		return fmt.Errorf("%w: the signature does not match", ErrInvalidProof)
+	}
+
+	return nil
+}
+
+// canonicalProofConfig returns the canonical proof without its value
+func canonicalProofConfig(proof *types.Proof) ([]byte, error) {
+	data, err := json.Marshal(proof)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the proof: %w", err)
+	}
+
+	var config map[string]any
+
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the proof: %w", err)
+	}
+
+	delete(config, "proofValue")
+
+	return jcs.Marshal(config)
+}
+
+func toProof(rawProof map[string]any) (*types.Proof, error) {
+	data, err := json.Marshal(rawProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	var proof types.Proof
+
+	err = json.Unmarshal(data, &proof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	return &proof, nil
+}
+
+// hashData concatenates the hashes of the proof configuration and of the credential
+func hashData(config, document []byte) []byte {
+	configHash := sha256.Sum256(config)
+	documentHash := sha256.Sum256(document)
+
+	return append(configHash[:], documentHash[:]...)
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var cryptosuites = []string{dataintegrity.EdDSAJCS2022, dataintegrity.ECDSAJCS2019}
+
+const controller = "https://api/v1alpha1/badges/issuers/issuer"
+
+func newCredential() *types.VerifiableCredential {
+	return &types.VerifiableCredential{
+		Context:           []string{"https://www.w3.org/ns/credentials/v2"},
+		ID:                "badge_id",
+		Type:              []string{types.BADGE_TYPE_AGENT_BADGE.String()},
+		Issuer:            "issuer",
+		IssuanceDate:      "2025-01-01T00:00:00Z",
+		CredentialSubject: &types.BadgeClaims{ID: "subject", Badge: `{"name":"agent","url":"https://agent?a=1&b=2"}`},
+	}
+}
+
+// secure returns the credential with the proof embedded, as shared with the verifiers
+func secure(t *testing.T, cryptosuite string, privKey *jwk.Jwk) (*types.VerifiableCredential, []byte) {
+	t.Helper()
+
+	vc := newCredential()
+
+	unsecured, err := json.Marshal(vc)
+	require.NoError(t, err)
+
+	vc.Proof, err = dataintegrity.Sign(unsecured, cryptosuite, privKey, controller, time.Now())
+	require.NoError(t, err)
+
+	credential, err := json.Marshal(vc)
+	require.NoError(t, err)
+
+	return vc, credential
+}
+
+func TestSign_and_Verify(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	for _, cryptosuite := range cryptosuites {
+		t.Run(cryptosuite, func(t *testing.T) {
+			t.Parallel()
+
+			vc, credential := secure(t, cryptosuite, privKey)
+
+			assert.Equal(t, types.DataIntegrityProof, vc.Proof.Type)
+			assert.Equal(t, cryptosuite, vc.Proof.Cryptosuite)
+			assert.Equal(t, "assertionMethod", vc.Proof.ProofPurpose)
+			assert.True(t, strings.HasPrefix(vc.Proof.VerificationMethod, controller+"#z"))
+			assert.True(t, strings.HasPrefix(vc.Proof.ProofValue, "z"))
+
+			proof, err := dataintegrity.Verify(credential)
+
+			assert.NoError(t, err)
+			assert.Equal(t, vc.Proof, proof)
+		})
+	}
+}
+
+func TestSign_should_derive_a_stable_key_per_issuer_key(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherPrivKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+
+	for _, cryptosuite := range cryptosuites {
+		t.Run(cryptosuite, func(t *testing.T) {
+			t.Parallel()
+
+			first, _ := secure(t, cryptosuite, privKey)
+			second, _ := secure(t, cryptosuite, privKey)
+			other, _ := secure(t, cryptosuite, otherPrivKey)
+
+			assert.Equal(t, first.Proof.VerificationMethod, second.Proof.VerificationMethod)
+			assert.NotEqual(t, first.Proof.VerificationMethod, other.Proof.VerificationMethod)
+		})
+	}
+}
+
+func TestVerify_should_return_err_when_the_credential_is_tampered(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherPrivKey, _ := joseutil.GenerateJWK("RS256", "sig", "other_key_id")
+
+	for _, cryptosuite := range cryptosuites {
+		t.Run(cryptosuite, func(t *testing.T) {
+			t.Parallel()
+
+			vc, _ := secure(t, cryptosuite, privKey)
+			otherVC, _ := secure(t, cryptosuite, otherPrivKey)
+
+			testCases := map[string]func(vc *types.VerifiableCredential){
+				"claims": func(vc *types.VerifiableCredential) {
+					vc.CredentialSubject.Badge = `{"name":"other agent"}`
+				},
+				"proof options": func(vc *types.VerifiableCredential) {
+					vc.Proof.Created = "2020-01-01T00:00:00Z"
+				},
+				"verification method": func(vc *types.VerifiableCredential) {
+					vc.Proof.VerificationMethod = otherVC.Proof.VerificationMethod
+				},
+				"missing proof": func(vc *types.VerifiableCredential) {
+					vc.Proof = nil
+				},
+			}
+
+			for tn, tamper := range testCases {
+				tampered := *vc
+				tampered.CredentialSubject = &types.BadgeClaims{
+					ID:    vc.CredentialSubject.ID,
+					Badge: vc.CredentialSubject.Badge,
+				}
+
+				if vc.Proof != nil {
+					proof := *vc.Proof
+					tampered.Proof = &proof
+				}
+
+				tamper(&tampered)
+
+				credential, err := json.Marshal(tampered)
+				require.NoError(t, err)
+
+				_, err = dataintegrity.Verify(credential)
+
+				assert.ErrorIs(t, err, dataintegrity.ErrInvalidProof, tn)
+			}
+		})
+	}
+}
+
+func TestSign_should_return_err_when_cryptosuite_is_unsupported(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	_, err := dataintegrity.Sign([]byte(`{}`), "bbs-2023", privKey, controller, time.Now())
+
+	assert.ErrorIs(t, err, dataintegrity.ErrUnsupportedCryptosuite)
+}
+
+func TestNewMultikey(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	for _, cryptosuite := range cryptosuites {
+		t.Run(cryptosuite, func(t *testing.T) {
+			t.Parallel()
+
+			vc, _ := secure(t, cryptosuite, privKey)
+
+			method, err := dataintegrity.NewMultikey(vc.Proof.VerificationMethod)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &types.VerificationMethod{
+				ID:                 vc.Proof.VerificationMethod,
+				Type:               types.MultikeyVerificationMethod,
+				Controller:         controller,
+				PublicKeyMultibase: strings.TrimPrefix(vc.Proof.VerificationMethod, controller+"#"),
+			}, method)
+		})
+	}
+}
+
+func TestNewMultikey_should_return_err_when_the_verification_method_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"no controller":   "#z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+		"no public key":   controller,
+		"not a multikey":  controller + "#key-1",
+		"unsupported key": controller + "#zQ3shMUiwgYY24hGs5upF8sbE9WHp6T7RyfWKT7KM6wVik73D",
+	}
+
+	for tn, verificationMethod := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := dataintegrity.NewMultikey(verificationMethod)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSign_should_return_err_when_the_controller_is_missing(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	_, err := dataintegrity.Sign([]byte(`{}`), dataintegrity.EdDSAJCS2022, privKey, "", time.Now())
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package dataintegrity
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/jwk"
+)
+
+// The multicodec prefixes of the public keys, encoded as varints
+var (
+	ed25519Multicodec = []byte{0xed, 0x01}
+	p256Multicodec    = []byte{0x80, 0x24}
+)
+
+// A new scalar is derived when the derived bytes are not a valid P-256 private key
+const maxDerivationAttempts = 16
+
+var errInvalidVerificationMethod = errors.New("unsupported verification method")
+
+// The issuer keys are RSA keys, which the Data Integrity cryptosuites do not support.
+// The signing keys of the cryptosuites are derived from the private issuer key with HKDF,
+// a distinct key per cryptosuite, so that the key store keeps a single key per tenant.
+func deriveSecret(privateKey *jwk.Jwk, cryptosuite string, attempt int) ([]byte, error) {
+	encoded := privateKey.D
+	if encoded == "" {
+		encoded = privateKey.PRIV
+	}
+
+	if encoded == "" {
+		return nil, errors.New("the issuer key has no private part")
+	}
+
+	secret, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the issuer key: %w", err)
+	}
+
+	return hkdf.Key(
+		sha256.New,
+		secret,
+		[]byte(privateKey.KID),
+		fmt.Sprintf("agntcy-badge-%s-%d", cryptosuite, attempt),
+		32, //nolint:mnd // the size of the Ed25519 seeds and P-256 scalars
+	)
+}
+
+func deriveEd25519Key(privateKey *jwk.Jwk) (ed25519.PrivateKey, error) {
+	seed, err := deriveSecret(privateKey, EdDSAJCS2022, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func deriveP256Key(privateKey *jwk.Jwk) (*ecdsa.PrivateKey, error) {
+	for attempt := range maxDerivationAttempts {
+		scalar, err := deriveSecret(privateKey, ECDSAJCS2019, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := ecdh.P256().NewPrivateKey(scalar)
+		if err != nil {
+			// The scalar is zero or not lower than the order of the curve
+			continue
+		}
+
+		// The uncompressed point: 0x04 || X || Y
+		point := key.PublicKey().Bytes()
+
+		return &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: elliptic.P256(),
+				X:     new(big.Int).SetBytes(point[1:33]),
+				Y:     new(big.Int).SetBytes(point[33:]),
+			},
+			D: new(big.Int).SetBytes(scalar),
+		}, nil
+	}
+
+	return nil, errors.New("unable to derive a P-256 key")
+}
+
+// newVerificationMethod returns the identifier of the public key in the controlled identifier
+// document of the issuer: the URL of the document with the public key as a Multikey fragment
+func newVerificationMethod(controller string, multicodec, publicKey []byte) string {
+	return controller + "#" + encodeMultibase(append(bytes.Clone(multicodec), publicKey...))
+}
+
+func ed25519VerificationMethod(controller string, publicKey ed25519.PublicKey) string {
+	return newVerificationMethod(controller, ed25519Multicodec, publicKey)
+}
+
+func p256VerificationMethod(controller string, publicKey *ecdsa.PublicKey) string {
+	return newVerificationMethod(
+		controller,
+		p256Multicodec,
+		elliptic.MarshalCompressed(elliptic.P256(), publicKey.X, publicKey.Y),
+	)
+}
+
+// splitVerificationMethod returns the controller and the Multikey of a verification method
+func splitVerificationMethod(verificationMethod string) (string, string, error) {
+	controller, multikey, _ := strings.Cut(verificationMethod, "#")
+	if controller == "" || multikey == "" {
+		return "", "", errInvalidVerificationMethod
+	}
+
+	_, _, err := parseMultikey(multikey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return controller, multikey, nil
+}
+
+// NewMultikey returns the verification method of a Data Integrity proof
+// as published in the controlled identifier document of the issuer
+func NewMultikey(verificationMethod string) (*types.VerificationMethod, error) {
+	controller, multikey, err := splitVerificationMethod(verificationMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VerificationMethod{
+		ID:                 verificationMethod,
+		Type:               types.MultikeyVerificationMethod,
+		Controller:         controller,
+		PublicKeyMultibase: multikey,
+	}, nil
+}
+
+// parseMultikey returns the multicodec and the public key of a Multikey
+func parseMultikey(multikey string) ([]byte, []byte, error) {
+	decoded, err := decodeMultibase(multikey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, multicodec := range [][]byte{ed25519Multicodec, p256Multicodec} {
+		if publicKey, ok := bytes.CutPrefix(decoded, multicodec); ok {
+			return multicodec, publicKey, nil
+		}
+	}
+
+	return nil, nil, errInvalidVerificationMethod
+}
+
+// parseVerificationMethod returns the multicodec and the public key of a verification method
+func parseVerificationMethod(verificationMethod string) ([]byte, []byte, error) {
+	_, multikey, err := splitVerificationMethod(verificationMethod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parseMultikey(multikey)
+}
+
+func parseEd25519VerificationMethod(verificationMethod string) (ed25519.PublicKey, error) {
+	multicodec, publicKey, err := parseVerificationMethod(verificationMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(multicodec, ed25519Multicodec) || len(publicKey) != ed25519.PublicKeySize {
+		return nil, errInvalidVerificationMethod
+	}
+
+	return publicKey, nil
+}
+
+func parseP256VerificationMethod(verificationMethod string) (*ecdsa.PublicKey, error) {
+	multicodec, publicKey, err := parseVerificationMethod(verificationMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(multicodec, p256Multicodec) {
+		return nil, errInvalidVerificationMethod
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), publicKey)
+	if x == nil {
+		return nil, errInvalidVerificationMethod
+	}
+
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+)
+
+// The keys identifying the items of the arrays of claims,
+// such as the skills of an A2A card or the tools of an MCP server
+var itemKeys = []string{"id", "name"}
+
+// Diff compares the claims of two badges as JSON documents. The items of the arrays
+// are matched by their id or name when all of them have one, by their position otherwise.
+func Diff(from, to *types.Badge) *types.BadgeDiff {
+	changes := make([]*types.ClaimChange, 0)
+	diffValues("", parseClaims(from), parseClaims(to), &changes)
+
+	slices.SortStableFunc(changes, func(a, b *types.ClaimChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return &types.BadgeDiff{
+		FromBadgeID: from.ID,
+		ToBadgeID:   to.ID,
+		Changes:     changes,
+	}
+}
+
+// parseClaims returns the decoded claims of the badge,
+// the claims that are not JSON are compared as a string
+func parseClaims(badge *types.Badge) any {
+	if badge.CredentialSubject == nil {
+		return nil
+	}
+
+	var claims any
+
+	err := json.Unmarshal([]byte(badge.CredentialSubject.Badge), &claims)
+	if err != nil {
+		return badge.CredentialSubject.Badge
+	}
+
+	return claims
+}
+
+func diffValues(path string, oldValue, newValue any, changes *[]*types.ClaimChange) {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+
+	if oldIsObject && newIsObject {
+		diffObjects(path, oldObject, newObject, changes)
+		return
+	}
+
+	oldArray, oldIsArray := oldValue.([]any)
+	newArray, newIsArray := newValue.([]any)
+
+	if oldIsArray && newIsArray {
+		diffArrays(path, oldArray, newArray, changes)
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*changes = append(*changes, &types.ClaimChange{
+			Path:     path,
+			Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+			OldValue: encodeValue(oldValue),
+			NewValue: encodeValue(newValue),
+		})
+	}
+}
+
+func diffObjects(path string, oldObject, newObject map[string]any, changes *[]*types.ClaimChange) {
+	keys := make([]string, 0, len(oldObject)+len(newObject))
+	for key := range oldObject {
+		keys = append(keys, key)
+	}
+
+	for key := range newObject {
+		if _, ok := oldObject[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+
+		diffEntries(childPath, oldObject, newObject, key, changes)
+	}
+}
+
+func diffArrays(path string, oldArray, newArray []any, changes *[]*types.ClaimChange) {
+	itemKey, ok := findItemKey(oldArray, newArray)
+	if !ok {
+		oldItems := make(map[string]any, len(oldArray))
+		newItems := make(map[string]any, len(newArray))
+		keys := make([]string, 0, max(len(oldArray), len(newArray)))
+
+		for i := range max(len(oldArray), len(newArray)) {
+			key := fmt.Sprintf("%d", i)
+			keys = append(keys, key)
+
+			if i < len(oldArray) {
+				oldItems[key] = oldArray[i]
+			}
+
+			if i < len(newArray) {
+				newItems[key] = newArray[i]
+			}
+		}
+
+		for _, key := range keys {
+			diffEntries(fmt.Sprintf("%s[%s]", path, key), oldItems, newItems, key, changes)
+		}
+
+		return
+	}
+
+	oldItems := indexItems(oldArray, itemKey)
+	newItems := indexItems(newArray, itemKey)
+
+	keys := make([]string, 0, len(oldItems)+len(newItems))
+	for _, item := range oldArray {
+		keys = append(keys, itemID(item, itemKey))
+	}
+
+	for _, item := range newArray {
+		if _, ok := oldItems[itemID(item, itemKey)]; !ok {
+			keys = append(keys, itemID(item, itemKey))
+		}
+	}
+
+	for _, key := range keys {
+		diffEntries(fmt.Sprintf("%s[%s=%s]", path, itemKey, key), oldItems, newItems, key, changes)
+	}
+}
+
+// diffEntries compares the entries with the given key of two collections
+func diffEntries(path string, oldEntries, newEntries map[string]any, key string, changes *[]*types.ClaimChange) {
+	oldValue, inOld := oldEntries[key]
+	newValue, inNew := newEntries[key]
+
+	switch {
+	case inOld && !inNew:
+		*changes = append(*changes, &types.ClaimChange{
+			Path:     path,
+			Type:     types.CLAIM_CHANGE_TYPE_REMOVED,
+			OldValue: encodeValue(oldValue),
+		})
+	case !inOld && inNew:
+		*changes = append(*changes, &types.ClaimChange{
+			Path:     path,
+			Type:     types.CLAIM_CHANGE_TYPE_ADDED,
+			NewValue: encodeValue(newValue),
+		})
+	default:
+		diffValues(path, oldValue, newValue, changes)
+	}
+}
+
+// findItemKey returns the key identifying all the items of both arrays
+func findItemKey(oldArray, newArray []any) (string, bool) {
+	if len(oldArray) == 0 && len(newArray) == 0 {
+		return "", false
+	}
+
+	for _, key := range itemKeys {
+		if hasUniqueKey(oldArray, key) && hasUniqueKey(newArray, key) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+func hasUniqueKey(items []any, key string) bool {
+	ids := make(map[string]struct{}, len(items))
+
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		id, ok := object[key].(string)
+		if !ok {
+			return false
+		}
+
+		if _, found := ids[id]; found {
+			return false
+		}
+
+		ids[id] = struct{}{}
+	}
+
+	return true
+}
+
+func indexItems(items []any, key string) map[string]any {
+	index := make(map[string]any, len(items))
+	for _, item := range items {
+		index[itemID(item, key)] = item
+	}
+
+	return index
+}
+
+func itemID(item any, key string) string {
+	id, _ := item.(map[string]any)[key].(string)
+	return id
+}
+
+func encodeValue(value any) *string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		encoded = fmt.Appendf(nil, "%v", value)
+	}
+
+	ret := string(encoded)
+
+	return &ret
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBadgeWithClaims(id, claims string) *types.Badge {
+	return &types.Badge{
+		VerifiableCredential: types.VerifiableCredential{
+			ID:                id,
+			CredentialSubject: &types.BadgeClaims{Badge: claims},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		from     string
+		to       string
+		expected []*types.ClaimChange
+	}{
+		"same claims": {
+			from:     `{"name":"agent","skills":[{"id":"search"}]}`,
+			to:       `{"skills":[{"id":"search"}],"name":"agent"}`,
+			expected: []*types.ClaimChange{},
+		},
+		"card fields": {
+			from: `{"name":"agent","description":"old","url":"https://agent"}`,
+			to:   `{"name":"agent","description":"new","version":"1.0.0"}`,
+			expected: []*types.ClaimChange{
+				{
+					Path:     "description",
+					Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+					OldValue: ptrutil.Ptr(`"old"`),
+					NewValue: ptrutil.Ptr(`"new"`),
+				},
+				{
+					Path:     "url",
+					Type:     types.CLAIM_CHANGE_TYPE_REMOVED,
+					OldValue: ptrutil.Ptr(`"https://agent"`),
+				},
+				{
+					Path:     "version",
+					Type:     types.CLAIM_CHANGE_TYPE_ADDED,
+					NewValue: ptrutil.Ptr(`"1.0.0"`),
+				},
+			},
+		},
+		"skills matched by id": {
+			from: `{"skills":[{"id":"search","name":"Search"},{"id":"book","name":"Book"}]}`,
+			to:   `{"skills":[{"id":"pay","name":"Pay"},{"id":"search","name":"Web search"}]}`,
+			expected: []*types.ClaimChange{
+				{
+					Path:     "skills[id=book]",
+					Type:     types.CLAIM_CHANGE_TYPE_REMOVED,
+					OldValue: ptrutil.Ptr(`{"id":"book","name":"Book"}`),
+				},
+				{
+					Path:     "skills[id=pay]",
+					Type:     types.CLAIM_CHANGE_TYPE_ADDED,
+					NewValue: ptrutil.Ptr(`{"id":"pay","name":"Pay"}`),
+				},
+				{
+					Path:     "skills[id=search].name",
+					Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+					OldValue: ptrutil.Ptr(`"Search"`),
+					NewValue: ptrutil.Ptr(`"Web search"`),
+				},
+			},
+		},
+		"tools matched by name": {
+			from: `{"tools":[{"name":"get_weather","description":"Weather"}]}`,
+			to:   `{"tools":[{"name":"get_weather","description":"Current weather"}]}`,
+			expected: []*types.ClaimChange{
+				{
+					Path:     "tools[name=get_weather].description",
+					Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+					OldValue: ptrutil.Ptr(`"Weather"`),
+					NewValue: ptrutil.Ptr(`"Current weather"`),
+				},
+			},
+		},
+		"values matched by position": {
+			from: `{"tags":["a","b"]}`,
+			to:   `{"tags":["a","c","d"]}`,
+			expected: []*types.ClaimChange{
+				{
+					Path:     "tags[1]",
+					Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+					OldValue: ptrutil.Ptr(`"b"`),
+					NewValue: ptrutil.Ptr(`"c"`),
+				},
+				{
+					Path:     "tags[2]",
+					Type:     types.CLAIM_CHANGE_TYPE_ADDED,
+					NewValue: ptrutil.Ptr(`"d"`),
+				},
+			},
+		},
+		"claims that are not json": {
+			from: "old",
+			to:   "new",
+			expected: []*types.ClaimChange{
+				{
+					Path:     "",
+					Type:     types.CLAIM_CHANGE_TYPE_MODIFIED,
+					OldValue: ptrutil.Ptr(`"old"`),
+					NewValue: ptrutil.Ptr(`"new"`),
+				},
+			},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			diff := badge.Diff(newBadgeWithClaims("from", tc.from), newBadgeWithClaims("to", tc.to))
+
+			assert.Equal(t, "from", diff.FromBadgeID)
+			assert.Equal(t, "to", diff.ToBadgeID)
+			assert.Equal(t, tc.expected, diff.Changes)
+		})
+	}
+}
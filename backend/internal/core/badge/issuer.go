@@ -7,20 +7,63 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
+	"github.com/agntcy/identity-service/internal/core/badge/sdjwt"
 	"github.com/agntcy/identity-service/internal/core/badge/types"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/agntcy/identity/pkg/jwk"
 	"github.com/google/uuid"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
 )
 
+// The path of the controlled identifier documents of the issuers
+const issuerDocumentPath = "/v1alpha1/badges/issuers/"
+
+var ErrInvalidHolderKey = errors.New("the holder key is not a public JWK")
+
+// IssuerDocumentURL returns the URL of the controlled identifier document publishing
+// the keys of the Data Integrity proofs of the issuer, under the given API URL
+func IssuerDocumentURL(baseURL, issuer string) string {
+	return baseURL + issuerDocumentPath + url.PathEscape(issuer)
+}
+
+// The claims of the badges issued as SD-JWT VCs
+type sdJwtClaims struct {
+	Issuer       string                    `json:"iss"`
+	ID           string                    `json:"jti"`
+	Type         string                    `json:"vct"`
+	Subject      string                    `json:"sub"`
+	IssuedAt     int64                     `json:"iat"`
+	ExpiresAt    int64                     `json:"exp,omitempty"`
+	Badge        json.RawMessage           `json:"badge"`
+	Status       []*types.CredentialStatus `json:"credentialStatus,omitempty"`
+	Confirmation *sdJwtConfirmation        `json:"cnf,omitempty"`
+}
+
+type sdJwtConfirmation struct {
+	Key any `json:"jwk"`
+}
+
+// Issue creates a badge secured with the given proof format, valid for the given lifetime.
+// A zero lifetime issues a badge that never expires. The holder key, a public JWK,
+// binds the SD-JWT VC badges to their holder. The controller is the URL of the document
+// of the issuer publishing the keys of the Data Integrity proofs.
 func Issue(
 	appID string,
 	issuer string,
+	controller string,
 	typ types.BadgeType,
 	claims *types.BadgeClaims,
 	privateKey *jwk.Jwk,
+	proofFormat types.ProofFormat,
+	holderKey string,
+	lifetime time.Duration,
+	statuses ...*types.CredentialStatus,
 ) (*types.Badge, error) {
 	if typ == types.BADGE_TYPE_UNSPECIFIED {
 		return nil, errors.New("unsupported badge type")
@@ -34,19 +77,38 @@ func Issue(
 		return nil, errors.New("invalid privateKey argument")
 	}
 
+	var (
+		holder jwxjwk.Key
+		err    error
+	)
+
+	if holderKey != "" {
+		holder, err = ParseHolderKey(holderKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	issuedAt := time.Now().UTC()
+
 	vc := types.VerifiableCredential{
 		Context: []string{
 			"https://www.w3.org/ns/credentials/v2",
 			"https://www.w3.org/ns/credentials/examples/v2",
 		},
 		ID:                uuid.NewString(),
-		IssuanceDate:      time.Now().UTC().Format(time.RFC3339),
+		IssuanceDate:      issuedAt.Format(time.RFC3339),
 		Issuer:            issuer,
 		Type:              []string{typ.String()},
 		CredentialSubject: claims,
+		Status:            statuses,
 	}
 
-	err := sign(&vc, privateKey)
+	if lifetime > 0 {
+		vc.ExpirationDate = issuedAt.Add(lifetime).Format(time.RFC3339)
+	}
+
+	err = signWithFormat(&vc, proofFormat, privateKey, controller, holder)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +119,29 @@ func Issue(
 	}, nil
 }
 
+// sign secures the credential again with the format of its current proof, the same
+// holder key and the same controller, used when the statuses of an issued badge change
 func sign(vc *types.VerifiableCredential, privateKey *jwk.Jwk) error {
+	var holder jwxjwk.Key
+	if vc.Proof.IsSdJwt() {
+		holder, _ = sdjwt.HolderKey(vc.Proof.ProofValue)
+	}
+
+	var controller string
+	if vc.Proof.IsDataIntegrity() {
+		controller, _, _ = strings.Cut(vc.Proof.VerificationMethod, "#")
+	}
+
+	return signWithFormat(vc, proofFormatOf(vc.Proof), privateKey, controller, holder)
+}
+
+func signWithFormat(
+	vc *types.VerifiableCredential,
+	proofFormat types.ProofFormat,
+	privateKey *jwk.Jwk,
+	controller string,
+	holder jwxjwk.Key,
+) error {
 	// Make sure to erease any existing proof
 	vc.Proof = nil
 
@@ -66,15 +150,195 @@ func sign(vc *types.VerifiableCredential, privateKey *jwk.Jwk) error {
 		return fmt.Errorf("unable to marshal badge: %w", err)
 	}
 
-	signed, err := joseutil.Sign(privateKey, payload)
+	switch proofFormat {
+	case types.PROOF_FORMAT_EDDSA_JCS_2022, types.PROOF_FORMAT_ECDSA_JCS_2019:
+		cryptosuite := dataintegrity.EdDSAJCS2022
+		if proofFormat == types.PROOF_FORMAT_ECDSA_JCS_2019 {
+			cryptosuite = dataintegrity.ECDSAJCS2019
+		}
+
+		proof, err := dataintegrity.Sign(payload, cryptosuite, privateKey, controller, time.Now())
+		if err != nil {
+			return fmt.Errorf("unable to sign the badge: %w", err)
+		}
+
+		vc.Proof = proof
+	case types.PROOF_FORMAT_SD_JWT_VC:
+		proof, err := issueSdJwt(vc, privateKey, holder)
+		if err != nil {
+			return fmt.Errorf("unable to sign the badge: %w", err)
+		}
+
+		vc.Proof = proof
+	default:
+		signed, err := joseutil.Sign(privateKey, payload)
+		if err != nil {
+			return fmt.Errorf("unable to sign the badge: %w", err)
+		}
+
+		vc.Proof = &types.Proof{
+			Type:       types.JoseProof,
+			ProofValue: string(signed),
+		}
+	}
+
+	return nil
+}
+
+func proofFormatOf(proof *types.Proof) types.ProofFormat {
+	if proof.IsSdJwt() {
+		return types.PROOF_FORMAT_SD_JWT_VC
+	}
+
+	if !proof.IsDataIntegrity() {
+		return types.PROOF_FORMAT_JOSE
+	}
+
+	if proof.Cryptosuite == dataintegrity.ECDSAJCS2019 {
+		return types.PROOF_FORMAT_ECDSA_JCS_2019
+	}
+
+	return types.PROOF_FORMAT_EDDSA_JCS_2022
+}
+
+// issueSdJwt secures the credential as an SD-JWT VC. The claims of the badge, except its name,
+// are selectively disclosable, as well as each element of its arrays (ex: the skills or the tools).
+func issueSdJwt(vc *types.VerifiableCredential, privateKey *jwk.Jwk, holder jwxjwk.Key) (*types.Proof, error) {
+	claims := sdJwtClaims{
+		Issuer: vc.Issuer,
+		ID:     vc.ID,
+		Status: vc.Status,
+	}
+
+	if len(vc.Type) > 0 {
+		claims.Type = vc.Type[0]
+	}
+
+	if holder != nil {
+		claims.Confirmation = &sdJwtConfirmation{Key: holder}
+	}
+
+	issuedAt, err := time.Parse(time.RFC3339, vc.IssuanceDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuance date: %w", err)
+	}
+
+	claims.IssuedAt = issuedAt.Unix()
+
+	if expiresAt, ok := vc.ExpiresAt(); ok {
+		claims.ExpiresAt = expiresAt.Unix()
+	}
+
+	var badge any
+
+	if vc.CredentialSubject != nil {
+		claims.Subject = vc.CredentialSubject.ID
+
+		err = json.Unmarshal([]byte(vc.CredentialSubject.Badge), &badge)
+		if err != nil {
+			badge = vc.CredentialSubject.Badge
+		}
+	}
+
+	data, err := json.Marshal(&claims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the claims: %w", err)
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(data, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the claims: %w", err)
+	}
+
+	payload["badge"] = badge
+	issuer := sdjwt.NewIssuer()
+
+	if object, ok := badge.(map[string]any); ok {
+		err = concealBadgeClaims(issuer, object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sdJWT, err := issuer.Sign(payload, privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerKey, err := sdjwt.IssuerKey(sdJWT)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbprint, err := sdjwt.Thumbprint(issuerKey)
 	if err != nil {
-		return fmt.Errorf("unable to sign the badge: %w", err)
+		return nil, err
+	}
+
+	return &types.Proof{
+		Type:               types.SdJwtProof,
+		ProofValue:         sdJWT,
+		VerificationMethod: thumbprint,
+		Created:            vc.IssuanceDate,
+	}, nil
+}
+
+func concealBadgeClaims(issuer *sdjwt.Issuer, badge map[string]any) error {
+	names := make([]string, 0, len(badge))
+	for name := range badge {
+		if name != "name" {
+			names = append(names, name)
+		}
 	}
 
-	vc.Proof = &types.Proof{
-		Type:       types.JoseProof,
-		ProofValue: string(signed),
+	slices.Sort(names)
+
+	for _, name := range names {
+		err := issuer.ConcealElements(badge, name)
+		if err != nil {
+			return err
+		}
+
+		err = issuer.Conceal(badge, name)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// ParseHolderKey parses the public JWK of the holder of an SD-JWT VC badge
+func ParseHolderKey(holderKey string) (jwxjwk.Key, error) {
+	key, err := jwxjwk.ParseKey([]byte(holderKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHolderKey, err)
+	}
+
+	if private, err := jwxjwk.IsPrivateKey(key); err != nil || private {
+		return nil, ErrInvalidHolderKey
+	}
+
+	return key, nil
+}
+
+// HolderKey returns the holder key of an SD-JWT VC badge, empty for the other badges
+func HolderKey(badge *types.Badge) string {
+	if badge == nil || !badge.Proof.IsSdJwt() {
+		return ""
+	}
+
+	key, ok := sdjwt.HolderKey(badge.Proof.ProofValue)
+	if !ok {
+		return ""
+	}
+
+	data, err := json.Marshal(key)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
@@ -6,8 +6,10 @@ package badge_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
 	"github.com/agntcy/identity-service/internal/core/badge/types"
 	"github.com/agntcy/identity/pkg/joseutil"
 	"github.com/google/uuid"
@@ -23,13 +25,42 @@ func TestIssue_should_issue_a_badge(t *testing.T) {
 	claims := &types.BadgeClaims{Badge: uuid.NewString()}
 	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
 
-	b, err := badge.Issue(appID, issuer, typ, claims, privKey)
+	b, err := badge.Issue(appID, issuer, "", typ, claims, privKey, types.PROOF_FORMAT_JOSE, "", 0)
 
 	assert.NoError(t, err)
 	assert.Equal(t, appID, b.AppID)
 	assert.Equal(t, issuer, b.Issuer)
 	assert.Equal(t, []string{typ.String()}, b.Type)
 	assert.Equal(t, claims, b.CredentialSubject)
+	assert.Empty(t, b.ExpirationDate)
+}
+
+func TestIssue_should_set_the_expiration_date(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	b, err := badge.Issue(
+		uuid.NewString(),
+		uuid.NewString(),
+		"",
+		types.BADGE_TYPE_MCP_BADGE,
+		&types.BadgeClaims{},
+		privKey,
+		types.PROOF_FORMAT_JOSE,
+		"",
+		time.Hour,
+	)
+
+	assert.NoError(t, err)
+
+	issuedAt, err := time.Parse(time.RFC3339, b.IssuanceDate)
+	assert.NoError(t, err)
+
+	expiresAt, ok := b.ExpiresAt()
+	assert.True(t, ok)
+	assert.Equal(t, issuedAt.Add(time.Hour), expiresAt)
+	assert.False(t, b.IsExpired())
 }
 
 func TestIssue_should_issue_a_badge_with_valid_jose_proof(t *testing.T) {
@@ -41,7 +72,7 @@ func TestIssue_should_issue_a_badge_with_valid_jose_proof(t *testing.T) {
 	claims := &types.BadgeClaims{Badge: uuid.NewString()}
 	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
 
-	b, err := badge.Issue(appID, issuer, typ, claims, privKey)
+	b, err := badge.Issue(appID, issuer, "", typ, claims, privKey, types.PROOF_FORMAT_JOSE, "", 0)
 
 	assert.NoError(t, err)
 	assert.Equal(t, types.JoseProof, b.Proof.Type)
@@ -55,6 +86,55 @@ func TestIssue_should_issue_a_badge_with_valid_jose_proof(t *testing.T) {
 	assert.Equal(t, signedBadge, actualBadge)
 }
 
+func TestIssue_should_issue_a_badge_with_valid_data_integrity_proof(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		proofFormat types.ProofFormat
+		cryptosuite string
+	}{
+		"eddsa-jcs-2022": {
+			proofFormat: types.PROOF_FORMAT_EDDSA_JCS_2022,
+			cryptosuite: dataintegrity.EdDSAJCS2022,
+		},
+		"ecdsa-jcs-2019": {
+			proofFormat: types.PROOF_FORMAT_ECDSA_JCS_2019,
+			cryptosuite: dataintegrity.ECDSAJCS2019,
+		},
+	}
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := badge.Issue(
+				uuid.NewString(),
+				"issuer",
+				badge.IssuerDocumentURL("https://api", "issuer"),
+				types.BADGE_TYPE_AGENT_BADGE,
+				&types.BadgeClaims{ID: uuid.NewString(), Badge: `{"name":"agent"}`},
+				privKey,
+				tc.proofFormat,
+				"",
+				0,
+			)
+
+			assert.NoError(t, err)
+			assert.True(t, b.Proof.IsDataIntegrity())
+			assert.Equal(t, tc.cryptosuite, b.Proof.Cryptosuite)
+
+			credential, err := json.Marshal(b.VerifiableCredential)
+			assert.NoError(t, err)
+
+			proof, err := dataintegrity.Verify(credential)
+			assert.NoError(t, err)
+			assert.Equal(t, b.Proof, proof)
+		})
+	}
+}
+
 func getVerifiableCredentialAsJSON(t *testing.T, b *types.VerifiableCredential) ([]byte, error) {
 	t.Helper()
 
@@ -68,7 +148,7 @@ func TestIssue_should_return_err_when_type_is_invalid(t *testing.T) {
 
 	invalidType := types.BADGE_TYPE_UNSPECIFIED
 
-	_, err := badge.Issue("", "", invalidType, nil, nil)
+	_, err := badge.Issue("", "", "", invalidType, nil, nil, types.PROOF_FORMAT_JOSE, "", 0)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "unsupported badge type")
@@ -77,7 +157,7 @@ func TestIssue_should_return_err_when_type_is_invalid(t *testing.T) {
 func TestIssue_should_return_err_when_claims_is_nil(t *testing.T) {
 	t.Parallel()
 
-	_, err := badge.Issue("", "", types.BADGE_TYPE_AGENT_BADGE, nil, nil)
+	_, err := badge.Issue("", "", "", types.BADGE_TYPE_AGENT_BADGE, nil, nil, types.PROOF_FORMAT_JOSE, "", 0)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "invalid badge claims")
@@ -86,8 +166,39 @@ func TestIssue_should_return_err_when_claims_is_nil(t *testing.T) {
 func TestIssue_should_return_err_when_private_key_is_nil(t *testing.T) {
 	t.Parallel()
 
-	_, err := badge.Issue("", "", types.BADGE_TYPE_AGENT_BADGE, &types.BadgeClaims{}, nil)
+	_, err := badge.Issue(
+		"",
+		"",
+		"",
+		types.BADGE_TYPE_AGENT_BADGE,
+		&types.BadgeClaims{},
+		nil,
+		types.PROOF_FORMAT_JOSE,
+		"",
+		0,
+	)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "invalid privateKey argument")
 }
+
+func TestIssue_should_return_err_when_holder_key_is_private(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	holderKey, _ := json.Marshal(privKey)
+
+	_, err := badge.Issue(
+		uuid.NewString(),
+		"",
+		"",
+		types.BADGE_TYPE_AGENT_BADGE,
+		&types.BadgeClaims{Badge: "{}"},
+		privKey,
+		types.PROOF_FORMAT_SD_JWT_VC,
+		string(holderKey),
+		0,
+	)
+
+	assert.ErrorIs(t, err, badge.ErrInvalidHolderKey)
+}
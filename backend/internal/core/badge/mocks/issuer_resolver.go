@@ -0,0 +1,186 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewIssuerResolver creates a new instance of IssuerResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIssuerResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IssuerResolver {
+	mock := &IssuerResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// IssuerResolver is an autogenerated mock type for the IssuerResolver type
+type IssuerResolver struct {
+	mock.Mock
+}
+
+type IssuerResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IssuerResolver) EXPECT() *IssuerResolver_Expecter {
+	return &IssuerResolver_Expecter{mock: &_m.Mock}
+}
+
+// ResolveKey provides a mock function for the type IssuerResolver
+func (_mock *IssuerResolver) ResolveKey(ctx context.Context, issuer string, keyID string) (jwk.Key, error) {
+	ret := _mock.Called(ctx, issuer, keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveKey")
+	}
+
+	var r0 jwk.Key
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (jwk.Key, error)); ok {
+		return returnFunc(ctx, issuer, keyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) jwk.Key); ok {
+		r0 = returnFunc(ctx, issuer, keyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jwk.Key)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, issuer, keyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IssuerResolver_ResolveKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveKey'
+type IssuerResolver_ResolveKey_Call struct {
+	*mock.Call
+}
+
+// ResolveKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - keyID string
+func (_e *IssuerResolver_Expecter) ResolveKey(ctx interface{}, issuer interface{}, keyID interface{}) *IssuerResolver_ResolveKey_Call {
+	return &IssuerResolver_ResolveKey_Call{Call: _e.mock.On("ResolveKey", ctx, issuer, keyID)}
+}
+
+func (_c *IssuerResolver_ResolveKey_Call) Run(run func(ctx context.Context, issuer string, keyID string)) *IssuerResolver_ResolveKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *IssuerResolver_ResolveKey_Call) Return(key jwk.Key, err error) *IssuerResolver_ResolveKey_Call {
+	_c.Call.Return(key, err)
+	return _c
+}
+
+func (_c *IssuerResolver_ResolveKey_Call) RunAndReturn(run func(ctx context.Context, issuer string, keyID string) (jwk.Key, error)) *IssuerResolver_ResolveKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveStatus provides a mock function for the type IssuerResolver
+func (_mock *IssuerResolver) ResolveStatus(ctx context.Context, issuer string, status *types.CredentialStatus) (bool, error) {
+	ret := _mock.Called(ctx, issuer, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *types.CredentialStatus) (bool, error)); ok {
+		return returnFunc(ctx, issuer, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *types.CredentialStatus) bool); ok {
+		r0 = returnFunc(ctx, issuer, status)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *types.CredentialStatus) error); ok {
+		r1 = returnFunc(ctx, issuer, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IssuerResolver_ResolveStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveStatus'
+type IssuerResolver_ResolveStatus_Call struct {
+	*mock.Call
+}
+
+// ResolveStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - status *types.CredentialStatus
+func (_e *IssuerResolver_Expecter) ResolveStatus(ctx interface{}, issuer interface{}, status interface{}) *IssuerResolver_ResolveStatus_Call {
+	return &IssuerResolver_ResolveStatus_Call{Call: _e.mock.On("ResolveStatus", ctx, issuer, status)}
+}
+
+func (_c *IssuerResolver_ResolveStatus_Call) Run(run func(ctx context.Context, issuer string, status *types.CredentialStatus)) *IssuerResolver_ResolveStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *types.CredentialStatus
+		if args[2] != nil {
+			arg2 = args[2].(*types.CredentialStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *IssuerResolver_ResolveStatus_Call) Return(b bool, err error) *IssuerResolver_ResolveStatus_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *IssuerResolver_ResolveStatus_Call) RunAndReturn(run func(ctx context.Context, issuer string, status *types.CredentialStatus) (bool, error)) *IssuerResolver_ResolveStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,8 +6,10 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -95,6 +97,69 @@ func (_c *Repository_Create_Call) RunAndReturn(run func(ctx context.Context, bad
 	return _c
 }
 
+// DeleteStatuses provides a mock function for the type Repository
+func (_mock *Repository) DeleteStatuses(ctx context.Context, badgeID string, purpose types.CredentialStatusPurpose) error {
+	ret := _mock.Called(ctx, badgeID, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatuses")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, types.CredentialStatusPurpose) error); ok {
+		r0 = returnFunc(ctx, badgeID, purpose)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_DeleteStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStatuses'
+type Repository_DeleteStatuses_Call struct {
+	*mock.Call
+}
+
+// DeleteStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+//   - purpose types.CredentialStatusPurpose
+func (_e *Repository_Expecter) DeleteStatuses(ctx interface{}, badgeID interface{}, purpose interface{}) *Repository_DeleteStatuses_Call {
+	return &Repository_DeleteStatuses_Call{Call: _e.mock.On("DeleteStatuses", ctx, badgeID, purpose)}
+}
+
+func (_c *Repository_DeleteStatuses_Call) Run(run func(ctx context.Context, badgeID string, purpose types.CredentialStatusPurpose)) *Repository_DeleteStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 types.CredentialStatusPurpose
+		if args[2] != nil {
+			arg2 = args[2].(types.CredentialStatusPurpose)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteStatuses_Call) Return(err error) *Repository_DeleteStatuses_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_DeleteStatuses_Call) RunAndReturn(run func(ctx context.Context, badgeID string, purpose types.CredentialStatusPurpose) error) *Repository_DeleteStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllActiveBadges provides a mock function for the type Repository
 func (_mock *Repository) GetAllActiveBadges(ctx context.Context, appID string) ([]*types.Badge, error) {
 	ret := _mock.Called(ctx, appID)
@@ -163,6 +228,160 @@ func (_c *Repository_GetAllActiveBadges_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetBadgesToRenew provides a mock function for the type Repository
+func (_mock *Repository) GetBadgesToRenew(ctx context.Context, renewalWindow time.Duration, failedBefore time.Time, maxFailures int, limit int) ([]*types.Badge, error) {
+	ret := _mock.Called(ctx, renewalWindow, failedBefore, maxFailures, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBadgesToRenew")
+	}
+
+	var r0 []*types.Badge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration, time.Time, int, int) ([]*types.Badge, error)); ok {
+		return returnFunc(ctx, renewalWindow, failedBefore, maxFailures, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration, time.Time, int, int) []*types.Badge); ok {
+		r0 = returnFunc(ctx, renewalWindow, failedBefore, maxFailures, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Badge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Duration, time.Time, int, int) error); ok {
+		r1 = returnFunc(ctx, renewalWindow, failedBefore, maxFailures, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetBadgesToRenew_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBadgesToRenew'
+type Repository_GetBadgesToRenew_Call struct {
+	*mock.Call
+}
+
+// GetBadgesToRenew is a helper method to define mock.On call
+//   - ctx context.Context
+//   - renewalWindow time.Duration
+//   - failedBefore time.Time
+//   - maxFailures int
+//   - limit int
+func (_e *Repository_Expecter) GetBadgesToRenew(ctx interface{}, renewalWindow interface{}, failedBefore interface{}, maxFailures interface{}, limit interface{}) *Repository_GetBadgesToRenew_Call {
+	return &Repository_GetBadgesToRenew_Call{Call: _e.mock.On("GetBadgesToRenew", ctx, renewalWindow, failedBefore, maxFailures, limit)}
+}
+
+func (_c *Repository_GetBadgesToRenew_Call) Run(run func(ctx context.Context, renewalWindow time.Duration, failedBefore time.Time, maxFailures int, limit int)) *Repository_GetBadgesToRenew_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetBadgesToRenew_Call) Return(badges []*types.Badge, err error) *Repository_GetBadgesToRenew_Call {
+	_c.Call.Return(badges, err)
+	return _c
+}
+
+func (_c *Repository_GetBadgesToRenew_Call) RunAndReturn(run func(ctx context.Context, renewalWindow time.Duration, failedBefore time.Time, maxFailures int, limit int) ([]*types.Badge, error)) *Repository_GetBadgesToRenew_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type Repository
+func (_mock *Repository) GetByID(ctx context.Context, id string) (*types.Badge, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *types.Badge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Badge, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Badge); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Badge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type Repository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) GetByID(ctx interface{}, id interface{}) *Repository_GetByID_Call {
+	return &Repository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *Repository_GetByID_Call) Run(run func(ctx context.Context, id string)) *Repository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) Return(badge *types.Badge, err error) *Repository_GetByID_Call {
+	_c.Call.Return(badge, err)
+	return _c
+}
+
+func (_c *Repository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.Badge, error)) *Repository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestByAppIdOrResolverMetadataID provides a mock function for the type Repository
 func (_mock *Repository) GetLatestByAppIdOrResolverMetadataID(ctx context.Context, id string) (*types.Badge, error) {
 	ret := _mock.Called(ctx, id)
@@ -231,6 +450,527 @@ func (_c *Repository_GetLatestByAppIdOrResolverMetadataID_Call) RunAndReturn(run
 	return _c
 }
 
+// GetProof provides a mock function for the type Repository
+func (_mock *Repository) GetProof(ctx context.Context, badgeID string) (*types.Proof, error) {
+	ret := _mock.Called(ctx, badgeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProof")
+	}
+
+	var r0 *types.Proof
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.Proof, error)); ok {
+		return returnFunc(ctx, badgeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.Proof); ok {
+		r0 = returnFunc(ctx, badgeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Proof)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, badgeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetProof_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProof'
+type Repository_GetProof_Call struct {
+	*mock.Call
+}
+
+// GetProof is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+func (_e *Repository_Expecter) GetProof(ctx interface{}, badgeID interface{}) *Repository_GetProof_Call {
+	return &Repository_GetProof_Call{Call: _e.mock.On("GetProof", ctx, badgeID)}
+}
+
+func (_c *Repository_GetProof_Call) Run(run func(ctx context.Context, badgeID string)) *Repository_GetProof_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetProof_Call) Return(proof *types.Proof, err error) *Repository_GetProof_Call {
+	_c.Call.Return(proof, err)
+	return _c
+}
+
+func (_c *Repository_GetProof_Call) RunAndReturn(run func(ctx context.Context, badgeID string) (*types.Proof, error)) *Repository_GetProof_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevocationStatus provides a mock function for the type Repository
+func (_mock *Repository) GetRevocationStatus(ctx context.Context, badgeID string) (*types.CredentialStatus, error) {
+	ret := _mock.Called(ctx, badgeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevocationStatus")
+	}
+
+	var r0 *types.CredentialStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.CredentialStatus, error)); ok {
+		return returnFunc(ctx, badgeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.CredentialStatus); ok {
+		r0 = returnFunc(ctx, badgeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CredentialStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, badgeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetRevocationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevocationStatus'
+type Repository_GetRevocationStatus_Call struct {
+	*mock.Call
+}
+
+// GetRevocationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+func (_e *Repository_Expecter) GetRevocationStatus(ctx interface{}, badgeID interface{}) *Repository_GetRevocationStatus_Call {
+	return &Repository_GetRevocationStatus_Call{Call: _e.mock.On("GetRevocationStatus", ctx, badgeID)}
+}
+
+func (_c *Repository_GetRevocationStatus_Call) Run(run func(ctx context.Context, badgeID string)) *Repository_GetRevocationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetRevocationStatus_Call) Return(credentialStatus *types.CredentialStatus, err error) *Repository_GetRevocationStatus_Call {
+	_c.Call.Return(credentialStatus, err)
+	return _c
+}
+
+func (_c *Repository_GetRevocationStatus_Call) RunAndReturn(run func(ctx context.Context, badgeID string) (*types.CredentialStatus, error)) *Repository_GetRevocationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTenantID provides a mock function for the type Repository
+func (_mock *Repository) GetTenantID(ctx context.Context, badgeID string) (string, error) {
+	ret := _mock.Called(ctx, badgeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantID")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, badgeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, badgeID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, badgeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetTenantID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTenantID'
+type Repository_GetTenantID_Call struct {
+	*mock.Call
+}
+
+// GetTenantID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+func (_e *Repository_Expecter) GetTenantID(ctx interface{}, badgeID interface{}) *Repository_GetTenantID_Call {
+	return &Repository_GetTenantID_Call{Call: _e.mock.On("GetTenantID", ctx, badgeID)}
+}
+
+func (_c *Repository_GetTenantID_Call) Run(run func(ctx context.Context, badgeID string)) *Repository_GetTenantID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetTenantID_Call) Return(s string, err error) *Repository_GetTenantID_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Repository_GetTenantID_Call) RunAndReturn(run func(ctx context.Context, badgeID string) (string, error)) *Repository_GetTenantID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerificationMethods provides a mock function for the type Repository
+func (_mock *Repository) GetVerificationMethods(ctx context.Context, issuer string) ([]*types.VerificationMethod, error) {
+	ret := _mock.Called(ctx, issuer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationMethods")
+	}
+
+	var r0 []*types.VerificationMethod
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*types.VerificationMethod, error)); ok {
+		return returnFunc(ctx, issuer)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*types.VerificationMethod); ok {
+		r0 = returnFunc(ctx, issuer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.VerificationMethod)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, issuer)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetVerificationMethods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationMethods'
+type Repository_GetVerificationMethods_Call struct {
+	*mock.Call
+}
+
+// GetVerificationMethods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+func (_e *Repository_Expecter) GetVerificationMethods(ctx interface{}, issuer interface{}) *Repository_GetVerificationMethods_Call {
+	return &Repository_GetVerificationMethods_Call{Call: _e.mock.On("GetVerificationMethods", ctx, issuer)}
+}
+
+func (_c *Repository_GetVerificationMethods_Call) Run(run func(ctx context.Context, issuer string)) *Repository_GetVerificationMethods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetVerificationMethods_Call) Return(verificationMethods []*types.VerificationMethod, err error) *Repository_GetVerificationMethods_Call {
+	_c.Call.Return(verificationMethods, err)
+	return _c
+}
+
+func (_c *Repository_GetVerificationMethods_Call) RunAndReturn(run func(ctx context.Context, issuer string) ([]*types.VerificationMethod, error)) *Repository_GetVerificationMethods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByAppID provides a mock function for the type Repository
+func (_mock *Repository) ListByAppID(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error) {
+	ret := _mock.Called(ctx, appID, paginationFilter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByAppID")
+	}
+
+	var r0 *pagination.Pageable[types.Badge]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error)); ok {
+		return returnFunc(ctx, appID, paginationFilter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, pagination.PaginationFilter) *pagination.Pageable[types.Badge]); ok {
+		r0 = returnFunc(ctx, appID, paginationFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pageable[types.Badge])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, pagination.PaginationFilter) error); ok {
+		r1 = returnFunc(ctx, appID, paginationFilter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListByAppID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByAppID'
+type Repository_ListByAppID_Call struct {
+	*mock.Call
+}
+
+// ListByAppID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - paginationFilter pagination.PaginationFilter
+func (_e *Repository_Expecter) ListByAppID(ctx interface{}, appID interface{}, paginationFilter interface{}) *Repository_ListByAppID_Call {
+	return &Repository_ListByAppID_Call{Call: _e.mock.On("ListByAppID", ctx, appID, paginationFilter)}
+}
+
+func (_c *Repository_ListByAppID_Call) Run(run func(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter)) *Repository_ListByAppID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 pagination.PaginationFilter
+		if args[2] != nil {
+			arg2 = args[2].(pagination.PaginationFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListByAppID_Call) Return(pageable *pagination.Pageable[types.Badge], err error) *Repository_ListByAppID_Call {
+	_c.Call.Return(pageable, err)
+	return _c
+}
+
+func (_c *Repository_ListByAppID_Call) RunAndReturn(run func(ctx context.Context, appID string, paginationFilter pagination.PaginationFilter) (*pagination.Pageable[types.Badge], error)) *Repository_ListByAppID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveVerificationMethod provides a mock function for the type Repository
+func (_mock *Repository) SaveVerificationMethod(ctx context.Context, issuer string, method *types.VerificationMethod) error {
+	ret := _mock.Called(ctx, issuer, method)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVerificationMethod")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *types.VerificationMethod) error); ok {
+		r0 = returnFunc(ctx, issuer, method)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SaveVerificationMethod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveVerificationMethod'
+type Repository_SaveVerificationMethod_Call struct {
+	*mock.Call
+}
+
+// SaveVerificationMethod is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issuer string
+//   - method *types.VerificationMethod
+func (_e *Repository_Expecter) SaveVerificationMethod(ctx interface{}, issuer interface{}, method interface{}) *Repository_SaveVerificationMethod_Call {
+	return &Repository_SaveVerificationMethod_Call{Call: _e.mock.On("SaveVerificationMethod", ctx, issuer, method)}
+}
+
+func (_c *Repository_SaveVerificationMethod_Call) Run(run func(ctx context.Context, issuer string, method *types.VerificationMethod)) *Repository_SaveVerificationMethod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *types.VerificationMethod
+		if args[2] != nil {
+			arg2 = args[2].(*types.VerificationMethod)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SaveVerificationMethod_Call) Return(err error) *Repository_SaveVerificationMethod_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SaveVerificationMethod_Call) RunAndReturn(run func(ctx context.Context, issuer string, method *types.VerificationMethod) error) *Repository_SaveVerificationMethod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRenewalFailed provides a mock function for the type Repository
+func (_mock *Repository) SetRenewalFailed(ctx context.Context, badgeID string) error {
+	ret := _mock.Called(ctx, badgeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRenewalFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, badgeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_SetRenewalFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRenewalFailed'
+type Repository_SetRenewalFailed_Call struct {
+	*mock.Call
+}
+
+// SetRenewalFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeID string
+func (_e *Repository_Expecter) SetRenewalFailed(ctx interface{}, badgeID interface{}) *Repository_SetRenewalFailed_Call {
+	return &Repository_SetRenewalFailed_Call{Call: _e.mock.On("SetRenewalFailed", ctx, badgeID)}
+}
+
+func (_c *Repository_SetRenewalFailed_Call) Run(run func(ctx context.Context, badgeID string)) *Repository_SetRenewalFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_SetRenewalFailed_Call) Return(err error) *Repository_SetRenewalFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_SetRenewalFailed_Call) RunAndReturn(run func(ctx context.Context, badgeID string) error) *Repository_SetRenewalFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transaction provides a mock function for the type Repository
+func (_mock *Repository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_Transaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transaction'
+type Repository_Transaction_Call struct {
+	*mock.Call
+}
+
+// Transaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *Repository_Expecter) Transaction(ctx interface{}, fn interface{}) *Repository_Transaction_Call {
+	return &Repository_Transaction_Call{Call: _e.mock.On("Transaction", ctx, fn)}
+}
+
+func (_c *Repository_Transaction_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *Repository_Transaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_Transaction_Call) Return(err error) *Repository_Transaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_Transaction_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *Repository_Transaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type Repository
 func (_mock *Repository) Update(ctx context.Context, badge *types.Badge) error {
 	ret := _mock.Called(ctx, badge)
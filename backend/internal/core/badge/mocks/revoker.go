@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/agntcy/identity-service/internal/core/badge"
 	"github.com/agntcy/identity-service/internal/core/identity"
 	"github.com/agntcy/identity-service/internal/core/idp"
 	"github.com/agntcy/identity/pkg/jwk"
@@ -40,17 +41,104 @@ func (_m *Revoker) EXPECT() *Revoker_Expecter {
 	return &Revoker_Expecter{mock: &_m.Mock}
 }
 
+// Revoke provides a mock function for the type Revoker
+func (_mock *Revoker) Revoke(ctx context.Context, appID string, badgeID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error {
+	ret := _mock.Called(ctx, appID, badgeID, revocation, clientCredentials, issuer, privKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *badge.Revocation, *idp.ClientCredentials, *identity.Issuer, *jwk.Jwk) error); ok {
+		r0 = returnFunc(ctx, appID, badgeID, revocation, clientCredentials, issuer, privKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Revoker_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type Revoker_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - badgeID string
+//   - revocation *badge.Revocation
+//   - clientCredentials *idp.ClientCredentials
+//   - issuer *identity.Issuer
+//   - privKey *jwk.Jwk
+func (_e *Revoker_Expecter) Revoke(ctx interface{}, appID interface{}, badgeID interface{}, revocation interface{}, clientCredentials interface{}, issuer interface{}, privKey interface{}) *Revoker_Revoke_Call {
+	return &Revoker_Revoke_Call{Call: _e.mock.On("Revoke", ctx, appID, badgeID, revocation, clientCredentials, issuer, privKey)}
+}
+
+func (_c *Revoker_Revoke_Call) Run(run func(ctx context.Context, appID string, badgeID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk)) *Revoker_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *badge.Revocation
+		if args[3] != nil {
+			arg3 = args[3].(*badge.Revocation)
+		}
+		var arg4 *idp.ClientCredentials
+		if args[4] != nil {
+			arg4 = args[4].(*idp.ClientCredentials)
+		}
+		var arg5 *identity.Issuer
+		if args[5] != nil {
+			arg5 = args[5].(*identity.Issuer)
+		}
+		var arg6 *jwk.Jwk
+		if args[6] != nil {
+			arg6 = args[6].(*jwk.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *Revoker_Revoke_Call) Return(err error) *Revoker_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Revoker_Revoke_Call) RunAndReturn(run func(ctx context.Context, appID string, badgeID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error) *Revoker_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevokeAll provides a mock function for the type Revoker
-func (_mock *Revoker) RevokeAll(ctx context.Context, appID string, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error {
-	ret := _mock.Called(ctx, appID, clientCredentials, issuer, privKey)
+func (_mock *Revoker) RevokeAll(ctx context.Context, appID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error {
+	ret := _mock.Called(ctx, appID, revocation, clientCredentials, issuer, privKey)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RevokeAll")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *idp.ClientCredentials, *identity.Issuer, *jwk.Jwk) error); ok {
-		r0 = returnFunc(ctx, appID, clientCredentials, issuer, privKey)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *badge.Revocation, *idp.ClientCredentials, *identity.Issuer, *jwk.Jwk) error); ok {
+		r0 = returnFunc(ctx, appID, revocation, clientCredentials, issuer, privKey)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -65,14 +153,15 @@ type Revoker_RevokeAll_Call struct {
 // RevokeAll is a helper method to define mock.On call
 //   - ctx context.Context
 //   - appID string
+//   - revocation *badge.Revocation
 //   - clientCredentials *idp.ClientCredentials
 //   - issuer *identity.Issuer
 //   - privKey *jwk.Jwk
-func (_e *Revoker_Expecter) RevokeAll(ctx interface{}, appID interface{}, clientCredentials interface{}, issuer interface{}, privKey interface{}) *Revoker_RevokeAll_Call {
-	return &Revoker_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, appID, clientCredentials, issuer, privKey)}
+func (_e *Revoker_Expecter) RevokeAll(ctx interface{}, appID interface{}, revocation interface{}, clientCredentials interface{}, issuer interface{}, privKey interface{}) *Revoker_RevokeAll_Call {
+	return &Revoker_RevokeAll_Call{Call: _e.mock.On("RevokeAll", ctx, appID, revocation, clientCredentials, issuer, privKey)}
 }
 
-func (_c *Revoker_RevokeAll_Call) Run(run func(ctx context.Context, appID string, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk)) *Revoker_RevokeAll_Call {
+func (_c *Revoker_RevokeAll_Call) Run(run func(ctx context.Context, appID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk)) *Revoker_RevokeAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -82,17 +171,21 @@ func (_c *Revoker_RevokeAll_Call) Run(run func(ctx context.Context, appID string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *idp.ClientCredentials
+		var arg2 *badge.Revocation
 		if args[2] != nil {
-			arg2 = args[2].(*idp.ClientCredentials)
+			arg2 = args[2].(*badge.Revocation)
 		}
-		var arg3 *identity.Issuer
+		var arg3 *idp.ClientCredentials
 		if args[3] != nil {
-			arg3 = args[3].(*identity.Issuer)
+			arg3 = args[3].(*idp.ClientCredentials)
 		}
-		var arg4 *jwk.Jwk
+		var arg4 *identity.Issuer
 		if args[4] != nil {
-			arg4 = args[4].(*jwk.Jwk)
+			arg4 = args[4].(*identity.Issuer)
+		}
+		var arg5 *jwk.Jwk
+		if args[5] != nil {
+			arg5 = args[5].(*jwk.Jwk)
 		}
 		run(
 			arg0,
@@ -100,6 +193,7 @@ func (_c *Revoker_RevokeAll_Call) Run(run func(ctx context.Context, appID string
 			arg2,
 			arg3,
 			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -110,7 +204,7 @@ func (_c *Revoker_RevokeAll_Call) Return(err error) *Revoker_RevokeAll_Call {
 	return _c
 }
 
-func (_c *Revoker_RevokeAll_Call) RunAndReturn(run func(ctx context.Context, appID string, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error) *Revoker_RevokeAll_Call {
+func (_c *Revoker_RevokeAll_Call) RunAndReturn(run func(ctx context.Context, appID string, revocation *badge.Revocation, clientCredentials *idp.ClientCredentials, issuer *identity.Issuer, privKey *jwk.Jwk) error) *Revoker_RevokeAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
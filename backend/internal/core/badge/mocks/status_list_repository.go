@@ -0,0 +1,301 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewStatusListRepository creates a new instance of StatusListRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusListRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusListRepository {
+	mock := &StatusListRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// StatusListRepository is an autogenerated mock type for the StatusListRepository type
+type StatusListRepository struct {
+	mock.Mock
+}
+
+type StatusListRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusListRepository) EXPECT() *StatusListRepository_Expecter {
+	return &StatusListRepository_Expecter{mock: &_m.Mock}
+}
+
+// AllocateIndex provides a mock function for the type StatusListRepository
+func (_mock *StatusListRepository) AllocateIndex(ctx context.Context, purpose string) (*types.StatusList, int, error) {
+	ret := _mock.Called(ctx, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllocateIndex")
+	}
+
+	var r0 *types.StatusList
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.StatusList, int, error)); ok {
+		return returnFunc(ctx, purpose)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.StatusList); ok {
+		r0 = returnFunc(ctx, purpose)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StatusList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) int); ok {
+		r1 = returnFunc(ctx, purpose)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, purpose)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// StatusListRepository_AllocateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllocateIndex'
+type StatusListRepository_AllocateIndex_Call struct {
+	*mock.Call
+}
+
+// AllocateIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purpose string
+func (_e *StatusListRepository_Expecter) AllocateIndex(ctx interface{}, purpose interface{}) *StatusListRepository_AllocateIndex_Call {
+	return &StatusListRepository_AllocateIndex_Call{Call: _e.mock.On("AllocateIndex", ctx, purpose)}
+}
+
+func (_c *StatusListRepository_AllocateIndex_Call) Run(run func(ctx context.Context, purpose string)) *StatusListRepository_AllocateIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListRepository_AllocateIndex_Call) Return(statusList *types.StatusList, n int, err error) *StatusListRepository_AllocateIndex_Call {
+	_c.Call.Return(statusList, n, err)
+	return _c
+}
+
+func (_c *StatusListRepository_AllocateIndex_Call) RunAndReturn(run func(ctx context.Context, purpose string) (*types.StatusList, int, error)) *StatusListRepository_AllocateIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type StatusListRepository
+func (_mock *StatusListRepository) Create(ctx context.Context, statusList *types.StatusList) error {
+	ret := _mock.Called(ctx, statusList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.StatusList) error); ok {
+		r0 = returnFunc(ctx, statusList)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// StatusListRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type StatusListRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statusList *types.StatusList
+func (_e *StatusListRepository_Expecter) Create(ctx interface{}, statusList interface{}) *StatusListRepository_Create_Call {
+	return &StatusListRepository_Create_Call{Call: _e.mock.On("Create", ctx, statusList)}
+}
+
+func (_c *StatusListRepository_Create_Call) Run(run func(ctx context.Context, statusList *types.StatusList)) *StatusListRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.StatusList
+		if args[1] != nil {
+			arg1 = args[1].(*types.StatusList)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListRepository_Create_Call) Return(err error) *StatusListRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *StatusListRepository_Create_Call) RunAndReturn(run func(ctx context.Context, statusList *types.StatusList) error) *StatusListRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatusList provides a mock function for the type StatusListRepository
+func (_mock *StatusListRepository) GetStatusList(ctx context.Context, id string) (*types.StatusList, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusList")
+	}
+
+	var r0 *types.StatusList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.StatusList, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.StatusList); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StatusList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StatusListRepository_GetStatusList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusList'
+type StatusListRepository_GetStatusList_Call struct {
+	*mock.Call
+}
+
+// GetStatusList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *StatusListRepository_Expecter) GetStatusList(ctx interface{}, id interface{}) *StatusListRepository_GetStatusList_Call {
+	return &StatusListRepository_GetStatusList_Call{Call: _e.mock.On("GetStatusList", ctx, id)}
+}
+
+func (_c *StatusListRepository_GetStatusList_Call) Run(run func(ctx context.Context, id string)) *StatusListRepository_GetStatusList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListRepository_GetStatusList_Call) Return(statusList *types.StatusList, err error) *StatusListRepository_GetStatusList_Call {
+	_c.Call.Return(statusList, err)
+	return _c
+}
+
+func (_c *StatusListRepository_GetStatusList_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.StatusList, error)) *StatusListRepository_GetStatusList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type StatusListRepository
+func (_mock *StatusListRepository) Update(ctx context.Context, id string, update func(statusList *types.StatusList) error) error {
+	ret := _mock.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(statusList *types.StatusList) error) error); ok {
+		r0 = returnFunc(ctx, id, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// StatusListRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type StatusListRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - update func(statusList *types.StatusList) error
+func (_e *StatusListRepository_Expecter) Update(ctx interface{}, id interface{}, update interface{}) *StatusListRepository_Update_Call {
+	return &StatusListRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, update)}
+}
+
+func (_c *StatusListRepository_Update_Call) Run(run func(ctx context.Context, id string, update func(statusList *types.StatusList) error)) *StatusListRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 func(statusList *types.StatusList) error
+		if args[2] != nil {
+			arg2 = args[2].(func(statusList *types.StatusList) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListRepository_Update_Call) Return(err error) *StatusListRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *StatusListRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id string, update func(statusList *types.StatusList) error) error) *StatusListRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
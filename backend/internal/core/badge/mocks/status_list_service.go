@@ -0,0 +1,201 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/jwk"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewStatusListService creates a new instance of StatusListService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusListService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusListService {
+	mock := &StatusListService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// StatusListService is an autogenerated mock type for the StatusListService type
+type StatusListService struct {
+	mock.Mock
+}
+
+type StatusListService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusListService) EXPECT() *StatusListService_Expecter {
+	return &StatusListService_Expecter{mock: &_m.Mock}
+}
+
+// Allocate provides a mock function for the type StatusListService
+func (_mock *StatusListService) Allocate(ctx context.Context, purpose string, issuer string, privKey *jwk.Jwk) (*types.CredentialStatus, error) {
+	ret := _mock.Called(ctx, purpose, issuer, privKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allocate")
+	}
+
+	var r0 *types.CredentialStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *jwk.Jwk) (*types.CredentialStatus, error)); ok {
+		return returnFunc(ctx, purpose, issuer, privKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *jwk.Jwk) *types.CredentialStatus); ok {
+		r0 = returnFunc(ctx, purpose, issuer, privKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CredentialStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *jwk.Jwk) error); ok {
+		r1 = returnFunc(ctx, purpose, issuer, privKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// StatusListService_Allocate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allocate'
+type StatusListService_Allocate_Call struct {
+	*mock.Call
+}
+
+// Allocate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purpose string
+//   - issuer string
+//   - privKey *jwk.Jwk
+func (_e *StatusListService_Expecter) Allocate(ctx interface{}, purpose interface{}, issuer interface{}, privKey interface{}) *StatusListService_Allocate_Call {
+	return &StatusListService_Allocate_Call{Call: _e.mock.On("Allocate", ctx, purpose, issuer, privKey)}
+}
+
+func (_c *StatusListService_Allocate_Call) Run(run func(ctx context.Context, purpose string, issuer string, privKey *jwk.Jwk)) *StatusListService_Allocate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *jwk.Jwk
+		if args[3] != nil {
+			arg3 = args[3].(*jwk.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListService_Allocate_Call) Return(credentialStatus *types.CredentialStatus, err error) *StatusListService_Allocate_Call {
+	_c.Call.Return(credentialStatus, err)
+	return _c
+}
+
+func (_c *StatusListService_Allocate_Call) RunAndReturn(run func(ctx context.Context, purpose string, issuer string, privKey *jwk.Jwk) (*types.CredentialStatus, error)) *StatusListService_Allocate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStatus provides a mock function for the type StatusListService
+func (_mock *StatusListService) SetStatus(ctx context.Context, vc *types.VerifiableCredential, purpose string, value bool, issuer string, privKey *jwk.Jwk) error {
+	ret := _mock.Called(ctx, vc, purpose, value, issuer, privKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.VerifiableCredential, string, bool, string, *jwk.Jwk) error); ok {
+		r0 = returnFunc(ctx, vc, purpose, value, issuer, privKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// StatusListService_SetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStatus'
+type StatusListService_SetStatus_Call struct {
+	*mock.Call
+}
+
+// SetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vc *types.VerifiableCredential
+//   - purpose string
+//   - value bool
+//   - issuer string
+//   - privKey *jwk.Jwk
+func (_e *StatusListService_Expecter) SetStatus(ctx interface{}, vc interface{}, purpose interface{}, value interface{}, issuer interface{}, privKey interface{}) *StatusListService_SetStatus_Call {
+	return &StatusListService_SetStatus_Call{Call: _e.mock.On("SetStatus", ctx, vc, purpose, value, issuer, privKey)}
+}
+
+func (_c *StatusListService_SetStatus_Call) Run(run func(ctx context.Context, vc *types.VerifiableCredential, purpose string, value bool, issuer string, privKey *jwk.Jwk)) *StatusListService_SetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.VerifiableCredential
+		if args[1] != nil {
+			arg1 = args[1].(*types.VerifiableCredential)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 *jwk.Jwk
+		if args[5] != nil {
+			arg5 = args[5].(*jwk.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *StatusListService_SetStatus_Call) Return(err error) *StatusListService_SetStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *StatusListService_SetStatus_Call) RunAndReturn(run func(ctx context.Context, vc *types.VerifiableCredential, purpose string, value bool, issuer string, privKey *jwk.Jwk) error) *StatusListService_SetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,189 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity/pkg/jwk"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewSuspender creates a new instance of Suspender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSuspender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Suspender {
+	mock := &Suspender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Suspender is an autogenerated mock type for the Suspender type
+type Suspender struct {
+	mock.Mock
+}
+
+type Suspender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Suspender) EXPECT() *Suspender_Expecter {
+	return &Suspender_Expecter{mock: &_m.Mock}
+}
+
+// ResumeAll provides a mock function for the type Suspender
+func (_mock *Suspender) ResumeAll(ctx context.Context, appID string, issuer string, privKey *jwk.Jwk) error {
+	ret := _mock.Called(ctx, appID, issuer, privKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *jwk.Jwk) error); ok {
+		r0 = returnFunc(ctx, appID, issuer, privKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Suspender_ResumeAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeAll'
+type Suspender_ResumeAll_Call struct {
+	*mock.Call
+}
+
+// ResumeAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - issuer string
+//   - privKey *jwk.Jwk
+func (_e *Suspender_Expecter) ResumeAll(ctx interface{}, appID interface{}, issuer interface{}, privKey interface{}) *Suspender_ResumeAll_Call {
+	return &Suspender_ResumeAll_Call{Call: _e.mock.On("ResumeAll", ctx, appID, issuer, privKey)}
+}
+
+func (_c *Suspender_ResumeAll_Call) Run(run func(ctx context.Context, appID string, issuer string, privKey *jwk.Jwk)) *Suspender_ResumeAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *jwk.Jwk
+		if args[3] != nil {
+			arg3 = args[3].(*jwk.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Suspender_ResumeAll_Call) Return(err error) *Suspender_ResumeAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Suspender_ResumeAll_Call) RunAndReturn(run func(ctx context.Context, appID string, issuer string, privKey *jwk.Jwk) error) *Suspender_ResumeAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuspendAll provides a mock function for the type Suspender
+func (_mock *Suspender) SuspendAll(ctx context.Context, appID string, suspendedBy string, reason string, issuer string, privKey *jwk.Jwk) error {
+	ret := _mock.Called(ctx, appID, suspendedBy, reason, issuer, privKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuspendAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, *jwk.Jwk) error); ok {
+		r0 = returnFunc(ctx, appID, suspendedBy, reason, issuer, privKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Suspender_SuspendAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendAll'
+type Suspender_SuspendAll_Call struct {
+	*mock.Call
+}
+
+// SuspendAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - suspendedBy string
+//   - reason string
+//   - issuer string
+//   - privKey *jwk.Jwk
+func (_e *Suspender_Expecter) SuspendAll(ctx interface{}, appID interface{}, suspendedBy interface{}, reason interface{}, issuer interface{}, privKey interface{}) *Suspender_SuspendAll_Call {
+	return &Suspender_SuspendAll_Call{Call: _e.mock.On("SuspendAll", ctx, appID, suspendedBy, reason, issuer, privKey)}
+}
+
+func (_c *Suspender_SuspendAll_Call) Run(run func(ctx context.Context, appID string, suspendedBy string, reason string, issuer string, privKey *jwk.Jwk)) *Suspender_SuspendAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 *jwk.Jwk
+		if args[5] != nil {
+			arg5 = args[5].(*jwk.Jwk)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *Suspender_SuspendAll_Call) Return(err error) *Suspender_SuspendAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Suspender_SuspendAll_Call) RunAndReturn(run func(ctx context.Context, appID string, suspendedBy string, reason string, issuer string, privKey *jwk.Jwk) error) *Suspender_SuspendAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,408 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewTrustPolicyRepository creates a new instance of TrustPolicyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrustPolicyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrustPolicyRepository {
+	mock := &TrustPolicyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// TrustPolicyRepository is an autogenerated mock type for the TrustPolicyRepository type
+type TrustPolicyRepository struct {
+	mock.Mock
+}
+
+type TrustPolicyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TrustPolicyRepository) EXPECT() *TrustPolicyRepository_Expecter {
+	return &TrustPolicyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) Create(ctx context.Context, policy *types.TrustPolicy) error {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) error); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TrustPolicyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TrustPolicyRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *types.TrustPolicy
+func (_e *TrustPolicyRepository_Expecter) Create(ctx interface{}, policy interface{}) *TrustPolicyRepository_Create_Call {
+	return &TrustPolicyRepository_Create_Call{Call: _e.mock.On("Create", ctx, policy)}
+}
+
+func (_c *TrustPolicyRepository_Create_Call) Run(run func(ctx context.Context, policy *types.TrustPolicy)) *TrustPolicyRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.TrustPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*types.TrustPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Create_Call) Return(err error) *TrustPolicyRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Create_Call) RunAndReturn(run func(ctx context.Context, policy *types.TrustPolicy) error) *TrustPolicyRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) Delete(ctx context.Context, policy *types.TrustPolicy) error {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) error); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TrustPolicyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TrustPolicyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *types.TrustPolicy
+func (_e *TrustPolicyRepository_Expecter) Delete(ctx interface{}, policy interface{}) *TrustPolicyRepository_Delete_Call {
+	return &TrustPolicyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, policy)}
+}
+
+func (_c *TrustPolicyRepository_Delete_Call) Run(run func(ctx context.Context, policy *types.TrustPolicy)) *TrustPolicyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.TrustPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*types.TrustPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Delete_Call) Return(err error) *TrustPolicyRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, policy *types.TrustPolicy) error) *TrustPolicyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) GetAll(ctx context.Context) ([]*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*types.TrustPolicy); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TrustPolicyRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TrustPolicyRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TrustPolicyRepository_Expecter) GetAll(ctx interface{}) *TrustPolicyRepository_GetAll_Call {
+	return &TrustPolicyRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *TrustPolicyRepository_GetAll_Call) Run(run func(ctx context.Context)) *TrustPolicyRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetAll_Call) Return(trustPolicys []*types.TrustPolicy, err error) *TrustPolicyRepository_GetAll_Call {
+	_c.Call.Return(trustPolicys, err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]*types.TrustPolicy, error)) *TrustPolicyRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) GetByID(ctx context.Context, id string) (*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.TrustPolicy); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TrustPolicyRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type TrustPolicyRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TrustPolicyRepository_Expecter) GetByID(ctx interface{}, id interface{}) *TrustPolicyRepository_GetByID_Call {
+	return &TrustPolicyRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *TrustPolicyRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *TrustPolicyRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetByID_Call) Return(trustPolicy *types.TrustPolicy, err error) *TrustPolicyRepository_GetByID_Call {
+	_c.Call.Return(trustPolicy, err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*types.TrustPolicy, error)) *TrustPolicyRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) GetByName(ctx context.Context, name string) (*types.TrustPolicy, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *types.TrustPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.TrustPolicy, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.TrustPolicy); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TrustPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TrustPolicyRepository_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type TrustPolicyRepository_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *TrustPolicyRepository_Expecter) GetByName(ctx interface{}, name interface{}) *TrustPolicyRepository_GetByName_Call {
+	return &TrustPolicyRepository_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *TrustPolicyRepository_GetByName_Call) Run(run func(ctx context.Context, name string)) *TrustPolicyRepository_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetByName_Call) Return(trustPolicy *types.TrustPolicy, err error) *TrustPolicyRepository_GetByName_Call {
+	_c.Call.Return(trustPolicy, err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_GetByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*types.TrustPolicy, error)) *TrustPolicyRepository_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type TrustPolicyRepository
+func (_mock *TrustPolicyRepository) Update(ctx context.Context, policy *types.TrustPolicy) error {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.TrustPolicy) error); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TrustPolicyRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TrustPolicyRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *types.TrustPolicy
+func (_e *TrustPolicyRepository_Expecter) Update(ctx interface{}, policy interface{}) *TrustPolicyRepository_Update_Call {
+	return &TrustPolicyRepository_Update_Call{Call: _e.mock.On("Update", ctx, policy)}
+}
+
+func (_c *TrustPolicyRepository_Update_Call) Run(run func(ctx context.Context, policy *types.TrustPolicy)) *TrustPolicyRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.TrustPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*types.TrustPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Update_Call) Return(err error) *TrustPolicyRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TrustPolicyRepository_Update_Call) RunAndReturn(run func(ctx context.Context, policy *types.TrustPolicy) error) *TrustPolicyRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -29,6 +29,10 @@ type Badge struct {
 	TenantID          string              `gorm:"not null;type:varchar(256);index"`
 	AppID             string
 	App               app.App
+	ExpiresAt         *time.Time            `gorm:"index:badge_exp_idx"`
+	Discovery         *types.BadgeDiscovery `gorm:"embedded;embeddedPrefix:discovery_"`
+	RenewalFailedAt   *time.Time
+	RenewalFailures   int `gorm:"not null;default:0"`
 }
 
 func (bm *Badge) ToCoreType() *types.Badge {
@@ -63,7 +67,9 @@ func (bm *Badge) ToCoreType() *types.Badge {
 			),
 			Proof: bm.Proof,
 		},
-		AppID: bm.AppID,
+		AppID:     bm.AppID,
+		Discovery: bm.Discovery,
+		TenantID:  bm.TenantID,
 	}
 }
 
@@ -86,14 +92,26 @@ type CredentialStatus struct {
 	Type                   string
 	CreatedAt              time.Time
 	Purpose                types.CredentialStatusPurpose
+	StatusPurpose          string
+	StatusListIndex        string
+	StatusListCredential   string
+	CreatedBy              string
+	Comment                string
+	RevocationReason       types.RevocationReason
 }
 
 func (s *CredentialStatus) ToCoreType() *types.CredentialStatus {
 	return &types.CredentialStatus{
-		ID:        s.ID,
-		Type:      s.Type,
-		CreatedAt: s.CreatedAt,
-		Purpose:   s.Purpose,
+		ID:                   s.ID,
+		Type:                 s.Type,
+		CreatedAt:            s.CreatedAt,
+		Purpose:              s.Purpose,
+		StatusPurpose:        s.StatusPurpose,
+		StatusListIndex:      s.StatusListIndex,
+		StatusListCredential: s.StatusListCredential,
+		CreatedBy:            s.CreatedBy,
+		Comment:              s.Comment,
+		RevocationReason:     s.RevocationReason,
 	}
 }
 
@@ -104,6 +122,11 @@ func newBadgeModel(src *types.Badge, tenantID string) *Badge {
 			Warn("failed to marshal CredentialSubject in badge.postgres.newBadgeModel")
 	}
 
+	var expiresAt *time.Time
+	if value, ok := src.ExpiresAt(); ok {
+		expiresAt = &value
+	}
+
 	return &Badge{
 		ID:                src.ID,
 		CreatedAt:         time.Now().UTC(),
@@ -125,9 +148,11 @@ func newBadgeModel(src *types.Badge, tenantID string) *Badge {
 				return newCredentialStatusModel(status, src.ID)
 			},
 		),
-		Proof:    src.Proof,
-		TenantID: tenantID,
-		AppID:    src.AppID,
+		Proof:     src.Proof,
+		TenantID:  tenantID,
+		AppID:     src.AppID,
+		ExpiresAt: expiresAt,
+		Discovery: src.Discovery,
 	}
 }
 
@@ -146,5 +171,139 @@ func newCredentialStatusModel(src *types.CredentialStatus, verifiableCredentialI
 		VerifiableCredentialID: verifiableCredentialID,
 		CreatedAt:              src.CreatedAt,
 		Purpose:                src.Purpose,
+		StatusPurpose:          src.StatusPurpose,
+		StatusListIndex:        src.StatusListIndex,
+		StatusListCredential:   src.StatusListCredential,
+		CreatedBy:              src.CreatedBy,
+		Comment:                src.Comment,
+		RevocationReason:       src.RevocationReason,
+	}
+}
+
+type StatusList struct {
+	ID          string `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	TenantID    string `gorm:"not null;type:varchar(256);index"`
+	Purpose     string `gorm:"not null"`
+	Bitstring   []byte `gorm:"not null"`
+	Allocations []byte
+	Allocated   int `gorm:"not null"`
+	Credential  json.RawMessage
+}
+
+func (l *StatusList) ToCoreType() *types.StatusList {
+	var credential *types.StatusListCredential
+
+	if len(l.Credential) > 0 {
+		err := json.Unmarshal(l.Credential, &credential)
+		if err != nil {
+			log.WithError(err).
+				Warn("failed to unmarshal Credential in badge.postgres.StatusList.ToCoreType")
+		}
+	}
+
+	return &types.StatusList{
+		ID:          l.ID,
+		Purpose:     l.Purpose,
+		Bitstring:   l.Bitstring,
+		Allocations: l.Allocations,
+		Allocated:   l.Allocated,
+		Credential:  credential,
+	}
+}
+
+func newStatusListModel(src *types.StatusList, tenantID string) *StatusList {
+	credential, err := json.Marshal(src.Credential)
+	if err != nil {
+		log.WithError(err).
+			Warn("failed to marshal Credential in badge.postgres.newStatusListModel")
+	}
+
+	return &StatusList{
+		ID:          src.ID,
+		TenantID:    tenantID,
+		Purpose:     src.Purpose,
+		Bitstring:   src.Bitstring,
+		Allocations: src.Allocations,
+		Allocated:   src.Allocated,
+		Credential:  credential,
+	}
+}
+
+type TrustPolicy struct {
+	ID               string `gorm:"primarykey"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	TenantID         string         `gorm:"not null;type:varchar(256);uniqueIndex:trust_policy_name_idx"`
+	Name             string         `gorm:"not null;uniqueIndex:trust_policy_name_idx"`
+	Issuers          pq.StringArray `gorm:"type:text[]"`
+	BadgeTypes       pq.Int32Array  `gorm:"type:integer[]"`
+	IssuedWithinDays int32
+	RequireActiveApp bool
+}
+
+func (p *TrustPolicy) ToCoreType() *types.TrustPolicy {
+	badgeTypes := make([]types.BadgeType, 0, len(p.BadgeTypes))
+	for _, typ := range p.BadgeTypes {
+		badgeTypes = append(badgeTypes, types.BadgeType(typ))
+	}
+
+	return &types.TrustPolicy{
+		ID:               p.ID,
+		Name:             p.Name,
+		Issuers:          p.Issuers,
+		BadgeTypes:       badgeTypes,
+		IssuedWithinDays: p.IssuedWithinDays,
+		RequireActiveApp: p.RequireActiveApp,
+		CreatedAt:        p.CreatedAt,
+	}
+}
+
+func newTrustPolicyModel(src *types.TrustPolicy, tenantID string) *TrustPolicy {
+	badgeTypes := make(pq.Int32Array, 0, len(src.BadgeTypes))
+	for _, typ := range src.BadgeTypes {
+		badgeTypes = append(badgeTypes, int32(typ))
+	}
+
+	return &TrustPolicy{
+		ID:               src.ID,
+		TenantID:         tenantID,
+		Name:             src.Name,
+		Issuers:          src.Issuers,
+		BadgeTypes:       badgeTypes,
+		IssuedWithinDays: src.IssuedWithinDays,
+		RequireActiveApp: src.RequireActiveApp,
+		CreatedAt:        src.CreatedAt,
+	}
+}
+
+// VerificationMethod is a key of the Data Integrity proofs published by an issuer
+type VerificationMethod struct {
+	ID                 string `gorm:"primarykey"`
+	CreatedAt          time.Time
+	Issuer             string `gorm:"not null;index"`
+	TenantID           string `gorm:"not null;type:varchar(256);index"`
+	Controller         string `gorm:"not null"`
+	PublicKeyMultibase string `gorm:"not null"`
+}
+
+func (m *VerificationMethod) ToCoreType() *types.VerificationMethod {
+	return &types.VerificationMethod{
+		ID:                 m.ID,
+		Type:               types.MultikeyVerificationMethod,
+		Controller:         m.Controller,
+		PublicKeyMultibase: m.PublicKeyMultibase,
+	}
+}
+
+func newVerificationMethodModel(src *types.VerificationMethod, issuer, tenantID string) *VerificationMethod {
+	return &VerificationMethod{
+		ID:                 src.ID,
+		CreatedAt:          time.Now().UTC(),
+		Issuer:             issuer,
+		TenantID:           tenantID,
+		Controller:         src.Controller,
+		PublicKeyMultibase: src.PublicKeyMultibase,
 	}
 }
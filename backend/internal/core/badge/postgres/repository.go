@@ -7,13 +7,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	badgecore "github.com/agntcy/identity-service/internal/core/badge"
 	"github.com/agntcy/identity-service/internal/core/badge/types"
 	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
 	"github.com/agntcy/identity-service/internal/pkg/convertutil"
 	"github.com/agntcy/identity-service/internal/pkg/gormutil"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type postgresRepository struct {
@@ -26,6 +29,10 @@ func NewRepository(dbContext *gorm.DB) badgecore.Repository {
 	}
 }
 
+func (r *postgresRepository) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return gormutil.Transaction(ctx, r.dbContext, fn)
+}
+
 func (r *postgresRepository) Create(ctx context.Context, badge *types.Badge) error {
 	tenantID, ok := identitycontext.GetTenantID(ctx)
 	if !ok {
@@ -34,7 +41,7 @@ func (r *postgresRepository) Create(ctx context.Context, badge *types.Badge) err
 
 	model := newBadgeModel(badge, tenantID)
 
-	result := r.dbContext.Create(model)
+	result := gormutil.DB(ctx, r.dbContext).Create(model)
 	if result.Error != nil {
 		return fmt.Errorf("there was an error creating the badge: %w", result.Error)
 	}
@@ -50,7 +57,7 @@ func (r *postgresRepository) Update(ctx context.Context, badge *types.Badge) err
 
 	model := newBadgeModel(badge, tenantID)
 
-	result := r.dbContext.Save(model)
+	result := gormutil.DB(ctx, r.dbContext).Save(model)
 	if result.Error != nil {
 		return fmt.Errorf("there was an error updating the badge: %w", result.Error)
 	}
@@ -64,7 +71,7 @@ func (r *postgresRepository) GetLatestByAppIdOrResolverMetadataID(
 ) (*types.Badge, error) {
 	var badge Badge
 
-	result := r.dbContext.
+	result := gormutil.DB(ctx, r.dbContext).
 		Scopes(gormutil.BelongsToTenant(ctx)).
 		Where(
 			"app_id = ? OR app_id IN (?)",
@@ -87,27 +94,291 @@ func (r *postgresRepository) GetLatestByAppIdOrResolverMetadataID(
 	return badge.ToCoreType(), nil
 }
 
+func (r *postgresRepository) GetByID(ctx context.Context, id string) (*types.Badge, error) {
+	var badge Badge
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Preload("Status").
+		Preload("CredentialSchema").
+		Where("id = ?", id).
+		First(&badge)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, badgecore.ErrBadgeNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the badge: %w", result.Error)
+	}
+
+	return badge.ToCoreType(), nil
+}
+
+func (r *postgresRepository) ListByAppID(
+	ctx context.Context,
+	appID string,
+	paginationFilter pagination.PaginationFilter,
+) (*pagination.Pageable[types.Badge], error) {
+	dbQuery := gormutil.DB(ctx, r.dbContext).
+		Model(&Badge{}).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Where("app_id = ?", appID).
+		Session(&gorm.Session{}) // https://gorm.io/docs/method_chaining.html#Reusability-and-Safety
+
+	var badges []*Badge
+
+	err := dbQuery.
+		Scopes(gormutil.Paginate(paginationFilter)).
+		Preload("Status").
+		Preload("CredentialSchema").
+		Order("created_at DESC").
+		Find(&badges).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error fetching the badges: %w", err)
+	}
+
+	var totalBadges int64
+
+	err = dbQuery.Count(&totalBadges).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error counting the badges: %w", err)
+	}
+
+	return &pagination.Pageable[types.Badge]{
+		Items: convertutil.ConvertSlice(badges, func(badge *Badge) *types.Badge {
+			return badge.ToCoreType()
+		}),
+		Total: totalBadges,
+		Page:  paginationFilter.GetPage(),
+		Size:  int32(len(badges)),
+	}, nil
+}
+
 func (r *postgresRepository) GetAllActiveBadges(
 	ctx context.Context,
 	appID string,
 ) ([]*types.Badge, error) {
 	var badges []*Badge
 
-	err := r.dbContext.
+	err := gormutil.DB(ctx, r.dbContext).
+		Table("badges").
+		Scopes(gormutil.BelongsToTenantForTable(ctx, "badges"), r.notRevoked).
+		Preload("Status").
+		Where("badges.app_id = ?", appID).
+		Find(&badges).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error fetching the active badges: %w", err)
+	}
+
+	return convertutil.ConvertSlice(badges, func(badge *Badge) *types.Badge {
+		return badge.ToCoreType()
+	}), nil
+}
+
+func (r *postgresRepository) GetBadgesToRenew(
+	ctx context.Context,
+	renewalWindow time.Duration,
+	failedBefore time.Time,
+	maxFailures int,
+	limit int,
+) ([]*types.Badge, error) {
+	var badges []*Badge
+
+	now := time.Now().UTC()
+
+	// The renewal window is capped to a third of the lifetime of the badge
+	// so the short-lived badges are not renewed right after being issued
+	err := gormutil.DB(ctx, r.dbContext).
 		Table("badges").
-		Scopes(gormutil.BelongsToTenantForTable(ctx, "badges")).
-		Joins("LEFT JOIN credential_statuses ON badges.id = credential_statuses.verifiable_credential_id").
+		Joins("JOIN apps ON apps.id = badges.app_id AND apps.deleted_at IS NULL").
 		Where(
-			"badges.app_id = ? AND (credential_statuses.purpose NOT IN (?) OR credential_statuses IS NULL)",
-			appID,
-			types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+			"badges.expires_at IS NOT NULL AND badges.expires_at > ? AND "+
+				"badges.expires_at - LEAST(make_interval(secs => ?), (badges.expires_at - badges.created_at) / 3) <= ?",
+			now,
+			renewalWindow.Seconds(),
+			now,
 		).
+		Where("(badges.renewal_failed_at IS NULL OR badges.renewal_failed_at < ?)", failedBefore).
+		Where("badges.renewal_failures < ?", maxFailures).
+		Scopes(r.notRevoked, r.notSuspended).
+		Order("badges.expires_at").
+		Limit(limit).
+		Select("badges.*").
 		Find(&badges).Error
 	if err != nil {
-		return nil, fmt.Errorf("there was an error fetching the active badges: %w", err)
+		return nil, fmt.Errorf("there was an error fetching the badges to renew: %w", err)
 	}
 
 	return convertutil.ConvertSlice(badges, func(badge *Badge) *types.Badge {
 		return badge.ToCoreType()
 	}), nil
 }
+
+func (r *postgresRepository) SetRenewalFailed(ctx context.Context, badgeID string) error {
+	result := gormutil.DB(ctx, r.dbContext).
+		Model(&Badge{}).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Where("id = ?", badgeID).
+		Updates(map[string]any{
+			"renewal_failed_at": time.Now().UTC(),
+			"renewal_failures":  gorm.Expr("renewal_failures + 1"),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("there was an error updating the badge: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteStatuses(
+	ctx context.Context,
+	badgeID string,
+	purpose types.CredentialStatusPurpose,
+) error {
+	result := gormutil.DB(ctx, r.dbContext).
+		Where(
+			"verifiable_credential_id IN (?) AND purpose = ?",
+			r.dbContext.
+				Table("badges").
+				Scopes(gormutil.BelongsToTenant(ctx)).
+				Select("id").
+				Where("id = ?", badgeID),
+			purpose,
+		).
+		Delete(&CredentialStatus{})
+	if result.Error != nil {
+		return fmt.Errorf("there was an error deleting the badge statuses: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetRevocationStatus(
+	ctx context.Context,
+	badgeID string,
+) (*types.CredentialStatus, error) {
+	var status CredentialStatus
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Where("verifiable_credential_id = ? AND purpose = ?", badgeID, types.CREDENTIAL_STATUS_PURPOSE_REVOCATION).
+		Order("created_at").
+		First(&status)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, badgecore.ErrCredentialStatusNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the revocation status: %w", result.Error)
+	}
+
+	return status.ToCoreType(), nil
+}
+
+func (r *postgresRepository) GetProof(
+	ctx context.Context,
+	badgeID string,
+) (*types.Proof, error) {
+	var badge Badge
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Where("id = ?", badgeID).
+		First(&badge)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, badgecore.ErrBadgeNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the proof of the badge: %w", result.Error)
+	}
+
+	return badge.Proof, nil
+}
+
+func (r *postgresRepository) GetTenantID(
+	ctx context.Context,
+	badgeID string,
+) (string, error) {
+	var badge Badge
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Select("tenant_id").
+		Where("id = ?", badgeID).
+		First(&badge)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return "", badgecore.ErrBadgeNotFound
+		}
+
+		return "", fmt.Errorf("there was an error fetching the tenant of the badge: %w", result.Error)
+	}
+
+	return badge.TenantID, nil
+}
+
+func (r *postgresRepository) SaveVerificationMethod(
+	ctx context.Context,
+	issuer string,
+	method *types.VerificationMethod,
+) error {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(newVerificationMethodModel(method, issuer, tenantID))
+	if result.Error != nil {
+		return fmt.Errorf("there was an error storing the verification method: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetVerificationMethods(
+	ctx context.Context,
+	issuer string,
+) ([]*types.VerificationMethod, error) {
+	var methods []*VerificationMethod
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Where("issuer = ?", issuer).
+		Order("created_at").
+		Find(&methods)
+	if result.Error != nil {
+		return nil, fmt.Errorf("there was an error fetching the verification methods: %w", result.Error)
+	}
+
+	return convertutil.ConvertSlice(methods, func(m *VerificationMethod) *types.VerificationMethod {
+		return m.ToCoreType()
+	}), nil
+}
+
+// notRevoked filters out the badges with a revocation status. The badges can have several
+// statuses, such as their status list entries, so they cannot be filtered with a join.
+func (r *postgresRepository) notRevoked(db *gorm.DB) *gorm.DB {
+	return db.Where(
+		"NOT EXISTS (?)",
+		r.dbContext.
+			Table("credential_statuses").
+			Select("1").
+			Where(
+				"credential_statuses.verifiable_credential_id = badges.id AND credential_statuses.purpose = ?",
+				types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+			),
+	)
+}
+
+// notSuspended filters out the badges with a suspension status
+func (r *postgresRepository) notSuspended(db *gorm.DB) *gorm.DB {
+	return db.Where(
+		"NOT EXISTS (?)",
+		r.dbContext.
+			Table("credential_statuses").
+			Select("1").
+			Where(
+				"credential_statuses.verifiable_credential_id = badges.id AND credential_statuses.purpose = ?",
+				types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+			),
+	)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/gormutil"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type statusListRepository struct {
+	dbContext *gorm.DB
+}
+
+func NewStatusListRepository(dbContext *gorm.DB) badgecore.StatusListRepository {
+	return &statusListRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *statusListRepository) AllocateIndex(
+	ctx context.Context,
+	purpose string,
+) (*types.StatusList, int, error) {
+	var (
+		model StatusList
+		index int
+	)
+
+	err := gormutil.DB(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scopes(gormutil.BelongsToTenant(ctx)).
+			Where("purpose = ? AND allocated < octet_length(bitstring) * 8", purpose).
+			Order("created_at").
+			First(&model)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return badgecore.ErrStatusListNotFound
+			}
+
+			return result.Error
+		}
+
+		statusList := model.ToCoreType()
+
+		var err error
+
+		index, err = statusList.Allocate()
+		if err != nil {
+			return err
+		}
+
+		model.Allocations = statusList.Allocations
+		model.Allocated = statusList.Allocated
+
+		return tx.Model(&model).Updates(map[string]any{
+			"allocations": model.Allocations,
+			"allocated":   model.Allocated,
+		}).Error
+	})
+	if err != nil {
+		if errors.Is(err, badgecore.ErrStatusListNotFound) {
+			return nil, 0, err
+		}
+
+		return nil, 0, fmt.Errorf("there was an error allocating a status list index: %w", err)
+	}
+
+	return model.ToCoreType(), index, nil
+}
+
+func (r *statusListRepository) Create(ctx context.Context, statusList *types.StatusList) error {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	model := newStatusListModel(statusList, tenantID)
+
+	result := gormutil.DB(ctx, r.dbContext).Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("there was an error creating the status list: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *statusListRepository) Update(
+	ctx context.Context,
+	id string,
+	update func(statusList *types.StatusList) error,
+) error {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	return gormutil.DB(ctx, r.dbContext).Transaction(func(tx *gorm.DB) error {
+		var model StatusList
+
+		result := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND tenant_id = ?", id, tenantID).
+			First(&model)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return badgecore.ErrStatusListNotFound
+			}
+
+			return fmt.Errorf("there was an error fetching the status list: %w", result.Error)
+		}
+
+		statusList := model.ToCoreType()
+
+		err := update(statusList)
+		if err != nil {
+			return err
+		}
+
+		updated := newStatusListModel(statusList, tenantID)
+		updated.CreatedAt = model.CreatedAt
+
+		result = tx.Save(updated)
+		if result.Error != nil {
+			return fmt.Errorf("there was an error updating the status list: %w", result.Error)
+		}
+
+		return nil
+	})
+}
+
+func (r *statusListRepository) GetStatusList(ctx context.Context, id string) (*types.StatusList, error) {
+	var statusList StatusList
+
+	result := gormutil.DB(ctx, r.dbContext).
+		Where("id = ?", id).
+		First(&statusList)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, badgecore.ErrStatusListNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the status list: %w", result.Error)
+	}
+
+	return statusList.ToCoreType(), nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	badgecore "github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/internal/pkg/convertutil"
+	"github.com/agntcy/identity-service/internal/pkg/gormutil"
+	"gorm.io/gorm"
+)
+
+type trustPolicyRepository struct {
+	dbContext *gorm.DB
+}
+
+func NewTrustPolicyRepository(dbContext *gorm.DB) badgecore.TrustPolicyRepository {
+	return &trustPolicyRepository{
+		dbContext: dbContext,
+	}
+}
+
+func (r *trustPolicyRepository) Create(ctx context.Context, policy *types.TrustPolicy) error {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	model := newTrustPolicyModel(policy, tenantID)
+
+	result := r.dbContext.WithContext(ctx).Create(model)
+	if result.Error != nil {
+		return fmt.Errorf("there was an error creating the trust policy: %w", result.Error)
+	}
+
+	policy.CreatedAt = model.CreatedAt
+
+	return nil
+}
+
+func (r *trustPolicyRepository) Update(ctx context.Context, policy *types.TrustPolicy) error {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return identitycontext.ErrTenantNotFound
+	}
+
+	model := newTrustPolicyModel(policy, tenantID)
+
+	result := r.dbContext.WithContext(ctx).Save(model)
+	if result.Error != nil {
+		return fmt.Errorf("there was an error updating the trust policy: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *trustPolicyRepository) Delete(ctx context.Context, policy *types.TrustPolicy) error {
+	result := r.dbContext.
+		WithContext(ctx).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Where("id = ?", policy.ID).
+		Delete(&TrustPolicy{})
+	if result.Error != nil {
+		return fmt.Errorf("there was an error deleting the trust policy: %w", result.Error)
+	}
+
+	return nil
+}
+
+func (r *trustPolicyRepository) GetByID(ctx context.Context, id string) (*types.TrustPolicy, error) {
+	return r.getBy(ctx, "id = ?", id)
+}
+
+func (r *trustPolicyRepository) GetByName(ctx context.Context, name string) (*types.TrustPolicy, error) {
+	return r.getBy(ctx, "name = ?", name)
+}
+
+func (r *trustPolicyRepository) GetAll(ctx context.Context) ([]*types.TrustPolicy, error) {
+	var policies []*TrustPolicy
+
+	result := r.dbContext.
+		WithContext(ctx).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Order("name").
+		Find(&policies)
+	if result.Error != nil {
+		return nil, fmt.Errorf("there was an error fetching the trust policies: %w", result.Error)
+	}
+
+	return convertutil.ConvertSlice(policies, func(policy *TrustPolicy) *types.TrustPolicy {
+		return policy.ToCoreType()
+	}), nil
+}
+
+func (r *trustPolicyRepository) getBy(ctx context.Context, query string, arg string) (*types.TrustPolicy, error) {
+	var policy TrustPolicy
+
+	result := r.dbContext.
+		WithContext(ctx).
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		Where(query, arg).
+		First(&policy)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, badgecore.ErrTrustPolicyNotFound
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the trust policy: %w", result.Error)
+	}
+
+	return policy.ToCoreType(), nil
+}
@@ -6,15 +6,97 @@ package badge
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/pagination"
 )
 
 type Repository interface {
+	// Transaction runs fn in a transaction joined by the badge and the status list
+	// repositories through the context, the transaction is rolled back when fn fails
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
 	Create(ctx context.Context, badge *types.Badge) error
 	Update(ctx context.Context, badge *types.Badge) error
 	GetLatestByAppIdOrResolverMetadataID(ctx context.Context, id string) (*types.Badge, error)
+	GetByID(ctx context.Context, id string) (*types.Badge, error)
+
+	// ListByAppID returns all the badges issued to the app, including the revoked ones,
+	// starting from the latest
+	ListByAppID(
+		ctx context.Context,
+		appID string,
+		paginationFilter pagination.PaginationFilter,
+	) (*pagination.Pageable[types.Badge], error)
 	GetAllActiveBadges(ctx context.Context, appID string) ([]*types.Badge, error)
+
+	// GetBadgesToRenew returns the active badges of all the tenants entering
+	// their renewal window, skipping the expired badges, the badges of deleted apps,
+	// the badges whose renewal failed after failedBefore and the badges whose renewal
+	// failed maxFailures times
+	GetBadgesToRenew(
+		ctx context.Context,
+		renewalWindow time.Duration,
+		failedBefore time.Time,
+		maxFailures int,
+		limit int,
+	) ([]*types.Badge, error)
+
+	// SetRenewalFailed records a failed renewal of the badge
+	SetRenewalFailed(ctx context.Context, badgeID string) error
+
+	// DeleteStatuses removes the statuses of the badge with the given purpose
+	DeleteStatuses(ctx context.Context, badgeID string, purpose types.CredentialStatusPurpose) error
+
+	// GetRevocationStatus returns the revocation status of a badge of any tenant,
+	// ErrCredentialStatusNotFound is returned when the badge is not revoked
+	GetRevocationStatus(ctx context.Context, badgeID string) (*types.CredentialStatus, error)
+
+	// GetProof returns the proof of a badge of any tenant
+	GetProof(ctx context.Context, badgeID string) (*types.Proof, error)
+
+	// GetTenantID returns the tenant that issued a badge of any tenant
+	GetTenantID(ctx context.Context, badgeID string) (string, error)
+
+	// SaveVerificationMethod publishes a key of the Data Integrity proofs of the issuer,
+	// the verification methods already published are kept
+	SaveVerificationMethod(ctx context.Context, issuer string, method *types.VerificationMethod) error
+
+	// GetVerificationMethods returns the keys published by the issuer in all the tenants
+	GetVerificationMethods(ctx context.Context, issuer string) ([]*types.VerificationMethod, error)
+}
+
+type StatusListRepository interface {
+	// AllocateIndex reserves an index picked at random in a status list of the tenant
+	// with the given purpose, ErrStatusListNotFound is returned when all the lists are full.
+	// The index is released when the transaction of the context is rolled back.
+	AllocateIndex(ctx context.Context, purpose string) (*types.StatusList, int, error)
+	Create(ctx context.Context, statusList *types.StatusList) error
+
+	// Update locks the status list of the tenant while the update function
+	// changes it, the status list is saved when the function succeeds
+	Update(ctx context.Context, id string, update func(statusList *types.StatusList) error) error
+
+	// GetStatusList returns a status list of any tenant
+	GetStatusList(ctx context.Context, id string) (*types.StatusList, error)
 }
 
-var ErrBadgeNotFound = errors.New("badge not found")
+// TrustPolicyRepository stores the trust policies of the tenant
+type TrustPolicyRepository interface {
+	Create(ctx context.Context, policy *types.TrustPolicy) error
+	Update(ctx context.Context, policy *types.TrustPolicy) error
+	Delete(ctx context.Context, policy *types.TrustPolicy) error
+	GetByID(ctx context.Context, id string) (*types.TrustPolicy, error)
+	GetByName(ctx context.Context, name string) (*types.TrustPolicy, error)
+	GetAll(ctx context.Context) ([]*types.TrustPolicy, error)
+}
+
+var (
+	ErrBadgeNotFound       = errors.New("badge not found")
+	ErrStatusListNotFound  = errors.New("status list not found")
+	ErrTrustPolicyNotFound = errors.New("trust policy not found")
+
+	ErrCredentialStatusNotFound = errors.New("credential status not found")
+	ErrBadgeAlreadyRevoked      = errors.New("badge already revoked")
+)
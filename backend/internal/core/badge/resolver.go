@@ -0,0 +1,327 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/httputil"
+	"github.com/agntcy/identity-service/pkg/log"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// The path of the JWKS of an issuer on the identity node
+	issuerJwksPath = "/v1alpha1/issuer/%s/.well-known/jwks.json"
+
+	// The maximum size of the JWKS and the status lists
+	maxResponseSize = 1 << 20
+)
+
+var (
+	ErrIssuerKeyNotFound  = errors.New("no key of the issuer found for the badge key ID")
+	ErrInvalidStatusEntry = errors.New("invalid status list entry")
+	ErrResponseTooLarge   = errors.New("the response exceeds the maximum size")
+)
+
+// IssuerResolver resolves the public keys and the status lists of the issuers of the badges
+// issued by other services, to verify them without the identity node.
+type IssuerResolver interface {
+	// ResolveKey returns the key with the ID of the JWKS published by the issuer on the identity node
+	ResolveKey(ctx context.Context, issuer, keyID string) (jwxjwk.Key, error)
+
+	// ResolveStatus returns the status of the status list entry of a badge, reading
+	// the status list credential signed by the issuer of the badge
+	ResolveStatus(ctx context.Context, issuer string, status *types.CredentialStatus) (bool, error)
+}
+
+type cachedValue[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// cache holds the fetched values, the concurrent fetches of the same value are shared
+type cache[T any] struct {
+	values  map[string]*cachedValue[T]
+	fetches singleflight.Group
+}
+
+type issuerResolver struct {
+	identityNodeURL  string
+	cacheTTL         time.Duration
+	maxStaleness     time.Duration
+	nodeClient       *http.Client
+	statusListClient *http.Client
+	mu               sync.Mutex
+	keySets          cache[jwxjwk.Set]
+	statusLists      cache[*types.StatusList]
+}
+
+type IssuerResolverOption func(r *issuerResolver)
+
+// WithHTTPClient replaces the clients reaching the identity node and the status lists.
+// The status lists are still only fetched from https URLs.
+func WithHTTPClient(client *http.Client) IssuerResolverOption {
+	return func(r *issuerResolver) {
+		r.nodeClient = client
+		r.statusListClient = client
+	}
+}
+
+// NewIssuerResolver returns an IssuerResolver caching the JWKS of the issuers and the status
+// lists for the TTL. The cached values are still served while the issuers are unreachable,
+// until maxStaleness after they were fetched. The status lists are referenced by the badges,
+// they are only fetched over https from public addresses.
+func NewIssuerResolver(
+	identityNodeURL string,
+	cacheTTL time.Duration,
+	maxStaleness time.Duration,
+	opts ...IssuerResolverOption,
+) IssuerResolver {
+	r := &issuerResolver{
+		identityNodeURL:  strings.TrimSuffix(identityNodeURL, "/"),
+		cacheTTL:         cacheTTL,
+		maxStaleness:     maxStaleness,
+		nodeClient:       &http.Client{Timeout: httputil.Timeout * time.Second},
+		statusListClient: httputil.NewPublicClient(),
+		keySets:          cache[jwxjwk.Set]{values: make(map[string]*cachedValue[jwxjwk.Set])},
+		statusLists:      cache[*types.StatusList]{values: make(map[string]*cachedValue[*types.StatusList])},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *issuerResolver) ResolveKey(ctx context.Context, issuer, keyID string) (jwxjwk.Key, error) {
+	if issuer == "" || keyID == "" {
+		return nil, ErrIssuerKeyNotFound
+	}
+
+	set, err := r.getKeySet(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := set.LookupKeyID(keyID)
+	if !ok {
+		return nil, ErrIssuerKeyNotFound
+	}
+
+	return key, nil
+}
+
+func (r *issuerResolver) ResolveStatus(
+	ctx context.Context,
+	issuer string,
+	status *types.CredentialStatus,
+) (bool, error) {
+	index, err := strconv.Atoi(status.StatusListIndex)
+	if err != nil || status.StatusListCredential == "" {
+		return false, ErrInvalidStatusEntry
+	}
+
+	statusList, err := r.getStatusList(ctx, issuer, status.StatusListCredential)
+	if err != nil {
+		return false, err
+	}
+
+	if statusList.Purpose != status.StatusPurpose {
+		return false, fmt.Errorf("%w: the purpose does not match the status list", ErrInvalidStatusEntry)
+	}
+
+	return statusList.Get(index)
+}
+
+func (r *issuerResolver) getKeySet(ctx context.Context, issuer string) (jwxjwk.Set, error) {
+	return getCached(ctx, r, &r.keySets, issuer, func() (jwxjwk.Set, error) {
+		return r.fetchKeySet(ctx, issuer)
+	})
+}
+
+func (r *issuerResolver) getStatusList(ctx context.Context, issuer, uri string) (*types.StatusList, error) {
+	return getCached(ctx, r, &r.statusLists, uri, func() (*types.StatusList, error) {
+		return r.fetchStatusList(ctx, issuer, uri)
+	})
+}
+
+// getCached returns the cached value when it is fresh, or fetches it. The stale value
+// is returned when the fetch fails, unless it was fetched more than maxStaleness ago.
+func getCached[T any](
+	ctx context.Context,
+	r *issuerResolver,
+	c *cache[T],
+	key string,
+	fetch func() (T, error),
+) (T, error) {
+	r.mu.Lock()
+	cached, ok := c.values[key]
+	r.mu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < r.cacheTTL {
+		return cached.value, nil
+	}
+
+	fetched, err, _ := c.fetches.Do(key, func() (any, error) {
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		c.values[key] = &cachedValue[T]{value: value, fetchedAt: time.Now()}
+		r.mu.Unlock()
+
+		return value, nil
+	})
+	if err != nil {
+		if ok && time.Since(cached.fetchedAt) < r.maxStaleness {
+			log.FromContext(ctx).WithError(err).Warn("unable to refresh the cached value of ", key)
+			return cached.value, nil
+		}
+
+		var zero T
+
+		return zero, err
+	}
+
+	value, _ := fetched.(T)
+
+	return value, nil
+}
+
+func (r *issuerResolver) fetchKeySet(ctx context.Context, issuer string) (jwxjwk.Set, error) {
+	var wellKnown struct {
+		Jwks json.RawMessage `json:"jwks"`
+	}
+
+	body, err := fetch(ctx, r.nodeClient, r.identityNodeURL+fmt.Sprintf(issuerJwksPath, url.PathEscape(issuer)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the JWKS of the issuer %s: %w", issuer, err)
+	}
+
+	err = json.Unmarshal(body, &wellKnown)
+	if err != nil || len(wellKnown.Jwks) == 0 {
+		return nil, fmt.Errorf("invalid JWKS for the issuer %s: %w", issuer, err)
+	}
+
+	set, err := jwxjwk.Parse(wellKnown.Jwks)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS for the issuer %s: %w", issuer, err)
+	}
+
+	return set, nil
+}
+
+// fetchStatusList fetches the status list credential and verifies that it is signed
+// by the issuer of the badge, only the signed claims of the credential are read
+func (r *issuerResolver) fetchStatusList(ctx context.Context, issuer, uri string) (*types.StatusList, error) {
+	statusListURL, err := url.Parse(uri)
+	if err != nil || statusListURL.Scheme != "https" {
+		return nil, fmt.Errorf("%w: the status list %s is not an https URL", ErrInvalidStatusEntry, uri)
+	}
+
+	body, err := fetch(ctx, r.statusListClient, uri)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the status list %s: %w", uri, err)
+	}
+
+	var unverified types.StatusListCredential
+
+	err = json.Unmarshal(body, &unverified)
+	if err != nil || unverified.Proof == nil || !unverified.Proof.IsJOSE() {
+		return nil, fmt.Errorf("invalid status list credential %s: %w", uri, err)
+	}
+
+	msg, err := jws.Parse([]byte(unverified.Proof.ProofValue))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, fmt.Errorf("invalid status list credential %s: %w", uri, err)
+	}
+
+	keyID, _ := msg.Signatures()[0].ProtectedHeaders().KeyID()
+
+	key, err := r.ResolveKey(ctx, issuer, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := verifyJWS(unverified.Proof.ProofValue, key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid status list credential %s: %w", uri, err)
+	}
+
+	var credential types.StatusListCredential
+
+	err = json.Unmarshal(payload, &credential)
+	if err != nil || credential.CredentialSubject == nil {
+		return nil, fmt.Errorf("invalid status list credential %s: %w", uri, err)
+	}
+
+	if credential.Issuer != issuer || credential.ID != uri {
+		return nil, fmt.Errorf("the status list credential %s was not issued by %s", uri, issuer)
+	}
+
+	bitstring, err := types.DecodeBitstring(credential.CredentialSubject.EncodedList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StatusList{
+		ID:        credential.ID,
+		Purpose:   credential.CredentialSubject.StatusPurpose,
+		Bitstring: bitstring,
+	}, nil
+}
+
+// fetch reads the response within the timeout, up to maxResponseSize
+func fetch(ctx context.Context, client *http.Client, uri string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, httputil.Timeout*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxResponseSize {
+		return nil, ErrResponseTooLarge
+	}
+
+	return body, nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/httputil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const revokedIndex = 7
+
+// newIssuerServer is a local stand-in for the JWKS of an issuer on the identity node
+// and its revocation status list, where the status at revokedIndex is set
+func newIssuerServer(t *testing.T, privKey, statusListKey *jwk.Jwk) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/v1alpha1/issuer/issuer/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jwks": map[string]any{"keys": []*jwk.Jwk{privKey.PublicKey()}},
+		})
+	})
+
+	statusList := types.NewStatusList("1", types.StatusPurposeRevocation, 16)
+	require.NoError(t, statusList.Set(revokedIndex, true))
+
+	encodedList, err := types.EncodeBitstring(statusList.Bitstring)
+	require.NoError(t, err)
+
+	credential := types.StatusListCredential{
+		ID:     srv.URL + "/status-lists/1",
+		Type:   []string{"VerifiableCredential", types.BitstringStatusListCredentialType},
+		Issuer: "issuer",
+		CredentialSubject: &types.BitstringStatusList{
+			Type:          types.BitstringStatusListType,
+			StatusPurpose: types.StatusPurposeRevocation,
+			EncodedList:   encodedList,
+		},
+	}
+
+	payload, _ := json.Marshal(&credential)
+	signed, err := joseutil.Sign(statusListKey, payload)
+	require.NoError(t, err)
+
+	credential.Proof = &types.Proof{Type: types.JoseProof, ProofValue: string(signed)}
+
+	mux.HandleFunc("/status-lists/1", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&credential)
+	})
+
+	return srv
+}
+
+func TestIssuerResolver_ResolveKey_should_return_the_key_of_the_issuer(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	srv := newIssuerServer(t, privKey, privKey)
+	sut := badge.NewIssuerResolver(srv.URL, time.Hour, time.Hour, badge.WithHTTPClient(srv.Client()))
+
+	key, err := sut.ResolveKey(context.Background(), "issuer", "key_id")
+
+	assert.NoError(t, err)
+
+	keyID, _ := key.KeyID()
+	assert.Equal(t, "key_id", keyID)
+
+	_, err = sut.ResolveKey(context.Background(), "issuer", "other_key_id")
+
+	assert.ErrorIs(t, err, badge.ErrIssuerKeyNotFound)
+}
+
+func TestIssuerResolver_ResolveKey_should_serve_the_cached_keys_when_the_node_is_unreachable(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	srv := newIssuerServer(t, privKey, privKey)
+	sut := badge.NewIssuerResolver(srv.URL, 0, time.Hour, badge.WithHTTPClient(srv.Client()))
+
+	_, err := sut.ResolveKey(context.Background(), "issuer", "key_id")
+	require.NoError(t, err)
+
+	srv.Close()
+
+	_, err = sut.ResolveKey(context.Background(), "issuer", "key_id")
+
+	assert.NoError(t, err)
+}
+
+func TestIssuerResolver_ResolveKey_should_not_serve_the_keys_cached_for_longer_than_the_max_staleness(
+	t *testing.T,
+) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	srv := newIssuerServer(t, privKey, privKey)
+	sut := badge.NewIssuerResolver(srv.URL, 0, 0, badge.WithHTTPClient(srv.Client()))
+
+	_, err := sut.ResolveKey(context.Background(), "issuer", "key_id")
+	require.NoError(t, err)
+
+	srv.Close()
+
+	_, err = sut.ResolveKey(context.Background(), "issuer", "key_id")
+
+	assert.Error(t, err)
+}
+
+func TestIssuerResolver_ResolveKey_should_return_err_when_the_response_is_too_large(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(bytes.Repeat([]byte(" "), 2<<20))
+	}))
+	t.Cleanup(srv.Close)
+
+	sut := badge.NewIssuerResolver(srv.URL, time.Hour, time.Hour)
+
+	_, err := sut.ResolveKey(context.Background(), "issuer", "key_id")
+
+	assert.ErrorIs(t, err, badge.ErrResponseTooLarge)
+}
+
+func TestIssuerResolver_ResolveStatus(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	otherKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	testCases := map[string]*struct {
+		statusListKey *jwk.Jwk
+		index         string
+		purpose       string
+		expected      bool
+		expectedErr   bool
+	}{
+		"revoked badge": {
+			statusListKey: privKey,
+			index:         "7",
+			purpose:       types.StatusPurposeRevocation,
+			expected:      true,
+		},
+		"active badge": {
+			statusListKey: privKey,
+			index:         "8",
+			purpose:       types.StatusPurposeRevocation,
+			expected:      false,
+		},
+		"another purpose": {
+			statusListKey: privKey,
+			index:         "7",
+			purpose:       types.StatusPurposeSuspension,
+			expectedErr:   true,
+		},
+		"status list signed with another key": {
+			statusListKey: otherKey,
+			index:         "7",
+			purpose:       types.StatusPurposeRevocation,
+			expectedErr:   true,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newIssuerServer(t, privKey, tc.statusListKey)
+			sut := badge.NewIssuerResolver(srv.URL, time.Hour, time.Hour, badge.WithHTTPClient(srv.Client()))
+
+			revoked, err := sut.ResolveStatus(context.Background(), "issuer", &types.CredentialStatus{
+				Type:                 types.BitstringStatusListEntryType,
+				StatusPurpose:        tc.purpose,
+				StatusListIndex:      tc.index,
+				StatusListCredential: srv.URL + "/status-lists/1",
+			})
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, revoked)
+			}
+		})
+	}
+}
+
+func TestIssuerResolver_ResolveStatus_should_only_fetch_public_https_urls(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		uri         string
+		expectedErr error
+	}{
+		"http url": {
+			uri:         "http://example.com/status-lists/1",
+			expectedErr: badge.ErrInvalidStatusEntry,
+		},
+		"loopback address": {
+			uri:         "https://127.0.0.1/status-lists/1",
+			expectedErr: httputil.ErrNonPublicAddress,
+		},
+		"private address": {
+			uri:         "https://10.0.0.1/status-lists/1",
+			expectedErr: httputil.ErrNonPublicAddress,
+		},
+		"link-local address": {
+			uri:         "https://169.254.169.254/status-lists/1",
+			expectedErr: httputil.ErrNonPublicAddress,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			sut := badge.NewIssuerResolver("http://localhost", time.Hour, time.Hour)
+
+			_, err := sut.ResolveStatus(context.Background(), "issuer", &types.CredentialStatus{
+				Type:                 types.BitstringStatusListEntryType,
+				StatusPurpose:        types.StatusPurposeRevocation,
+				StatusListIndex:      "7",
+				StatusListCredential: tc.uri,
+			})
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestIssuerResolver_ResolveKey_should_share_the_concurrent_fetches(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	var fetches atomic.Int32
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		<-release
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jwks": map[string]any{"keys": []*jwk.Jwk{privKey.PublicKey()}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	sut := badge.NewIssuerResolver(srv.URL, time.Hour, time.Hour, badge.WithHTTPClient(srv.Client()))
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := sut.ResolveKey(context.Background(), "issuer", "key_id")
+			assert.NoError(t, err)
+		}()
+	}
+
+	assert.Eventually(t, func() bool { return fetches.Load() == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), fetches.Load())
+}
@@ -15,10 +15,30 @@ import (
 	"github.com/google/uuid"
 )
 
+// Revocation describes why the badges are revoked and who revoked them
+type Revocation struct {
+	Reason    types.RevocationReason
+	Comment   string
+	RevokedBy string
+}
+
 type Revoker interface {
 	RevokeAll(
 		ctx context.Context,
 		appID string,
+		revocation *Revocation,
+		clientCredentials *idpcore.ClientCredentials,
+		issuer *identitycore.Issuer,
+		privKey *jwk.Jwk,
+	) error
+
+	// Revoke revokes a badge of the app, ErrBadgeNotFound is returned
+	// when the badge does not belong to the app
+	Revoke(
+		ctx context.Context,
+		appID string,
+		badgeID string,
+		revocation *Revocation,
 		clientCredentials *idpcore.ClientCredentials,
 		issuer *identitycore.Issuer,
 		privKey *jwk.Jwk,
@@ -26,23 +46,27 @@ type Revoker interface {
 }
 
 type revoker struct {
-	badgeRepository Repository
-	identityService identitycore.Service
+	badgeRepository   Repository
+	identityService   identitycore.Service
+	statusListService StatusListService
 }
 
 func NewRevoker(
 	badgeRepository Repository,
 	identityService identitycore.Service,
+	statusListService StatusListService,
 ) Revoker {
 	return &revoker{
-		badgeRepository: badgeRepository,
-		identityService: identityService,
+		badgeRepository:   badgeRepository,
+		identityService:   identityService,
+		statusListService: statusListService,
 	}
 }
 
 func (s *revoker) RevokeAll(
 	ctx context.Context,
 	appID string,
+	revocation *Revocation,
 	clientCredentials *idpcore.ClientCredentials,
 	issuer *identitycore.Issuer,
 	privKey *jwk.Jwk,
@@ -53,32 +77,85 @@ func (s *revoker) RevokeAll(
 	}
 
 	for _, badge := range badges {
-		err := revoke(badge, privKey)
+		err := s.revokeBadge(ctx, badge, revocation, clientCredentials, issuer, privKey)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = s.identityService.RevokeVerifiableCredential(
-			ctx,
-			clientCredentials,
-			&badge.VerifiableCredential,
-			issuer,
-		)
-		if err != nil {
-			return fmt.Errorf("identity service failed to revoke badge %s: %w", badge.ID, err)
-		}
+	return nil
+}
 
-		err = s.badgeRepository.Update(ctx, badge)
-		if err != nil {
-			return fmt.Errorf("repository failed to save revoked badge %s: %w", badge.ID, err)
-		}
+func (s *revoker) Revoke(
+	ctx context.Context,
+	appID string,
+	badgeID string,
+	revocation *Revocation,
+	clientCredentials *idpcore.ClientCredentials,
+	issuer *identitycore.Issuer,
+	privKey *jwk.Jwk,
+) error {
+	badge, err := s.badgeRepository.GetByID(ctx, badgeID)
+	if err != nil {
+		return err
+	}
+
+	if badge.AppID != appID {
+		return ErrBadgeNotFound
+	}
+
+	if badge.IsRevoked() {
+		return ErrBadgeAlreadyRevoked
+	}
+
+	return s.revokeBadge(ctx, badge, revocation, clientCredentials, issuer, privKey)
+}
+
+func (s *revoker) revokeBadge(
+	ctx context.Context,
+	badge *types.Badge,
+	revocation *Revocation,
+	clientCredentials *idpcore.ClientCredentials,
+	issuer *identitycore.Issuer,
+	privKey *jwk.Jwk,
+) error {
+	err := revoke(badge, revocation, privKey)
+	if err != nil {
+		return err
+	}
+
+	err = s.identityService.RevokeVerifiableCredential(
+		ctx,
+		clientCredentials,
+		&badge.VerifiableCredential,
+		issuer,
+	)
+	if err != nil {
+		return fmt.Errorf("identity service failed to revoke badge %s: %w", badge.ID, err)
+	}
+
+	err = s.statusListService.SetStatus(
+		ctx,
+		&badge.VerifiableCredential,
+		types.StatusPurposeRevocation,
+		true,
+		issuer.CommonName,
+		privKey,
+	)
+	if err != nil {
+		return fmt.Errorf("status list service failed to revoke badge %s: %w", badge.ID, err)
+	}
+
+	err = s.badgeRepository.Update(ctx, badge)
+	if err != nil {
+		return fmt.Errorf("repository failed to save revoked badge %s: %w", badge.ID, err)
 	}
 
 	return nil
 }
 
-func revoke(badge *types.Badge, privateKey *jwk.Jwk) error {
-	badge.Status = append(badge.Status, &types.CredentialStatus{
+func revoke(badge *types.Badge, revocation *Revocation, privateKey *jwk.Jwk) error {
+	status := &types.CredentialStatus{
 		ID: fmt.Sprintf(
 			"https://spec.identity.agntcy.org/protodocs/agntcy/identity/core/v1alpha1/vc.proto#%s",
 			uuid.NewString(),
@@ -86,7 +163,15 @@ func revoke(badge *types.Badge, privateKey *jwk.Jwk) error {
 		Type:      "CredentialStatus",
 		Purpose:   types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
 		CreatedAt: time.Now().UTC(),
-	})
+	}
+
+	if revocation != nil {
+		status.RevocationReason = revocation.Reason
+		status.Comment = revocation.Comment
+		status.CreatedBy = revocation.RevokedBy
+	}
+
+	badge.Status = append(badge.Status, status)
 
 	err := sign(&badge.VerifiableCredential, privateKey)
 	if err != nil {
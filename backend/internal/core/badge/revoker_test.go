@@ -41,7 +41,12 @@ func generateValidTestSetupForRevokeAll(
 		RevokeVerifiableCredential(ctx, clientCreds, mock.Anything, issuer).
 		Return(nil)
 
-	return badge.NewRevoker(badgeRepo, identityServ)
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, types.StatusPurposeRevocation, true, issuer.CommonName, mock.Anything).
+		Return(nil)
+
+	return badge.NewRevoker(badgeRepo, identityServ, statusListSrv)
 }
 
 func TestRevoker_RevokeAll_should_revoke_all_badges(t *testing.T) {
@@ -61,7 +66,7 @@ func TestRevoker_RevokeAll_should_revoke_all_badges(t *testing.T) {
 
 	sut := generateValidTestSetupForRevokeAll(t, ctx, appID, clientCreds, issuer, badges)
 
-	err := sut.RevokeAll(ctx, appID, clientCreds, issuer, privKey)
+	err := sut.RevokeAll(ctx, appID, nil, clientCreds, issuer, privKey)
 
 	assert.NoError(t, err)
 	assert.Contains(
@@ -91,7 +96,7 @@ func TestRevoker_RevokeAll_should_resign_badges_after_revocation(t *testing.T) {
 
 	sut := generateValidTestSetupForRevokeAll(t, ctx, appID, clientCreds, issuer, badges)
 
-	_ = sut.RevokeAll(ctx, appID, clientCreds, issuer, privKey)
+	_ = sut.RevokeAll(ctx, appID, nil, clientCreds, issuer, privKey)
 
 	signedBadge, err := joseutil.Verify(privKey.PublicKey(), []byte(badges[0].Proof.ProofValue))
 	assert.NoError(t, err)
@@ -111,9 +116,9 @@ func TestRevoker_RevokeAll_should_do_nothing_when_no_active_badge_found(t *testi
 	badgeRepo := badgemocks.NewRepository(t)
 	badgeRepo.EXPECT().GetAllActiveBadges(ctx, appID).Return(nil, nil)
 
-	sut := badge.NewRevoker(badgeRepo, nil)
+	sut := badge.NewRevoker(badgeRepo, nil, nil)
 
-	err := sut.RevokeAll(ctx, appID, nil, nil, nil)
+	err := sut.RevokeAll(ctx, appID, nil, nil, nil, nil)
 
 	assert.NoError(t, err)
 }
@@ -127,9 +132,9 @@ func TestRevoker_RevokeAll_should_return_err_when_unable_to_get_active_badges(t
 	badgeRepo := badgemocks.NewRepository(t)
 	badgeRepo.EXPECT().GetAllActiveBadges(ctx, appID).Return(nil, errors.New("error"))
 
-	sut := badge.NewRevoker(badgeRepo, nil)
+	sut := badge.NewRevoker(badgeRepo, nil, nil)
 
-	err := sut.RevokeAll(ctx, appID, nil, nil, nil)
+	err := sut.RevokeAll(ctx, appID, nil, nil, nil, nil)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "error")
@@ -152,9 +157,9 @@ func TestRevoker_RevokeAll_should_return_err_when_cannot_revoke_badge(t *testing
 		RevokeVerifiableCredential(ctx, mock.Anything, mock.Anything, mock.Anything).
 		Return(errors.New("error"))
 
-	sut := badge.NewRevoker(badgeRepo, identityServ)
+	sut := badge.NewRevoker(badgeRepo, identityServ, nil)
 
-	err := sut.RevokeAll(ctx, appID, nil, nil, privKey)
+	err := sut.RevokeAll(ctx, appID, nil, nil, nil, privKey)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "identity service failed to revoke badge")
@@ -173,9 +178,9 @@ func TestRevoker_RevokeAll_should_return_err_when_cannot_sign_revoked_badge(t *t
 		GetAllActiveBadges(ctx, appID).
 		Return([]*types.Badge{{VerifiableCredential: types.VerifiableCredential{}}}, nil)
 
-	sut := badge.NewRevoker(badgeRepo, nil)
+	sut := badge.NewRevoker(badgeRepo, nil, nil)
 
-	err := sut.RevokeAll(ctx, appID, nil, nil, invalidPrivKey)
+	err := sut.RevokeAll(ctx, appID, nil, nil, nil, invalidPrivKey)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "unable to sign the badge: private key is nil")
@@ -205,10 +210,132 @@ func TestRevoker_RevokeAll_should_return_err_when_cannot_save_badge(t *testing.T
 		RevokeVerifiableCredential(ctx, clientCreds, mock.Anything, issuer).
 		Return(nil)
 
-	sut := badge.NewRevoker(badgeRepo, identityServ)
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
 
-	err := sut.RevokeAll(ctx, appID, clientCreds, issuer, privKey)
+	sut := badge.NewRevoker(badgeRepo, identityServ, statusListSrv)
+
+	err := sut.RevokeAll(ctx, appID, nil, clientCreds, issuer, privKey)
 
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "repository failed to save revoked badge")
 }
+
+func TestRevoker_RevokeAll_should_return_err_when_cannot_update_the_status_list(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetAllActiveBadges(ctx, appID).
+		Return([]*types.Badge{{VerifiableCredential: types.VerifiableCredential{}}}, nil)
+
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		RevokeVerifiableCredential(ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("error"))
+
+	sut := badge.NewRevoker(badgeRepo, identityServ, statusListSrv)
+
+	err := sut.RevokeAll(ctx, appID, nil, nil, &identitycore.Issuer{}, privKey)
+
+	assert.ErrorContains(t, err, "status list service failed to revoke badge")
+}
+
+func TestRevoker_Revoke_should_record_the_reason(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	revokedBadge := &types.Badge{
+		AppID:                appID,
+		VerifiableCredential: types.VerifiableCredential{ID: uuid.NewString()},
+	}
+	clientCreds := &idpcore.ClientCredentials{}
+	issuer := &identitycore.Issuer{}
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	revocation := &badge.Revocation{
+		Reason:    types.REVOCATION_REASON_KEY_COMPROMISE,
+		Comment:   "comment",
+		RevokedBy: "user_id",
+	}
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetByID(ctx, revokedBadge.ID).Return(revokedBadge, nil)
+	badgeRepo.EXPECT().Update(ctx, revokedBadge).Return(nil)
+
+	identityServ := identitymocks.NewService(t)
+	identityServ.EXPECT().
+		RevokeVerifiableCredential(ctx, clientCreds, &revokedBadge.VerifiableCredential, issuer).
+		Return(nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, types.StatusPurposeRevocation, true, issuer.CommonName, privKey).
+		Return(nil)
+
+	sut := badge.NewRevoker(badgeRepo, identityServ, statusListSrv)
+
+	err := sut.Revoke(ctx, appID, revokedBadge.ID, revocation, clientCreds, issuer, privKey)
+
+	assert.NoError(t, err)
+	assert.True(t, revokedBadge.IsRevoked())
+	assert.Equal(t, types.REVOCATION_REASON_KEY_COMPROMISE, revokedBadge.Status[0].RevocationReason)
+	assert.Equal(t, "comment", revokedBadge.Status[0].Comment)
+	assert.Equal(t, "user_id", revokedBadge.Status[0].CreatedBy)
+}
+
+func TestRevoker_Revoke_should_return_err(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+
+	testCases := map[string]*struct {
+		badge *types.Badge
+		err   error
+	}{
+		"badge of another app": {
+			badge: &types.Badge{AppID: uuid.NewString()},
+			err:   badge.ErrBadgeNotFound,
+		},
+		"badge already revoked": {
+			badge: &types.Badge{
+				AppID: appID,
+				VerifiableCredential: types.VerifiableCredential{
+					Status: []*types.CredentialStatus{
+						{Purpose: types.CREDENTIAL_STATUS_PURPOSE_REVOCATION},
+					},
+				},
+			},
+			err: badge.ErrBadgeAlreadyRevoked,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			badgeID := uuid.NewString()
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().GetByID(ctx, badgeID).Return(tc.badge, nil)
+
+			sut := badge.NewRevoker(badgeRepo, nil, nil)
+
+			err := sut.Revoke(ctx, appID, badgeID, &badge.Revocation{}, nil, nil, nil)
+
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+// KeyBinding binds a presentation to the holder key confirmed by the issuer
+type KeyBinding struct {
+	// The private key of the holder
+	Key jwxjwk.Key
+
+	// The signature algorithm of the key
+	Algorithm jwa.SignatureAlgorithm
+
+	// The verifier the presentation is intended for
+	Audience string
+
+	// The nonce provided by the verifier, if any
+	Nonce string
+}
+
+type keyBindingClaims struct {
+	IssuedAt int64  `json:"iat"`
+	Audience string `json:"aud"`
+	Nonce    string `json:"nonce,omitempty"`
+	SdHash   string `json:"sd_hash"`
+}
+
+// Present keeps only the disclosures of the selected claims of an issued SD-JWT.
+// The claims are selected by their path, such as "badge.url" for a claim of an object
+// or "badge.skills[id=search]" for an element of an array, identified by its id or name
+// (its index otherwise). The presentation ends with a key binding JWT when one is given.
+func Present(sdJWT string, selected func(path string) bool, keyBinding *KeyBinding) (string, error) {
+	parts := strings.Split(sdJWT, separator)
+
+	msg, err := jws.Parse([]byte(parts[0]))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return "", ErrInvalidSdJwt
+	}
+
+	var claims map[string]any
+
+	err = decode(msg.Payload(), &claims)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidSdJwt, err)
+	}
+
+	p := presenter{
+		disclosures: make(map[string]*Disclosure),
+		kept:        make(map[*Disclosure]bool),
+		selected:    selected,
+	}
+
+	issued := make([]*Disclosure, 0, len(parts))
+
+	for _, encoded := range parts[1:] {
+		if encoded == "" {
+			continue
+		}
+
+		disclosure, err := parseDisclosure(encoded)
+		if err != nil {
+			return "", err
+		}
+
+		p.disclosures[disclosure.digest()] = disclosure
+		issued = append(issued, disclosure)
+	}
+
+	p.walk("", claims)
+
+	kept := make([]*Disclosure, 0, len(p.kept))
+
+	for _, disclosure := range issued {
+		if p.kept[disclosure] {
+			kept = append(kept, disclosure)
+		}
+	}
+
+	presentation := serialize(parts[0], kept)
+
+	if keyBinding == nil {
+		return presentation, nil
+	}
+
+	keyBindingJWT, err := signKeyBinding(presentation, keyBinding)
+	if err != nil {
+		return "", err
+	}
+
+	return presentation + keyBindingJWT, nil
+}
+
+// SelectClaims selects the claims with the paths, with all their nested claims
+// and the claims containing them
+func SelectClaims(paths ...string) func(path string) bool {
+	return func(path string) bool {
+		for _, selected := range paths {
+			if selected == path || isParentPath(path, selected) || isParentPath(selected, path) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func isParentPath(parent, path string) bool {
+	return strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[")
+}
+
+type presenter struct {
+	disclosures map[string]*Disclosure
+	kept        map[*Disclosure]bool
+	selected    func(path string) bool
+}
+
+func (p *presenter) walk(path string, value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for name, child := range v {
+			if name != digestsClaim {
+				p.walk(childPath(path, name), child)
+			}
+		}
+
+		digests, _ := v[digestsClaim].([]any)
+
+		for _, digest := range digests {
+			disclosure := p.disclosures[fmt.Sprint(digest)]
+			if disclosure == nil || disclosure.isElement() {
+				continue
+			}
+
+			p.keep(childPath(path, disclosure.Name), disclosure)
+		}
+	case []any:
+		for i, element := range v {
+			if disclosure := p.elementDisclosure(element); disclosure != nil {
+				p.keep(elementPath(path, disclosure.Value, i), disclosure)
+			} else {
+				p.walk(elementPath(path, element, i), element)
+			}
+		}
+	}
+}
+
+func (p *presenter) keep(path string, disclosure *Disclosure) {
+	if !p.selected(path) {
+		return
+	}
+
+	p.kept[disclosure] = true
+	p.walk(path, disclosure.Value)
+}
+
+func (p *presenter) elementDisclosure(element any) *Disclosure {
+	ref, ok := element.(map[string]any)
+	if !ok || len(ref) != 1 {
+		return nil
+	}
+
+	digest, ok := ref[arrayElementClaim].(string)
+	if !ok {
+		return nil
+	}
+
+	disclosure := p.disclosures[digest]
+	if disclosure == nil || !disclosure.isElement() {
+		return nil
+	}
+
+	return disclosure
+}
+
+func childPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// elementPath identifies the element of an array by its id or its name, or by its index
+func elementPath(path string, element any, index int) string {
+	if object, ok := element.(map[string]any); ok {
+		for _, key := range []string{"id", "name"} {
+			if id, ok := object[key].(string); ok {
+				return fmt.Sprintf("%s[%s=%s]", path, key, id)
+			}
+		}
+	}
+
+	return path + "[" + strconv.Itoa(index) + "]"
+}
+
+func signKeyBinding(presentation string, keyBinding *KeyBinding) (string, error) {
+	payload, err := json.Marshal(&keyBindingClaims{
+		IssuedAt: time.Now().Unix(),
+		Audience: keyBinding.Audience,
+		Nonce:    keyBinding.Nonce,
+		SdHash:   hash(presentation),
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal the key binding claims: %w", err)
+	}
+
+	headers := jws.NewHeaders()
+	_ = headers.Set(jws.TypeKey, keyBindingType)
+
+	signed, err := jws.Sign(
+		payload,
+		jws.WithKey(keyBinding.Algorithm, keyBinding.Key, jws.WithProtectedHeaders(headers)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("unable to sign the key binding JWT: %w", err)
+	}
+
+	return string(signed), nil
+}
@@ -0,0 +1,374 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sdjwt issues, presents and verifies [SD-JWT VCs], JWTs whose claims
+// are concealed in disclosures that the holders reveal selectively
+//
+// [SD-JWT VCs]: https://datatracker.ietf.org/doc/draft-ietf-oauth-sd-jwt-vc/
+package sdjwt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// Type is the typ header of the issuer-signed JWT of an SD-JWT VC
+	Type = "dc+sd-jwt"
+
+	// keyBindingType is the typ header of the key binding JWTs
+	keyBindingType = "kb+jwt"
+
+	// separator separates the issuer-signed JWT, the disclosures and the key binding JWT
+	separator = "~"
+
+	hashAlgorithm = "sha-256"
+
+	digestsClaim       = "_sd"
+	hashAlgorithmClaim = "_sd_alg"
+	arrayElementClaim  = "..."
+
+	// The size of the random salts of the disclosures
+	saltSize = 16
+)
+
+// algorithms are the asymmetric signature algorithms accepted for the JWTs
+var algorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+var (
+	ErrInvalidSdJwt      = errors.New("invalid SD-JWT")
+	ErrInvalidKeyBinding = errors.New("invalid key binding JWT")
+)
+
+// Disclosure reveals a concealed claim, or a concealed element of an array when it has no name
+type Disclosure struct {
+	Salt  string
+	Name  string
+	Value any
+
+	// The base64url encoded JSON array of the salt, the name and the value
+	encoded string
+}
+
+func newDisclosure(name string, value any, isElement bool) (*Disclosure, error) {
+	salt := make([]byte, saltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate a salt: %w", err)
+	}
+
+	disclosure := &Disclosure{
+		Salt:  base64.RawURLEncoding.EncodeToString(salt),
+		Name:  name,
+		Value: value,
+	}
+
+	content := []any{disclosure.Salt, name, value}
+	if isElement {
+		content = []any{disclosure.Salt, value}
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the disclosure: %w", err)
+	}
+
+	disclosure.encoded = base64.RawURLEncoding.EncodeToString(data)
+
+	return disclosure, nil
+}
+
+func parseDisclosure(encoded string) (*Disclosure, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSdJwt, err)
+	}
+
+	var content []any
+
+	err = decode(data, &content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSdJwt, err)
+	}
+
+	disclosure := &Disclosure{encoded: encoded}
+
+	var ok bool
+
+	switch len(content) {
+	case 2: //nolint:mnd // the salt and the value of an array element
+		disclosure.Salt, ok = content[0].(string)
+		disclosure.Value = content[1]
+	case 3: //nolint:mnd // the salt, the name and the value of a claim
+		disclosure.Salt, ok = content[0].(string)
+		disclosure.Value = content[2]
+
+		if name, isString := content[1].(string); isString && name != digestsClaim && name != arrayElementClaim {
+			disclosure.Name = name
+		} else {
+			ok = false
+		}
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed disclosure", ErrInvalidSdJwt)
+	}
+
+	return disclosure, nil
+}
+
+// digest returns the base64url encoded SHA-256 hash of the disclosure
+func (d *Disclosure) digest() string {
+	return hash(d.encoded)
+}
+
+func (d *Disclosure) isElement() bool {
+	return d.Name == ""
+}
+
+// Issuer conceals the claims of an SD-JWT in disclosures before signing it
+type Issuer struct {
+	disclosures []*Disclosure
+}
+
+func NewIssuer() *Issuer {
+	return &Issuer{}
+}
+
+// Conceal replaces the claim of the object with the digest of its disclosure
+func (i *Issuer) Conceal(object map[string]any, name string) error {
+	value, ok := object[name]
+	if !ok {
+		return nil
+	}
+
+	disclosure, err := newDisclosure(name, value, false)
+	if err != nil {
+		return err
+	}
+
+	delete(object, name)
+
+	digests, _ := object[digestsClaim].([]any)
+	object[digestsClaim] = sortDigests(append(digests, disclosure.digest()))
+	i.disclosures = append(i.disclosures, disclosure)
+
+	return nil
+}
+
+// ConcealElements replaces each element of the array claim of the object
+// with the digest of its disclosure
+func (i *Issuer) ConcealElements(object map[string]any, name string) error {
+	elements, ok := object[name].([]any)
+	if !ok {
+		return nil
+	}
+
+	concealed := make([]any, 0, len(elements))
+
+	for _, element := range elements {
+		disclosure, err := newDisclosure("", element, true)
+		if err != nil {
+			return err
+		}
+
+		concealed = append(concealed, map[string]any{arrayElementClaim: disclosure.digest()})
+		i.disclosures = append(i.disclosures, disclosure)
+	}
+
+	object[name] = concealed
+
+	return nil
+}
+
+// Sign signs the claims with the private key and appends all the disclosures.
+// The public key is set in the jwk header of the issuer-signed JWT.
+func (i *Issuer) Sign(claims map[string]any, privateKey *jwk.Jwk) (string, error) {
+	if privateKey == nil {
+		return "", errors.New("invalid privateKey argument")
+	}
+
+	key, err := toJwx(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	publicKey, err := jwxjwk.PublicKeyOf(key)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the public key: %w", err)
+	}
+
+	alg := jwa.RS256()
+	if lookup, ok := jwa.LookupSignatureAlgorithm(privateKey.ALG); ok {
+		alg = lookup
+	}
+
+	claims[hashAlgorithmClaim] = hashAlgorithm
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal the claims: %w", err)
+	}
+
+	headers := jws.NewHeaders()
+	_ = headers.Set(jws.TypeKey, Type)
+	_ = headers.Set(jws.KeyIDKey, privateKey.KID)
+	_ = headers.Set(jws.JWKKey, publicKey)
+
+	signed, err := jws.Sign(payload, jws.WithKey(alg, key, jws.WithProtectedHeaders(headers)))
+	if err != nil {
+		return "", fmt.Errorf("unable to sign the SD-JWT: %w", err)
+	}
+
+	return serialize(string(signed), i.disclosures), nil
+}
+
+// Thumbprint returns the JWK SHA-256 thumbprint URI of the public key (RFC 9278)
+func Thumbprint(key jwxjwk.Key) (string, error) {
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("unable to compute the thumbprint of the key: %w", err)
+	}
+
+	return "urn:ietf:params:oauth:jwk-thumbprint:sha-256:" + base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// IssuerKey returns the public key in the jwk header of the issuer-signed JWT,
+// without verifying the signature
+func IssuerKey(sdJWT string) (jwxjwk.Key, error) {
+	token, _, _ := strings.Cut(sdJWT, separator)
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, ErrInvalidSdJwt
+	}
+
+	key, ok := msg.Signatures()[0].ProtectedHeaders().JWK()
+	if !ok {
+		return nil, fmt.Errorf("%w: missing jwk header", ErrInvalidSdJwt)
+	}
+
+	return key, nil
+}
+
+// HolderKey returns the holder key confirmed by the issuer in the cnf claim,
+// without verifying the signature
+func HolderKey(sdJWT string) (jwxjwk.Key, bool) {
+	token, _, _ := strings.Cut(sdJWT, separator)
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, false
+	}
+
+	var claims map[string]any
+
+	err = decode(msg.Payload(), &claims)
+	if err != nil {
+		return nil, false
+	}
+
+	holderKey := confirmedKey(claims)
+	if holderKey == nil {
+		return nil, false
+	}
+
+	data, err := json.Marshal(holderKey)
+	if err != nil {
+		return nil, false
+	}
+
+	key, err := jwxjwk.ParseKey(data)
+	if err != nil {
+		return nil, false
+	}
+
+	return key, true
+}
+
+// IsSdJwt returns true when the value is an SD-JWT VC, with or without a key binding JWT
+func IsSdJwt(value string) bool {
+	token, _, found := strings.Cut(strings.TrimSpace(value), separator)
+	if !found {
+		return false
+	}
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return false
+	}
+
+	typ, _ := msg.Signatures()[0].ProtectedHeaders().Type()
+
+	return typ == Type
+}
+
+func serialize(token string, disclosures []*Disclosure) string {
+	var builder strings.Builder
+
+	builder.WriteString(token)
+	builder.WriteString(separator)
+
+	for _, disclosure := range disclosures {
+		builder.WriteString(disclosure.encoded)
+		builder.WriteString(separator)
+	}
+
+	return builder.String()
+}
+
+func toJwx(key *jwk.Jwk) (jwxjwk.Key, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the key: %w", err)
+	}
+
+	parsed, err := jwxjwk.ParseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the key: %w", err)
+	}
+
+	return parsed, nil
+}
+
+// decode unmarshals the JSON keeping the numbers as they are
+func decode(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func hash(value string) string {
+	digest := sha256.Sum256([]byte(value))
+
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// sortDigests sorts the digests so that their order does not reveal the order of the claims
+func sortDigests(digests []any) []any {
+	slices.SortFunc(digests, func(a, b any) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+
+	return digests
+}
@@ -0,0 +1,274 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/badge/sdjwt"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newHolderKey(t *testing.T) (jwxjwk.Key, jwxjwk.Key) {
+	t.Helper()
+
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := jwxjwk.Import(raw)
+	require.NoError(t, err)
+
+	publicKey, err := jwxjwk.PublicKeyOf(key)
+	require.NoError(t, err)
+
+	return key, publicKey
+}
+
+// issue returns an SD-JWT concealing the url and each tool of the badge
+func issue(t *testing.T, holderKey jwxjwk.Key) string {
+	t.Helper()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	badge := map[string]any{
+		"name": "weather",
+		"url":  "https://weather.example",
+		"tools": []any{
+			map[string]any{"name": "get_weather"},
+			map[string]any{"name": "get_forecast"},
+		},
+	}
+	claims := map[string]any{"iss": "issuer", "badge": badge}
+
+	if holderKey != nil {
+		claims["cnf"] = map[string]any{"jwk": holderKey}
+	}
+
+	issuer := sdjwt.NewIssuer()
+	require.NoError(t, issuer.ConcealElements(badge, "tools"))
+	require.NoError(t, issuer.Conceal(badge, "tools"))
+	require.NoError(t, issuer.Conceal(badge, "url"))
+
+	sdJWT, err := issuer.Sign(claims, privKey)
+	require.NoError(t, err)
+
+	return sdJWT
+}
+
+func TestVerify_should_return_all_the_claims_of_the_issued_sd_jwt(t *testing.T) {
+	t.Parallel()
+
+	verified, err := sdjwt.Verify(issue(t, nil))
+
+	assert.NoError(t, err)
+	assert.False(t, verified.KeyBound)
+	assert.Equal(t, map[string]any{
+		"name": "weather",
+		"url":  "https://weather.example",
+		"tools": []any{
+			map[string]any{"name": "get_weather"},
+			map[string]any{"name": "get_forecast"},
+		},
+	}, verified.Claims["badge"])
+}
+
+func TestPresent_should_disclose_the_selected_claims(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		paths    []string
+		expected map[string]any
+	}{
+		"no claim": {
+			paths:    nil,
+			expected: map[string]any{"name": "weather"},
+		},
+		"a claim": {
+			paths:    []string{"badge.url"},
+			expected: map[string]any{"name": "weather", "url": "https://weather.example"},
+		},
+		"an array element": {
+			paths: []string{"badge.tools[name=get_forecast]"},
+			expected: map[string]any{
+				"name":  "weather",
+				"tools": []any{map[string]any{"name": "get_forecast"}},
+			},
+		},
+		"an array with all its elements": {
+			paths: []string{"badge.tools"},
+			expected: map[string]any{
+				"name": "weather",
+				"tools": []any{
+					map[string]any{"name": "get_weather"},
+					map[string]any{"name": "get_forecast"},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			presentation, err := sdjwt.Present(issue(t, nil), sdjwt.SelectClaims(tc.paths...), nil)
+			require.NoError(t, err)
+
+			verified, err := sdjwt.Verify(presentation)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, verified.Claims["badge"])
+		})
+	}
+}
+
+func TestVerify_should_verify_the_key_binding(t *testing.T) {
+	t.Parallel()
+
+	holderKey, holderPublicKey := newHolderKey(t)
+	otherHolderKey, _ := newHolderKey(t)
+	issued := issue(t, holderPublicKey)
+
+	keyBinding := &sdjwt.KeyBinding{
+		Key:       holderKey,
+		Algorithm: jwa.ES256(),
+		Audience:  "verifier",
+		Nonce:     "nonce",
+	}
+
+	testCases := map[string]*struct {
+		presentation func() string
+		opts         []sdjwt.VerifyOption
+		err          error
+	}{
+		"bound presentation": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				return presentation
+			},
+			opts: []sdjwt.VerifyOption{sdjwt.WithAudience("verifier"), sdjwt.WithNonce("nonce")},
+		},
+		"presentation without key binding": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), nil)
+				return presentation
+			},
+			err: sdjwt.ErrInvalidKeyBinding,
+		},
+		"presentation bound to another key": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(
+					issued,
+					sdjwt.SelectClaims("badge.url"),
+					&sdjwt.KeyBinding{Key: otherHolderKey, Algorithm: jwa.ES256(), Audience: "verifier"},
+				)
+				return presentation
+			},
+			err: sdjwt.ErrInvalidKeyBinding,
+		},
+		"disclosure added after the key binding": {
+			presentation: func() string {
+				bound, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				all, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge"), nil)
+				parts := strings.Split(bound, "~")
+				// The disclosure of the tools, referenced by the badge
+				extra := strings.Split(all, "~")[3]
+
+				return strings.Join(append(parts[:len(parts)-1], extra, parts[len(parts)-1]), "~")
+			},
+			err: sdjwt.ErrInvalidKeyBinding,
+		},
+		"another audience": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				return presentation
+			},
+			opts: []sdjwt.VerifyOption{sdjwt.WithAudience("other verifier"), sdjwt.WithNonce("nonce")},
+			err:  sdjwt.ErrInvalidKeyBinding,
+		},
+		"another nonce": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				return presentation
+			},
+			opts: []sdjwt.VerifyOption{sdjwt.WithAudience("verifier"), sdjwt.WithNonce("other nonce")},
+			err:  sdjwt.ErrInvalidKeyBinding,
+		},
+		"missing audience": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				return presentation
+			},
+			opts: []sdjwt.VerifyOption{sdjwt.WithNonce("nonce")},
+			err:  sdjwt.ErrInvalidKeyBinding,
+		},
+		"missing nonce": {
+			presentation: func() string {
+				presentation, _ := sdjwt.Present(issued, sdjwt.SelectClaims("badge.url"), keyBinding)
+				return presentation
+			},
+			opts: []sdjwt.VerifyOption{sdjwt.WithAudience("verifier")},
+			err:  sdjwt.ErrInvalidKeyBinding,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			verified, err := sdjwt.Verify(tc.presentation(), tc.opts...)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, verified.KeyBound)
+			}
+		})
+	}
+}
+
+func TestVerify_should_return_err_when_the_sd_jwt_is_tampered(t *testing.T) {
+	t.Parallel()
+
+	issued := issue(t, nil)
+	parts := strings.Split(issued, "~")
+
+	testCases := map[string]string{
+		"forged disclosure":     parts[0] + "~WyJzYWx0IiwgInVybCIsICJodHRwczovL2V2aWwiXQ~",
+		"duplicate disclosure":  parts[0] + "~" + parts[1] + "~" + parts[1] + "~",
+		"modified signature":    parts[0] + "x~",
+		"key binding no holder": issued + parts[0],
+		"not an sd-jwt":         "header.payload.signature",
+	}
+
+	for tn, presentation := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sdjwt.Verify(presentation)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIsSdJwt(t *testing.T) {
+	t.Parallel()
+
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	jose, _ := joseutil.Sign(privKey, []byte(`{"id":"badge"}`))
+
+	assert.True(t, sdjwt.IsSdJwt(issue(t, nil)))
+	assert.False(t, sdjwt.IsSdJwt(string(jose)))
+	assert.False(t, sdjwt.IsSdJwt(string(jose)+"~"))
+	assert.False(t, sdjwt.IsSdJwt(`{"id":"badge"}`))
+}
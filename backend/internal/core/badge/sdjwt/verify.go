@@ -0,0 +1,374 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package sdjwt
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	// KeyBindingLifetime is the window in which the iat of a key binding JWT is accepted
+	KeyBindingLifetime = 5 * time.Minute
+
+	// acceptableSkew is the tolerance for key binding JWTs issued in the future
+	acceptableSkew = 30 * time.Second
+)
+
+// Verified is a verified SD-JWT presentation
+type Verified struct {
+	// The claims of the issuer-signed JWT, with the disclosed claims in place of their digests
+	Claims map[string]any
+
+	// The public key in the jwk header of the issuer-signed JWT
+	IssuerKey jwxjwk.Key
+
+	// True when the presentation ends with a valid key binding JWT
+	KeyBound bool
+}
+
+type verifyOptions struct {
+	audience string
+	nonce    string
+}
+
+type VerifyOption func(opts *verifyOptions)
+
+// WithAudience requires the key binding JWT to be intended for the audience.
+// The audience is mandatory to verify a presentation bound to a holder key.
+func WithAudience(audience string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.audience = audience
+	}
+}
+
+// WithNonce requires the key binding JWT to carry the nonce.
+// The nonce is mandatory to verify a presentation bound to a holder key.
+func WithNonce(nonce string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.nonce = nonce
+	}
+}
+
+// Verify verifies the signature of the issuer-signed JWT with the public key in its header,
+// the digests of the disclosures and, when the issuer confirmed a holder key, the key binding JWT,
+// which must be intended for the audience and carry the nonce of the options.
+// The caller is responsible for trusting the public key of the issuer.
+func Verify(presentation string, opts ...VerifyOption) (*Verified, error) {
+	options := verifyOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	parts := strings.Split(strings.TrimSpace(presentation), separator)
+	if len(parts) < 2 { //nolint:mnd // the issuer-signed JWT and the key binding JWT, which can be empty
+		return nil, fmt.Errorf("%w: missing separator", ErrInvalidSdJwt)
+	}
+
+	claims, issuerKey, err := verifyIssuerSignedJWT(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	v := verifier{
+		disclosures: make(map[string]*Disclosure),
+		used:        make(map[string]bool),
+	}
+
+	for _, encoded := range parts[1 : len(parts)-1] {
+		disclosure, err := parseDisclosure(encoded)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := v.disclosures[disclosure.digest()]; ok {
+			return nil, fmt.Errorf("%w: duplicate disclosure", ErrInvalidSdJwt)
+		}
+
+		v.disclosures[disclosure.digest()] = disclosure
+	}
+
+	processed, err := v.process(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(v.used) != len(v.disclosures) {
+		return nil, fmt.Errorf("%w: a disclosure is not referenced by the SD-JWT", ErrInvalidSdJwt)
+	}
+
+	result := &Verified{
+		Claims:    processed.(map[string]any),
+		IssuerKey: issuerKey,
+	}
+
+	delete(result.Claims, hashAlgorithmClaim)
+
+	keyBindingJWT := parts[len(parts)-1]
+	holderKey := confirmedKey(result.Claims)
+
+	switch {
+	case holderKey == nil && keyBindingJWT != "":
+		return nil, fmt.Errorf("%w: the SD-JWT confirms no holder key", ErrInvalidKeyBinding)
+	case holderKey != nil && keyBindingJWT == "":
+		return nil, fmt.Errorf("%w: the presentation is not bound to the holder key", ErrInvalidKeyBinding)
+	case holderKey != nil:
+		presented := strings.TrimSuffix(strings.TrimSpace(presentation), keyBindingJWT)
+
+		err = verifyKeyBinding(keyBindingJWT, holderKey, presented, &options)
+		if err != nil {
+			return nil, err
+		}
+
+		result.KeyBound = true
+	}
+
+	return result, nil
+}
+
+func verifyIssuerSignedJWT(token string) (map[string]any, jwxjwk.Key, error) {
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, nil, ErrInvalidSdJwt
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+
+	if typ, _ := headers.Type(); typ != Type {
+		return nil, nil, fmt.Errorf("%w: unexpected type", ErrInvalidSdJwt)
+	}
+
+	alg, ok := headers.Algorithm()
+	if !ok || !slices.Contains(algorithms, alg.String()) {
+		return nil, nil, fmt.Errorf("%w: algorithm not allowed", ErrInvalidSdJwt)
+	}
+
+	key, ok := headers.JWK()
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: missing jwk header", ErrInvalidSdJwt)
+	}
+
+	if private, err := jwxjwk.IsPrivateKey(key); err != nil || private {
+		return nil, nil, fmt.Errorf("%w: the jwk header is not a public key", ErrInvalidSdJwt)
+	}
+
+	payload, err := jws.Verify([]byte(token), jws.WithKey(alg, key))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidSdJwt, err)
+	}
+
+	var claims map[string]any
+
+	err = decode(payload, &claims)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidSdJwt, err)
+	}
+
+	if alg, ok := claims[hashAlgorithmClaim]; ok && alg != hashAlgorithm {
+		return nil, nil, fmt.Errorf("%w: unsupported hash algorithm", ErrInvalidSdJwt)
+	}
+
+	return claims, key, nil
+}
+
+func verifyKeyBinding(token string, holderKey any, presented string, options *verifyOptions) error {
+	data, err := json.Marshal(holderKey)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidKeyBinding, err)
+	}
+
+	key, err := jwxjwk.ParseKey(data)
+	if err != nil {
+		return fmt.Errorf("%w: invalid holder key: %w", ErrInvalidKeyBinding, err)
+	}
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return ErrInvalidKeyBinding
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+
+	if typ, _ := headers.Type(); typ != keyBindingType {
+		return fmt.Errorf("%w: unexpected type", ErrInvalidKeyBinding)
+	}
+
+	alg, ok := headers.Algorithm()
+	if !ok || !slices.Contains(algorithms, alg.String()) {
+		return fmt.Errorf("%w: algorithm not allowed", ErrInvalidKeyBinding)
+	}
+
+	payload, err := jws.Verify([]byte(token), jws.WithKey(alg, key))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidKeyBinding, err)
+	}
+
+	var claims keyBindingClaims
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidKeyBinding, err)
+	}
+
+	now := time.Now()
+	if claims.IssuedAt < now.Add(-KeyBindingLifetime).Unix() || claims.IssuedAt > now.Add(acceptableSkew).Unix() {
+		return fmt.Errorf("%w: iat out of the acceptable window", ErrInvalidKeyBinding)
+	}
+
+	if claims.SdHash != hash(presented) {
+		return fmt.Errorf("%w: the key binding JWT was created for another presentation", ErrInvalidKeyBinding)
+	}
+
+	// Without an audience and a nonce to compare with, a key binding JWT
+	// intercepted by any verifier could be replayed
+	if options.audience == "" || options.nonce == "" {
+		return fmt.Errorf("%w: an audience and a nonce are required to verify the key binding", ErrInvalidKeyBinding)
+	}
+
+	if claims.Audience != options.audience {
+		return fmt.Errorf("%w: invalid audience", ErrInvalidKeyBinding)
+	}
+
+	if claims.Nonce != options.nonce {
+		return fmt.Errorf("%w: invalid nonce", ErrInvalidKeyBinding)
+	}
+
+	return nil
+}
+
+// confirmedKey returns the holder key confirmed by the issuer in the cnf claim, if any
+func confirmedKey(claims map[string]any) any {
+	confirmation, ok := claims["cnf"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return confirmation["jwk"]
+}
+
+type verifier struct {
+	disclosures map[string]*Disclosure
+	used        map[string]bool
+}
+
+// process replaces the digests of the disclosed claims with their values
+// and removes the digests of the undisclosed ones
+func (v *verifier) process(value any) (any, error) {
+	switch value := value.(type) {
+	case map[string]any:
+		return v.processObject(value)
+	case []any:
+		return v.processArray(value)
+	default:
+		return value, nil
+	}
+}
+
+func (v *verifier) processObject(object map[string]any) (any, error) {
+	processed := make(map[string]any, len(object))
+
+	for name, child := range object {
+		if name == digestsClaim {
+			continue
+		}
+
+		value, err := v.process(child)
+		if err != nil {
+			return nil, err
+		}
+
+		processed[name] = value
+	}
+
+	digests, _ := object[digestsClaim].([]any)
+
+	for _, digest := range digests {
+		disclosure, err := v.use(digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if disclosure == nil {
+			continue
+		}
+
+		if disclosure.isElement() {
+			return nil, fmt.Errorf("%w: an array element disclosed as a claim", ErrInvalidSdJwt)
+		}
+
+		if _, ok := processed[disclosure.Name]; ok {
+			return nil, fmt.Errorf("%w: the claim %s is disclosed twice", ErrInvalidSdJwt, disclosure.Name)
+		}
+
+		value, err := v.process(disclosure.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		processed[disclosure.Name] = value
+	}
+
+	return processed, nil
+}
+
+func (v *verifier) processArray(array []any) (any, error) {
+	processed := make([]any, 0, len(array))
+
+	for _, element := range array {
+		if ref, ok := element.(map[string]any); ok && len(ref) == 1 {
+			if digest, ok := ref[arrayElementClaim]; ok {
+				disclosure, err := v.use(digest)
+				if err != nil {
+					return nil, err
+				}
+
+				if disclosure == nil {
+					continue
+				}
+
+				if !disclosure.isElement() {
+					return nil, fmt.Errorf("%w: a claim disclosed as an array element", ErrInvalidSdJwt)
+				}
+
+				element = disclosure.Value
+			}
+		}
+
+		value, err := v.process(element)
+		if err != nil {
+			return nil, err
+		}
+
+		processed = append(processed, value)
+	}
+
+	return processed, nil
+}
+
+// use returns the disclosure of the digest, nil when it is not disclosed
+func (v *verifier) use(digest any) (*Disclosure, error) {
+	value, ok := digest.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed digest", ErrInvalidSdJwt)
+	}
+
+	disclosure, ok := v.disclosures[value]
+	if !ok {
+		return nil, nil //nolint:nilnil // the claim is not disclosed
+	}
+
+	if v.used[value] {
+		return nil, fmt.Errorf("%w: a digest is referenced twice", ErrInvalidSdJwt)
+	}
+
+	v.used[value] = true
+
+	return disclosure, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/google/uuid"
+)
+
+// The number of statuses of each status list, the minimum
+// recommended by the Bitstring Status List to provide herd privacy
+const StatusListSize = 131072
+
+const statusListPath = "/v1alpha1/badges/status-lists/"
+
+type StatusListService interface {
+	// Allocate assigns a status list entry with the given purpose to a new credential,
+	// a new status list is created and signed when the lists of the tenant are full
+	Allocate(
+		ctx context.Context,
+		purpose string,
+		issuer string,
+		privKey *jwk.Jwk,
+	) (*types.CredentialStatus, error)
+
+	// SetStatus changes the status of the credential in the status lists of the given purpose
+	// and signs the updated status lists. The entries of other issuers are ignored.
+	SetStatus(
+		ctx context.Context,
+		vc *types.VerifiableCredential,
+		purpose string,
+		value bool,
+		issuer string,
+		privKey *jwk.Jwk,
+	) error
+}
+
+type statusListService struct {
+	repository StatusListRepository
+	baseURL    string
+}
+
+// NewStatusListService returns the status list service publishing
+// the status list credentials under the given API URL
+func NewStatusListService(repository StatusListRepository, baseURL string) StatusListService {
+	return &statusListService{
+		repository: repository,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *statusListService) Allocate(
+	ctx context.Context,
+	purpose string,
+	issuer string,
+	privKey *jwk.Jwk,
+) (*types.CredentialStatus, error) {
+	statusList, allocated, err := s.repository.AllocateIndex(ctx, purpose)
+	if errors.Is(err, ErrStatusListNotFound) {
+		statusList, allocated, err = s.create(ctx, purpose, issuer, privKey)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to allocate a status list index: %w", err)
+	}
+
+	listURL := s.statusListURL(statusList.ID)
+	index := strconv.Itoa(allocated)
+
+	return &types.CredentialStatus{
+		ID:                   listURL + "#" + index,
+		Type:                 types.BitstringStatusListEntryType,
+		CreatedAt:            time.Now().UTC(),
+		StatusPurpose:        purpose,
+		StatusListIndex:      index,
+		StatusListCredential: listURL,
+	}, nil
+}
+
+func (s *statusListService) SetStatus(
+	ctx context.Context,
+	vc *types.VerifiableCredential,
+	purpose string,
+	value bool,
+	issuer string,
+	privKey *jwk.Jwk,
+) error {
+	for _, status := range vc.Status {
+		if !status.IsStatusListEntry() || status.StatusPurpose != purpose {
+			continue
+		}
+
+		id, ok := strings.CutPrefix(status.StatusListCredential, s.baseURL+statusListPath)
+		if !ok {
+			continue
+		}
+
+		index, err := strconv.Atoi(status.StatusListIndex)
+		if err != nil {
+			return fmt.Errorf("invalid status list index %s: %w", status.StatusListIndex, err)
+		}
+
+		err = s.repository.Update(ctx, id, func(statusList *types.StatusList) error {
+			err := statusList.Set(index, value)
+			if err != nil {
+				return err
+			}
+
+			return s.sign(statusList, issuer, privKey)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update the status list %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *statusListService) create(
+	ctx context.Context,
+	purpose string,
+	issuer string,
+	privKey *jwk.Jwk,
+) (*types.StatusList, int, error) {
+	statusList := types.NewStatusList(uuid.NewString(), purpose, StatusListSize)
+
+	// The first index is allocated to the credential creating the list
+	index, err := statusList.Allocate()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = s.sign(statusList, issuer, privKey)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = s.repository.Create(ctx, statusList)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return statusList, index, nil
+}
+
+// sign issues a new status list credential with the current statuses
+func (s *statusListService) sign(statusList *types.StatusList, issuer string, privKey *jwk.Jwk) error {
+	encodedList, err := types.EncodeBitstring(statusList.Bitstring)
+	if err != nil {
+		return err
+	}
+
+	listURL := s.statusListURL(statusList.ID)
+	credential := types.StatusListCredential{
+		Context: []string{"https://www.w3.org/ns/credentials/v2"},
+		ID:      listURL,
+		Type: []string{
+			"VerifiableCredential",
+			types.BitstringStatusListCredentialType,
+		},
+		Issuer:    issuer,
+		ValidFrom: time.Now().UTC().Format(time.RFC3339),
+		CredentialSubject: &types.BitstringStatusList{
+			ID:            listURL + "#list",
+			Type:          types.BitstringStatusListType,
+			StatusPurpose: statusList.Purpose,
+			EncodedList:   encodedList,
+		},
+	}
+
+	payload, err := json.Marshal(&credential)
+	if err != nil {
+		return fmt.Errorf("unable to marshal the status list credential: %w", err)
+	}
+
+	signed, err := joseutil.Sign(privKey, payload)
+	if err != nil {
+		return fmt.Errorf("unable to sign the status list credential: %w", err)
+	}
+
+	credential.Proof = &types.Proof{
+		Type:       types.JoseProof,
+		ProofValue: string(signed),
+	}
+
+	statusList.Credential = &credential
+
+	return nil
+}
+
+func (s *statusListService) statusListURL(id string) string {
+	return s.baseURL + statusListPath + id
+}
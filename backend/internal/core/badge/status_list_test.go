@@ -0,0 +1,140 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge_test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/badge"
+	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIURL = "https://api.example.com"
+
+func TestStatusListService_Allocate_should_create_a_status_list_when_all_are_full(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	var created *types.StatusList
+
+	repo := badgemocks.NewStatusListRepository(t)
+	repo.EXPECT().
+		AllocateIndex(ctx, types.StatusPurposeRevocation).
+		Return(nil, 0, badge.ErrStatusListNotFound)
+	repo.EXPECT().
+		Create(ctx, mock.Anything).
+		RunAndReturn(func(ctx context.Context, statusList *types.StatusList) error {
+			created = statusList
+			return nil
+		})
+
+	sut := badge.NewStatusListService(repo, testAPIURL+"/")
+
+	status, err := sut.Allocate(ctx, types.StatusPurposeRevocation, "issuer", privKey)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Allocated)
+	assert.Equal(t, badge.StatusListSize, created.Size())
+
+	index, _ := strconv.Atoi(status.StatusListIndex)
+	assert.NotEqual(t, 0, created.Allocations[index/8]&(0x80>>(index%8)))
+
+	listURL := testAPIURL + "/v1alpha1/badges/status-lists/" + created.ID
+	assert.Equal(t, types.BitstringStatusListEntryType, status.Type)
+	assert.Equal(t, types.StatusPurposeRevocation, status.StatusPurpose)
+	assert.Equal(t, listURL, status.StatusListCredential)
+	assert.Equal(t, listURL+"#"+status.StatusListIndex, status.ID)
+
+	// The status list credential is signed with the issuer key
+	assert.Equal(t, listURL, created.Credential.ID)
+	assert.Equal(t, "issuer", created.Credential.Issuer)
+	assert.Equal(t, types.StatusPurposeRevocation, created.Credential.CredentialSubject.StatusPurpose)
+
+	payload, err := joseutil.Verify(privKey.PublicKey(), []byte(created.Credential.Proof.ProofValue))
+	require.NoError(t, err)
+
+	var signed types.StatusListCredential
+
+	require.NoError(t, json.Unmarshal(payload, &signed))
+	assert.Equal(t, created.Credential.CredentialSubject, signed.CredentialSubject)
+}
+
+func TestStatusListService_Allocate_should_use_the_index_allocated_in_a_status_list(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	statusList := types.NewStatusList(uuid.NewString(), types.StatusPurposeRevocation, badge.StatusListSize)
+
+	repo := badgemocks.NewStatusListRepository(t)
+	repo.EXPECT().AllocateIndex(ctx, types.StatusPurposeRevocation).Return(statusList, 73, nil)
+
+	sut := badge.NewStatusListService(repo, testAPIURL)
+
+	status, err := sut.Allocate(ctx, types.StatusPurposeRevocation, "issuer", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "73", status.StatusListIndex)
+}
+
+func TestStatusListService_SetStatus_should_set_the_bits_of_the_status_list_entries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	statusList := types.NewStatusList(uuid.NewString(), types.StatusPurposeRevocation, badge.StatusListSize)
+	listURL := testAPIURL + "/v1alpha1/badges/status-lists/" + statusList.ID
+
+	vc := &types.VerifiableCredential{
+		Status: []*types.CredentialStatus{
+			{
+				Type:                 types.BitstringStatusListEntryType,
+				StatusPurpose:        types.StatusPurposeRevocation,
+				StatusListIndex:      "42",
+				StatusListCredential: listURL,
+			},
+			{
+				// Entry of a status list of another issuer
+				Type:                 types.BitstringStatusListEntryType,
+				StatusPurpose:        types.StatusPurposeRevocation,
+				StatusListIndex:      "1",
+				StatusListCredential: "https://other.example.com/status-lists/1",
+			},
+			{
+				Type:    "CredentialStatus",
+				Purpose: types.CREDENTIAL_STATUS_PURPOSE_REVOCATION,
+			},
+		},
+	}
+
+	repo := badgemocks.NewStatusListRepository(t)
+	repo.EXPECT().
+		Update(ctx, statusList.ID, mock.Anything).
+		RunAndReturn(func(ctx context.Context, id string, update func(*types.StatusList) error) error {
+			return update(statusList)
+		})
+
+	sut := badge.NewStatusListService(repo, testAPIURL)
+
+	err := sut.SetStatus(ctx, vc, types.StatusPurposeRevocation, true, "issuer", privKey)
+
+	require.NoError(t, err)
+
+	revoked, _ := statusList.Get(42)
+	assert.True(t, revoked)
+
+	bitstring, err := types.DecodeBitstring(statusList.Credential.CredentialSubject.EncodedList)
+	require.NoError(t, err)
+	assert.Equal(t, statusList.Bitstring, bitstring)
+}
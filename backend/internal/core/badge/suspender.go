@@ -0,0 +1,166 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBadgeAlreadySuspended = errors.New("badge already suspended")
+	ErrBadgeNotSuspended     = errors.New("badge not suspended")
+)
+
+// Suspender pauses the validity of the active badges of an app,
+// unlike the revocation the suspension can be undone.
+type Suspender interface {
+	// SuspendAll records who suspended the active badges of the app and why,
+	// ErrBadgeNotFound is returned when the app has no active badge
+	SuspendAll(
+		ctx context.Context,
+		appID string,
+		suspendedBy string,
+		reason string,
+		issuer string,
+		privKey *jwk.Jwk,
+	) error
+
+	// ResumeAll removes the suspension of the active badges of the app
+	ResumeAll(ctx context.Context, appID string, issuer string, privKey *jwk.Jwk) error
+}
+
+type suspender struct {
+	badgeRepository   Repository
+	statusListService StatusListService
+}
+
+func NewSuspender(badgeRepository Repository, statusListService StatusListService) Suspender {
+	return &suspender{
+		badgeRepository:   badgeRepository,
+		statusListService: statusListService,
+	}
+}
+
+func (s *suspender) SuspendAll(
+	ctx context.Context,
+	appID string,
+	suspendedBy string,
+	reason string,
+	issuer string,
+	privKey *jwk.Jwk,
+) error {
+	badges, err := s.badgeRepository.GetAllActiveBadges(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	if len(badges) == 0 {
+		return ErrBadgeNotFound
+	}
+
+	if slices.ContainsFunc(badges, (*types.Badge).IsSuspended) {
+		return ErrBadgeAlreadySuspended
+	}
+
+	for _, badge := range badges {
+		badge.Status = append(badge.Status, &types.CredentialStatus{
+			ID: fmt.Sprintf(
+				"https://spec.identity.agntcy.org/protodocs/agntcy/identity/core/v1alpha1/vc.proto#%s",
+				uuid.NewString(),
+			),
+			Type:      "CredentialStatus",
+			Purpose:   types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION,
+			CreatedAt: time.Now().UTC(),
+			CreatedBy: suspendedBy,
+			Comment:   reason,
+		})
+
+		err := sign(&badge.VerifiableCredential, privKey)
+		if err != nil {
+			return err
+		}
+
+		err = s.statusListService.SetStatus(
+			ctx,
+			&badge.VerifiableCredential,
+			types.StatusPurposeSuspension,
+			true,
+			issuer,
+			privKey,
+		)
+		if err != nil {
+			return fmt.Errorf("status list service failed to suspend badge %s: %w", badge.ID, err)
+		}
+
+		err = s.badgeRepository.Update(ctx, badge)
+		if err != nil {
+			return fmt.Errorf("repository failed to save suspended badge %s: %w", badge.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *suspender) ResumeAll(
+	ctx context.Context,
+	appID string,
+	issuer string,
+	privKey *jwk.Jwk,
+) error {
+	badges, err := s.badgeRepository.GetAllActiveBadges(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(badges, (*types.Badge).IsSuspended) {
+		return ErrBadgeNotSuspended
+	}
+
+	for _, badge := range badges {
+		if !badge.IsSuspended() {
+			continue
+		}
+
+		badge.Status = slices.DeleteFunc(badge.Status, func(status *types.CredentialStatus) bool {
+			return status.Purpose == types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION
+		})
+
+		err := sign(&badge.VerifiableCredential, privKey)
+		if err != nil {
+			return err
+		}
+
+		err = s.statusListService.SetStatus(
+			ctx,
+			&badge.VerifiableCredential,
+			types.StatusPurposeSuspension,
+			false,
+			issuer,
+			privKey,
+		)
+		if err != nil {
+			return fmt.Errorf("status list service failed to resume badge %s: %w", badge.ID, err)
+		}
+
+		err = s.badgeRepository.DeleteStatuses(ctx, badge.ID, types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION)
+		if err != nil {
+			return fmt.Errorf("repository failed to delete the suspension of badge %s: %w", badge.ID, err)
+		}
+
+		err = s.badgeRepository.Update(ctx, badge)
+		if err != nil {
+			return fmt.Errorf("repository failed to save resumed badge %s: %w", badge.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
+	badgemocks "github.com/agntcy/identity-service/internal/core/badge/mocks"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSuspender_SuspendAll_should_suspend_all_badges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	badges := []*types.Badge{
+		{
+			AppID:                appID,
+			VerifiableCredential: types.VerifiableCredential{ID: uuid.NewString()},
+		},
+	}
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetAllActiveBadges(ctx, appID).Return(badges, nil)
+	badgeRepo.EXPECT().Update(ctx, badges[0]).Return(nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, &badges[0].VerifiableCredential, types.StatusPurposeSuspension, true, "issuer", privKey).
+		Return(nil)
+
+	sut := badge.NewSuspender(badgeRepo, statusListSrv)
+
+	err := sut.SuspendAll(ctx, appID, "user_id", "reason", "issuer", privKey)
+
+	assert.NoError(t, err)
+	assert.True(t, badges[0].IsSuspended())
+	assert.False(t, badges[0].IsRevoked())
+	assert.Equal(t, "user_id", badges[0].Status[0].CreatedBy)
+	assert.Equal(t, "reason", badges[0].Status[0].Comment)
+	assert.Greater(t, badges[0].Status[0].CreatedAt, time.Now().Add(-time.Minute).UTC())
+
+	signedBadge, err := joseutil.Verify(privKey.PublicKey(), []byte(badges[0].Proof.ProofValue))
+	assert.NoError(t, err)
+
+	actualBadge, err := getVerifiableCredentialAsJSON(t, &badges[0].VerifiableCredential)
+	assert.NoError(t, err)
+	assert.Equal(t, signedBadge, actualBadge)
+	assert.NotContains(t, string(signedBadge), "user_id")
+}
+
+func TestSuspender_SuspendAll_should_return_err(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		badges []*types.Badge
+		err    error
+	}{
+		"no active badge": {
+			badges: nil,
+			err:    badge.ErrBadgeNotFound,
+		},
+		"badge already suspended": {
+			badges: []*types.Badge{
+				{
+					VerifiableCredential: types.VerifiableCredential{
+						Status: []*types.CredentialStatus{
+							{Purpose: types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION},
+						},
+					},
+				},
+			},
+			err: badge.ErrBadgeAlreadySuspended,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			appID := uuid.NewString()
+
+			badgeRepo := badgemocks.NewRepository(t)
+			badgeRepo.EXPECT().GetAllActiveBadges(ctx, appID).Return(tc.badges, nil)
+
+			sut := badge.NewSuspender(badgeRepo, nil)
+
+			err := sut.SuspendAll(ctx, appID, "user_id", "reason", "issuer", nil)
+
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestSuspender_SuspendAll_should_return_err_when_cannot_update_the_status_list(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetAllActiveBadges(ctx, appID).
+		Return([]*types.Badge{{VerifiableCredential: types.VerifiableCredential{}}}, nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("error"))
+
+	sut := badge.NewSuspender(badgeRepo, statusListSrv)
+
+	err := sut.SuspendAll(ctx, appID, "user_id", "reason", "issuer", privKey)
+
+	assert.ErrorContains(t, err, "status list service failed to suspend badge")
+}
+
+func TestSuspender_ResumeAll_should_resume_the_suspended_badges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	entry := &types.CredentialStatus{
+		Type:          types.BitstringStatusListEntryType,
+		StatusPurpose: types.StatusPurposeSuspension,
+	}
+	badges := []*types.Badge{
+		{
+			AppID: appID,
+			VerifiableCredential: types.VerifiableCredential{
+				ID: uuid.NewString(),
+				Status: []*types.CredentialStatus{
+					entry,
+					{Purpose: types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION},
+				},
+			},
+		},
+	}
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetAllActiveBadges(ctx, appID).Return(badges, nil)
+	badgeRepo.EXPECT().
+		DeleteStatuses(ctx, badges[0].ID, types.CREDENTIAL_STATUS_PURPOSE_SUSPENSION).
+		Return(nil)
+	badgeRepo.EXPECT().Update(ctx, badges[0]).Return(nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, &badges[0].VerifiableCredential, types.StatusPurposeSuspension, false, "issuer", privKey).
+		Return(nil)
+
+	sut := badge.NewSuspender(badgeRepo, statusListSrv)
+
+	err := sut.ResumeAll(ctx, appID, "issuer", privKey)
+
+	assert.NoError(t, err)
+	assert.False(t, badges[0].IsSuspended())
+	assert.Equal(t, []*types.CredentialStatus{entry}, badges[0].Status)
+
+	_, err = joseutil.Verify(privKey.PublicKey(), []byte(badges[0].Proof.ProofValue))
+	assert.NoError(t, err)
+}
+
+func TestSuspender_ResumeAll_should_return_err_when_no_badge_is_suspended(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().
+		GetAllActiveBadges(ctx, appID).
+		Return([]*types.Badge{{VerifiableCredential: types.VerifiableCredential{}}}, nil)
+
+	sut := badge.NewSuspender(badgeRepo, nil)
+
+	err := sut.ResumeAll(ctx, appID, "issuer", nil)
+
+	assert.ErrorIs(t, err, badge.ErrBadgeNotSuspended)
+}
+
+func TestSuspender_SuspendAll_should_keep_the_proof_format(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	issued, _ := badge.Issue(
+		uuid.NewString(),
+		"issuer",
+		badge.IssuerDocumentURL("https://api", "issuer"),
+		types.BADGE_TYPE_AGENT_BADGE,
+		&types.BadgeClaims{ID: uuid.NewString(), Badge: "{}"},
+		privKey,
+		types.PROOF_FORMAT_EDDSA_JCS_2022,
+		"",
+		0,
+	)
+
+	badgeRepo := badgemocks.NewRepository(t)
+	badgeRepo.EXPECT().GetAllActiveBadges(ctx, issued.AppID).Return([]*types.Badge{issued}, nil)
+	badgeRepo.EXPECT().Update(ctx, issued).Return(nil)
+
+	statusListSrv := badgemocks.NewStatusListService(t)
+	statusListSrv.EXPECT().
+		SetStatus(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	sut := badge.NewSuspender(badgeRepo, statusListSrv)
+
+	err := sut.SuspendAll(ctx, issued.AppID, "user_id", "reason", "issuer", privKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dataintegrity.EdDSAJCS2022, issued.Proof.Cryptosuite)
+
+	credential, _ := json.Marshal(issued.VerifiableCredential)
+	assert.True(t, badge.VerifyDataIntegrity(string(credential)).Status)
+}
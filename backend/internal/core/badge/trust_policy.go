@@ -0,0 +1,131 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+)
+
+const day = 24 * time.Hour
+
+// ApplyTrustPolicy runs the checks of the trust policy on the verified badge. The failed checks
+// fail the verification and are returned in the errors, the passed and the skipped checks
+// are returned in the warnings. A nil appStatus, used when the App of the badge is unknown
+// to the service, fails the App check since the App cannot be proven to be active.
+func ApplyTrustPolicy(
+	result *types.VerificationResult,
+	policy *types.TrustPolicy,
+	appStatus *apptypes.AppStatus,
+	now time.Time,
+) {
+	if result.Document == nil {
+		return
+	}
+
+	vc := result.Document
+
+	if len(policy.Issuers) > 0 {
+		if slices.Contains(policy.Issuers, vc.Issuer) {
+			trustCheckPassed(result, policy, "the issuer %s is trusted", vc.Issuer)
+		} else {
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyUntrustedIssuer,
+				policy,
+				"the issuer %s is not trusted",
+				vc.Issuer,
+			)
+		}
+	}
+
+	if len(policy.BadgeTypes) > 0 {
+		trusted := slices.ContainsFunc(policy.BadgeTypes, func(typ types.BadgeType) bool {
+			return slices.Contains(vc.Type, typ.String())
+		})
+		if trusted {
+			trustCheckPassed(result, policy, "the badge type is trusted")
+		} else {
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyUntrustedBadgeType,
+				policy,
+				"the badge type %v is not trusted",
+				vc.Type,
+			)
+		}
+	}
+
+	if policy.IssuedWithinDays > 0 {
+		issuedAt, err := time.Parse(time.RFC3339, vc.IssuanceDate)
+
+		switch {
+		case err != nil:
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyBadgeTooOld,
+				policy,
+				"the issuance date %q is invalid",
+				vc.IssuanceDate,
+			)
+		case now.Sub(issuedAt) > time.Duration(policy.IssuedWithinDays)*day:
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyBadgeTooOld,
+				policy,
+				"the badge was issued more than %d days ago",
+				policy.IssuedWithinDays,
+			)
+		default:
+			trustCheckPassed(result, policy, "the badge was issued within %d days", policy.IssuedWithinDays)
+		}
+	}
+
+	if policy.RequireActiveApp {
+		switch {
+		case appStatus == nil:
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyAppNotActive,
+				policy,
+				"the App of the badge is unknown",
+			)
+		case *appStatus != apptypes.APP_STATUS_ACTIVE:
+			trustCheckFailed(
+				result,
+				types.ErrorReasonTrustPolicyAppNotActive,
+				policy,
+				"the App of the badge is %s",
+				appStatus.String(),
+			)
+		default:
+			trustCheckPassed(result, policy, "the App of the badge is active")
+		}
+	}
+}
+
+func trustCheckPassed(result *types.VerificationResult, policy *types.TrustPolicy, format string, args ...any) {
+	result.Warnings = append(result.Warnings, &types.ErrorInfo{
+		Reason:  types.WarningReasonTrustPolicyCheckPassed,
+		Message: fmt.Sprintf("Trust policy %s: %s.", policy.Name, fmt.Sprintf(format, args...)),
+	})
+}
+
+func trustCheckFailed(
+	result *types.VerificationResult,
+	reason string,
+	policy *types.TrustPolicy,
+	format string,
+	args ...any,
+) {
+	result.Status = false
+	result.Errors = append(result.Errors, &types.ErrorInfo{
+		Reason:  reason,
+		Message: fmt.Sprintf("Trust policy %s: %s.", policy.Name, fmt.Sprintf(format, args...)),
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge_test
+
+import (
+	"testing"
+	"time"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/core/badge"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTrustPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := map[string]*struct {
+		policy           *types.TrustPolicy
+		appStatus        *apptypes.AppStatus
+		expectedStatus   bool
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		"empty policy": {
+			policy:         &types.TrustPolicy{Name: "empty"},
+			expectedStatus: true,
+		},
+		"all checks passed": {
+			policy: &types.TrustPolicy{
+				Name:             "strict",
+				Issuers:          []string{"other", "issuer"},
+				BadgeTypes:       []types.BadgeType{types.BADGE_TYPE_MCP_BADGE},
+				IssuedWithinDays: 7,
+				RequireActiveApp: true,
+			},
+			appStatus:      ptrutil.Ptr(apptypes.APP_STATUS_ACTIVE),
+			expectedStatus: true,
+			expectedWarnings: []string{
+				types.WarningReasonTrustPolicyCheckPassed,
+				types.WarningReasonTrustPolicyCheckPassed,
+				types.WarningReasonTrustPolicyCheckPassed,
+				types.WarningReasonTrustPolicyCheckPassed,
+			},
+		},
+		"untrusted issuer": {
+			policy:         &types.TrustPolicy{Name: "issuers", Issuers: []string{"other"}},
+			expectedStatus: false,
+			expectedErrors: []string{types.ErrorReasonTrustPolicyUntrustedIssuer},
+		},
+		"untrusted badge type": {
+			policy: &types.TrustPolicy{
+				Name:       "agents",
+				BadgeTypes: []types.BadgeType{types.BADGE_TYPE_AGENT_BADGE},
+			},
+			expectedStatus: false,
+			expectedErrors: []string{types.ErrorReasonTrustPolicyUntrustedBadgeType},
+		},
+		"badge too old": {
+			policy:           &types.TrustPolicy{Name: "recent", Issuers: []string{"issuer"}, IssuedWithinDays: 1},
+			expectedStatus:   false,
+			expectedErrors:   []string{types.ErrorReasonTrustPolicyBadgeTooOld},
+			expectedWarnings: []string{types.WarningReasonTrustPolicyCheckPassed},
+		},
+		"revoked app": {
+			policy:         &types.TrustPolicy{Name: "active", RequireActiveApp: true},
+			appStatus:      ptrutil.Ptr(apptypes.APP_STATUS_REVOKED),
+			expectedStatus: false,
+			expectedErrors: []string{types.ErrorReasonTrustPolicyAppNotActive},
+		},
+		"unknown app": {
+			policy:         &types.TrustPolicy{Name: "active", RequireActiveApp: true},
+			expectedStatus: false,
+			expectedErrors: []string{types.ErrorReasonTrustPolicyAppNotActive},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			result := &types.VerificationResult{
+				Status: true,
+				Document: &types.VerifiableCredential{
+					Issuer:       "issuer",
+					Type:         []string{types.BADGE_TYPE_MCP_BADGE.String()},
+					IssuanceDate: now.Add(-2 * 24 * time.Hour).Format(time.RFC3339),
+				},
+			}
+
+			badge.ApplyTrustPolicy(result, tc.policy, tc.appStatus, now)
+
+			assert.Equal(t, tc.expectedStatus, result.Status)
+			assert.Equal(t, tc.expectedErrors, reasons(result.Errors))
+			assert.Equal(t, tc.expectedWarnings, reasons(result.Warnings))
+		})
+	}
+}
+
+func reasons(infos []*types.ErrorInfo) []string {
+	var res []string
+	for _, info := range infos {
+		res = append(res, info.Reason)
+	}
+
+	return res
+}
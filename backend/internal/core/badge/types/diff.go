@@ -0,0 +1,69 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate stringer -type=ClaimChangeType
+
+package types
+
+// The type of a change of a claim
+type ClaimChangeType int
+
+const (
+	// Unspecified change
+	CLAIM_CHANGE_TYPE_UNSPECIFIED ClaimChangeType = iota
+
+	// The claim was added
+	CLAIM_CHANGE_TYPE_ADDED
+
+	// The claim was removed
+	CLAIM_CHANGE_TYPE_REMOVED
+
+	// The value of the claim changed
+	CLAIM_CHANGE_TYPE_MODIFIED
+)
+
+func (t *ClaimChangeType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case CLAIM_CHANGE_TYPE_ADDED.String():
+		*t = CLAIM_CHANGE_TYPE_ADDED
+	case CLAIM_CHANGE_TYPE_REMOVED.String():
+		*t = CLAIM_CHANGE_TYPE_REMOVED
+	case CLAIM_CHANGE_TYPE_MODIFIED.String():
+		*t = CLAIM_CHANGE_TYPE_MODIFIED
+	default:
+		*t = CLAIM_CHANGE_TYPE_UNSPECIFIED
+	}
+
+	return nil
+}
+
+func (t ClaimChangeType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// BadgeDiff represents how the claims changed between two badges of an App
+type BadgeDiff struct {
+	// The ID of the previous badge
+	FromBadgeID string `json:"fromBadgeId" protobuf:"bytes,1,opt,name=from_badge_id"`
+
+	// The ID of the next badge
+	ToBadgeID string `json:"toBadgeId" protobuf:"bytes,2,opt,name=to_badge_id"`
+
+	// The changes of the claims, sorted by path
+	Changes []*ClaimChange `json:"changes" protobuf:"bytes,3,opt,name=changes"`
+}
+
+// ClaimChange represents a change of a claim of a badge
+type ClaimChange struct {
+	// The path of the claim (ex: skills[id=search].description)
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+
+	// The type of the change
+	Type ClaimChangeType `json:"type" protobuf:"bytes,2,opt,name=type"`
+
+	// The JSON encoded value of the claim in the previous badge
+	OldValue *string `json:"oldValue,omitempty" protobuf:"bytes,3,opt,name=old_value"`
+
+	// The JSON encoded value of the claim in the next badge
+	NewValue *string `json:"newValue,omitempty" protobuf:"bytes,4,opt,name=new_value"`
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// The type of the verification methods holding a multibase encoded public key
+const MultikeyVerificationMethod = "Multikey"
+
+// The context of the controlled identifier documents
+const ControlledIdentifierContext = "https://www.w3.org/ns/cid/v1"
+
+// IssuerDocument is the controlled identifier document of an issuer defined [here],
+// it publishes the keys verifying the Data Integrity proofs of the badges of the issuer
+//
+// [here]: https://www.w3.org/TR/cid-1.0/#controlled-identifier-documents
+type IssuerDocument struct {
+	// https://www.w3.org/TR/cid-1.0/#json-ld-context
+	Context []string `json:"@context" protobuf:"bytes,1,opt,name=context"`
+
+	// The URL of the document, the controller of its verification methods
+	ID string `json:"id" protobuf:"bytes,2,opt,name=id"`
+
+	// The keys of the issuer
+	VerificationMethod []*VerificationMethod `json:"verificationMethod" protobuf:"bytes,3,opt,name=verification_method"`
+
+	// The IDs of the verification methods signing the badges
+	AssertionMethod []string `json:"assertionMethod" protobuf:"bytes,4,opt,name=assertion_method"`
+}
+
+// VerificationMethod is a Multikey verification method defined [here]
+//
+// [here]: https://www.w3.org/TR/cid-1.0/#Multikey
+type VerificationMethod struct {
+	// The URL of the document with the public key as fragment
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+
+	// The type of the verification method, always Multikey
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+
+	// The URL of the document of the issuer
+	Controller string `json:"controller" protobuf:"bytes,3,opt,name=controller"`
+
+	// The multibase encoded public key with its multicodec prefix
+	PublicKeyMultibase string `json:"publicKeyMultibase" protobuf:"bytes,4,opt,name=public_key_multibase"`
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+)
+
+const (
+	BitstringStatusListEntryType      = "BitstringStatusListEntry"
+	BitstringStatusListType           = "BitstringStatusList"
+	BitstringStatusListCredentialType = "BitstringStatusListCredential"
+
+	// The status purposes defined by the Bitstring Status List
+	StatusPurposeRevocation = "revocation"
+	StatusPurposeSuspension = "suspension"
+
+	// The multibase prefix of the base64url encoding without padding
+	multibaseBase64URL = "u"
+)
+
+// The number of random draws of a free index before looking it up
+const maxAllocationDraws = 32
+
+var (
+	ErrStatusListIndexOutOfRange = errors.New("the status list index is out of range")
+	ErrStatusListFull            = errors.New("all the indexes of the status list are allocated")
+)
+
+// StatusList is a W3C Bitstring Status List of a tenant defined [here].
+// The status of a credential is the bit at its index, starting
+// from the left-most bit of the bitstring.
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/
+type StatusList struct {
+	ID string
+
+	// The purpose of the statuses of the list (ex: revocation)
+	Purpose string
+
+	// The statuses of the credentials
+	Bitstring []byte
+
+	// The indexes already allocated to credentials, one bit per index
+	Allocations []byte
+
+	// The number of indexes already allocated to credentials
+	Allocated int
+
+	// The last signed status list credential
+	Credential *StatusListCredential
+}
+
+// NewStatusList returns an empty status list of the given number of bits
+func NewStatusList(id, purpose string, size int) *StatusList {
+	return &StatusList{
+		ID:          id,
+		Purpose:     purpose,
+		Bitstring:   make([]byte, size/8), //nolint:mnd // bits per byte
+		Allocations: make([]byte, size/8), //nolint:mnd // bits per byte
+	}
+}
+
+// Size returns the number of statuses of the list
+func (l *StatusList) Size() int {
+	return len(l.Bitstring) * 8 //nolint:mnd // bits per byte
+}
+
+// IsFull returns true when all the indexes are allocated
+func (l *StatusList) IsFull() bool {
+	return l.Allocated >= l.Size()
+}
+
+// Allocate reserves an index picked at random among the free ones, as recommended
+// by the Bitstring Status List, so the index of a credential does not reveal
+// when it was issued nor correlate the credentials issued together.
+func (l *StatusList) Allocate() (int, error) {
+	if l.IsFull() {
+		return 0, ErrStatusListFull
+	}
+
+	if len(l.Allocations) != len(l.Bitstring) {
+		return 0, errors.New("the allocations of the status list do not match its size")
+	}
+
+	// The random draws rarely miss until the list is almost full,
+	// the free indexes are then looked up from a random one
+	for range maxAllocationDraws {
+		index, err := randomIndex(l.Size())
+		if err != nil {
+			return 0, err
+		}
+
+		if !isSet(l.Allocations, index) {
+			return l.allocate(index), nil
+		}
+	}
+
+	start, err := randomIndex(l.Size())
+	if err != nil {
+		return 0, err
+	}
+
+	for offset := range l.Size() {
+		index := (start + offset) % l.Size()
+		if !isSet(l.Allocations, index) {
+			return l.allocate(index), nil
+		}
+	}
+
+	return 0, ErrStatusListFull
+}
+
+func (l *StatusList) allocate(index int) int {
+	l.Allocations[index/8] |= 0x80 >> (index % 8) //nolint:mnd // bits per byte
+	l.Allocated++
+
+	return index
+}
+
+func randomIndex(size int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(size)))
+	if err != nil {
+		return 0, fmt.Errorf("unable to draw a status list index: %w", err)
+	}
+
+	return int(index.Int64()), nil
+}
+
+func isSet(bitstring []byte, index int) bool {
+	return bitstring[index/8]&(0x80>>(index%8)) != 0 //nolint:mnd // bits per byte
+}
+
+// Get returns the status at the given index
+func (l *StatusList) Get(index int) (bool, error) {
+	if index < 0 || index >= l.Size() {
+		return false, ErrStatusListIndexOutOfRange
+	}
+
+	return isSet(l.Bitstring, index), nil
+}
+
+// Set changes the status at the given index
+func (l *StatusList) Set(index int, value bool) error {
+	if index < 0 || index >= l.Size() {
+		return ErrStatusListIndexOutOfRange
+	}
+
+	mask := byte(0x80 >> (index % 8)) //nolint:mnd // bits per byte
+
+	if value {
+		l.Bitstring[index/8] |= mask //nolint:mnd // bits per byte
+	} else {
+		l.Bitstring[index/8] &^= mask //nolint:mnd // bits per byte
+	}
+
+	return nil
+}
+
+// EncodeBitstring compresses the bitstring with GZIP and encodes it
+// as a multibase base64url string without padding
+func EncodeBitstring(bitstring []byte) (string, error) {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+
+	_, err := writer.Write(bitstring)
+	if err != nil {
+		return "", fmt.Errorf("unable to compress the bitstring: %w", err)
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return "", fmt.Errorf("unable to compress the bitstring: %w", err)
+	}
+
+	return multibaseBase64URL + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeBitstring decodes an encoded list of a status list credential
+func DecodeBitstring(encodedList string) ([]byte, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(encodedList, multibaseBase64URL))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the encoded list: %w", err)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress the encoded list: %w", err)
+	}
+
+	defer reader.Close()
+
+	bitstring, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress the encoded list: %w", err)
+	}
+
+	return bitstring, nil
+}
+
+// StatusListCredential represents a Bitstring Status List credential defined [here]
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/#bitstringstatuslistcredential
+type StatusListCredential struct {
+	// https://www.w3.org/TR/vc-data-model-2.0/#contexts
+	Context []string `json:"@context" protobuf:"bytes,1,opt,name=context"`
+
+	// The URL of the status list credential
+	ID string `json:"id" protobuf:"bytes,2,opt,name=id"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#types
+	Type []string `json:"type" protobuf:"bytes,3,opt,name=type"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#issuer
+	Issuer string `json:"issuer" protobuf:"bytes,4,opt,name=issuer"`
+
+	// https://www.w3.org/TR/vc-data-model-2.0/#validity-period
+	ValidFrom string `json:"validFrom" protobuf:"bytes,5,opt,name=valid_from"`
+
+	// The status list
+	CredentialSubject *BitstringStatusList `json:"credentialSubject" protobuf:"bytes,6,opt,name=credential_subject"`
+
+	// https://w3id.org/security#proof
+	Proof *Proof `json:"proof,omitempty" protobuf:"bytes,7,opt,name=proof"`
+}
+
+// BitstringStatusList represents the credential subject of a status list credential defined [here]
+//
+// [here]: https://www.w3.org/TR/vc-bitstring-status-list/#bitstringstatuslist
+type BitstringStatusList struct {
+	// The ID of the status list
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+
+	// The type of the credential subject, always BitstringStatusList
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+
+	// The purpose of the statuses of the list (ex: revocation)
+	StatusPurpose string `json:"statusPurpose" protobuf:"bytes,3,opt,name=status_purpose"`
+
+	// The GZIP compressed bitstring, encoded as a multibase base64url string
+	EncodedList string `json:"encodedList" protobuf:"bytes,4,opt,name=encoded_list"`
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusList_Set(t *testing.T) {
+	t.Parallel()
+
+	sut := types.NewStatusList("id", types.StatusPurposeRevocation, 16)
+
+	require.NoError(t, sut.Set(0, true))
+	require.NoError(t, sut.Set(9, true))
+
+	// The index zero is the left-most bit of the bitstring
+	assert.Equal(t, []byte{0x80, 0x40}, sut.Bitstring)
+
+	require.NoError(t, sut.Set(0, false))
+
+	value, err := sut.Get(0)
+	assert.NoError(t, err)
+	assert.False(t, value)
+
+	value, err = sut.Get(9)
+	assert.NoError(t, err)
+	assert.True(t, value)
+
+	assert.ErrorIs(t, sut.Set(16, true), types.ErrStatusListIndexOutOfRange)
+}
+
+func TestStatusList_Allocate_should_allocate_each_index_once(t *testing.T) {
+	t.Parallel()
+
+	sut := types.NewStatusList("id", types.StatusPurposeRevocation, 1024)
+	indexes := make(map[int]bool)
+
+	for range sut.Size() {
+		index, err := sut.Allocate()
+		require.NoError(t, err)
+
+		indexes[index] = true
+	}
+
+	assert.Len(t, indexes, sut.Size())
+	assert.True(t, sut.IsFull())
+
+	_, err := sut.Allocate()
+
+	assert.ErrorIs(t, err, types.ErrStatusListFull)
+}
+
+func TestStatusList_Allocate_should_not_allocate_the_indexes_in_order(t *testing.T) {
+	t.Parallel()
+
+	sut := types.NewStatusList("id", types.StatusPurposeRevocation, 131072)
+	indexes := make([]int, 0, 16)
+
+	for range cap(indexes) {
+		index, err := sut.Allocate()
+		require.NoError(t, err)
+
+		indexes = append(indexes, index)
+	}
+
+	assert.False(t, slices.IsSorted(indexes))
+}
+
+func TestEncodeBitstring(t *testing.T) {
+	t.Parallel()
+
+	bitstring := make([]byte, 16384)
+	bitstring[42] = 0x01
+
+	encoded, err := types.EncodeBitstring(bitstring)
+	require.NoError(t, err)
+
+	// The encoded list is a multibase base64url string
+	assert.Equal(t, "u", encoded[:1])
+
+	decoded, err := types.DecodeBitstring(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, bitstring, decoded)
+}
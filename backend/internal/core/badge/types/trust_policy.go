@@ -0,0 +1,42 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "time"
+
+// The reasons of the failed checks of a trust policy, returned in the errors of the verification
+const (
+	ErrorReasonTrustPolicyUntrustedIssuer    = "ERROR_REASON_TRUST_POLICY_UNTRUSTED_ISSUER"
+	ErrorReasonTrustPolicyUntrustedBadgeType = "ERROR_REASON_TRUST_POLICY_UNTRUSTED_BADGE_TYPE"
+	ErrorReasonTrustPolicyBadgeTooOld        = "ERROR_REASON_TRUST_POLICY_BADGE_TOO_OLD"
+	ErrorReasonTrustPolicyAppNotActive       = "ERROR_REASON_TRUST_POLICY_APP_NOT_ACTIVE"
+)
+
+// The reason of the passed checks of a trust policy, returned in the warnings of the verification
+const WarningReasonTrustPolicyCheckPassed = "WARNING_REASON_TRUST_POLICY_CHECK_PASSED"
+
+// TrustPolicy is a named set of checks a relying party applies
+// on top of the verification of a badge
+type TrustPolicy struct {
+	// The ID of the trust policy
+	ID string `json:"id,omitempty" protobuf:"bytes,1,opt,name=id"`
+
+	// The name of the trust policy, unique in the tenant
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+
+	// The issuers of the trusted badges, any issuer is trusted when empty
+	Issuers []string `json:"issuers,omitempty" protobuf:"bytes,3,opt,name=issuers"`
+
+	// The types of the trusted badges, any type is trusted when empty
+	BadgeTypes []BadgeType `json:"badgeTypes,omitempty" protobuf:"bytes,4,opt,name=badge_types"`
+
+	// The maximum age in days of the trusted badges, no limit when zero
+	IssuedWithinDays int32 `json:"issuedWithinDays,omitempty" protobuf:"bytes,5,opt,name=issued_within_days"`
+
+	// Only trust the badges of the Apps with the APP_STATUS_ACTIVE status
+	RequireActiveApp bool `json:"requireActiveApp,omitempty" protobuf:"bytes,6,opt,name=require_active_app"`
+
+	// The creation date and time of the trust policy
+	CreatedAt time.Time `json:"createdAt" protobuf:"google.protobuf.Timestamp,7,opt,name=created_at"`
+}
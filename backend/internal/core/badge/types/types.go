@@ -3,6 +3,9 @@
 
 //go:generate stringer -type=BadgeType
 //go:generate stringer -type=CredentialStatusPurpose
+//go:generate stringer -type=RevocationReason
+//go:generate stringer -type=ProofFormat
+//go:generate stringer -type=VerificationSource
 
 package types
 
@@ -14,8 +17,20 @@ import (
 )
 
 type Badge struct {
-	VerifiableCredential `       json:"verifiable_credential"`
-	AppID                string `json:"app_id,omitempty"`
+	VerifiableCredential `                json:"verifiable_credential"`
+	AppID                string          `json:"app_id,omitempty"`
+	Discovery            *BadgeDiscovery `json:"discovery,omitempty"`
+
+	// Only set when listing the badges of all the tenants
+	TenantID string `json:"-"`
+}
+
+// Where the claims of the badge were discovered, used to renew the badge
+// with up to date claims. Not set when the claims were provided as a schema.
+type BadgeDiscovery struct {
+	A2AWellKnownUrl string `json:"a2a_well_known_url,omitempty"`
+	McpName         string `json:"mcp_name,omitempty"`
+	McpUrl          string `json:"mcp_url,omitempty"`
 }
 
 func (b *Badge) IsRevoked() bool {
@@ -24,6 +39,12 @@ func (b *Badge) IsRevoked() bool {
 	})
 }
 
+func (b *Badge) IsSuspended() bool {
+	return slices.ContainsFunc(b.Status, func(status *CredentialStatus) bool {
+		return status.Purpose == CREDENTIAL_STATUS_PURPOSE_SUSPENSION
+	})
+}
+
 // The purpose of the status entry
 type CredentialStatusPurpose int
 
@@ -34,12 +55,18 @@ const (
 	// Used to cancel the validity of a verifiable credential.
 	// This status is not reversible.
 	CREDENTIAL_STATUS_PURPOSE_REVOCATION
+
+	// Used to temporarily pause the validity of a verifiable credential.
+	// This status is reversible.
+	CREDENTIAL_STATUS_PURPOSE_SUSPENSION
 )
 
 func (t *CredentialStatusPurpose) UnmarshalText(text []byte) error {
 	switch string(text) {
 	case CREDENTIAL_STATUS_PURPOSE_REVOCATION.String():
 		*t = CREDENTIAL_STATUS_PURPOSE_REVOCATION
+	case CREDENTIAL_STATUS_PURPOSE_SUSPENSION.String():
+		*t = CREDENTIAL_STATUS_PURPOSE_SUSPENSION
 	default:
 		*t = CREDENTIAL_STATUS_PURPOSE_UNSPECIFIED
 	}
@@ -51,6 +78,47 @@ func (t CredentialStatusPurpose) MarshalText() ([]byte, error) {
 	return []byte(t.String()), nil
 }
 
+// The reason of the revocation of a badge
+type RevocationReason int
+
+const (
+	// Unspecified reason
+	REVOCATION_REASON_UNSPECIFIED RevocationReason = iota
+
+	// The private key of the subject was compromised
+	REVOCATION_REASON_KEY_COMPROMISE
+
+	// The badge was replaced by a new badge
+	REVOCATION_REASON_SUPERSEDED
+
+	// The subject was decommissioned
+	REVOCATION_REASON_DECOMMISSIONED
+
+	// The subject violated a policy
+	REVOCATION_REASON_POLICY_VIOLATION
+)
+
+func (r *RevocationReason) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case REVOCATION_REASON_KEY_COMPROMISE.String():
+		*r = REVOCATION_REASON_KEY_COMPROMISE
+	case REVOCATION_REASON_SUPERSEDED.String():
+		*r = REVOCATION_REASON_SUPERSEDED
+	case REVOCATION_REASON_DECOMMISSIONED.String():
+		*r = REVOCATION_REASON_DECOMMISSIONED
+	case REVOCATION_REASON_POLICY_VIOLATION.String():
+		*r = REVOCATION_REASON_POLICY_VIOLATION
+	default:
+		*r = REVOCATION_REASON_UNSPECIFIED
+	}
+
+	return nil
+}
+
+func (r RevocationReason) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
 // CredentialStatus represents the credentialStatus property of a Verifiable Credential.
 // more information can be found [here]
 //
@@ -67,6 +135,29 @@ type CredentialStatus struct {
 
 	// The value of the purpose for the status entry
 	Purpose CredentialStatusPurpose `json:"purpose" protobuf:"bytes,4,opt,name=purpose"`
+
+	// The purpose of the Bitstring Status List entry (ex: revocation)
+	StatusPurpose string `json:"statusPurpose,omitempty" protobuf:"bytes,5,opt,name=status_purpose"`
+
+	// The position of the status of the credential in the status list
+	StatusListIndex string `json:"statusListIndex,omitempty" protobuf:"bytes,6,opt,name=status_list_index"`
+
+	// The URL of the status list credential
+	StatusListCredential string `json:"statusListCredential,omitempty" protobuf:"bytes,7,opt,name=status_list_credential"` //nolint:lll // ignore the line length limit
+
+	// The user who created the status (ex: who suspended the badge)
+	CreatedBy string `json:"-" protobuf:"bytes,8,opt,name=created_by"`
+
+	// Why the status was created
+	Comment string `json:"-" protobuf:"bytes,9,opt,name=comment"`
+
+	// Why the credential was revoked, set for the revocation statuses
+	RevocationReason RevocationReason `json:"-" protobuf:"bytes,10,opt,name=revocation_reason"`
+}
+
+// IsStatusListEntry returns true when the status references a Bitstring Status List
+func (s *CredentialStatus) IsStatusListEntry() bool {
+	return s.Type == BitstringStatusListEntryType
 }
 
 // DataModel represents the W3C Verifiable Credential Data Model defined [here]
@@ -104,6 +195,29 @@ type VerifiableCredential struct {
 	Proof *Proof `json:"proof,omitempty" protobuf:"bytes,10,opt,name=proof"`
 }
 
+// IsExpired returns true when the expiration date of the credential is over.
+// A credential without expiration date never expires.
+func (vc *VerifiableCredential) IsExpired() bool {
+	expiresAt, ok := vc.ExpiresAt()
+
+	return ok && !time.Now().Before(expiresAt)
+}
+
+// ExpiresAt parses the expiration date of the credential.
+func (vc *VerifiableCredential) ExpiresAt() (time.Time, bool) {
+	if vc.ExpirationDate == "" {
+		return time.Time{}, false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, vc.ExpirationDate)
+	if err != nil {
+		// An invalid date cannot be trusted, consider the credential expired
+		return time.Time{}, true
+	}
+
+	return expiresAt, true
+}
+
 // BadgeClaims represents the content of a Badge VC defined [here]
 //
 // [here]: https://spec.identity.agntcy.org/docs/vc/intro/
@@ -140,14 +254,84 @@ type Proof struct {
 
 	// The proof value
 	ProofValue string `json:"proofValue" protobuf:"bytes,3,opt,name=proof_value"`
+
+	// The cryptographic suite of a Data Integrity proof (ex: eddsa-jcs-2022)
+	Cryptosuite string `json:"cryptosuite,omitempty" protobuf:"bytes,4,opt,name=cryptosuite"`
+
+	// The identifier of the public key verifying a Data Integrity or an SD-JWT VC proof
+	VerificationMethod string `json:"verificationMethod,omitempty" protobuf:"bytes,5,opt,name=verification_method"`
+
+	// When a Data Integrity proof was created
+	Created string `json:"created,omitempty" protobuf:"bytes,6,opt,name=created"`
 }
 
-const JoseProof = "jwt"
+const (
+	JoseProof          = "jwt"
+	DataIntegrityProof = "DataIntegrityProof"
+	SdJwtProof         = "dc+sd-jwt"
+)
 
 func (p *Proof) IsJOSE() bool {
 	return strings.EqualFold(p.Type, JoseProof)
 }
 
+// IsDataIntegrity returns true when the proof is a W3C Data Integrity proof
+// embedded in the credential
+func (p *Proof) IsDataIntegrity() bool {
+	return p != nil && p.Type == DataIntegrityProof
+}
+
+// IsSdJwt returns true when the proof value is an SD-JWT VC with all its disclosures
+func (p *Proof) IsSdJwt() bool {
+	return p != nil && p.Type == SdJwtProof
+}
+
+// The format of the proof securing a badge
+type ProofFormat int
+
+const (
+	// Unspecified format, the default format of the tenant applies
+	PROOF_FORMAT_UNSPECIFIED ProofFormat = iota
+
+	// A JOSE enveloped credential signed with the issuer key
+	PROOF_FORMAT_JOSE
+
+	// A Data Integrity proof using the eddsa-jcs-2022 cryptosuite
+	PROOF_FORMAT_EDDSA_JCS_2022
+
+	// A Data Integrity proof using the ecdsa-jcs-2019 cryptosuite
+	PROOF_FORMAT_ECDSA_JCS_2019
+
+	// An SD-JWT VC with selectively disclosable claims
+	PROOF_FORMAT_SD_JWT_VC
+)
+
+func (f *ProofFormat) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case PROOF_FORMAT_JOSE.String():
+		*f = PROOF_FORMAT_JOSE
+	case PROOF_FORMAT_EDDSA_JCS_2022.String():
+		*f = PROOF_FORMAT_EDDSA_JCS_2022
+	case PROOF_FORMAT_ECDSA_JCS_2019.String():
+		*f = PROOF_FORMAT_ECDSA_JCS_2019
+	case PROOF_FORMAT_SD_JWT_VC.String():
+		*f = PROOF_FORMAT_SD_JWT_VC
+	default:
+		*f = PROOF_FORMAT_UNSPECIFIED
+	}
+
+	return nil
+}
+
+func (f ProofFormat) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// IsValid returns true for the known formats, including the unspecified format
+func (f ProofFormat) IsValid() bool {
+	return f >= PROOF_FORMAT_UNSPECIFIED && f <= PROOF_FORMAT_SD_JWT_VC
+}
+
 // The content of the Credential.
 // Multiple content types can be supported: AgentBadge, etc.
 type BadgeType int
@@ -168,6 +352,14 @@ const (
 	BADGE_TYPE_MCP_BADGE
 )
 
+// The reasons of the verification errors returned by the service
+const (
+	ErrorReasonVerifiableCredentialExpired           = "ERROR_REASON_VERIFIABLE_CREDENTIAL_EXPIRED"
+	ErrorReasonVerifiableCredentialRevoked           = "ERROR_REASON_VERIFIABLE_CREDENTIAL_REVOKED"
+	ErrorReasonVerifiableCredentialInvalidProof      = "ERROR_REASON_VERIFIABLE_CREDENTIAL_INVALID_PROOF"
+	ErrorReasonVerifiableCredentialInvalidKeyBinding = "ERROR_REASON_VERIFIABLE_CREDENTIAL_INVALID_KEY_BINDING"
+)
+
 //nolint:errname // ignore the error name rule
 type ErrorInfo struct {
 	Reason  string
@@ -204,4 +396,41 @@ type VerificationResult struct {
 
 	// A list represents zero or more errors generated by the verification process
 	Errors []*ErrorInfo `json:"errors" protobuf:"bytes,7,opt,name=errors"`
+
+	// Why the badge was revoked, set when the badge is revoked
+	RevocationReason RevocationReason `json:"revocationReason,omitempty" protobuf:"bytes,8,opt,name=revocation_reason"`
+
+	// Where the badge was verified
+	Source VerificationSource `json:"source,omitempty" protobuf:"bytes,9,opt,name=source"`
+}
+
+// Where a badge was verified
+type VerificationSource int
+
+const (
+	// Unspecified source
+	VERIFICATION_SOURCE_UNSPECIFIED VerificationSource = iota
+
+	// The badge was verified by the service, with the cached keys and statuses of its issuer
+	VERIFICATION_SOURCE_LOCAL
+
+	// The badge was verified by the identity node
+	VERIFICATION_SOURCE_REMOTE
+)
+
+func (s *VerificationSource) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case VERIFICATION_SOURCE_LOCAL.String():
+		*s = VERIFICATION_SOURCE_LOCAL
+	case VERIFICATION_SOURCE_REMOTE.String():
+		*s = VERIFICATION_SOURCE_REMOTE
+	default:
+		*s = VERIFICATION_SOURCE_UNSPECIFIED
+	}
+
+	return nil
+}
+
+func (s VerificationSource) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
 }
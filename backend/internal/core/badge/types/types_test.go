@@ -5,6 +5,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/core/badge/types"
 	"github.com/google/uuid"
@@ -49,6 +50,42 @@ func TestBadge_IsRevoked(t *testing.T) {
 	}
 }
 
+func TestVerifiableCredential_IsExpired(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		expirationDate string
+		expectedResult bool
+	}{
+		"should return false without an expiration date": {
+			expirationDate: "",
+			expectedResult: false,
+		},
+		"should return false before the expiration date": {
+			expirationDate: time.Now().Add(time.Hour).Format(time.RFC3339),
+			expectedResult: false,
+		},
+		"should return true after the expiration date": {
+			expirationDate: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			expectedResult: true,
+		},
+		"should return true for an invalid expiration date": {
+			expirationDate: "invalid",
+			expectedResult: true,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			sut := types.VerifiableCredential{ExpirationDate: tc.expirationDate}
+
+			assert.Equal(t, tc.expectedResult, sut.IsExpired())
+		})
+	}
+}
+
 func TestCredentialStatusPurpose_UnmarshalText(t *testing.T) {
 	t.Parallel()
 
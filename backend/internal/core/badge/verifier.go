@@ -0,0 +1,246 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package badge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/badge/dataintegrity"
+	"github.com/agntcy/identity-service/internal/core/badge/sdjwt"
+	"github.com/agntcy/identity-service/internal/core/badge/types"
+	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+)
+
+const (
+	dataIntegrityMediaType = "application/vc"
+	sdJwtMediaType         = "application/dc+sd-jwt"
+	joseMediaType          = "application/vc+jwt"
+)
+
+var ErrInvalidJose = errors.New("invalid JOSE enveloped badge")
+
+// ParseJose returns the credential of a JOSE enveloped badge and the ID of the key
+// that signed it, without verifying the signature
+func ParseJose(badge string) (*types.VerifiableCredential, string, error) {
+	msg, err := jws.Parse([]byte(strings.TrimSpace(badge)))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, "", ErrInvalidJose
+	}
+
+	var vc types.VerifiableCredential
+
+	err = json.Unmarshal(msg.Payload(), &vc)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidJose, err)
+	}
+
+	keyID, _ := msg.Signatures()[0].ProtectedHeaders().KeyID()
+
+	return &vc, keyID, nil
+}
+
+// VerifyJose verifies the signature of a JOSE enveloped badge with the public key of its issuer,
+// the verification fails with the ErrorReasonVerifiableCredentialInvalidProof reason
+func VerifyJose(badge string, key jwxjwk.Key) *types.VerificationResult {
+	result := &types.VerificationResult{
+		MediaType: joseMediaType,
+	}
+
+	payload, err := verifyJWS(strings.TrimSpace(badge), key)
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	var vc types.VerifiableCredential
+
+	err = json.Unmarshal(payload, &vc)
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	vc.Proof = &types.Proof{
+		Type:       types.JoseProof,
+		ProofValue: strings.TrimSpace(badge),
+	}
+
+	result.Status = true
+	result.Document = &vc
+	result.Controller = vc.Issuer
+	result.ControlledIdentifierDocument = vc.Issuer
+
+	return result
+}
+
+// verifyJWS verifies the compact JWS with the key, using the asymmetric algorithm of its header
+func verifyJWS(token string, key jwxjwk.Key) ([]byte, error) {
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, ErrInvalidJose
+	}
+
+	alg, ok := msg.Signatures()[0].ProtectedHeaders().Algorithm()
+	if !ok || !slices.Contains(jwtutil.DefaultAlgorithms, alg.String()) {
+		return nil, fmt.Errorf("%w: algorithm not allowed", ErrInvalidJose)
+	}
+
+	payload, err := jws.Verify([]byte(token), jws.WithKey(alg, key))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJose, err)
+	}
+
+	return payload, nil
+}
+
+// IsDataIntegrityCredential returns true when the badge is a credential with an embedded
+// Data Integrity proof, the other badges are JOSE enveloped credentials
+func IsDataIntegrityCredential(badge string) bool {
+	if !strings.HasPrefix(strings.TrimSpace(badge), "{") {
+		return false
+	}
+
+	var vc types.VerifiableCredential
+
+	err := json.Unmarshal([]byte(badge), &vc)
+
+	return err == nil && vc.Proof.IsDataIntegrity()
+}
+
+// VerifyDataIntegrity verifies the embedded Data Integrity proof of the badge,
+// the verification fails with the ErrorReasonVerifiableCredentialInvalidProof reason
+func VerifyDataIntegrity(badge string) *types.VerificationResult {
+	result := &types.VerificationResult{
+		MediaType: dataIntegrityMediaType,
+	}
+
+	var vc types.VerifiableCredential
+
+	err := json.Unmarshal([]byte(badge), &vc)
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	result.Document = &vc
+
+	proof, err := dataintegrity.Verify([]byte(badge))
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	// The key must be published in the document of the issuer of the badge
+	controller, _, _ := strings.Cut(proof.VerificationMethod, "#")
+	if !strings.HasSuffix(controller, issuerDocumentPath+url.PathEscape(vc.Issuer)) {
+		return invalidProof(result, errors.New("the verification method is not a key of the issuer"))
+	}
+
+	result.Status = true
+	result.Controller = controller
+	result.ControlledIdentifierDocument = controller
+
+	return result
+}
+
+// IsSdJwt returns true when the badge is the presentation of an SD-JWT VC badge
+func IsSdJwt(badge string) bool {
+	return sdjwt.IsSdJwt(badge)
+}
+
+// VerifySdJwt verifies the presentation of an SD-JWT VC badge, the document of the result
+// holds the disclosed claims only. The verification fails with the
+// ErrorReasonVerifiableCredentialInvalidKeyBinding reason when the key binding JWT is missing or invalid,
+// or when the audience or the nonce is not provided for a presentation bound to a holder key.
+//
+// The signature is verified by sdjwt.Verify with the key in the jwk header of the presentation,
+// which anyone can set: a successful result does not prove the badge was issued by its issuer.
+// The verification method of the result is derived from the thumbprint of that key, so the result
+// can only be trusted once the caller has compared it with the verification method of the badge
+// issued by the service, as the badge service does in verifyIssuedProof.
+func VerifySdJwt(badge string, opts ...sdjwt.VerifyOption) *types.VerificationResult {
+	result := &types.VerificationResult{
+		MediaType: sdJwtMediaType,
+	}
+
+	verified, err := sdjwt.Verify(badge, opts...)
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	vc, err := sdJwtCredential(verified)
+	if err != nil {
+		return invalidProof(result, err)
+	}
+
+	result.Status = true
+	result.Document = vc
+	result.Controller = vc.Issuer
+	result.ControlledIdentifierDocument = vc.Issuer
+
+	return result
+}
+
+// sdJwtCredential returns the credential of the disclosed claims of an SD-JWT VC
+func sdJwtCredential(verified *sdjwt.Verified) (*types.VerifiableCredential, error) {
+	data, err := json.Marshal(verified.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims sdJwtClaims
+
+	err = json.Unmarshal(data, &claims)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbprint, err := sdjwt.Thumbprint(verified.IssuerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vc := &types.VerifiableCredential{
+		ID:                claims.ID,
+		Type:              []string{claims.Type},
+		Issuer:            claims.Issuer,
+		IssuanceDate:      time.Unix(claims.IssuedAt, 0).UTC().Format(time.RFC3339),
+		CredentialSubject: &types.BadgeClaims{ID: claims.Subject, Badge: string(claims.Badge)},
+		Status:            claims.Status,
+		Proof: &types.Proof{
+			Type:               types.SdJwtProof,
+			VerificationMethod: thumbprint,
+		},
+	}
+
+	// The badges that are not JSON objects are kept as strings
+	var badge string
+	if json.Unmarshal(claims.Badge, &badge) == nil {
+		vc.CredentialSubject.Badge = badge
+	}
+
+	if claims.ExpiresAt > 0 {
+		vc.ExpirationDate = time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339)
+	}
+
+	return vc, nil
+}
+
+func invalidProof(result *types.VerificationResult, err error) *types.VerificationResult {
+	reason := types.ErrorReasonVerifiableCredentialInvalidProof
+	if errors.Is(err, sdjwt.ErrInvalidKeyBinding) {
+		reason = types.ErrorReasonVerifiableCredentialInvalidKeyBinding
+	}
+
+	result.Errors = append(result.Errors, &types.ErrorInfo{
+		Reason:  reason,
+		Message: err.Error(),
+	})
+
+	return result
+}
@@ -206,6 +206,11 @@ func (s *service) PublishVerifiableCredential(
 		return ErrVCIsNull
 	}
 
+	// The identity node does not support the SD-JWT VCs, they are verified by the service
+	if vc.Proof.IsSdJwt() {
+		return nil
+	}
+
 	proof, err := s.generateProof(ctx, clientCredentials, issuer.KeyID)
 	if err != nil {
 		return fmt.Errorf(
@@ -391,6 +396,11 @@ func (s *service) RevokeVerifiableCredential(
 		return ErrVCIsNull
 	}
 
+	// The identity node does not support the SD-JWT VCs, they are verified by the service
+	if vc.Proof.IsSdJwt() {
+		return nil
+	}
+
 	proof, err := s.generateProof(ctx, clientCredentials, issuer.KeyID)
 	if err != nil {
 		return fmt.Errorf(
@@ -401,9 +401,13 @@ func TestPublishVerifiableCredential(t *testing.T) {
 	vc, err := badge.Issue(
 		uuid.NewString(),
 		clientCred.Issuer,
+		"",
 		badgetypes.BADGE_TYPE_AGENT_BADGE,
 		&badgetypes.BadgeClaims{},
 		priv,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
 	)
 	assert.NoError(t, err)
 
@@ -460,9 +464,13 @@ func TestPublishVerifiableCredential_should_return_err_when_identity_node_fails(
 	vc, err := badge.Issue(
 		uuid.NewString(),
 		clientCred.Issuer,
+		"",
 		badgetypes.BADGE_TYPE_AGENT_BADGE,
 		&badgetypes.BadgeClaims{},
 		priv,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
 	)
 	assert.NoError(t, err)
 
@@ -503,9 +511,13 @@ func TestRevokeVerifiableCredential(t *testing.T) {
 	badg, err := badge.Issue(
 		uuid.NewString(),
 		clientCred.Issuer,
+		"",
 		badgetypes.BADGE_TYPE_AGENT_BADGE,
 		&badgetypes.BadgeClaims{},
 		priv,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
 	)
 	assert.NoError(t, err)
 
@@ -573,9 +585,13 @@ func TestRevokeVerifiableCredential_should_return_err_when_identity_node_fails(t
 	badg, err := badge.Issue(
 		uuid.NewString(),
 		clientCred.Issuer,
+		"",
 		badgetypes.BADGE_TYPE_AGENT_BADGE,
 		&badgetypes.BadgeClaims{},
 		priv,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
 	)
 	assert.NoError(t, err)
 
@@ -605,9 +621,13 @@ func TestRevokeVerifiableCredential_should_pass_when_vc_already_revoked(t *testi
 	badg, err := badge.Issue(
 		uuid.NewString(),
 		clientCred.Issuer,
+		"",
 		badgetypes.BADGE_TYPE_AGENT_BADGE,
 		&badgetypes.BadgeClaims{},
 		priv,
+		badgetypes.PROOF_FORMAT_JOSE,
+		"",
+		0,
 	)
 	assert.NoError(t, err)
 
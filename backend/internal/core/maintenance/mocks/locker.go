@@ -0,0 +1,110 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewLocker creates a new instance of Locker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Locker {
+	mock := &Locker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Locker is an autogenerated mock type for the Locker type
+type Locker struct {
+	mock.Mock
+}
+
+type Locker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Locker) EXPECT() *Locker_Expecter {
+	return &Locker_Expecter{mock: &_m.Mock}
+}
+
+// TryWithLock provides a mock function for the type Locker
+func (_mock *Locker) TryWithLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	ret := _mock.Called(ctx, name, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryWithLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(ctx context.Context) error) (bool, error)); ok {
+		return returnFunc(ctx, name, fn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, func(ctx context.Context) error) bool); ok {
+		r0 = returnFunc(ctx, name, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, func(ctx context.Context) error) error); ok {
+		r1 = returnFunc(ctx, name, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Locker_TryWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryWithLock'
+type Locker_TryWithLock_Call struct {
+	*mock.Call
+}
+
+// TryWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - fn func(ctx context.Context) error
+func (_e *Locker_Expecter) TryWithLock(ctx interface{}, name interface{}, fn interface{}) *Locker_TryWithLock_Call {
+	return &Locker_TryWithLock_Call{Call: _e.mock.On("TryWithLock", ctx, name, fn)}
+}
+
+func (_c *Locker_TryWithLock_Call) Run(run func(ctx context.Context, name string, fn func(ctx context.Context) error)) *Locker_TryWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 func(ctx context.Context) error
+		if args[2] != nil {
+			arg2 = args[2].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Locker_TryWithLock_Call) Return(b bool, err error) *Locker_TryWithLock_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Locker_TryWithLock_Call) RunAndReturn(run func(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)) *Locker_TryWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
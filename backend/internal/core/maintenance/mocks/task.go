@@ -0,0 +1,142 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewTask creates a new instance of Task. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTask(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Task {
+	mock := &Task{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Task is an autogenerated mock type for the Task type
+type Task struct {
+	mock.Mock
+}
+
+type Task_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Task) EXPECT() *Task_Expecter {
+	return &Task_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function for the type Task
+func (_mock *Task) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// Task_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Task_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Task_Expecter) Name() *Task_Name_Call {
+	return &Task_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Task_Name_Call) Run(run func()) *Task_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Task_Name_Call) Return(s string) *Task_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *Task_Name_Call) RunAndReturn(run func() string) *Task_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function for the type Task
+func (_mock *Task) Run(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Task_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Task_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Task_Expecter) Run(ctx interface{}) *Task_Run_Call {
+	return &Task_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *Task_Run_Call) Run(run func(ctx context.Context)) *Task_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Task_Run_Call) Return(n int64, err error) *Task_Run_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *Task_Run_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *Task_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	"github.com/agntcy/identity-service/pkg/log"
+	"gorm.io/gorm"
+)
+
+const lockPrefix = "maintenance:"
+
+// The locker relies on Postgres session-level advisory locks. The lock
+// is taken and released on the same connection, which is held while
+// the function runs.
+type locker struct {
+	dbContext *gorm.DB
+}
+
+func NewLocker(dbContext *gorm.DB) maintenance.Locker {
+	return &locker{
+		dbContext: dbContext,
+	}
+}
+
+func (l *locker) TryWithLock(
+	ctx context.Context,
+	name string,
+	fn func(ctx context.Context) error,
+) (bool, error) {
+	key := lockKey(name)
+
+	var acquired bool
+
+	err := l.dbContext.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		err := conn.Raw("SELECT pg_try_advisory_lock(?)", key).Scan(&acquired).Error
+		if err != nil || !acquired {
+			return err
+		}
+
+		// Release the lock even when the context is cancelled, otherwise
+		// it stays held by the pooled connection
+		defer func() {
+			err := conn.WithContext(context.WithoutCancel(ctx)).
+				Exec("SELECT pg_advisory_unlock(?)", key).Error
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Errorf("failed to release the lock %s", name)
+			}
+		}()
+
+		return fn(ctx)
+	})
+	if err != nil {
+		return acquired, fmt.Errorf("there was an error running %s with a lock: %w", name, err)
+	}
+
+	return acquired, nil
+}
+
+// lockKey maps the lock name to the 64-bit key of the advisory lock.
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(lockPrefix + name))
+
+	return int64(h.Sum64()) //nolint:gosec // the key only needs to be stable, overflows are fine
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "identity"
+	metricsSubsystem = "maintenance"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	ResultSkipped = "skipped"
+)
+
+// The Scheduler runs the maintenance tasks at a fixed interval.
+// Each task runs under its own lock, so when several replicas are deployed
+// only one of them runs a given task while the others skip it.
+type Scheduler interface {
+	// Start runs the tasks right away and then at every interval
+	// until the context is cancelled
+	Start(ctx context.Context)
+
+	// RunOnce runs each task once
+	RunOnce(ctx context.Context)
+}
+
+type scheduler struct {
+	locker   Locker
+	interval time.Duration
+	tasks    []Task
+
+	removedRows *prometheus.CounterVec
+	runs        *prometheus.CounterVec
+	lastSuccess *prometheus.GaugeVec
+}
+
+func NewScheduler(
+	locker Locker,
+	interval time.Duration,
+	registerer prometheus.Registerer,
+	tasks ...Task,
+) Scheduler {
+	s := &scheduler{
+		locker:   locker,
+		interval: interval,
+		tasks:    tasks,
+		removedRows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "removed_rows_total",
+			Help:      "Number of rows removed by the maintenance tasks.",
+		}, []string{"task"}),
+		runs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "runs_total",
+			Help:      "Number of runs of the maintenance tasks by result (success, failure, skipped).",
+		}, []string{"task", "result"}),
+		lastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "last_success_timestamp_seconds",
+			Help:      "Time of the last successful run of the maintenance tasks on this replica.",
+		}, []string{"task"}),
+	}
+
+	registerer.MustRegister(s.removedRows, s.runs, s.lastSuccess)
+
+	return s
+}
+
+func (s *scheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *scheduler) RunOnce(ctx context.Context) {
+	for _, task := range s.tasks {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.run(ctx, task)
+	}
+}
+
+func (s *scheduler) run(ctx context.Context, task Task) {
+	var removed int64
+
+	acquired, err := s.locker.TryWithLock(ctx, task.Name(), func(ctx context.Context) error {
+		var err error
+
+		removed, err = task.Run(ctx)
+
+		return err
+	})
+
+	// Rows removed before a failure are still counted
+	s.removedRows.WithLabelValues(task.Name()).Add(float64(removed))
+
+	switch {
+	case err != nil:
+		s.runs.WithLabelValues(task.Name(), ResultFailure).Inc()
+		log.FromContext(ctx).WithError(err).Errorf("maintenance task %s failed", task.Name())
+	case !acquired:
+		s.runs.WithLabelValues(task.Name(), ResultSkipped).Inc()
+		log.FromContext(ctx).Debugf("maintenance task %s is running on another replica", task.Name())
+	default:
+		s.runs.WithLabelValues(task.Name(), ResultSuccess).Inc()
+		s.lastSuccess.WithLabelValues(task.Name()).SetToCurrentTime()
+		log.FromContext(ctx).Infof("maintenance task %s removed %d rows", task.Name(), removed)
+	}
+}
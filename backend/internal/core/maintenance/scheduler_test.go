@@ -0,0 +1,116 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	maintenancemocks "github.com/agntcy/identity-service/internal/core/maintenance/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const taskName = "expired_rows"
+
+func newTask(t *testing.T) *maintenancemocks.Task {
+	t.Helper()
+
+	task := maintenancemocks.NewTask(t)
+	task.EXPECT().Name().Return(taskName)
+
+	return task
+}
+
+func runWithLock(acquired bool) func(context.Context, string, func(context.Context) error) (bool, error) {
+	return func(ctx context.Context, _ string, fn func(context.Context) error) (bool, error) {
+		if !acquired {
+			return false, nil
+		}
+
+		return true, fn(ctx)
+	}
+}
+
+func TestScheduler_should_run_the_tasks_and_record_the_removed_rows(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	task := newTask(t)
+	task.EXPECT().Run(ctx).Return(42, nil)
+
+	locker := maintenancemocks.NewLocker(t)
+	locker.EXPECT().TryWithLock(ctx, taskName, mock.Anything).RunAndReturn(runWithLock(true))
+
+	registry := prometheus.NewRegistry()
+	sut := maintenance.NewScheduler(locker, time.Hour, registry, task)
+
+	sut.RunOnce(ctx)
+
+	err := testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP identity_maintenance_removed_rows_total Number of rows removed by the maintenance tasks.
+# TYPE identity_maintenance_removed_rows_total counter
+identity_maintenance_removed_rows_total{task="expired_rows"} 42
+# HELP identity_maintenance_runs_total Number of runs of the maintenance tasks by result (success, failure, skipped).
+# TYPE identity_maintenance_runs_total counter
+identity_maintenance_runs_total{result="success",task="expired_rows"} 1
+`), "identity_maintenance_removed_rows_total", "identity_maintenance_runs_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testutil.CollectAndCount(registry, "identity_maintenance_last_success_timestamp_seconds"))
+}
+
+func TestScheduler_should_skip_the_tasks_running_on_another_replica(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	task := newTask(t)
+
+	locker := maintenancemocks.NewLocker(t)
+	locker.EXPECT().TryWithLock(ctx, taskName, mock.Anything).RunAndReturn(runWithLock(false))
+
+	registry := prometheus.NewRegistry()
+	sut := maintenance.NewScheduler(locker, time.Hour, registry, task)
+
+	sut.RunOnce(ctx)
+
+	err := testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP identity_maintenance_runs_total Number of runs of the maintenance tasks by result (success, failure, skipped).
+# TYPE identity_maintenance_runs_total counter
+identity_maintenance_runs_total{result="skipped",task="expired_rows"} 1
+`), "identity_maintenance_runs_total")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, testutil.CollectAndCount(registry, "identity_maintenance_last_success_timestamp_seconds"))
+}
+
+func TestScheduler_should_record_the_failed_runs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	task := newTask(t)
+	task.EXPECT().Run(ctx).Return(10, errors.New("failed"))
+
+	locker := maintenancemocks.NewLocker(t)
+	locker.EXPECT().TryWithLock(ctx, taskName, mock.Anything).RunAndReturn(runWithLock(true))
+
+	registry := prometheus.NewRegistry()
+	sut := maintenance.NewScheduler(locker, time.Hour, registry, task)
+
+	sut.RunOnce(ctx)
+
+	err := testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP identity_maintenance_removed_rows_total Number of rows removed by the maintenance tasks.
+# TYPE identity_maintenance_removed_rows_total counter
+identity_maintenance_removed_rows_total{task="expired_rows"} 10
+# HELP identity_maintenance_runs_total Number of runs of the maintenance tasks by result (success, failure, skipped).
+# TYPE identity_maintenance_runs_total counter
+identity_maintenance_runs_total{result="failure",task="expired_rows"} 1
+`), "identity_maintenance_removed_rows_total", "identity_maintenance_runs_total")
+	assert.NoError(t, err)
+}
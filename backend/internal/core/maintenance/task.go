@@ -0,0 +1,25 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+)
+
+// A Task removes stale data from the database. It is run periodically
+// by the Scheduler on a single replica at a time.
+type Task interface {
+	// Name identifies the task in the logs, the metrics and the lock
+	Name() string
+
+	// Run removes the stale data and returns the number of removed rows
+	Run(ctx context.Context) (int64, error)
+}
+
+// The Locker runs a function while holding a lock shared by all the replicas.
+type Locker interface {
+	// TryWithLock runs fn when the named lock can be acquired right away
+	// and reports whether it was acquired
+	TryWithLock(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
@@ -38,6 +38,86 @@ func (_m *TaskService) EXPECT() *TaskService_Expecter {
 	return &TaskService_Expecter{mock: &_m.Mock}
 }
 
+// CreateForA2A provides a mock function for the type TaskService
+func (_mock *TaskService) CreateForA2A(ctx context.Context, appID string, name string, agentCard string) ([]*types.Task, error) {
+	ret := _mock.Called(ctx, appID, name, agentCard)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateForA2A")
+	}
+
+	var r0 []*types.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) ([]*types.Task, error)); ok {
+		return returnFunc(ctx, appID, name, agentCard)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) []*types.Task); ok {
+		r0 = returnFunc(ctx, appID, name, agentCard)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, appID, name, agentCard)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskService_CreateForA2A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateForA2A'
+type TaskService_CreateForA2A_Call struct {
+	*mock.Call
+}
+
+// CreateForA2A is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - name string
+//   - agentCard string
+func (_e *TaskService_Expecter) CreateForA2A(ctx interface{}, appID interface{}, name interface{}, agentCard interface{}) *TaskService_CreateForA2A_Call {
+	return &TaskService_CreateForA2A_Call{Call: _e.mock.On("CreateForA2A", ctx, appID, name, agentCard)}
+}
+
+func (_c *TaskService_CreateForA2A_Call) Run(run func(ctx context.Context, appID string, name string, agentCard string)) *TaskService_CreateForA2A_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *TaskService_CreateForA2A_Call) Return(tasks []*types.Task, err error) *TaskService_CreateForA2A_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskService_CreateForA2A_Call) RunAndReturn(run func(ctx context.Context, appID string, name string, agentCard string) ([]*types.Task, error)) *TaskService_CreateForA2A_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateForMCP provides a mock function for the type TaskService
 func (_mock *TaskService) CreateForMCP(ctx context.Context, appID string, mcpSchema string) ([]*types.Task, error) {
 	ret := _mock.Called(ctx, appID, mcpSchema)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/agntcy/identity-service/internal/core/badge/a2a"
 	"github.com/agntcy/identity-service/internal/core/badge/mcp"
 	"github.com/agntcy/identity-service/internal/core/policy/types"
 	"github.com/google/uuid"
@@ -16,6 +17,7 @@ import (
 
 type TaskService interface {
 	UpdateOrCreateForAgent(ctx context.Context, appID, name string) (*types.Task, error)
+	CreateForA2A(ctx context.Context, appID, name, agentCard string) ([]*types.Task, error)
 	CreateForMCP(ctx context.Context, appID string, mcpSchema string) ([]*types.Task, error)
 }
 
@@ -31,6 +33,8 @@ func NewTaskService(
 	}
 }
 
+// UpdateOrCreateForAgent keeps the task granting access to the whole agent
+// in sync with the agent name. Per-skill tasks are left untouched.
 func (s *taskService) UpdateOrCreateForAgent(
 	ctx context.Context,
 	appID, name string,
@@ -40,22 +44,26 @@ func (s *taskService) UpdateOrCreateForAgent(
 		return nil, fmt.Errorf("repository failed to fetch tasks for app %s: %w", appID, err)
 	}
 
-	if len(tasks) > 0 {
-		for _, task := range tasks {
-			task.Name = fmt.Sprintf("Invoke Agent %s", name)
+	agentTasks := slices.DeleteFunc(tasks, func(task *types.Task) bool {
+		return task.ToolName != ""
+	})
+
+	if len(agentTasks) > 0 {
+		for _, task := range agentTasks {
+			task.Name = agentTaskName(name)
 		}
 
-		err = s.taskRepository.Update(ctx, tasks...)
+		err = s.taskRepository.Update(ctx, agentTasks...)
 		if err != nil {
 			return nil, fmt.Errorf("repository failed to update tasks for app %s: %w", appID, err)
 		}
 
-		return tasks[0], nil
+		return agentTasks[0], nil
 	}
 
 	task := &types.Task{
 		ID:    uuid.NewString(),
-		Name:  fmt.Sprintf("Invoke Agent %s", name),
+		Name:  agentTaskName(name),
 		AppID: appID,
 	}
 
@@ -67,6 +75,45 @@ func (s *taskService) UpdateOrCreateForAgent(
 	return task, nil
 }
 
+// CreateForA2A creates a task granting access to the whole agent and one task
+// per skill listed in the agent card. The skill ID is stored as the task tool name.
+func (s *taskService) CreateForA2A(
+	ctx context.Context,
+	appID, name, agentCard string,
+) ([]*types.Task, error) {
+	card, err := a2a.ParseAgentCard(agentCard)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*types.Task{
+		{
+			Name:  agentTaskName(name),
+			AppID: appID,
+		},
+	}
+
+	for _, skill := range card.Skills {
+		if skill == nil || skill.ID == "" {
+			continue
+		}
+
+		taskName := skill.Name
+		if taskName == "" {
+			taskName = skill.ID
+		}
+
+		tasks = append(tasks, &types.Task{
+			Name:        taskName,
+			Description: skill.Description,
+			AppID:       appID,
+			ToolName:    skill.ID,
+		})
+	}
+
+	return s.syncTasks(ctx, appID, tasks)
+}
+
 func (s *taskService) CreateForMCP(
 	ctx context.Context,
 	appID string, mcpSchema string,
@@ -78,6 +125,28 @@ func (s *taskService) CreateForMCP(
 		return nil, fmt.Errorf("failed to unmarshal MCP schema: %w", err)
 	}
 
+	tasks := make([]*types.Task, 0, len(mcpServer.Tools))
+
+	for _, tool := range mcpServer.Tools {
+		tasks = append(tasks, &types.Task{
+			Name:        tool.Name,
+			Description: tool.Description,
+			AppID:       appID,
+			ToolName:    tool.Name,
+		})
+	}
+
+	return s.syncTasks(ctx, appID, tasks)
+}
+
+// syncTasks reconciles the stored tasks of an app with the desired ones, matching
+// them by tool name. Existing tasks keep their IDs so that policy rules referencing
+// them remain valid, while stored tasks that are no longer desired are deleted.
+func (s *taskService) syncTasks(
+	ctx context.Context,
+	appID string,
+	tasks []*types.Task,
+) ([]*types.Task, error) {
 	existingTasks, err := s.taskRepository.GetByAppID(ctx, appID)
 	if err != nil {
 		return nil, err
@@ -91,21 +160,20 @@ func (s *taskService) CreateForMCP(
 	tasksToCreate := make([]*types.Task, 0)
 	tasksToUpdate := make([]*types.Task, 0)
 	tasksToDelete := make([]*types.Task, 0)
-	result := make([]*types.Task, 0)
+	result := make([]*types.Task, 0, len(tasks))
 
-	for _, tool := range mcpServer.Tools {
-		task := &types.Task{
-			ID:          uuid.NewString(),
-			Name:        tool.Name,
-			Description: tool.Description,
-			AppID:       appID,
-			ToolName:    tool.Name,
+	for _, task := range tasks {
+		if slices.ContainsFunc(result, func(t *types.Task) bool {
+			return t.ToolName == task.ToolName
+		}) {
+			continue
 		}
 
-		if et, ok := existingTasksByName[tool.Name]; ok {
+		if et, ok := existingTasksByName[task.ToolName]; ok {
 			task.ID = et.ID
 			tasksToUpdate = append(tasksToUpdate, task)
 		} else {
+			task.ID = uuid.NewString()
 			tasksToCreate = append(tasksToCreate, task)
 		}
 
@@ -114,7 +182,7 @@ func (s *taskService) CreateForMCP(
 
 	for _, task := range existingTasks {
 		toDelete := !slices.ContainsFunc(result, func(t *types.Task) bool {
-			return t.ToolName == task.ToolName
+			return t.ID == task.ID
 		})
 		if toDelete {
 			tasksToDelete = append(tasksToDelete, task)
@@ -138,3 +206,7 @@ func (s *taskService) CreateForMCP(
 
 	return result, nil
 }
+
+func agentTaskName(name string) string {
+	return fmt.Sprintf("Invoke Agent %s", name)
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	policycore "github.com/agntcy/identity-service/internal/core/policy"
+	policymocks "github.com/agntcy/identity-service/internal/core/policy/mocks"
+	"github.com/agntcy/identity-service/internal/core/policy/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testAgentCard = `{
+	"name": "Currency Agent",
+	"url": "http://localhost:9999",
+	"skills": [
+		{"id": "convert", "name": "Convert currency", "description": "Converts an amount"},
+		{"id": "rates"}
+	]
+}`
+
+func TestTaskService_CreateForA2A_should_create_agent_and_skill_tasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+
+	var created []*types.Task
+
+	taskRepo := policymocks.NewTaskRepository(t)
+	taskRepo.EXPECT().GetByAppID(ctx, appID).Return(nil, nil)
+	taskRepo.EXPECT().
+		Create(ctx, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, tasks ...*types.Task) {
+			created = tasks
+		}).
+		Return(nil)
+	taskRepo.EXPECT().Update(ctx).Return(nil)
+	taskRepo.EXPECT().Delete(ctx).Return(nil)
+
+	sut := policycore.NewTaskService(taskRepo)
+
+	tasks, err := sut.CreateForA2A(ctx, appID, "Currency Agent", testAgentCard)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created, tasks)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, "Invoke Agent Currency Agent", tasks[0].Name)
+	assert.Empty(t, tasks[0].ToolName)
+	assert.Equal(t, "Convert currency", tasks[1].Name)
+	assert.Equal(t, "Converts an amount", tasks[1].Description)
+	assert.Equal(t, "convert", tasks[1].ToolName)
+	assert.Equal(t, "rates", tasks[2].Name)
+	assert.Equal(t, "rates", tasks[2].ToolName)
+
+	for _, task := range tasks {
+		assert.Equal(t, appID, task.AppID)
+		assert.NotEmpty(t, task.ID)
+	}
+}
+
+func TestTaskService_CreateForA2A_should_sync_existing_tasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	agentTask := &types.Task{ID: uuid.NewString(), AppID: appID, Name: "Invoke Agent Old"}
+	convertTask := &types.Task{ID: uuid.NewString(), AppID: appID, ToolName: "convert"}
+	removedTask := &types.Task{ID: uuid.NewString(), AppID: appID, ToolName: "removed"}
+
+	taskRepo := policymocks.NewTaskRepository(t)
+	taskRepo.EXPECT().
+		GetByAppID(ctx, appID).
+		Return([]*types.Task{agentTask, convertTask, removedTask}, nil)
+	taskRepo.EXPECT().
+		Create(ctx, mock.MatchedBy(func(task *types.Task) bool {
+			return task.ToolName == "rates"
+		})).
+		Return(nil)
+	taskRepo.EXPECT().
+		Update(
+			ctx,
+			mock.MatchedBy(func(task *types.Task) bool {
+				return task.ID == agentTask.ID && task.Name == "Invoke Agent Currency Agent"
+			}),
+			mock.MatchedBy(func(task *types.Task) bool {
+				return task.ID == convertTask.ID && task.Name == "Convert currency"
+			}),
+		).
+		Return(nil)
+	taskRepo.EXPECT().Delete(ctx, removedTask).Return(nil)
+
+	sut := policycore.NewTaskService(taskRepo)
+
+	tasks, err := sut.CreateForA2A(ctx, appID, "Currency Agent", testAgentCard)
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+}
+
+func TestTaskService_CreateForA2A_should_return_err_when_card_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	sut := policycore.NewTaskService(policymocks.NewTaskRepository(t))
+
+	_, err := sut.CreateForA2A(context.Background(), uuid.NewString(), "agent", "not_a_card")
+
+	assert.Error(t, err)
+}
+
+func TestTaskService_UpdateOrCreateForAgent_should_not_rename_skill_tasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	appID := uuid.NewString()
+	agentTask := &types.Task{ID: uuid.NewString(), AppID: appID, Name: "Invoke Agent Old"}
+	skillTask := &types.Task{ID: uuid.NewString(), AppID: appID, Name: "Convert", ToolName: "convert"}
+
+	taskRepo := policymocks.NewTaskRepository(t)
+	taskRepo.EXPECT().GetByAppID(ctx, appID).Return([]*types.Task{agentTask, skillTask}, nil)
+	taskRepo.EXPECT().Update(ctx, agentTask).Return(nil)
+
+	sut := policycore.NewTaskService(taskRepo)
+
+	task, err := sut.UpdateOrCreateForAgent(ctx, appID, "New")
+
+	assert.NoError(t, err)
+	assert.Equal(t, agentTask.ID, task.ID)
+	assert.Equal(t, "Invoke Agent New", task.Name)
+	assert.Equal(t, "Convert", skillTask.Name)
+}
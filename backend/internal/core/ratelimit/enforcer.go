@@ -0,0 +1,244 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	settingscore "github.com/agntcy/identity-service/internal/core/settings"
+	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+// The Scope tells which limit of the RateLimitSettings applies to a bucket.
+type Scope string
+
+const (
+	ScopeTenant    Scope = "tenant"
+	ScopeCallerApp Scope = "caller_app"
+	ScopeCalleeApp Scope = "callee_app"
+)
+
+// The bucket of an app, the scope tells whether the app is the caller or the callee.
+type AppBucket struct {
+	Scope Scope
+	AppID string
+}
+
+// The Enforcer applies the rate limits of the tenant in the context.
+type Enforcer interface {
+	// Allow takes a token from the bucket of each app in order, the apps
+	// with an empty ID are skipped, then from the bucket of the tenant.
+	// The request is denied as soon as one of the buckets is empty,
+	// the tokens already taken from the other buckets are refunded.
+	Allow(ctx context.Context, apps ...AppBucket) (*Result, error)
+}
+
+type cachedSettings struct {
+	settings  *settingstypes.RateLimitSettings
+	expiresAt time.Time
+}
+
+type enforcer struct {
+	limiter            Limiter
+	settingsRepository settingscore.Repository
+	defaults           *settingstypes.RateLimitSettings
+	tenantShards       int
+	cacheTTL           time.Duration
+	mu                 sync.Mutex
+	cache              map[string]*cachedSettings
+}
+
+// NewEnforcer returns an Enforcer using the rate limit settings of the tenants,
+// cached for cacheTTL. The limits that are not set by a tenant fall back to the defaults,
+// the tenants can only lower them. The bucket of each tenant is split in tenantShards
+// buckets since it is taken from by every request of the tenant.
+func NewEnforcer(
+	limiter Limiter,
+	settingsRepository settingscore.Repository,
+	defaults *settingstypes.RateLimitSettings,
+	tenantShards int,
+	cacheTTL time.Duration,
+) Enforcer {
+	return &enforcer{
+		limiter:            limiter,
+		settingsRepository: settingsRepository,
+		defaults:           defaults,
+		tenantShards:       tenantShards,
+		cacheTTL:           cacheTTL,
+		cache:              make(map[string]*cachedSettings),
+	}
+}
+
+func (e *enforcer) Allow(ctx context.Context, apps ...AppBucket) (*Result, error) {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return nil, identitycontext.ErrTenantNotFound
+	}
+
+	settings, err := e.getSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]bucketLimit, 0, len(apps)+1)
+
+	for _, app := range apps {
+		if app.AppID != "" {
+			buckets = append(buckets, e.bucketLimit(app.Scope, app.AppID, settings))
+		}
+	}
+
+	buckets = append(buckets, e.bucketLimit(ScopeTenant, tenantID, settings))
+
+	for idx, bucket := range buckets {
+		// A missing limit or a zero rate disables the limit
+		if bucket.limit == nil {
+			continue
+		}
+
+		result, err := e.limiter.Allow(ctx, bucket.key, *bucket.limit)
+		if err != nil {
+			err = fmt.Errorf("limiter failed to take a token from the %s bucket: %w", bucket.scope, err)
+		}
+
+		if err != nil || !result.Allowed {
+			// The request is not handled, the tokens taken
+			// from the previous buckets are given back
+			e.refund(ctx, buckets[:idx])
+
+			if err != nil {
+				return nil, err
+			}
+
+			return result, nil
+		}
+	}
+
+	return &Result{Allowed: true}, nil
+}
+
+type bucketLimit struct {
+	scope Scope
+	key   string
+	limit *Limit
+}
+
+// bucketLimit returns the bucket of the scope with its limit,
+// a nil limit when the limit is disabled.
+func (e *enforcer) bucketLimit(
+	scope Scope,
+	id string,
+	settings *settingstypes.RateLimitSettings,
+) bucketLimit {
+	var rateLimit *settingstypes.RateLimit
+
+	switch scope {
+	case ScopeTenant:
+		rateLimit = settings.Tenant
+	case ScopeCallerApp:
+		rateLimit = settings.CallerApp
+	case ScopeCalleeApp:
+		rateLimit = settings.CalleeApp
+	}
+
+	bucket := bucketLimit{
+		scope: scope,
+		key:   fmt.Sprintf("%s:%s", scope, id),
+	}
+
+	if rateLimit == nil || rateLimit.RequestsPerSecond <= 0 {
+		return bucket
+	}
+
+	bucket.limit = &Limit{
+		Rate:  rateLimit.RequestsPerSecond,
+		Burst: burst(rateLimit),
+	}
+	if scope == ScopeTenant {
+		bucket.limit.Shards = e.tenantShards
+	}
+
+	return bucket
+}
+
+func (e *enforcer) refund(ctx context.Context, buckets []bucketLimit) {
+	for _, bucket := range buckets {
+		if bucket.limit == nil {
+			continue
+		}
+
+		err := e.limiter.Refund(ctx, bucket.key, *bucket.limit)
+		if err != nil {
+			log.FromContext(ctx).
+				WithError(err).
+				Warnf("enforcer failed to refund a token to the %s bucket", bucket.scope)
+		}
+	}
+}
+
+func (e *enforcer) getSettings(
+	ctx context.Context,
+	tenantID string,
+) (*settingstypes.RateLimitSettings, error) {
+	e.mu.Lock()
+	cached, ok := e.cache[tenantID]
+	e.mu.Unlock()
+
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.settings, nil
+	}
+
+	tenantSettings, err := e.settingsRepository.GetRateLimitSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository failed to fetch the rate limit settings: %w", err)
+	}
+
+	settings := &settingstypes.RateLimitSettings{
+		Tenant:    withDefault(tenantSettings.Tenant, e.defaults.Tenant),
+		CallerApp: withDefault(tenantSettings.CallerApp, e.defaults.CallerApp),
+		CalleeApp: withDefault(tenantSettings.CalleeApp, e.defaults.CalleeApp),
+	}
+
+	e.mu.Lock()
+	e.cache[tenantID] = &cachedSettings{
+		settings:  settings,
+		expiresAt: time.Now().Add(e.cacheTTL),
+	}
+	e.mu.Unlock()
+
+	return settings, nil
+}
+
+// withDefault returns the limit of the tenant, capped by the default one.
+// A tenant cannot raise nor disable a limit set by the deployment:
+// a missing limit or a zero rate falls back to the default.
+func withDefault(limit, defaultLimit *settingstypes.RateLimit) *settingstypes.RateLimit {
+	if limit == nil || limit.RequestsPerSecond <= 0 {
+		return defaultLimit
+	}
+
+	if defaultLimit == nil || defaultLimit.RequestsPerSecond <= 0 {
+		return limit
+	}
+
+	return &settingstypes.RateLimit{
+		RequestsPerSecond: min(limit.RequestsPerSecond, defaultLimit.RequestsPerSecond),
+		Burst:             int32(min(burst(limit), burst(defaultLimit))),
+	}
+}
+
+// The burst defaults to the rate rounded up
+func burst(limit *settingstypes.RateLimit) int {
+	if limit.Burst > 0 {
+		return int(limit.Burst)
+	}
+
+	return int(math.Ceil(limit.RequestsPerSecond))
+}
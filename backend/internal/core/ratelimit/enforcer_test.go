@@ -0,0 +1,333 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	ratelimitmocks "github.com/agntcy/identity-service/internal/core/ratelimit/mocks"
+	settingsmocks "github.com/agntcy/identity-service/internal/core/settings/mocks"
+	settingstypes "github.com/agntcy/identity-service/internal/core/settings/types"
+	identitycontext "github.com/agntcy/identity-service/internal/pkg/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var defaultRateLimitSettings = &settingstypes.RateLimitSettings{
+	Tenant:    &settingstypes.RateLimit{RequestsPerSecond: 100},
+	CallerApp: &settingstypes.RateLimit{RequestsPerSecond: 10, Burst: 20},
+	CalleeApp: &settingstypes.RateLimit{},
+}
+
+func TestEnforcer_Allow_should_take_a_token_from_the_app_and_the_tenant_buckets(t *testing.T) {
+	t.Parallel()
+
+	tenantID := uuid.NewString()
+	appID := uuid.NewString()
+	ctx := identitycontext.InsertTenantID(context.Background(), tenantID)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{}, nil)
+
+	limiter := ratelimitmocks.NewLimiter(t)
+	limiter.EXPECT().
+		Allow(ctx, "caller_app:"+appID, ratelimit.Limit{Rate: 10, Burst: 20}).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+	limiter.EXPECT().
+		Allow(ctx, "tenant:"+tenantID, ratelimit.Limit{Rate: 100, Burst: 100}).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+
+	sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+	ret, err := sut.Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: appID})
+
+	assert.NoError(t, err)
+	assert.True(t, ret.Allowed)
+}
+
+func TestEnforcer_Allow_should_use_the_tenant_settings_over_the_defaults(t *testing.T) {
+	t.Parallel()
+
+	tenantID := uuid.NewString()
+	ctx := identitycontext.InsertTenantID(context.Background(), tenantID)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{
+		Tenant: &settingstypes.RateLimit{RequestsPerSecond: 0.5},
+	}, nil)
+
+	limiter := ratelimitmocks.NewLimiter(t)
+	limiter.EXPECT().
+		Allow(ctx, "tenant:"+tenantID, ratelimit.Limit{Rate: 0.5, Burst: 1}).
+		Return(&ratelimit.Result{RetryAfter: time.Second}, nil)
+
+	sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+	// The callee app limit is disabled, only the tenant bucket is used
+	ret, err := sut.Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp, AppID: uuid.NewString()})
+
+	assert.NoError(t, err)
+	assert.False(t, ret.Allowed)
+	assert.Equal(t, time.Second, ret.RetryAfter)
+}
+
+func TestEnforcer_Allow_should_take_a_token_from_each_app_bucket(t *testing.T) {
+	t.Parallel()
+
+	tenantID := uuid.NewString()
+	callerAppID := uuid.NewString()
+	calleeAppID := uuid.NewString()
+	ctx := identitycontext.InsertTenantID(context.Background(), tenantID)
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{
+		CalleeApp: &settingstypes.RateLimit{RequestsPerSecond: 5},
+	}, nil)
+
+	limiter := ratelimitmocks.NewLimiter(t)
+	limiter.EXPECT().
+		Allow(ctx, "caller_app:"+callerAppID, ratelimit.Limit{Rate: 10, Burst: 20}).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+	limiter.EXPECT().
+		Allow(ctx, "callee_app:"+calleeAppID, ratelimit.Limit{Rate: 5, Burst: 5}).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+	limiter.EXPECT().
+		Allow(ctx, "tenant:"+tenantID, ratelimit.Limit{Rate: 100, Burst: 100, Shards: 4}).
+		Return(&ratelimit.Result{Allowed: true}, nil)
+
+	sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 4, time.Minute)
+
+	ret, err := sut.Allow(
+		ctx,
+		ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: callerAppID},
+		ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp, AppID: calleeAppID},
+	)
+
+	assert.NoError(t, err)
+	assert.True(t, ret.Allowed)
+}
+
+func TestEnforcer_Allow_should_cap_the_tenant_settings_with_the_defaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		tenant *settingstypes.RateLimit
+		limit  ratelimit.Limit
+	}{
+		"limit above the default": {
+			tenant: &settingstypes.RateLimit{RequestsPerSecond: 1000, Burst: 2000},
+			limit:  ratelimit.Limit{Rate: 100, Burst: 100},
+		},
+		"burst above the default": {
+			tenant: &settingstypes.RateLimit{RequestsPerSecond: 50, Burst: 200},
+			limit:  ratelimit.Limit{Rate: 50, Burst: 100},
+		},
+		"zero rate": {
+			tenant: &settingstypes.RateLimit{},
+			limit:  ratelimit.Limit{Rate: 100, Burst: 100},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			tenantID := uuid.NewString()
+			ctx := identitycontext.InsertTenantID(context.Background(), tenantID)
+
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{
+				Tenant: tc.tenant,
+			}, nil)
+
+			limiter := ratelimitmocks.NewLimiter(t)
+			limiter.EXPECT().
+				Allow(ctx, "tenant:"+tenantID, tc.limit).
+				Return(&ratelimit.Result{Allowed: true}, nil)
+
+			sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+			ret, err := sut.Allow(ctx)
+
+			assert.NoError(t, err)
+			assert.True(t, ret.Allowed)
+		})
+	}
+}
+
+func TestEnforcer_Allow_should_not_take_a_tenant_token_when_the_app_is_denied(t *testing.T) {
+	t.Parallel()
+
+	appID := uuid.NewString()
+	ctx := identitycontext.InsertTenantID(context.Background(), uuid.NewString())
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{}, nil)
+
+	limiter := ratelimitmocks.NewLimiter(t)
+	limiter.EXPECT().
+		Allow(ctx, "caller_app:"+appID, mock.Anything).
+		Return(&ratelimit.Result{RetryAfter: time.Second}, nil)
+
+	sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+	ret, err := sut.Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: appID})
+
+	assert.NoError(t, err)
+	assert.False(t, ret.Allowed)
+}
+
+func TestEnforcer_Allow_should_refund_the_app_buckets_when_a_later_bucket_denies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		calleeResult *ratelimit.Result
+		tenantResult *ratelimit.Result
+		tenantErr    error
+		refunds      []string
+		errExpected  bool
+	}{
+		"when the callee app is denied": {
+			calleeResult: &ratelimit.Result{RetryAfter: time.Second},
+			refunds:      []string{"caller_app"},
+		},
+		"when the tenant is denied": {
+			calleeResult: &ratelimit.Result{Allowed: true},
+			tenantResult: &ratelimit.Result{RetryAfter: time.Second},
+			refunds:      []string{"caller_app", "callee_app"},
+		},
+		"when the limiter fails": {
+			calleeResult: &ratelimit.Result{Allowed: true},
+			tenantErr:    errors.New("failed"),
+			refunds:      []string{"caller_app", "callee_app"},
+			errExpected:  true,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			tenantID := uuid.NewString()
+			callerAppID := uuid.NewString()
+			calleeAppID := uuid.NewString()
+			ctx := identitycontext.InsertTenantID(context.Background(), tenantID)
+			appIDs := map[string]string{"caller_app": callerAppID, "callee_app": calleeAppID}
+
+			settingsRepo := settingsmocks.NewRepository(t)
+			settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{
+				CalleeApp: &settingstypes.RateLimit{RequestsPerSecond: 5},
+			}, nil)
+
+			limiter := ratelimitmocks.NewLimiter(t)
+			limiter.EXPECT().
+				Allow(ctx, "caller_app:"+callerAppID, mock.Anything).
+				Return(&ratelimit.Result{Allowed: true}, nil)
+			limiter.EXPECT().
+				Allow(ctx, "callee_app:"+calleeAppID, mock.Anything).
+				Return(tc.calleeResult, nil)
+
+			if tc.calleeResult.Allowed {
+				limiter.EXPECT().
+					Allow(ctx, "tenant:"+tenantID, mock.Anything).
+					Return(tc.tenantResult, tc.tenantErr)
+			}
+
+			for _, scope := range tc.refunds {
+				limiter.EXPECT().Refund(ctx, scope+":"+appIDs[scope], mock.Anything).Return(nil).Once()
+			}
+
+			sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+			ret, err := sut.Allow(
+				ctx,
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: callerAppID},
+				ratelimit.AppBucket{Scope: ratelimit.ScopeCalleeApp, AppID: calleeAppID},
+			)
+
+			if tc.errExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, ret.Allowed)
+			}
+		})
+	}
+}
+
+func TestEnforcer_Allow_should_cache_the_settings(t *testing.T) {
+	t.Parallel()
+
+	ctx := identitycontext.InsertTenantID(context.Background(), uuid.NewString())
+
+	settingsRepo := settingsmocks.NewRepository(t)
+	settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{}, nil).Once()
+
+	limiter := ratelimitmocks.NewLimiter(t)
+	limiter.EXPECT().Allow(ctx, mock.Anything, mock.Anything).Return(&ratelimit.Result{Allowed: true}, nil)
+
+	sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+	for range 3 {
+		_, err := sut.Allow(ctx)
+		assert.NoError(t, err)
+	}
+}
+
+func TestEnforcer_Allow_should_return_err(t *testing.T) {
+	t.Parallel()
+
+	errUnexpected := errors.New("failed")
+
+	t.Run("when the tenant is missing", func(t *testing.T) {
+		t.Parallel()
+
+		sut := ratelimit.NewEnforcer(nil, nil, defaultRateLimitSettings, 0, time.Minute)
+
+		_, err := sut.Allow(
+			context.Background(),
+			ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: uuid.NewString()},
+		)
+
+		assert.ErrorIs(t, err, identitycontext.ErrTenantNotFound)
+	})
+
+	t.Run("when the settings cannot be fetched", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := identitycontext.InsertTenantID(context.Background(), uuid.NewString())
+
+		settingsRepo := settingsmocks.NewRepository(t)
+		settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(nil, errUnexpected)
+
+		sut := ratelimit.NewEnforcer(nil, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+		_, err := sut.Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: uuid.NewString()})
+
+		assert.ErrorIs(t, err, errUnexpected)
+	})
+
+	t.Run("when the limiter fails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := identitycontext.InsertTenantID(context.Background(), uuid.NewString())
+
+		settingsRepo := settingsmocks.NewRepository(t)
+		settingsRepo.EXPECT().GetRateLimitSettings(ctx).Return(&settingstypes.RateLimitSettings{}, nil)
+
+		limiter := ratelimitmocks.NewLimiter(t)
+		limiter.EXPECT().Allow(ctx, mock.Anything, mock.Anything).Return(nil, errUnexpected)
+
+		sut := ratelimit.NewEnforcer(limiter, settingsRepo, defaultRateLimitSettings, 0, time.Minute)
+
+		_, err := sut.Allow(ctx, ratelimit.AppBucket{Scope: ratelimit.ScopeCallerApp, AppID: uuid.NewString()})
+
+		assert.ErrorIs(t, err, errUnexpected)
+	})
+}
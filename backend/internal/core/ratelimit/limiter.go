@@ -0,0 +1,42 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// A token bucket limit. The bucket holds up to Burst tokens
+// and is refilled with Rate tokens per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+
+	// The number of buckets sharing the limit, for the limiters where a single
+	// bucket taken from by many requests becomes a point of contention.
+	// Each request takes a token from one of them, picked at random,
+	// which makes the limit approximate. Zero or one keeps a single bucket.
+	Shards int
+}
+
+type Result struct {
+	Allowed bool
+
+	// How long to wait before the next request is allowed, set when denied
+	RetryAfter time.Duration
+}
+
+// The Limiter takes a token from the bucket identified by the key.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (*Result, error)
+
+	// Refund gives back a token taken from the bucket, up to the burst.
+	Refund(ctx context.Context, key string, limit Limit) error
+}
+
+// RetryAfter returns the time needed to refill the missing part of a token.
+func RetryAfter(tokens, rate float64) time.Duration {
+	return time.Duration((1 - tokens) / rate * float64(time.Second))
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens     float64
+	refilledAt time.Time
+}
+
+type memoryLimiter struct {
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	idleTimeout time.Duration
+	sweptAt     time.Time
+}
+
+// NewMemoryLimiter returns a Limiter keeping the buckets in memory.
+// The limits are enforced per replica, the buckets not used
+// for idleTimeout are evicted.
+func NewMemoryLimiter(idleTimeout time.Duration) Limiter {
+	return &memoryLimiter{
+		buckets:     make(map[string]*bucket),
+		idleTimeout: idleTimeout,
+		sweptAt:     time.Now(),
+	}
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string, limit Limit) (*Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	l.evictIdleBuckets(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			tokens:     float64(limit.Burst),
+			refilledAt: now,
+		}
+		l.buckets[key] = b
+	}
+
+	b.tokens = min(float64(limit.Burst), b.tokens+now.Sub(b.refilledAt).Seconds()*limit.Rate)
+	b.refilledAt = now
+
+	if b.tokens < 1 {
+		return &Result{RetryAfter: RetryAfter(b.tokens, limit.Rate)}, nil
+	}
+
+	b.tokens--
+
+	return &Result{Allowed: true}, nil
+}
+
+func (l *memoryLimiter) Refund(_ context.Context, key string, limit Limit) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		b.tokens = min(float64(limit.Burst), b.tokens+1)
+	}
+
+	return nil
+}
+
+func (l *memoryLimiter) evictIdleBuckets(now time.Time) {
+	if now.Sub(l.sweptAt) < l.idleTimeout {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.refilledAt) >= l.idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+
+	l.sweptAt = now
+}
@@ -0,0 +1,99 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_should_deny_when_the_bucket_is_empty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 2}
+	sut := ratelimit.NewMemoryLimiter(time.Hour)
+
+	for range limit.Burst {
+		ret, err := sut.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.True(t, ret.Allowed)
+	}
+
+	ret, err := sut.Allow(ctx, "key", limit)
+
+	assert.NoError(t, err)
+	assert.False(t, ret.Allowed)
+	assert.Greater(t, ret.RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, ret.RetryAfter, time.Second)
+}
+
+func TestMemoryLimiter_should_refill_the_bucket(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 100, Burst: 1}
+	sut := ratelimit.NewMemoryLimiter(time.Hour)
+
+	ret, err := sut.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.True(t, ret.Allowed)
+
+	ret, err = sut.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.False(t, ret.Allowed)
+
+	time.Sleep(ret.RetryAfter)
+
+	ret, err = sut.Allow(ctx, "key", limit)
+
+	assert.NoError(t, err)
+	assert.True(t, ret.Allowed)
+}
+
+func TestMemoryLimiter_should_keep_a_bucket_per_key(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+	sut := ratelimit.NewMemoryLimiter(time.Hour)
+
+	ret, err := sut.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.True(t, ret.Allowed)
+
+	ret, err = sut.Allow(ctx, "other-key", limit)
+
+	assert.NoError(t, err)
+	assert.True(t, ret.Allowed)
+}
+
+func TestMemoryLimiter_should_refund_a_token_up_to_the_burst(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 1}
+	sut := ratelimit.NewMemoryLimiter(time.Hour)
+
+	ret, err := sut.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.True(t, ret.Allowed)
+
+	require.NoError(t, sut.Refund(ctx, "key", limit))
+	require.NoError(t, sut.Refund(ctx, "key", limit))
+
+	ret, err = sut.Allow(ctx, "key", limit)
+	require.NoError(t, err)
+	require.True(t, ret.Allowed)
+
+	ret, err = sut.Allow(ctx, "key", limit)
+
+	assert.NoError(t, err)
+	assert.False(t, ret.Allowed)
+}
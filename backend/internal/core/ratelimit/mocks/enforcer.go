@@ -0,0 +1,120 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewEnforcer creates a new instance of Enforcer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEnforcer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Enforcer {
+	mock := &Enforcer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Enforcer is an autogenerated mock type for the Enforcer type
+type Enforcer struct {
+	mock.Mock
+}
+
+type Enforcer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Enforcer) EXPECT() *Enforcer_Expecter {
+	return &Enforcer_Expecter{mock: &_m.Mock}
+}
+
+// Allow provides a mock function for the type Enforcer
+func (_mock *Enforcer) Allow(ctx context.Context, apps ...ratelimit.AppBucket) (*ratelimit.Result, error) {
+	// ratelimit.AppBucket
+	_va := make([]interface{}, len(apps))
+	for _i := range apps {
+		_va[_i] = apps[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allow")
+	}
+
+	var r0 *ratelimit.Result
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...ratelimit.AppBucket) (*ratelimit.Result, error)); ok {
+		return returnFunc(ctx, apps...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...ratelimit.AppBucket) *ratelimit.Result); ok {
+		r0 = returnFunc(ctx, apps...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ratelimit.Result)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...ratelimit.AppBucket) error); ok {
+		r1 = returnFunc(ctx, apps...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Enforcer_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type Enforcer_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apps ...ratelimit.AppBucket
+func (_e *Enforcer_Expecter) Allow(ctx interface{}, apps ...interface{}) *Enforcer_Allow_Call {
+	return &Enforcer_Allow_Call{Call: _e.mock.On("Allow",
+		append([]interface{}{ctx}, apps...)...)}
+}
+
+func (_c *Enforcer_Allow_Call) Run(run func(ctx context.Context, apps ...ratelimit.AppBucket)) *Enforcer_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []ratelimit.AppBucket
+		variadicArgs := make([]ratelimit.AppBucket, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(ratelimit.AppBucket)
+			}
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *Enforcer_Allow_Call) Return(result *ratelimit.Result, err error) *Enforcer_Allow_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *Enforcer_Allow_Call) RunAndReturn(run func(ctx context.Context, apps ...ratelimit.AppBucket) (*ratelimit.Result, error)) *Enforcer_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,176 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewLimiter creates a new instance of Limiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Limiter {
+	mock := &Limiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Limiter is an autogenerated mock type for the Limiter type
+type Limiter struct {
+	mock.Mock
+}
+
+type Limiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Limiter) EXPECT() *Limiter_Expecter {
+	return &Limiter_Expecter{mock: &_m.Mock}
+}
+
+// Allow provides a mock function for the type Limiter
+func (_mock *Limiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error) {
+	ret := _mock.Called(ctx, key, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allow")
+	}
+
+	var r0 *ratelimit.Result
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ratelimit.Limit) (*ratelimit.Result, error)); ok {
+		return returnFunc(ctx, key, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ratelimit.Limit) *ratelimit.Result); ok {
+		r0 = returnFunc(ctx, key, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ratelimit.Result)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, ratelimit.Limit) error); ok {
+		r1 = returnFunc(ctx, key, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Limiter_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type Limiter_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - limit ratelimit.Limit
+func (_e *Limiter_Expecter) Allow(ctx interface{}, key interface{}, limit interface{}) *Limiter_Allow_Call {
+	return &Limiter_Allow_Call{Call: _e.mock.On("Allow", ctx, key, limit)}
+}
+
+func (_c *Limiter_Allow_Call) Run(run func(ctx context.Context, key string, limit ratelimit.Limit)) *Limiter_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 ratelimit.Limit
+		if args[2] != nil {
+			arg2 = args[2].(ratelimit.Limit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Limiter_Allow_Call) Return(result *ratelimit.Result, err error) *Limiter_Allow_Call {
+	_c.Call.Return(result, err)
+	return _c
+}
+
+func (_c *Limiter_Allow_Call) RunAndReturn(run func(ctx context.Context, key string, limit ratelimit.Limit) (*ratelimit.Result, error)) *Limiter_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refund provides a mock function for the type Limiter
+func (_mock *Limiter) Refund(ctx context.Context, key string, limit ratelimit.Limit) error {
+	ret := _mock.Called(ctx, key, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refund")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ratelimit.Limit) error); ok {
+		r0 = returnFunc(ctx, key, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Limiter_Refund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refund'
+type Limiter_Refund_Call struct {
+	*mock.Call
+}
+
+// Refund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - limit ratelimit.Limit
+func (_e *Limiter_Expecter) Refund(ctx interface{}, key interface{}, limit interface{}) *Limiter_Refund_Call {
+	return &Limiter_Refund_Call{Call: _e.mock.On("Refund", ctx, key, limit)}
+}
+
+func (_c *Limiter_Refund_Call) Run(run func(ctx context.Context, key string, limit ratelimit.Limit)) *Limiter_Refund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 ratelimit.Limit
+		if args[2] != nil {
+			arg2 = args[2].(ratelimit.Limit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Limiter_Refund_Call) Return(err error) *Limiter_Refund_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Limiter_Refund_Call) RunAndReturn(run func(ctx context.Context, key string, limit ratelimit.Limit) error) *Limiter_Refund_Call {
+	_c.Call.Return(run)
+	return _c
+}
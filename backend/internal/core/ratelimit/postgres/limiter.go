@@ -0,0 +1,114 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/agntcy/identity-service/internal/core/ratelimit"
+	"gorm.io/gorm"
+)
+
+// The tokens of the bucket refilled since the last request,
+// computed from the row being updated
+const refilledTokens = `LEAST(
+	CAST(@burst AS double precision),
+	rate_limit_buckets.tokens +
+		(EXTRACT(EPOCH FROM statement_timestamp()) - rate_limit_buckets.refilled_at) * CAST(@rate AS double precision)
+)`
+
+// A new bucket starts full and gives its first token right away. An existing
+// bucket is refilled and gives a token when at least one is available.
+// The row lock taken by the upsert serializes the replicas.
+var allowQuery = strings.NewReplacer("{refilled}", refilledTokens).Replace(`
+INSERT INTO rate_limit_buckets (key, tokens, refilled_at, allowed)
+VALUES (@key, CAST(@burst AS double precision) - 1, EXTRACT(EPOCH FROM statement_timestamp()), TRUE)
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE WHEN {refilled} >= 1 THEN {refilled} - 1 ELSE {refilled} END,
+	refilled_at = EXTRACT(EPOCH FROM statement_timestamp()),
+	allowed = {refilled} >= 1
+RETURNING tokens, allowed`)
+
+const refundQuery = `
+UPDATE rate_limit_buckets
+SET tokens = LEAST(CAST(@burst AS double precision), tokens + 1)
+WHERE key = @key`
+
+type limiter struct {
+	dbContext *gorm.DB
+}
+
+// NewLimiter returns a Limiter keeping the buckets in Postgres,
+// the limits are shared by all the replicas.
+func NewLimiter(dbContext *gorm.DB) ratelimit.Limiter {
+	return &limiter{
+		dbContext: dbContext,
+	}
+}
+
+func (l *limiter) Allow(
+	ctx context.Context,
+	key string,
+	limit ratelimit.Limit,
+) (*ratelimit.Result, error) {
+	var bucket RateLimitBucket
+
+	key, limit = shard(key, limit)
+
+	err := l.dbContext.
+		WithContext(ctx).
+		Raw(allowQuery, map[string]any{
+			"key":   key,
+			"burst": limit.Burst,
+			"rate":  limit.Rate,
+		}).
+		Scan(&bucket).Error
+	if err != nil {
+		return nil, fmt.Errorf("there was an error taking a token from the bucket: %w", err)
+	}
+
+	if !bucket.Allowed {
+		return &ratelimit.Result{RetryAfter: ratelimit.RetryAfter(bucket.Tokens, limit.Rate)}, nil
+	}
+
+	return &ratelimit.Result{Allowed: true}, nil
+}
+
+// Refund gives back the token to one of the shards of the bucket, picked at random
+// like when taking a token.
+func (l *limiter) Refund(ctx context.Context, key string, limit ratelimit.Limit) error {
+	key, limit = shard(key, limit)
+
+	err := l.dbContext.
+		WithContext(ctx).
+		Exec(refundQuery, map[string]any{
+			"key":   key,
+			"burst": limit.Burst,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("there was an error refunding a token to the bucket: %w", err)
+	}
+
+	return nil
+}
+
+// shard returns the key and the limit of one of the shards of the bucket, picked at random.
+// The limit is split evenly between the shards, there are no more shards than tokens
+// in the burst so that each shard holds at least one token.
+func shard(key string, limit ratelimit.Limit) (string, ratelimit.Limit) {
+	shards := min(limit.Shards, limit.Burst)
+	if shards <= 1 {
+		return key, limit
+	}
+
+	index := rand.IntN(shards) //nolint:gosec // the shard is not a secret
+
+	return fmt.Sprintf("%s#%d", key, index), ratelimit.Limit{
+		Rate:  limit.Rate / float64(shards),
+		Burst: limit.Burst / shards,
+	}
+}
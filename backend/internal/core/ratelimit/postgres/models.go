@@ -0,0 +1,14 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+// A RateLimitBucket holds the tokens left in a bucket.
+// The refill time is stored in seconds since the epoch to refill
+// the bucket with a single statement.
+type RateLimitBucket struct {
+	Key        string  `gorm:"primaryKey;type:varchar(512);"`
+	Tokens     float64 `gorm:"not null;type:double precision;"`
+	RefilledAt float64 `gorm:"not null;type:double precision;index:rate_limit_bucket_refilled_at_idx"`
+	Allowed    bool    `gorm:"not null;default:true;"`
+}
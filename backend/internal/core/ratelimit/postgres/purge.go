@@ -0,0 +1,64 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/maintenance"
+	"gorm.io/gorm"
+)
+
+type purgeTask struct {
+	dbContext   *gorm.DB
+	idleTimeout time.Duration
+	batchSize   int
+}
+
+// NewPurgeTask returns the maintenance task deleting the buckets
+// not used for idleTimeout. These buckets are full again, deleting
+// them has no effect on the limits.
+func NewPurgeTask(dbContext *gorm.DB, idleTimeout time.Duration, batchSize int) maintenance.Task {
+	return &purgeTask{
+		dbContext:   dbContext,
+		idleTimeout: idleTimeout,
+		batchSize:   batchSize,
+	}
+}
+
+func (*purgeTask) Name() string {
+	return "idle_rate_limit_buckets"
+}
+
+func (t *purgeTask) Run(ctx context.Context) (int64, error) {
+	idleSince := float64(time.Now().Add(-t.idleTimeout).Unix())
+
+	var total int64
+
+	for {
+		result := t.dbContext.
+			WithContext(ctx).
+			Where(
+				"key IN (?)",
+				t.dbContext.
+					Model(&RateLimitBucket{}).
+					Select("key").
+					Where("refilled_at < ?", idleSince).
+					Limit(t.batchSize),
+			).
+			Delete(&RateLimitBucket{})
+		total += result.RowsAffected
+
+		if result.Error != nil {
+			return total, fmt.Errorf("there was an error deleting the idle rate limit buckets: %w", result.Error)
+		}
+
+		// A partial batch means there is nothing left to delete
+		if result.RowsAffected < int64(t.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
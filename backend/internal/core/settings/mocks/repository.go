@@ -38,6 +38,68 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
+// GetBadgeSettings provides a mock function for the type Repository
+func (_mock *Repository) GetBadgeSettings(ctx context.Context) (*types.BadgeSettings, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBadgeSettings")
+	}
+
+	var r0 *types.BadgeSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*types.BadgeSettings, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *types.BadgeSettings); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BadgeSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetBadgeSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBadgeSettings'
+type Repository_GetBadgeSettings_Call struct {
+	*mock.Call
+}
+
+// GetBadgeSettings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Repository_Expecter) GetBadgeSettings(ctx interface{}) *Repository_GetBadgeSettings_Call {
+	return &Repository_GetBadgeSettings_Call{Call: _e.mock.On("GetBadgeSettings", ctx)}
+}
+
+func (_c *Repository_GetBadgeSettings_Call) Run(run func(ctx context.Context)) *Repository_GetBadgeSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetBadgeSettings_Call) Return(badgeSettings *types.BadgeSettings, err error) *Repository_GetBadgeSettings_Call {
+	_c.Call.Return(badgeSettings, err)
+	return _c
+}
+
+func (_c *Repository_GetBadgeSettings_Call) RunAndReturn(run func(ctx context.Context) (*types.BadgeSettings, error)) *Repository_GetBadgeSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIssuerSettings provides a mock function for the type Repository
 func (_mock *Repository) GetIssuerSettings(ctx context.Context) (*types.IssuerSettings, error) {
 	ret := _mock.Called(ctx)
@@ -100,6 +162,136 @@ func (_c *Repository_GetIssuerSettings_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetRateLimitSettings provides a mock function for the type Repository
+func (_mock *Repository) GetRateLimitSettings(ctx context.Context) (*types.RateLimitSettings, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRateLimitSettings")
+	}
+
+	var r0 *types.RateLimitSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*types.RateLimitSettings, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *types.RateLimitSettings); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RateLimitSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_GetRateLimitSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRateLimitSettings'
+type Repository_GetRateLimitSettings_Call struct {
+	*mock.Call
+}
+
+// GetRateLimitSettings is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Repository_Expecter) GetRateLimitSettings(ctx interface{}) *Repository_GetRateLimitSettings_Call {
+	return &Repository_GetRateLimitSettings_Call{Call: _e.mock.On("GetRateLimitSettings", ctx)}
+}
+
+func (_c *Repository_GetRateLimitSettings_Call) Run(run func(ctx context.Context)) *Repository_GetRateLimitSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_GetRateLimitSettings_Call) Return(rateLimitSettings *types.RateLimitSettings, err error) *Repository_GetRateLimitSettings_Call {
+	_c.Call.Return(rateLimitSettings, err)
+	return _c
+}
+
+func (_c *Repository_GetRateLimitSettings_Call) RunAndReturn(run func(ctx context.Context) (*types.RateLimitSettings, error)) *Repository_GetRateLimitSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBadgeSettings provides a mock function for the type Repository
+func (_mock *Repository) UpdateBadgeSettings(ctx context.Context, badgeSettings *types.BadgeSettings) (*types.BadgeSettings, error) {
+	ret := _mock.Called(ctx, badgeSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBadgeSettings")
+	}
+
+	var r0 *types.BadgeSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.BadgeSettings) (*types.BadgeSettings, error)); ok {
+		return returnFunc(ctx, badgeSettings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.BadgeSettings) *types.BadgeSettings); ok {
+		r0 = returnFunc(ctx, badgeSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BadgeSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.BadgeSettings) error); ok {
+		r1 = returnFunc(ctx, badgeSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateBadgeSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBadgeSettings'
+type Repository_UpdateBadgeSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateBadgeSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - badgeSettings *types.BadgeSettings
+func (_e *Repository_Expecter) UpdateBadgeSettings(ctx interface{}, badgeSettings interface{}) *Repository_UpdateBadgeSettings_Call {
+	return &Repository_UpdateBadgeSettings_Call{Call: _e.mock.On("UpdateBadgeSettings", ctx, badgeSettings)}
+}
+
+func (_c *Repository_UpdateBadgeSettings_Call) Run(run func(ctx context.Context, badgeSettings *types.BadgeSettings)) *Repository_UpdateBadgeSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.BadgeSettings
+		if args[1] != nil {
+			arg1 = args[1].(*types.BadgeSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateBadgeSettings_Call) Return(badgeSettings1 *types.BadgeSettings, err error) *Repository_UpdateBadgeSettings_Call {
+	_c.Call.Return(badgeSettings1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateBadgeSettings_Call) RunAndReturn(run func(ctx context.Context, badgeSettings *types.BadgeSettings) (*types.BadgeSettings, error)) *Repository_UpdateBadgeSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateIssuerSettings provides a mock function for the type Repository
 func (_mock *Repository) UpdateIssuerSettings(ctx context.Context, issuerSettings *types.IssuerSettings) (*types.IssuerSettings, error) {
 	ret := _mock.Called(ctx, issuerSettings)
@@ -167,3 +359,71 @@ func (_c *Repository_UpdateIssuerSettings_Call) RunAndReturn(run func(ctx contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateRateLimitSettings provides a mock function for the type Repository
+func (_mock *Repository) UpdateRateLimitSettings(ctx context.Context, rateLimitSettings *types.RateLimitSettings) (*types.RateLimitSettings, error) {
+	ret := _mock.Called(ctx, rateLimitSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRateLimitSettings")
+	}
+
+	var r0 *types.RateLimitSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.RateLimitSettings) (*types.RateLimitSettings, error)); ok {
+		return returnFunc(ctx, rateLimitSettings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *types.RateLimitSettings) *types.RateLimitSettings); ok {
+		r0 = returnFunc(ctx, rateLimitSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RateLimitSettings)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *types.RateLimitSettings) error); ok {
+		r1 = returnFunc(ctx, rateLimitSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateRateLimitSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRateLimitSettings'
+type Repository_UpdateRateLimitSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateRateLimitSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rateLimitSettings *types.RateLimitSettings
+func (_e *Repository_Expecter) UpdateRateLimitSettings(ctx interface{}, rateLimitSettings interface{}) *Repository_UpdateRateLimitSettings_Call {
+	return &Repository_UpdateRateLimitSettings_Call{Call: _e.mock.On("UpdateRateLimitSettings", ctx, rateLimitSettings)}
+}
+
+func (_c *Repository_UpdateRateLimitSettings_Call) Run(run func(ctx context.Context, rateLimitSettings *types.RateLimitSettings)) *Repository_UpdateRateLimitSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *types.RateLimitSettings
+		if args[1] != nil {
+			arg1 = args[1].(*types.RateLimitSettings)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateRateLimitSettings_Call) Return(rateLimitSettings1 *types.RateLimitSettings, err error) *Repository_UpdateRateLimitSettings_Call {
+	_c.Call.Return(rateLimitSettings1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateRateLimitSettings_Call) RunAndReturn(run func(ctx context.Context, rateLimitSettings *types.RateLimitSettings) (*types.RateLimitSettings, error)) *Repository_UpdateRateLimitSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"time"
 
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
 	"github.com/agntcy/identity-service/internal/core/settings/types"
 	"github.com/agntcy/identity-service/internal/pkg/pgutil"
 	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
@@ -80,6 +81,29 @@ type EntraIdpSettings struct {
 	ClientSecret *secrets.EncryptedString `gorm:"type:varchar(4096);"`
 }
 
+type RateLimitSettings struct {
+	TenantID                   string   `gorm:"primaryKey;type:varchar(256);"`
+	TenantRequestsPerSecond    *float64 `gorm:"type:double precision;"`
+	TenantBurst                *int32
+	CallerAppRequestsPerSecond *float64 `gorm:"type:double precision;"`
+	CallerAppBurst             *int32
+	CalleeAppRequestsPerSecond *float64 `gorm:"type:double precision;"`
+	CalleeAppBurst             *int32
+	CreatedAt                  time.Time
+	UpdatedAt                  sql.NullTime
+}
+
+type BadgeSettings struct {
+	TenantID                 string `gorm:"primaryKey;type:varchar(256);"`
+	DefaultLifetimeSeconds   int64
+	AgentA2ALifetimeSeconds  int64
+	AgentOASFLifetimeSeconds int64
+	McpServerLifetimeSeconds int64
+	ProofFormat              badgetypes.ProofFormat
+	CreatedAt                time.Time
+	UpdatedAt                sql.NullTime
+}
+
 type Device struct {
 	ID                uuid.UUID `gorm:"primaryKey;default:gen_random_uuid()"`
 	TenantID          string    `gorm:"not null;type:varchar(256);"`
@@ -181,6 +205,43 @@ func (i *IssuerSettings) ToCoreType(crypter secrets.Crypter) *types.IssuerSettin
 	}
 }
 
+func (i *RateLimitSettings) ToCoreType() *types.RateLimitSettings {
+	if i == nil {
+		return nil
+	}
+
+	return &types.RateLimitSettings{
+		Tenant:    toRateLimit(i.TenantRequestsPerSecond, i.TenantBurst),
+		CallerApp: toRateLimit(i.CallerAppRequestsPerSecond, i.CallerAppBurst),
+		CalleeApp: toRateLimit(i.CalleeAppRequestsPerSecond, i.CalleeAppBurst),
+	}
+}
+
+func (i *BadgeSettings) ToCoreType() *types.BadgeSettings {
+	if i == nil {
+		return nil
+	}
+
+	return &types.BadgeSettings{
+		DefaultLifetimeSeconds:   i.DefaultLifetimeSeconds,
+		AgentA2ALifetimeSeconds:  i.AgentA2ALifetimeSeconds,
+		AgentOASFLifetimeSeconds: i.AgentOASFLifetimeSeconds,
+		McpServerLifetimeSeconds: i.McpServerLifetimeSeconds,
+		ProofFormat:              i.ProofFormat,
+	}
+}
+
+func toRateLimit(requestsPerSecond *float64, burst *int32) *types.RateLimit {
+	if requestsPerSecond == nil {
+		return nil
+	}
+
+	return &types.RateLimit{
+		RequestsPerSecond: *requestsPerSecond,
+		Burst:             ptrutil.Derefrence(burst, 0),
+	}
+}
+
 func newOktaIdpSettingsModel(src *types.OktaIdpSettings, crypter secrets.Crypter) *OktaIdpSettings {
 	if src == nil {
 		return nil
@@ -270,3 +331,37 @@ func newIssuerSettingsModel(src *types.IssuerSettings, crypter secrets.Crypter)
 		UpdatedAt:           pgutil.TimeToSqlNullTime(src.UpdatedAt),
 	}
 }
+
+func newRateLimitSettingsModel(src *types.RateLimitSettings, tenantID string) *RateLimitSettings {
+	model := &RateLimitSettings{
+		TenantID: tenantID,
+	}
+
+	if src.Tenant != nil {
+		model.TenantRequestsPerSecond = &src.Tenant.RequestsPerSecond
+		model.TenantBurst = &src.Tenant.Burst
+	}
+
+	if src.CallerApp != nil {
+		model.CallerAppRequestsPerSecond = &src.CallerApp.RequestsPerSecond
+		model.CallerAppBurst = &src.CallerApp.Burst
+	}
+
+	if src.CalleeApp != nil {
+		model.CalleeAppRequestsPerSecond = &src.CalleeApp.RequestsPerSecond
+		model.CalleeAppBurst = &src.CalleeApp.Burst
+	}
+
+	return model
+}
+
+func newBadgeSettingsModel(src *types.BadgeSettings, tenantID string) *BadgeSettings {
+	return &BadgeSettings{
+		TenantID:                 tenantID,
+		DefaultLifetimeSeconds:   src.DefaultLifetimeSeconds,
+		AgentA2ALifetimeSeconds:  src.AgentA2ALifetimeSeconds,
+		AgentOASFLifetimeSeconds: src.AgentOASFLifetimeSeconds,
+		McpServerLifetimeSeconds: src.McpServerLifetimeSeconds,
+		ProofFormat:              src.ProofFormat,
+	}
+}
@@ -5,8 +5,10 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	settingscore "github.com/agntcy/identity-service/internal/core/settings"
 	"github.com/agntcy/identity-service/internal/core/settings/types"
@@ -116,6 +118,95 @@ func (r *repository) GetIssuerSettings(
 	return issuerSettings.ToCoreType(r.crypter), nil
 }
 
+// UpdateRateLimitSettings replaces the rate limit settings of the tenant.
+// The limits that are not set are removed.
+func (r *repository) UpdateRateLimitSettings(
+	ctx context.Context,
+	rateLimitSettings *types.RateLimitSettings,
+) (*types.RateLimitSettings, error) {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return nil, identitycontext.ErrTenantNotFound
+	}
+
+	model := newRateLimitSettingsModel(rateLimitSettings, tenantID)
+	model.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	result := r.dbContext.
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(model)
+	if result.Error != nil {
+		return nil, fmt.Errorf("there was an error updating the rate limit settings: %w", result.Error)
+	}
+
+	return model.ToCoreType(), nil
+}
+
+// GetRateLimitSettings returns the rate limit settings of the tenant,
+// empty settings are returned when the tenant has none.
+func (r *repository) GetRateLimitSettings(
+	ctx context.Context,
+) (*types.RateLimitSettings, error) {
+	var model RateLimitSettings
+
+	result := r.dbContext.
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return &types.RateLimitSettings{}, nil
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the rate limit settings: %w", result.Error)
+	}
+
+	return model.ToCoreType(), nil
+}
+
+// UpdateBadgeSettings replaces the badge settings of the tenant.
+func (r *repository) UpdateBadgeSettings(
+	ctx context.Context,
+	badgeSettings *types.BadgeSettings,
+) (*types.BadgeSettings, error) {
+	tenantID, ok := identitycontext.GetTenantID(ctx)
+	if !ok {
+		return nil, identitycontext.ErrTenantNotFound
+	}
+
+	model := newBadgeSettingsModel(badgeSettings, tenantID)
+	model.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	result := r.dbContext.
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(model)
+	if result.Error != nil {
+		return nil, fmt.Errorf("there was an error updating the badge settings: %w", result.Error)
+	}
+
+	return model.ToCoreType(), nil
+}
+
+// GetBadgeSettings returns the badge settings of the tenant,
+// empty settings are returned when the tenant has none.
+func (r *repository) GetBadgeSettings(
+	ctx context.Context,
+) (*types.BadgeSettings, error) {
+	var model BadgeSettings
+
+	result := r.dbContext.
+		Scopes(gormutil.BelongsToTenant(ctx)).
+		First(&model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return &types.BadgeSettings{}, nil
+		}
+
+		return nil, fmt.Errorf("there was an error fetching the badge settings: %w", result.Error)
+	}
+
+	return model.ToCoreType(), nil
+}
+
 func (r *repository) getOrCreateIssuerSettings(
 	ctx context.Context,
 ) (*IssuerSettings, error) {
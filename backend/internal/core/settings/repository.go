@@ -17,4 +17,18 @@ type Repository interface {
 	GetIssuerSettings(
 		ctx context.Context,
 	) (*types.IssuerSettings, error)
+	UpdateRateLimitSettings(
+		ctx context.Context,
+		rateLimitSettings *types.RateLimitSettings,
+	) (*types.RateLimitSettings, error)
+	GetRateLimitSettings(
+		ctx context.Context,
+	) (*types.RateLimitSettings, error)
+	UpdateBadgeSettings(
+		ctx context.Context,
+		badgeSettings *types.BadgeSettings,
+	) (*types.BadgeSettings, error)
+	GetBadgeSettings(
+		ctx context.Context,
+	) (*types.BadgeSettings, error)
 }
@@ -5,7 +5,11 @@
 
 package types
 
-import "time"
+import (
+	"time"
+
+	badgetypes "github.com/agntcy/identity-service/internal/core/badge/types"
+)
 
 // Type
 type IdpType int
@@ -160,6 +164,60 @@ type IssuerSettings struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty" protobuf:"google.protobuf.Timestamp,9,opt,name=updated_at"`
 }
 
+// A token bucket rate limit
+type RateLimit struct {
+	// The number of requests per second refilling the bucket.
+	// A zero value falls back to the limit of the deployment.
+	// The limits above the ones of the deployment are lowered to them.
+	// +field_behavior:REQUIRED
+	RequestsPerSecond float64 `json:"requests_per_second,omitempty" protobuf:"fixed64,1,opt,name=requests_per_second"`
+
+	// The maximum number of requests allowed in a burst.
+	// Defaults to the number of requests per second rounded up.
+	// +field_behavior:OPTIONAL
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,2,opt,name=burst"`
+}
+
+// Rate limits applied to the authorization endpoints
+type RateLimitSettings struct {
+	// The limit shared by all the requests of the tenant.
+	// +field_behavior:OPTIONAL
+	Tenant *RateLimit `json:"tenant,omitempty" protobuf:"bytes,1,opt,name=tenant"`
+
+	// The limit applied to each application calling Authorize and Token.
+	// +field_behavior:OPTIONAL
+	CallerApp *RateLimit `json:"caller_app,omitempty" protobuf:"bytes,2,opt,name=caller_app"`
+
+	// The limit applied to each application authorizing the requests
+	// it receives through the ExtAuthz endpoints.
+	// +field_behavior:OPTIONAL
+	CalleeApp *RateLimit `json:"callee_app,omitempty" protobuf:"bytes,3,opt,name=callee_app"`
+}
+
+// Badge Settings
+type BadgeSettings struct {
+	// The lifetime of the badges in seconds, used for the application types
+	// without a specific lifetime. Zero falls back to the deployment default.
+	// +field_behavior:OPTIONAL
+	DefaultLifetimeSeconds int64 `json:"default_lifetime_seconds,omitempty" protobuf:"varint,1,opt,name=default_lifetime_seconds"` //nolint:lll // struct tags exceed line length
+
+	// The lifetime of the badges of the A2A agents in seconds.
+	// +field_behavior:OPTIONAL
+	AgentA2ALifetimeSeconds int64 `json:"agent_a2a_lifetime_seconds,omitempty" protobuf:"varint,2,opt,name=agent_a2a_lifetime_seconds"` //nolint:lll // struct tags exceed line length
+
+	// The lifetime of the badges of the OASF agents in seconds.
+	// +field_behavior:OPTIONAL
+	AgentOASFLifetimeSeconds int64 `json:"agent_oasf_lifetime_seconds,omitempty" protobuf:"varint,3,opt,name=agent_oasf_lifetime_seconds"` //nolint:lll // struct tags exceed line length
+
+	// The lifetime of the badges of the MCP servers in seconds.
+	// +field_behavior:OPTIONAL
+	McpServerLifetimeSeconds int64 `json:"mcp_server_lifetime_seconds,omitempty" protobuf:"varint,4,opt,name=mcp_server_lifetime_seconds"` //nolint:lll // struct tags exceed line length
+
+	// The format of the proof of the badges, JOSE when unspecified.
+	// +field_behavior:OPTIONAL
+	ProofFormat badgetypes.ProofFormat `json:"proof_format,omitempty" protobuf:"varint,5,opt,name=proof_format"`
+}
+
 // Identity Settings
 type Settings struct {
 	// An API Key for the Identity Service.
@@ -169,4 +227,12 @@ type Settings struct {
 	// Settings for the Issuer.
 	// +field_behavior:OPTIONAL
 	IssuerSettings *IssuerSettings `json:"issuer_settings,omitempty" protobuf:"bytes,2,opt,name=issuer_settings"`
+
+	// The rate limits applied to the authorization endpoints.
+	// +field_behavior:OUTPUT_ONLY
+	RateLimitSettings *RateLimitSettings `json:"rate_limit_settings,omitempty" protobuf:"bytes,3,opt,name=rate_limit_settings"` //nolint:lll // struct tags exceed line length
+
+	// The lifetime of the badges issued for the applications.
+	// +field_behavior:OUTPUT_ONLY
+	BadgeSettings *BadgeSettings `json:"badge_settings,omitempty" protobuf:"bytes,4,opt,name=badge_settings"`
 }
@@ -0,0 +1,233 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/pkg/log"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	skillsField = "skills"
+	urlField    = "url"
+
+	// The skills of the agent card are fetched again after this duration
+	skillsCacheTTL = time.Minute
+)
+
+var errCardFilter = errors.New("unable to filter the agent card")
+
+type skillID struct {
+	ID string `json:"id"`
+}
+
+// filterCard removes the skills the caller is not allowed to use from the agent card.
+// The other fields of the card are kept as is, except for the URL when
+// the gateway has a public URL.
+func (p *Proxy) filterCard(
+	ctx context.Context,
+	accessToken string,
+	raw []byte,
+) ([]byte, error) {
+	card, skills, ids, err := parseCard(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]struct{}, len(ids))
+
+	if len(ids) > 0 {
+		allowedIDs, err := p.client.FilterA2ASkills(ctx, accessToken, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range allowedIDs {
+			allowed[id] = struct{}{}
+		}
+	}
+
+	filtered := make([]json.RawMessage, 0, len(allowed))
+
+	for idx, skill := range skills {
+		if _, ok := allowed[ids[idx]]; ok {
+			filtered = append(filtered, skill)
+		}
+	}
+
+	card[skillsField], err = json.Marshal(filtered)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.publicURL != "" {
+		card[urlField], err = json.Marshal(p.publicURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(card)
+}
+
+// parseCard returns the fields of the agent card along with its skills and their IDs.
+func parseCard(raw []byte) (map[string]json.RawMessage, []json.RawMessage, []string, error) {
+	var card map[string]json.RawMessage
+
+	err := json.Unmarshal(raw, &card)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var skills []json.RawMessage
+
+	if rawSkills, ok := card[skillsField]; ok {
+		err = json.Unmarshal(rawSkills, &skills)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	ids := make([]string, len(skills))
+
+	for idx, skill := range skills {
+		var id skillID
+
+		err = json.Unmarshal(skill, &id)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		ids[idx] = id.ID
+	}
+
+	return card, skills, ids, nil
+}
+
+// agentSkills caches the IDs of the skills advertised in the agent card
+type agentSkills struct {
+	mu        sync.Mutex
+	ids       map[string]struct{}
+	expiresAt time.Time
+	fetches   singleflight.Group
+}
+
+// hasSkill tells whether the agent card advertises the skill, the skill set
+// by the caller in the metadata of a message is not trusted otherwise.
+func (p *Proxy) hasSkill(ctx context.Context, id string) (bool, error) {
+	p.skills.mu.Lock()
+	ids, expiresAt := p.skills.ids, p.skills.expiresAt
+	p.skills.mu.Unlock()
+
+	if ids == nil || !time.Now().Before(expiresAt) {
+		value, err, _ := p.skills.fetches.Do("", func() (any, error) {
+			return p.fetchSkills(ctx)
+		})
+		if err != nil {
+			return false, err
+		}
+
+		ids, _ = value.(map[string]struct{})
+	}
+
+	_, ok := ids[id]
+
+	return ok, nil
+}
+
+func (p *Proxy) fetchSkills(ctx context.Context) (map[string]struct{}, error) {
+	var (
+		raw []byte
+		err error
+	)
+
+	for _, path := range agentCardPaths {
+		raw, err = p.fetchAgentCard(ctx, path)
+		if err == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, skillIDs, err := parseCard(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]struct{}, len(skillIDs))
+	for _, id := range skillIDs {
+		ids[id] = struct{}{}
+	}
+
+	p.skills.mu.Lock()
+	defer p.skills.mu.Unlock()
+
+	p.skills.ids = ids
+	p.skills.expiresAt = time.Now().Add(skillsCacheTTL)
+
+	return ids, nil
+}
+
+// filterExtendedCards filters the agent cards returned in the responses
+// to agent/getAuthenticatedExtendedCard requests. When the filtering fails
+// the response is replaced by an error so no skill is disclosed.
+func (p *Proxy) filterExtendedCards(ctx context.Context, exch *exchange, payload []byte) []byte {
+	messages, err := jsonrpc.Parse(payload, jsonrpc.ContentTypeJSON)
+	if err != nil {
+		return payload
+	}
+
+	changed := false
+
+	for idx, msg := range messages {
+		if !msg.IsResponse() || len(msg.Result) == 0 {
+			continue
+		}
+
+		if _, ok := exch.extendedCards[jsonrpc.IDKey(msg.ID)]; !ok {
+			continue
+		}
+
+		result, err := p.filterCard(ctx, exch.caller.AccessToken, msg.Result)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("unable to filter the extended agent card")
+
+			messages[idx] = &jsonrpc.Message{
+				JSONRPC: jsonrpc.Version,
+				ID:      msg.ID,
+				Error: &jsonrpc.Error{
+					Code:    gateway.JSONRPCInternalError,
+					Message: errCardFilter.Error(),
+				},
+			}
+		} else {
+			msg.Result = result
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return payload
+	}
+
+	filtered, err := jsonrpc.Encode(messages, jsonrpc.IsBatch(payload))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to encode the filtered agent card")
+		return payload
+	}
+
+	return filtered
+}
@@ -0,0 +1,454 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strconv"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	autha2a "github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+// The well-known paths of the agent card (the second one is used by A2A < 0.3)
+var agentCardPaths = []string{
+	"/.well-known/agent-card.json",
+	"/.well-known/agent.json",
+}
+
+var (
+	errUnknownSkill      = errors.New("the skill is not advertised by the agent")
+	errSkillsUnavailable = errors.New("unable to fetch the skills of the agent")
+)
+
+type exchangeKey struct{}
+
+// An exchange holds the state of a request going through the proxy
+type exchange struct {
+	caller  *gateway.Caller
+	skillID string
+
+	// The path of the request, where the agent serves its JSON-RPC endpoint
+	path string
+
+	// The pending agent/getAuthenticatedExtendedCard requests
+	// whose responses need to be filtered
+	extendedCards map[string]struct{}
+}
+
+// The Proxy is a reverse proxy in front of an A2A agent (APP_TYPE_AGENT_A2A).
+// The JSON-RPC requests sent by the callers are authorized by the Identity Service
+// before being forwarded, the messages are evaluated against the policies for the
+// skill they target. The agent card is filtered down to the skills the caller
+// is allowed to use.
+type Proxy struct {
+	upstream     *url.URL
+	publicURL    string
+	client       gateway.IdentityClient
+	app          *apptypes.App
+	maxBodySize  int64
+	httpClient   *http.Client
+	tasks        *taskOwners
+	skills       agentSkills
+	reverseProxy *httputil.ReverseProxy
+}
+
+// NewProxy creates a proxy for the A2A agent served at upstream.
+// When publicURL is provided it replaces the URL advertised in the agent card
+// so the callers keep going through the gateway. Its scheme and host are also
+// the ones the DPoP proofs of the callers are checked against.
+func NewProxy(
+	upstream *url.URL,
+	publicURL string,
+	client gateway.IdentityClient,
+	app *apptypes.App,
+	maxBodySize int64,
+) *Proxy {
+	p := &Proxy{
+		upstream:    upstream,
+		publicURL:   publicURL,
+		client:      client,
+		app:         app,
+		maxBodySize: maxBodySize,
+		httpClient:  &http.Client{},
+		tasks:       newTaskOwners(),
+	}
+
+	p.reverseProxy = &httputil.ReverseProxy{
+		Rewrite:        p.rewriteRequest,
+		ModifyResponse: p.modifyResponse,
+		ErrorHandler:   p.handleUpstreamError,
+	}
+
+	return p
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	caller, err := gateway.CallerFromRequest(r, p.publicURL)
+	if err != nil {
+		gateway.WriteError(ctx, w, http.StatusUnauthorized, nil, gateway.JSONRPCUnauthorized, err)
+		return
+	}
+
+	err = caller.Authenticate(ctx, p.client, p.app)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("unable to authenticate the caller")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), nil, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return
+	}
+
+	if r.Method == http.MethodGet && isAgentCardPath(r.URL.Path) {
+		p.serveAgentCard(w, r, caller)
+		return
+	}
+
+	exch := &exchange{
+		caller:        caller,
+		path:          r.URL.Path,
+		extendedCards: make(map[string]struct{}),
+	}
+
+	// The requests other than the JSON-RPC ones are authenticated as well
+	var authorized bool
+	if r.Method == http.MethodPost {
+		authorized = p.authorizeMessages(w, r, exch)
+	} else {
+		authorized = p.authenticate(w, r, exch)
+	}
+
+	if !authorized {
+		return
+	}
+
+	p.reverseProxy.ServeHTTP(w, r.WithContext(context.WithValue(ctx, exchangeKey{}, exch)))
+}
+
+// authenticate validates the access token of a request without body.
+func (p *Proxy) authenticate(w http.ResponseWriter, r *http.Request, exch *exchange) bool {
+	ctx := r.Context()
+
+	var err error
+
+	exch.caller.Identity, err = p.client.ExtAuthzA2A(
+		ctx,
+		exch.caller.AccessToken,
+		nil,
+		"",
+		exch.caller.TransactionToken,
+		exch.caller.DPoP,
+	)
+
+	gateway.WriteDPoPNonce(w.Header(), exch.caller, err)
+
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("the A2A request was not authenticated")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), nil, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return false
+	}
+
+	return true
+}
+
+// authorizeMessages authorizes the A2A messages in the body of the request
+// and records the skill targeted by the request.
+func (p *Proxy) authorizeMessages(w http.ResponseWriter, r *http.Request, exch *exchange) bool {
+	ctx := r.Context()
+
+	body, statusCode, err := gateway.ReadBody(w, r, p.maxBodySize)
+	if err != nil {
+		gateway.WriteError(ctx, w, statusCode, nil, gateway.JSONRPCInvalidRequest, err)
+		return false
+	}
+
+	contentType := r.Header.Get("Content-Type")
+
+	messages, err := jsonrpc.Parse(body, contentType)
+	if err != nil {
+		gateway.WriteError(ctx, w, http.StatusBadRequest, nil, gateway.JSONRPCParseError, err)
+		return false
+	}
+
+	exch.caller.Identity, err = p.client.ExtAuthzA2A(
+		ctx,
+		exch.caller.AccessToken,
+		body,
+		contentType,
+		exch.caller.TransactionToken,
+		exch.caller.DPoP,
+	)
+
+	gateway.WriteDPoPNonce(w.Header(), exch.caller, err)
+
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("the A2A request was not authorized")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), messages, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return false
+	}
+
+	for _, msg := range messages {
+		statusCode, code, err := p.checkMessage(ctx, exch, msg)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Debug("the A2A request was rejected")
+			gateway.WriteError(ctx, w, statusCode, messages, code, err)
+
+			return false
+		}
+
+		switch {
+		case autha2a.IsInvocation(msg):
+			// The skill header is only set for single messages,
+			// batches can target several skills
+			if len(messages) == 1 {
+				exch.skillID, _ = autha2a.ParseSkillID(msg)
+			}
+		case msg.Method == autha2a.MethodGetAuthenticatedExtendedCard && msg.IsRequest():
+			exch.extendedCards[jsonrpc.IDKey(msg.ID)] = struct{}{}
+		}
+	}
+
+	err = p.stampOwner(r, exch, body, messages)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("unable to stamp the owner of the A2A messages")
+		gateway.WriteError(ctx, w, http.StatusBadRequest, messages, gateway.JSONRPCInvalidRequest, err)
+
+		return false
+	}
+
+	return true
+}
+
+// stampOwner sets the caller in the metadata of the messages sent to the agent,
+// the owner of the tasks is read back from their history. The body is then
+// replaced by the encoded messages.
+func (p *Proxy) stampOwner(r *http.Request, exch *exchange, body []byte, messages []*jsonrpc.Message) error {
+	stamped := false
+
+	for _, msg := range messages {
+		if !autha2a.IsInvocation(msg) {
+			continue
+		}
+
+		err := autha2a.SetMessageOwner(msg, exch.callerAppID())
+		if err != nil {
+			return err
+		}
+
+		stamped = true
+	}
+
+	if !stamped {
+		return nil
+	}
+
+	encoded, err := jsonrpc.Encode(messages, jsonrpc.IsBatch(body))
+	if err != nil {
+		return err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(encoded))
+	r.ContentLength = int64(len(encoded))
+	r.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+
+	return nil
+}
+
+// checkMessage makes sure the skill targeted by a message is advertised by the agent
+// and that the task the message operates on, if any, was created by the caller.
+// On error the HTTP status code and the JSON-RPC error code to return are provided.
+func (p *Proxy) checkMessage(ctx context.Context, exch *exchange, msg *jsonrpc.Message) (int, int, error) {
+	if autha2a.IsInvocation(msg) {
+		skillID, err := autha2a.ParseSkillID(msg)
+		if err != nil {
+			return http.StatusBadRequest, gateway.JSONRPCInvalidRequest, err
+		}
+
+		known, err := p.hasSkill(ctx, skillID)
+		if err != nil {
+			return http.StatusBadGateway, gateway.JSONRPCInternalError, errSkillsUnavailable
+		}
+
+		if !known {
+			return http.StatusBadRequest, gateway.JSONRPCInvalidRequest, errUnknownSkill
+		}
+	}
+
+	taskID, err := autha2a.ParseTaskID(msg)
+	if err != nil {
+		return http.StatusBadRequest, gateway.JSONRPCInvalidRequest, err
+	}
+
+	if taskID != "" && !p.ownsTask(ctx, exch, taskID) {
+		return http.StatusNotFound, jsonrpcTaskNotFound, errTaskNotFound
+	}
+
+	return http.StatusOK, 0, nil
+}
+
+func (p *Proxy) rewriteRequest(pr *httputil.ProxyRequest) {
+	pr.SetURL(p.upstream)
+	pr.SetXForwarded()
+
+	exch, _ := pr.In.Context().Value(exchangeKey{}).(*exchange)
+	if exch == nil {
+		return
+	}
+
+	gateway.InjectIdentityHeaders(pr.Out.Header, exch.caller, p.app)
+
+	if exch.skillID != "" {
+		pr.Out.Header.Set(gateway.HeaderSkillID, exch.skillID)
+	}
+}
+
+// modifyResponse records the tasks created by the caller and filters the skills
+// of the extended agent cards returned by agent/getAuthenticatedExtendedCard.
+func (p *Proxy) modifyResponse(resp *http.Response) error {
+	ctx := resp.Request.Context()
+
+	exch, _ := ctx.Value(exchangeKey{}).(*exchange)
+	if exch == nil {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+
+	switch mediaType {
+	case jsonrpc.ContentTypeEventStream:
+		resp.Body = gateway.RewriteEventStream(resp.Body, func(event *gateway.Event) {
+			if event.Data != "" {
+				event.Data = string(p.recordTasks(ctx, exch, []byte(event.Data)))
+			}
+		})
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+	case jsonrpc.ContentTypeJSON:
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if err != nil {
+			return err
+		}
+
+		body = p.recordTasks(ctx, exch, body)
+
+		if len(exch.extendedCards) > 0 {
+			body = p.filterExtendedCards(ctx, exch, body)
+		}
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	return nil
+}
+
+// serveAgentCard fetches the agent card from the upstream agent
+// and returns it with only the skills the caller is allowed to use.
+func (p *Proxy) serveAgentCard(w http.ResponseWriter, r *http.Request, caller *gateway.Caller) {
+	ctx := r.Context()
+
+	card, err := p.fetchAgentCard(ctx, r.URL.Path)
+	if err != nil {
+		p.handleUpstreamError(w, r, err)
+		return
+	}
+
+	card, err = p.filterCard(ctx, caller.AccessToken, card)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to filter the agent card")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), nil, gateway.JSONRPCInternalError, errCardFilter,
+		)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(card)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to write the agent card")
+	}
+}
+
+func (p *Proxy) fetchAgentCard(ctx context.Context, path string) ([]byte, error) {
+	cardURL := p.upstream.JoinPath(path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cardURL.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get agent card with status code: %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, p.maxBodySize))
+}
+
+func (p *Proxy) handleUpstreamError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+
+	log.FromContext(ctx).WithError(err).Error("unable to reach the A2A agent")
+
+	gateway.WriteError(
+		ctx,
+		w,
+		http.StatusBadGateway,
+		nil,
+		gateway.JSONRPCInternalError,
+		errors.New("unable to reach the A2A agent"),
+	)
+}
+
+// callerAppID returns the ID of the caller app once authenticated.
+func (e *exchange) callerAppID() string {
+	if e.caller.Identity != nil && e.caller.Identity.AppID != "" {
+		return e.caller.Identity.AppID
+	}
+
+	if e.caller.App != nil {
+		return e.caller.App.ID
+	}
+
+	return ""
+}
+
+func isAgentCardPath(path string) bool {
+	return slices.Contains(agentCardPaths, path)
+}
@@ -0,0 +1,482 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/gateway"
+	gatewaya2a "github.com/agntcy/identity-service/internal/gateway/a2a"
+	gatewaymocks "github.com/agntcy/identity-service/internal/gateway/mocks"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBodySize = 1024 * 1024
+	agentCard   = `{
+		"name": "agent",
+		"url": "http://agent.internal",
+		"version": "1.0.0",
+		"skills": [
+			{"id": "skill_a", "name": "Skill A"},
+			{"id": "skill_b", "name": "Skill B"}
+		]
+	}`
+)
+
+// newAgent creates a fake A2A agent answering the requests with the identity
+// headers it received.
+func newAgent(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+			_, _ = io.WriteString(w, agentCard)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		messages, err := jsonrpc.Parse(body, r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+
+		result := map[string]string{
+			"callerAppId": r.Header.Get(gateway.HeaderCallerAppID),
+			"calleeAppId": r.Header.Get(gateway.HeaderCalleeAppID),
+			"skillId":     r.Header.Get(gateway.HeaderSkillID),
+			"ruleId":      r.Header.Get(gateway.HeaderRuleID),
+			"apiKey":      r.Header.Get(gateway.HeaderApiKey),
+		}
+		rawResult, _ := json.Marshal(result)
+
+		if messages[0].Method == "agent/getAuthenticatedExtendedCard" {
+			rawResult = json.RawMessage(agentCard)
+		}
+
+		response, _ := json.Marshal(&jsonrpc.Message{
+			JSONRPC: jsonrpc.Version,
+			ID:      messages[0].ID,
+			Result:  rawResult,
+		})
+
+		if messages[0].Method == "message/stream" {
+			w.Header().Set("Content-Type", jsonrpc.ContentTypeEventStream)
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", response)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+		_, _ = w.Write(response)
+	}))
+}
+
+func newGateway(
+	t *testing.T,
+	identityClient gateway.IdentityClient,
+	calleeApp *apptypes.App,
+) *httptest.Server {
+	t.Helper()
+
+	agent := newAgent(t)
+	t.Cleanup(agent.Close)
+
+	upstream, _ := url.Parse(agent.URL)
+
+	gw := httptest.NewServer(
+		gatewaya2a.NewProxy(upstream, "https://gateway.example", identityClient, calleeApp, maxBodySize),
+	)
+	t.Cleanup(gw.Close)
+
+	return gw
+}
+
+func post(t *testing.T, gwURL string, headers map[string]string, body string) *http.Response {
+	t.Helper()
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost, gwURL, strings.NewReader(body))
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	return resp
+}
+
+func TestProxy_should_authorize_messages_and_inject_identity_headers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		method string
+	}{
+		"send":   {method: "message/send"},
+		"stream": {method: "message/stream"},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			calleeApp := &apptypes.App{
+				ID:                 uuid.NewString(),
+				Type:               apptypes.APP_TYPE_AGENT_A2A,
+				ResolverMetadataID: uuid.NewString(),
+			}
+			callerApp := &apptypes.App{ID: uuid.NewString()}
+			callerApiKey := uuid.NewString()
+			accessToken := uuid.NewString()
+			body := `{"jsonrpc":"2.0","id":1,"method":"` + tc.method + `",` +
+				`"params":{"message":{"role":"user","parts":[]},"metadata":{"skillId":"skill_a"}}}`
+
+			identityClient := gatewaymocks.NewIdentityClient(t)
+			identityClient.EXPECT().AppInfo(mock.Anything, callerApiKey).Return(callerApp, nil)
+			identityClient.EXPECT().
+				IssueAccessToken(mock.Anything, callerApiKey, calleeApp.ResolverMetadataID).
+				Return(accessToken, nil)
+			identity := &authtypes.CallerIdentity{
+				AppID:     callerApp.ID,
+				SessionID: uuid.NewString(),
+				RuleID:    ptrutil.Ptr(uuid.NewString()),
+			}
+			identityClient.EXPECT().
+				ExtAuthzA2A(mock.Anything, accessToken, []byte(body), jsonrpc.ContentTypeJSON, "", mock.Anything).
+				Return(identity, nil)
+
+			gw := newGateway(t, identityClient, calleeApp)
+
+			resp := post(t, gw.URL, map[string]string{gateway.HeaderApiKey: callerApiKey}, body)
+			respBody, _ := io.ReadAll(resp.Body)
+
+			messages, err := jsonrpc.Parse(respBody, resp.Header.Get("Content-Type"))
+			require.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.JSONEq(
+				t,
+				fmt.Sprintf(
+					`{"callerAppId":%q,"calleeAppId":%q,"skillId":"skill_a","ruleId":%q,"apiKey":""}`,
+					callerApp.ID,
+					calleeApp.ID,
+					*identity.RuleID,
+				),
+				string(messages[0].Result),
+			)
+		})
+	}
+}
+
+func TestProxy_should_return_err_when_message_is_denied(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":"req-1","method":"message/send","params":{"message":{}}}`
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, accessToken, []byte(body), jsonrpc.ContentTypeJSON, "", mock.Anything).
+		Return(nil, status.Error(codes.PermissionDenied, "denied"))
+
+	gw := newGateway(t, identityClient, calleeApp)
+
+	resp := post(t, gw.URL, map[string]string{gateway.HeaderAuthorization: "Bearer " + accessToken}, body)
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.JSONEq(t, `"req-1"`, string(msg.ID))
+	assert.Equal(t, gateway.JSONRPCUnauthorized, msg.Error.Code)
+}
+
+func TestProxy_should_serve_filtered_agent_card(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	accessToken := uuid.NewString()
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		FilterA2ASkills(mock.Anything, accessToken, []string{"skill_a", "skill_b"}).
+		Return([]string{"skill_b"}, nil)
+
+	gw := newGateway(t, identityClient, calleeApp)
+
+	req, _ := http.NewRequestWithContext(
+		t.Context(),
+		http.MethodGet,
+		gw.URL+"/.well-known/agent-card.json",
+		http.NoBody,
+	)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	card, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{
+		"name": "agent",
+		"url": "https://gateway.example",
+		"version": "1.0.0",
+		"skills": [{"id": "skill_b", "name": "Skill B"}]
+	}`, string(card))
+}
+
+func TestProxy_should_filter_extended_agent_card(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":3,"method":"agent/getAuthenticatedExtendedCard"}`
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, accessToken, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&authtypes.CallerIdentity{}, nil)
+	identityClient.EXPECT().
+		FilterA2ASkills(mock.Anything, accessToken, []string{"skill_a", "skill_b"}).
+		Return([]string{"skill_a"}, nil)
+
+	gw := newGateway(t, identityClient, calleeApp)
+
+	resp := post(t, gw.URL, map[string]string{gateway.HeaderAuthorization: "Bearer " + accessToken}, body)
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.JSONEq(t, `{
+		"name": "agent",
+		"url": "https://gateway.example",
+		"version": "1.0.0",
+		"skills": [{"id": "skill_a", "name": "Skill A"}]
+	}`, string(msg.Result))
+}
+
+func TestProxy_should_only_allow_the_task_owner(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.NewString()
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	ownerToken := uuid.NewString()
+	otherToken := uuid.NewString()
+
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+			_, _ = io.WriteString(w, agentCard)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		messages, err := jsonrpc.Parse(body, r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+
+		response, _ := json.Marshal(&jsonrpc.Message{
+			JSONRPC: jsonrpc.Version,
+			ID:      messages[0].ID,
+			Result:  json.RawMessage(`{"kind":"task","id":"` + taskID + `","status":{"state":"working"}}`),
+		})
+
+		w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+		_, _ = w.Write(response)
+	}))
+	defer agent.Close()
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, ownerToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, otherToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+
+	upstream, _ := url.Parse(agent.URL)
+	gw := httptest.NewServer(gatewaya2a.NewProxy(upstream, "", identityClient, calleeApp, maxBodySize))
+	defer gw.Close()
+
+	getTask := `{"jsonrpc":"2.0","id":2,"method":"tasks/get","params":{"id":"` + taskID + `"}}`
+	ownerHeaders := map[string]string{gateway.HeaderAuthorization: "Bearer " + ownerToken}
+	otherHeaders := map[string]string{gateway.HeaderAuthorization: "Bearer " + otherToken}
+
+	// The task is unknown until created through the gateway
+	resp := post(t, gw.URL, ownerHeaders, getTask)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = post(
+		t,
+		gw.URL,
+		ownerHeaders,
+		`{"jsonrpc":"2.0","id":1,"method":"message/send",`+
+			`"params":{"message":{"role":"user","parts":[]},"metadata":{"skillId":"skill_a"}}}`,
+	)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = post(t, gw.URL, ownerHeaders, getTask)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = post(t, gw.URL, otherHeaders, getTask)
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.Equal(t, "task not found", msg.Error.Message)
+}
+
+func TestProxy_should_read_the_task_owner_from_the_agent(t *testing.T) {
+	t.Parallel()
+
+	taskID := uuid.NewString()
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	ownerApp := &authtypes.CallerIdentity{AppID: uuid.NewString()}
+	otherApp := &authtypes.CallerIdentity{AppID: uuid.NewString()}
+	ownerToken := uuid.NewString()
+	otherToken := uuid.NewString()
+
+	// The agent keeps the messages it receives in the history of the task
+	var history []json.RawMessage
+
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+			_, _ = io.WriteString(w, agentCard)
+
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		messages, err := jsonrpc.Parse(body, r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+
+		if messages[0].Method == "message/send" {
+			var params struct {
+				Message json.RawMessage `json:"message"`
+			}
+
+			require.NoError(t, messages[0].DecodeParams(&params))
+
+			history = append(history, params.Message)
+		}
+
+		rawHistory, _ := json.Marshal(history)
+		response, _ := json.Marshal(&jsonrpc.Message{
+			JSONRPC: jsonrpc.Version,
+			ID:      messages[0].ID,
+			Result:  json.RawMessage(`{"kind":"task","id":"` + taskID + `","history":` + string(rawHistory) + `}`),
+		})
+
+		w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+		_, _ = w.Write(response)
+	}))
+	defer agent.Close()
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, ownerToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(ownerApp, nil)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, otherToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(otherApp, nil)
+
+	upstream, _ := url.Parse(agent.URL)
+	ownerHeaders := map[string]string{gateway.HeaderAuthorization: "Bearer " + ownerToken}
+	otherHeaders := map[string]string{gateway.HeaderAuthorization: "Bearer " + otherToken}
+
+	// The caller cannot claim to be another caller in the metadata of the message
+	gw := httptest.NewServer(gatewaya2a.NewProxy(upstream, "", identityClient, calleeApp, maxBodySize))
+	resp := post(t, gw.URL, ownerHeaders, `{"jsonrpc":"2.0","id":1,"method":"message/send","params":{`+
+		`"message":{"role":"user","parts":[],"metadata":{"skillId":"skill_a",`+
+		`"x-id-caller-app-id":"`+otherApp.AppID+`"}}}}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	gw.Close()
+
+	// Another replica, or the gateway after a restart, does not know the task
+	otherGw := httptest.NewServer(gatewaya2a.NewProxy(upstream, "", identityClient, calleeApp, maxBodySize))
+	defer otherGw.Close()
+
+	getTask := `{"jsonrpc":"2.0","id":2,"method":"tasks/get","params":{"id":"` + taskID + `"}}`
+
+	resp = post(t, otherGw.URL, otherHeaders, getTask)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = post(t, otherGw.URL, ownerHeaders, getTask)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestProxy_should_return_err_when_skill_is_unknown(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":1,"method":"message/send",` +
+		`"params":{"message":{"role":"user","parts":[]},"metadata":{"skillId":"skill_z"}}}`
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, accessToken, []byte(body), jsonrpc.ContentTypeJSON, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+
+	gw := newGateway(t, identityClient, calleeApp)
+
+	resp := post(t, gw.URL, map[string]string{gateway.HeaderAuthorization: "Bearer " + accessToken}, body)
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.Equal(t, gateway.JSONRPCInvalidRequest, msg.Error.Code)
+}
+
+func TestProxy_should_authenticate_requests_without_body(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_AGENT_A2A}
+	accessToken := uuid.NewString()
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzA2A(mock.Anything, accessToken, []byte(nil), "", "", mock.Anything).
+		Return(nil, status.Error(codes.Unauthenticated, "invalid token"))
+
+	gw := newGateway(t, identityClient, calleeApp)
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, gw.URL+"/files/report", http.NoBody)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	autha2a "github.com/agntcy/identity-service/internal/core/auth/a2a"
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/google/uuid"
+)
+
+// The TaskNotFoundError code of the A2A protocol
+const jsonrpcTaskNotFound = -32001
+
+var errTaskNotFound = errors.New("task not found")
+
+// The owners are kept in memory for this duration after their last use,
+// after which they are read back from the history of the task
+const taskOwnershipTTL = 24 * time.Hour
+
+type taskOwner struct {
+	appID     string
+	expiresAt time.Time
+}
+
+// taskOwners caches the caller app that created each task, the tasks
+// can only be read, canceled or continued by the same caller.
+// The gateway stamps the caller on the messages it forwards, so the owner
+// of a task unknown to the replica, e.g. after a restart, is read from
+// the history of the task held by the agent.
+type taskOwners struct {
+	mu        sync.Mutex
+	owners    map[string]*taskOwner
+	nextSweep time.Time
+}
+
+func newTaskOwners() *taskOwners {
+	return &taskOwners{
+		owners: make(map[string]*taskOwner),
+	}
+}
+
+// record makes the caller app the owner of the task,
+// unless the task already belongs to another caller.
+func (t *taskOwners) record(taskID, appID string) bool {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sweep(now)
+
+	owner, ok := t.owners[taskID]
+	if ok && owner.appID != appID && now.Before(owner.expiresAt) {
+		return false
+	}
+
+	t.owners[taskID] = &taskOwner{
+		appID:     appID,
+		expiresAt: now.Add(taskOwnershipTTL),
+	}
+
+	return true
+}
+
+// owns tells whether the task was created by the caller app.
+func (t *taskOwners) owns(taskID, appID string) bool {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	owner, ok := t.owners[taskID]
+	if !ok || appID == "" || owner.appID != appID || !now.Before(owner.expiresAt) {
+		return false
+	}
+
+	owner.expiresAt = now.Add(taskOwnershipTTL)
+
+	return true
+}
+
+// sweep removes the expired tasks, at most once per TTL.
+func (t *taskOwners) sweep(now time.Time) {
+	if now.Before(t.nextSweep) {
+		return
+	}
+
+	for taskID, owner := range t.owners {
+		if !now.Before(owner.expiresAt) {
+			delete(t.owners, taskID)
+		}
+	}
+
+	t.nextSweep = now.Add(taskOwnershipTTL)
+}
+
+// recordTasks makes the caller the owner of the tasks returned in the responses
+// contained in the payload. The responses holding a task that belongs to another
+// caller are replaced by an error so the task is not disclosed.
+func (p *Proxy) recordTasks(ctx context.Context, exch *exchange, payload []byte) []byte {
+	messages, err := jsonrpc.Parse(payload, jsonrpc.ContentTypeJSON)
+	if err != nil {
+		return payload
+	}
+
+	changed := false
+
+	for idx, msg := range messages {
+		if !msg.IsResponse() || len(msg.Result) == 0 {
+			continue
+		}
+
+		taskID := autha2a.ResultTaskID(msg.Result)
+		if taskID == "" {
+			continue
+		}
+
+		owner, ok := autha2a.TaskOwner(msg.Result)
+		if (!ok || owner == exch.callerAppID()) && p.tasks.record(taskID, exch.callerAppID()) {
+			continue
+		}
+
+		log.FromContext(ctx).Warn("the A2A agent returned a task of another caller: ", taskID)
+
+		messages[idx] = &jsonrpc.Message{
+			JSONRPC: jsonrpc.Version,
+			ID:      msg.ID,
+			Error: &jsonrpc.Error{
+				Code:    jsonrpcTaskNotFound,
+				Message: errTaskNotFound.Error(),
+			},
+		}
+		changed = true
+	}
+
+	if !changed {
+		return payload
+	}
+
+	filtered, err := jsonrpc.Encode(messages, jsonrpc.IsBatch(payload))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to encode the A2A responses")
+		return payload
+	}
+
+	return filtered
+}
+
+// ownsTask tells whether the task was created by the caller. The tasks unknown
+// to the replica are fetched from the agent to read their owner from their history.
+func (p *Proxy) ownsTask(ctx context.Context, exch *exchange, taskID string) bool {
+	if p.tasks.owns(taskID, exch.callerAppID()) {
+		return true
+	}
+
+	owner, err := p.fetchTaskOwner(ctx, exch, taskID)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("unable to read the owner of the A2A task ", taskID)
+		return false
+	}
+
+	if owner == "" || owner != exch.callerAppID() {
+		return false
+	}
+
+	return p.tasks.record(taskID, owner)
+}
+
+func (p *Proxy) fetchTaskOwner(ctx context.Context, exch *exchange, taskID string) (string, error) {
+	params, err := json.Marshal(map[string]string{"id": taskID})
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(&jsonrpc.Message{
+		JSONRPC: jsonrpc.Version,
+		ID:      json.RawMessage(strconv.Quote(uuid.NewString())),
+		Method:  autha2a.MethodTasksGet,
+		Params:  params,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		p.upstream.JoinPath(exch.path).String(),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+	gateway.InjectIdentityHeaders(req.Header, exch.caller, p.app)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the task with status code: %s", resp.Status)
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBodySize))
+	if err != nil {
+		return "", err
+	}
+
+	messages, err := jsonrpc.Parse(payload, resp.Header.Get("Content-Type"))
+	if err != nil {
+		return "", err
+	}
+
+	if len(messages) != 1 || len(messages[0].Result) == 0 {
+		return "", errTaskNotFound
+	}
+
+	owner, _ := autha2a.TaskOwner(messages[0].Result)
+
+	return owner, nil
+}
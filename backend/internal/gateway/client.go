@@ -0,0 +1,281 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/bff/grpc/converters"
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	"github.com/agntcy/identity-service/internal/core/auth/pkce"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const apiKeyMetadata = "x-id-api-key"
+
+var ErrEmptyAppInfo = errors.New("the identity service returned an empty app")
+
+// The IdentityClient interface defines the calls made by the gateways
+// to the Identity Service. The calls are authenticated with the API key
+// of the protected app unless another API key is provided.
+type IdentityClient interface {
+	// Returns the app identified by the API key
+	AppInfo(ctx context.Context, apiKey string) (*apptypes.App, error)
+
+	// Runs the Authorize and Token flow for the caller identified by callerApiKey
+	// and returns an access token for the protected app. The token is reused
+	// until it expires or is rejected by the Identity Service
+	IssueAccessToken(ctx context.Context, callerApiKey, resolverMetadataID string) (string, error)
+
+	// Authorizes the MCP messages in the body for the access token and its DPoP proof,
+	// propagating the transaction token if any, and returns the identity of the caller
+	// along with the receipt of the authorization when enabled.
+	// The nonce issued for the next DPoP proof is stored in the proof
+	ExtAuthzMcp(
+		ctx context.Context,
+		accessToken string,
+		body []byte,
+		contentType string,
+		transactionToken string,
+		dpopProof *DPoPProof,
+	) (*authtypes.CallerIdentity, error)
+
+	// Returns the tools the access token is allowed to invoke
+	FilterMcpTools(ctx context.Context, accessToken string, toolNames []string) ([]string, error)
+
+	// Authorizes the A2A messages in the body for the access token and its DPoP proof,
+	// propagating the transaction token if any, and returns the identity of the caller
+	// along with the receipt of the authorization when enabled.
+	// The nonce issued for the next DPoP proof is stored in the proof
+	ExtAuthzA2A(
+		ctx context.Context,
+		accessToken string,
+		body []byte,
+		contentType string,
+		transactionToken string,
+		dpopProof *DPoPProof,
+	) (*authtypes.CallerIdentity, error)
+
+	// Returns the skills the access token is allowed to use
+	FilterA2ASkills(ctx context.Context, accessToken string, skillIDs []string) ([]string, error)
+}
+
+type identityClient struct {
+	authClient    identity_service_sdk_go.AuthServiceClient
+	apiKey        string
+	issueReceipts bool
+	accessTokens  *accessTokenCache
+}
+
+// NewIdentityClient returns an IdentityClient authenticated with the API key,
+// requesting a receipt of the authorizations when issueReceipts is set.
+func NewIdentityClient(
+	authClient identity_service_sdk_go.AuthServiceClient,
+	apiKey string,
+	issueReceipts bool,
+) IdentityClient {
+	return &identityClient{
+		authClient:    authClient,
+		apiKey:        apiKey,
+		issueReceipts: issueReceipts,
+		accessTokens:  newAccessTokenCache(),
+	}
+}
+
+func (c *identityClient) AppInfo(ctx context.Context, apiKey string) (*apptypes.App, error) {
+	resp, err := c.authClient.AppInfo(withApiKey(ctx, apiKey), &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch app info: %w", err)
+	}
+
+	if resp.GetApp() == nil {
+		return nil, ErrEmptyAppInfo
+	}
+
+	return converters.ToApp(resp.GetApp()), nil
+}
+
+func (c *identityClient) IssueAccessToken(
+	ctx context.Context,
+	callerApiKey, resolverMetadataID string,
+) (string, error) {
+	key := accessTokenKey{apiKey: callerApiKey, resolverMetadataID: resolverMetadataID}
+
+	if accessToken, ok := c.accessTokens.get(key); ok {
+		return accessToken, nil
+	}
+
+	ctx = withApiKey(ctx, callerApiKey)
+
+	codeVerifier := pkce.NewVerifier()
+
+	authzResp, err := c.authClient.Authorize(ctx, &identity_service_sdk_go.AuthorizeRequest{
+		ResolverMetadataId:  &resolverMetadataID,
+		CodeChallenge:       ptrutil.Ptr(pkce.S256Challenge(codeVerifier)),
+		CodeChallengeMethod: ptrutil.Ptr(pkce.MethodS256),
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to authorize the caller: %w", err)
+	}
+
+	tokenResp, err := c.authClient.Token(ctx, &identity_service_sdk_go.TokenRequest{
+		AuthorizationCode: authzResp.GetAuthorizationCode(),
+		CodeVerifier:      &codeVerifier,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to issue an access token for the caller: %w", err)
+	}
+
+	c.accessTokens.put(key, tokenResp.GetAccessToken())
+
+	return tokenResp.GetAccessToken(), nil
+}
+
+func (c *identityClient) ExtAuthzMcp(
+	ctx context.Context,
+	accessToken string,
+	body []byte,
+	contentType string,
+	transactionToken string,
+	dpopProof *DPoPProof,
+) (*authtypes.CallerIdentity, error) {
+	var header metadata.MD
+
+	resp, err := c.authClient.ExtAuthzMcp(withApiKey(ctx, c.apiKey), &identity_service_sdk_go.ExtAuthzMcpRequest{
+		AccessToken:      accessToken,
+		Body:             string(body),
+		ContentType:      &contentType,
+		TransactionToken: optionalString(transactionToken),
+		Dpop:             toDPoPProof(dpopProof),
+		IssueReceipt:     ptrutil.Ptr(c.issueReceipts),
+	}, grpc.Header(&header))
+
+	storeDPoPNonce(dpopProof, header)
+
+	if err != nil {
+		c.forgetRejectedAccessToken(accessToken, err)
+		return nil, err
+	}
+
+	return converters.ToCallerIdentity(resp), nil
+}
+
+func (c *identityClient) FilterMcpTools(
+	ctx context.Context,
+	accessToken string,
+	toolNames []string,
+) ([]string, error) {
+	resp, err := c.authClient.ExtAuthzMcpTools(
+		withApiKey(ctx, c.apiKey),
+		&identity_service_sdk_go.ExtAuthzMcpToolsRequest{
+			AccessToken: accessToken,
+			ToolNames:   toolNames,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetToolNames(), nil
+}
+
+func (c *identityClient) ExtAuthzA2A(
+	ctx context.Context,
+	accessToken string,
+	body []byte,
+	contentType string,
+	transactionToken string,
+	dpopProof *DPoPProof,
+) (*authtypes.CallerIdentity, error) {
+	var header metadata.MD
+
+	resp, err := c.authClient.ExtAuthzA2A(withApiKey(ctx, c.apiKey), &identity_service_sdk_go.ExtAuthzA2ARequest{
+		AccessToken:      accessToken,
+		Body:             string(body),
+		ContentType:      &contentType,
+		TransactionToken: optionalString(transactionToken),
+		Dpop:             toDPoPProof(dpopProof),
+		IssueReceipt:     ptrutil.Ptr(c.issueReceipts),
+	}, grpc.Header(&header))
+
+	storeDPoPNonce(dpopProof, header)
+
+	if err != nil {
+		c.forgetRejectedAccessToken(accessToken, err)
+		return nil, err
+	}
+
+	return converters.ToCallerIdentity(resp), nil
+}
+
+func (c *identityClient) FilterA2ASkills(
+	ctx context.Context,
+	accessToken string,
+	skillIDs []string,
+) ([]string, error) {
+	resp, err := c.authClient.ExtAuthzA2ASkills(
+		withApiKey(ctx, c.apiKey),
+		&identity_service_sdk_go.ExtAuthzA2ASkillsRequest{
+			AccessToken: accessToken,
+			SkillIds:    skillIDs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSkillIds(), nil
+}
+
+func (c *identityClient) forgetRejectedAccessToken(accessToken string, err error) {
+	if status.Code(err) == codes.Unauthenticated {
+		c.accessTokens.forget(accessToken)
+	}
+}
+
+func withApiKey(ctx context.Context, apiKey string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, apiKeyMetadata, apiKey)
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func toDPoPProof(proof *DPoPProof) *identity_service_sdk_go.DPoPProof {
+	if proof == nil {
+		return nil
+	}
+
+	return &identity_service_sdk_go.DPoPProof{
+		Proof:      proof.Proof,
+		HttpMethod: proof.Method,
+		HttpUrl:    proof.URL,
+	}
+}
+
+// storeDPoPNonce keeps the nonce sent by the Identity Service, including
+// with errors, so that the gateway can forward it to the caller.
+func storeDPoPNonce(proof *DPoPProof, header metadata.MD) {
+	if proof == nil {
+		return
+	}
+
+	if nonces := header.Get(dpop.NonceHeader); len(nonces) > 0 {
+		proof.Nonce = nonces[0]
+	}
+}
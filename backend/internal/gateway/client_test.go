@@ -0,0 +1,118 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/core/auth/sessiontoken"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authClient issues a new session token on each Authorize and Token flow
+// and rejects the revoked tokens
+type authClient struct {
+	identity_service_sdk_go.AuthServiceClient
+
+	t       *testing.T
+	issued  []string
+	revoked map[string]bool
+}
+
+func (c *authClient) Authorize(
+	_ context.Context,
+	_ *identity_service_sdk_go.AuthorizeRequest,
+	_ ...grpc.CallOption,
+) (*identity_service_sdk_go.AuthorizeResponse, error) {
+	return &identity_service_sdk_go.AuthorizeResponse{AuthorizationCode: uuid.NewString()}, nil
+}
+
+func (c *authClient) Token(
+	_ context.Context,
+	_ *identity_service_sdk_go.TokenRequest,
+	_ ...grpc.CallOption,
+) (*identity_service_sdk_go.TokenResponse, error) {
+	privKey, _ := joseutil.GenerateJWK("RS256", "sig", "key_id")
+	session := &authtypes.Session{ID: uuid.NewString(), OwnerAppID: uuid.NewString()}
+
+	token, _, err := sessiontoken.Issue("issuer", session, "", privKey)
+	require.NoError(c.t, err)
+
+	c.issued = append(c.issued, token)
+
+	return &identity_service_sdk_go.TokenResponse{AccessToken: token}, nil
+}
+
+func (c *authClient) ExtAuthzMcp(
+	_ context.Context,
+	in *identity_service_sdk_go.ExtAuthzMcpRequest,
+	_ ...grpc.CallOption,
+) (*identity_service_sdk_go.ExtAuthzResponse, error) {
+	if c.revoked[in.GetAccessToken()] {
+		return nil, status.Error(codes.Unauthenticated, "revoked")
+	}
+
+	if in.GetIssueReceipt() {
+		return &identity_service_sdk_go.ExtAuthzResponse{
+			Receipt: &identity_service_sdk_go.Receipt{Id: "receipt_id", Token: "receipt"},
+		}, nil
+	}
+
+	return &identity_service_sdk_go.ExtAuthzResponse{}, nil
+}
+
+func TestIdentityClient_should_reuse_the_access_tokens_until_rejected(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	auth := &authClient{t: t, revoked: make(map[string]bool)}
+	sut := gateway.NewIdentityClient(auth, "api_key", false)
+
+	first, err := sut.IssueAccessToken(ctx, "caller_api_key", "callee")
+	assert.NoError(t, err)
+
+	second, err := sut.IssueAccessToken(ctx, "caller_api_key", "callee")
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := sut.IssueAccessToken(ctx, "other_api_key", "callee")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	auth.revoked[first] = true
+
+	_, err = sut.ExtAuthzMcp(ctx, first, nil, "", "", nil)
+	assert.Error(t, err)
+
+	renewed, err := sut.IssueAccessToken(ctx, "caller_api_key", "callee")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, renewed)
+	assert.Len(t, auth.issued, 3)
+}
+
+func TestIdentityClient_should_request_the_receipts_when_enabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	identity, err := gateway.NewIdentityClient(&authClient{t: t}, "api_key", true).
+		ExtAuthzMcp(ctx, "token", nil, "", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "receipt", identity.Receipt.Token)
+
+	identity, err = gateway.NewIdentityClient(&authClient{t: t}, "api_key", false).
+		ExtAuthzMcp(ctx, "token", nil, "", "", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, identity.Receipt)
+}
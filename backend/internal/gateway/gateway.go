@@ -0,0 +1,362 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/internal/core/auth/dpop"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	HeaderAuthorization = "Authorization"
+	HeaderApiKey        = "X-Id-Api-Key"
+	HeaderDPoP          = "DPoP"
+
+	// Identity headers injected in the upstream requests
+	HeaderCallerAppID              = "X-Id-Caller-App-Id"
+	HeaderCallerAppName            = "X-Id-Caller-App-Name"
+	HeaderCallerAppType            = "X-Id-Caller-App-Type"
+	HeaderCallerResolverMetadataID = "X-Id-Caller-Resolver-Metadata-Id"
+	HeaderCallerBadgeID            = "X-Id-Caller-Badge-Id"
+	HeaderCalleeAppID              = "X-Id-Callee-App-Id"
+	HeaderUserID                   = "X-Id-User-Id"
+	HeaderSessionID                = "X-Id-Session-Id"
+	HeaderRuleID                   = "X-Id-Rule-Id"
+	HeaderToolName                 = "X-Id-Tool-Name"
+	HeaderSkillID                  = "X-Id-Skill-Id"
+	HeaderTransactionID            = "X-Id-Transaction-Id"
+	HeaderReceipt                  = "X-Id-Receipt"
+
+	// Transaction token propagated across the calls of a workflow
+	HeaderTransactionToken = "Txn-Token"
+
+	identityHeaderPrefix  = "X-Id-"
+	bearerPrefix          = "Bearer "
+	dpopPrefix            = "DPoP "
+	wwwAuthenticateHeader = "WWW-Authenticate"
+
+	// The ID of the error returned by the Identity Service
+	// when a DPoP proof does not carry a nonce it issued
+	useDPoPNonceErrorID = "auth.useDPoPNonce"
+)
+
+// JSON-RPC error codes returned by the gateways
+const (
+	JSONRPCParseError     = -32700
+	JSONRPCInvalidRequest = -32600
+	JSONRPCUnauthorized   = -32001
+	JSONRPCInternalError  = -32603
+)
+
+var ErrMissingCredentials = errors.New("an access token or an API key is required")
+
+// The Caller holds the credentials presented by the caller of a protected app.
+// A caller either presents an access token obtained through the Authorize
+// and Token flow or its API key, in which case the gateway runs the flow on its behalf.
+type Caller struct {
+	AccessToken string
+	ApiKey      string
+
+	// The transaction token received from the upstream call, if any
+	TransactionToken string
+
+	// The DPoP proof presented with a DPoP bound access token, if any
+	DPoP *DPoPProof
+
+	// Only available when the caller presents an API key
+	App *apptypes.App
+
+	// The identity returned by the Identity Service
+	// once the request of the caller is authorized
+	Identity *authtypes.CallerIdentity
+}
+
+// The DPoPProof holds a DPoP proof and the request it was presented with,
+// which the Identity Service checks against the claims of the proof.
+type DPoPProof struct {
+	Proof  string
+	Method string
+	URL    string
+
+	// The nonce issued by the Identity Service for the next proof of the caller,
+	// set once the proof has been checked
+	Nonce string
+}
+
+// CallerFromRequest reads the credentials of the caller. The URL of DPoP bound requests
+// is resolved against the public URL of the gateway when provided, the scheme and
+// the host sent by the caller are only used otherwise.
+func CallerFromRequest(r *http.Request, publicURL string) (*Caller, error) {
+	transactionToken := r.Header.Get(HeaderTransactionToken)
+
+	auth := r.Header.Get(HeaderAuthorization)
+	if strings.HasPrefix(auth, bearerPrefix) {
+		token := strings.TrimSpace(strings.TrimPrefix(auth, bearerPrefix))
+		if token != "" {
+			return &Caller{AccessToken: token, TransactionToken: transactionToken}, nil
+		}
+	}
+
+	if strings.HasPrefix(auth, dpopPrefix) {
+		token := strings.TrimSpace(strings.TrimPrefix(auth, dpopPrefix))
+		if token != "" {
+			return &Caller{
+				AccessToken:      token,
+				TransactionToken: transactionToken,
+				DPoP: &DPoPProof{
+					Proof:  r.Header.Get(HeaderDPoP),
+					Method: r.Method,
+					URL:    requestURL(r, publicURL),
+				},
+			}, nil
+		}
+	}
+
+	if apiKey := r.Header.Get(HeaderApiKey); apiKey != "" {
+		return &Caller{ApiKey: apiKey, TransactionToken: transactionToken}, nil
+	}
+
+	return nil, ErrMissingCredentials
+}
+
+// requestURL returns the URL of the request as sent by the caller,
+// without its query, for the htu claim of DPoP proofs.
+// The forwarding headers are not trusted since any caller can set them.
+func requestURL(r *http.Request, publicURL string) string {
+	if u, err := url.Parse(publicURL); err == nil && u.Scheme != "" && u.Host != "" {
+		return u.Scheme + "://" + u.Host + r.URL.Path
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host + r.URL.Path
+}
+
+// WriteDPoPNonce sends the nonce issued by the Identity Service for the next
+// DPoP proof of the caller, asking the caller to retry with it when the error
+// is caused by a missing or an expired nonce.
+func WriteDPoPNonce(header http.Header, caller *Caller, err error) {
+	if caller == nil || caller.DPoP == nil || caller.DPoP.Nonce == "" {
+		return
+	}
+
+	header.Set(dpop.NonceHeader, caller.DPoP.Nonce)
+
+	if isUseDPoPNonceError(err) {
+		header.Set(wwwAuthenticateHeader, `DPoP error="use_dpop_nonce"`)
+	}
+}
+
+func isUseDPoPNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if ok && info.GetMetadata()["messageId"] == useDPoPNonceErrorID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authenticate makes sure the caller has an access token for the callee app,
+// running the Authorize and Token flow when the caller presented an API key.
+func (c *Caller) Authenticate(
+	ctx context.Context,
+	client IdentityClient,
+	calleeApp *apptypes.App,
+) error {
+	if c.AccessToken != "" {
+		return nil
+	}
+
+	callerApp, err := client.AppInfo(ctx, c.ApiKey)
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := client.IssueAccessToken(ctx, c.ApiKey, calleeApp.ResolverMetadataID)
+	if err != nil {
+		return err
+	}
+
+	c.App = callerApp
+	c.AccessToken = accessToken
+
+	return nil
+}
+
+// InjectIdentityHeaders removes the caller credentials and any identity header
+// sent by the caller, then sets the identity headers for the upstream app.
+// The transaction token is only forwarded once validated by the Identity Service.
+func InjectIdentityHeaders(
+	header http.Header,
+	caller *Caller,
+	calleeApp *apptypes.App,
+) {
+	header.Del(HeaderAuthorization)
+	header.Del(HeaderDPoP)
+	header.Del(HeaderTransactionToken)
+
+	for name := range header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), identityHeaderPrefix) {
+			header.Del(name)
+		}
+	}
+
+	if calleeApp != nil {
+		header.Set(HeaderCalleeAppID, calleeApp.ID)
+	}
+
+	if caller == nil {
+		return
+	}
+
+	if caller.Identity != nil {
+		setIdentityHeaders(header, caller.Identity)
+	} else if caller.App != nil {
+		header.Set(HeaderCallerAppID, caller.App.ID)
+	}
+}
+
+func setIdentityHeaders(header http.Header, identity *authtypes.CallerIdentity) {
+	header.Set(HeaderCallerAppID, identity.AppID)
+	header.Set(HeaderCallerAppType, identity.AppType.String())
+	header.Set(HeaderSessionID, identity.SessionID)
+
+	optionalHeaders := map[string]string{
+		HeaderCallerAppName:            ptrutil.DerefStr(identity.AppName),
+		HeaderCallerResolverMetadataID: identity.ResolverMetadataID,
+		HeaderCallerBadgeID:            ptrutil.DerefStr(identity.BadgeID),
+		HeaderUserID:                   ptrutil.DerefStr(identity.UserID),
+		HeaderRuleID:                   ptrutil.DerefStr(identity.RuleID),
+		HeaderTransactionID:            ptrutil.DerefStr(identity.TransactionID),
+		HeaderTransactionToken:         ptrutil.DerefStr(identity.TransactionToken),
+	}
+
+	if identity.Receipt != nil {
+		optionalHeaders[HeaderReceipt] = identity.Receipt.Token
+	}
+
+	for name, value := range optionalHeaders {
+		if value != "" {
+			header.Set(name, value)
+		}
+	}
+}
+
+// ReadBody reads the body of the request, up to maxSize bytes, and replaces it
+// so the request can still be forwarded upstream. On error the returned
+// status code is the one to send back to the caller.
+func ReadBody(w http.ResponseWriter, r *http.Request, maxSize int64) ([]byte, int, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, http.StatusRequestEntityTooLarge, err
+		}
+
+		return nil, http.StatusBadRequest, err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+
+	return body, http.StatusOK, nil
+}
+
+// HTTPStatusFromError maps the errors returned by the Identity Service
+// to HTTP status codes.
+func HTTPStatusFromError(err error) int {
+	if errors.Is(err, ErrMissingCredentials) {
+		return http.StatusUnauthorized
+	}
+
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return runtime.HTTPStatusFromCode(st.Code())
+	}
+
+	return http.StatusBadGateway
+}
+
+// WriteError writes a JSON-RPC error response for each request
+// (or a single one with a null ID when there is none).
+func WriteError(
+	ctx context.Context,
+	w http.ResponseWriter,
+	statusCode int,
+	requests []*jsonrpc.Message,
+	code int,
+	err error,
+) {
+	message := err.Error()
+	if st, ok := status.FromError(err); ok {
+		message = st.Message()
+	}
+
+	responses := make([]*jsonrpc.Message, 0, len(requests))
+
+	for _, req := range requests {
+		if req.IsRequest() {
+			responses = append(responses, newErrorResponse(req.ID, code, message))
+		}
+	}
+
+	var payload any
+
+	switch len(responses) {
+	case 0:
+		payload = newErrorResponse(json.RawMessage("null"), code, message)
+	case 1:
+		payload = responses[0]
+	default:
+		payload = responses
+	}
+
+	w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to write the gateway error")
+	}
+}
+
+func newErrorResponse(id json.RawMessage, code int, message string) *jsonrpc.Message {
+	return &jsonrpc.Message{
+		JSONRPC: jsonrpc.Version,
+		ID:      id,
+		Error: &jsonrpc.Error{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
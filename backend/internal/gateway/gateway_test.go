@@ -0,0 +1,203 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway_test
+
+import (
+	"net/http"
+	"testing"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/ptrutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInjectIdentityHeaders_should_replace_caller_headers_with_identity(t *testing.T) {
+	t.Parallel()
+
+	calleeApp := &apptypes.App{ID: uuid.NewString()}
+	identity := &authtypes.CallerIdentity{
+		AppID:              uuid.NewString(),
+		AppName:            ptrutil.Ptr("caller"),
+		AppType:            apptypes.APP_TYPE_MCP_SERVER,
+		ResolverMetadataID: uuid.NewString(),
+		UserID:             ptrutil.Ptr(uuid.NewString()),
+		SessionID:          uuid.NewString(),
+		RuleID:             ptrutil.Ptr(uuid.NewString()),
+		Receipt:            &authtypes.Receipt{Token: "receipt"},
+	}
+
+	header := http.Header{}
+	header.Set(gateway.HeaderAuthorization, "Bearer token")
+	header.Set(gateway.HeaderCallerBadgeID, "spoofed")
+	header.Set(gateway.HeaderReceipt, "spoofed")
+	header.Set("X-Id-Custom", "spoofed")
+	header.Set("Accept", "application/json")
+
+	gateway.InjectIdentityHeaders(header, &gateway.Caller{Identity: identity}, calleeApp)
+
+	assert.Equal(t, http.Header{
+		"Accept":                               {"application/json"},
+		gateway.HeaderCalleeAppID:              {calleeApp.ID},
+		gateway.HeaderCallerAppID:              {identity.AppID},
+		gateway.HeaderCallerAppName:            {"caller"},
+		gateway.HeaderCallerAppType:            {"APP_TYPE_MCP_SERVER"},
+		gateway.HeaderCallerResolverMetadataID: {identity.ResolverMetadataID},
+		gateway.HeaderUserID:                   {*identity.UserID},
+		gateway.HeaderSessionID:                {identity.SessionID},
+		gateway.HeaderRuleID:                   {*identity.RuleID},
+		gateway.HeaderReceipt:                  {"receipt"},
+	}, header)
+}
+
+func TestInjectIdentityHeaders_should_only_forward_validated_transaction_token(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		identity *authtypes.CallerIdentity
+		expected http.Header
+	}{
+		"transaction propagated": {
+			identity: &authtypes.CallerIdentity{
+				TransactionID:    ptrutil.Ptr("txn_id"),
+				TransactionToken: ptrutil.Ptr("validated"),
+			},
+			expected: http.Header{
+				gateway.HeaderTransactionID:    {"txn_id"},
+				gateway.HeaderTransactionToken: {"validated"},
+			},
+		},
+		"no transaction": {
+			identity: &authtypes.CallerIdentity{},
+			expected: http.Header{},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			header.Set(gateway.HeaderTransactionToken, "unvalidated")
+
+			gateway.InjectIdentityHeaders(header, &gateway.Caller{Identity: tc.identity}, nil)
+
+			assert.Equal(
+				t,
+				tc.expected.Get(gateway.HeaderTransactionToken),
+				header.Get(gateway.HeaderTransactionToken),
+			)
+			assert.Equal(
+				t,
+				tc.expected.Get(gateway.HeaderTransactionID),
+				header.Get(gateway.HeaderTransactionID),
+			)
+		})
+	}
+}
+
+func TestCallerFromRequest_should_read_transaction_token(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost", nil)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer token")
+	req.Header.Set(gateway.HeaderTransactionToken, "txn_token")
+
+	caller, err := gateway.CallerFromRequest(req, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "token", caller.AccessToken)
+	assert.Equal(t, "txn_token", caller.TransactionToken)
+}
+
+func TestCallerFromRequest_should_read_dpop_proof(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		publicURL   string
+		expectedURL string
+	}{
+		"without public url": {
+			expectedURL: "http://localhost/mcp",
+		},
+		"with public url": {
+			publicURL:   "https://gateway.example.com/",
+			expectedURL: "https://gateway.example.com/mcp",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			req, _ := http.NewRequest(http.MethodPost, "http://localhost/mcp?session=1", nil)
+			req.Header.Set(gateway.HeaderAuthorization, "DPoP token")
+			req.Header.Set(gateway.HeaderDPoP, "proof")
+			// The forwarding headers are set by the callers and not trusted
+			req.Header.Set("X-Forwarded-Proto", "ftp")
+
+			caller, err := gateway.CallerFromRequest(req, tc.publicURL)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "token", caller.AccessToken)
+			assert.Equal(t, &gateway.DPoPProof{
+				Proof:  "proof",
+				Method: http.MethodPost,
+				URL:    tc.expectedURL,
+			}, caller.DPoP)
+		})
+	}
+}
+
+func TestWriteDPoPNonce(t *testing.T) {
+	t.Parallel()
+
+	useNonceErr, _ := status.New(codes.Unauthenticated, "use nonce").WithDetails(&errdetails.ErrorInfo{
+		Metadata: map[string]string{"messageId": "auth.useDPoPNonce"},
+	})
+
+	testCases := map[string]*struct {
+		caller          *gateway.Caller
+		err             error
+		expectedNonce   string
+		expectedWWWAuth string
+	}{
+		"without dpop": {
+			caller: &gateway.Caller{},
+		},
+		"authorized": {
+			caller:        &gateway.Caller{DPoP: &gateway.DPoPProof{Nonce: "nonce"}},
+			expectedNonce: "nonce",
+		},
+		"other error": {
+			caller:        &gateway.Caller{DPoP: &gateway.DPoPProof{Nonce: "nonce"}},
+			err:           status.Error(codes.Unauthenticated, "invalid proof"),
+			expectedNonce: "nonce",
+		},
+		"nonce required": {
+			caller:          &gateway.Caller{DPoP: &gateway.DPoPProof{Nonce: "nonce"}},
+			err:             useNonceErr.Err(),
+			expectedNonce:   "nonce",
+			expectedWWWAuth: `DPoP error="use_dpop_nonce"`,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+
+			gateway.WriteDPoPNonce(header, tc.caller, tc.err)
+
+			assert.Equal(t, tc.expectedNonce, header.Get("DPoP-Nonce"))
+			assert.Equal(t, tc.expectedWWWAuth, header.Get("WWW-Authenticate"))
+		})
+	}
+}
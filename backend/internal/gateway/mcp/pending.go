@@ -0,0 +1,87 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"errors"
+	"sync"
+)
+
+var errSessionNotOwned = errors.New("the session was opened by another caller")
+
+// pendingToolsLists keeps track of the tools/list requests sent with
+// the SSE transport, their responses are delivered on the session stream.
+// A session belongs to the caller app that opened its stream and only
+// that caller can post requests to it.
+// The sessions are kept in memory, so the messages of a session must reach
+// the replica holding its stream: a session unknown to the replica is
+// rejected and the tools listed for untracked requests are withheld.
+type pendingToolsLists struct {
+	mu       sync.Mutex
+	sessions map[string]*sseSession
+}
+
+type sseSession struct {
+	ownerAppID string
+
+	// The pending tools/list requests mapped to
+	// the access token used to filter the tools
+	requests map[string]string
+}
+
+func newPendingToolsLists() *pendingToolsLists {
+	return &pendingToolsLists{
+		sessions: make(map[string]*sseSession),
+	}
+}
+
+func (p *pendingToolsLists) open(sessionID, ownerAppID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.sessions[sessionID] = &sseSession{
+		ownerAppID: ownerAppID,
+		requests:   make(map[string]string),
+	}
+}
+
+func (p *pendingToolsLists) add(sessionID, callerAppID string, requests map[string]string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session, ok := p.sessions[sessionID]
+	if !ok || callerAppID == "" || session.ownerAppID != callerAppID {
+		return errSessionNotOwned
+	}
+
+	for id, accessToken := range requests {
+		session.requests[id] = accessToken
+	}
+
+	return nil
+}
+
+func (p *pendingToolsLists) take(sessionID, id string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session, ok := p.sessions[sessionID]
+	if !ok {
+		return "", false
+	}
+
+	accessToken, ok := session.requests[id]
+	if ok {
+		delete(session.requests, id)
+	}
+
+	return accessToken, ok
+}
+
+func (p *pendingToolsLists) drop(sessionID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.sessions, sessionID)
+}
@@ -0,0 +1,392 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	authmcp "github.com/agntcy/identity-service/internal/core/auth/mcp"
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+// The query parameter used by the MCP SSE transport to route
+// the messages posted by the client to its stream
+const sessionIDParam = "sessionId"
+
+type exchangeKey struct{}
+
+// An exchange holds the state of a request going through the proxy
+type exchange struct {
+	caller   *gateway.Caller
+	toolName string
+
+	// The legacy SSE session of the request, set from the endpoint
+	// event while the stream of the session is being read
+	mu        sync.Mutex
+	sessionID string
+
+	// The pending tools/list requests answered in the response,
+	// mapped to the access token used to filter the tools
+	toolsLists map[string]string
+}
+
+// The Proxy is a reverse proxy in front of an MCP server (APP_TYPE_MCP_SERVER)
+// supporting both the streamable HTTP and the SSE transports.
+// The MCP messages sent by the callers are authorized by the Identity Service
+// before being forwarded and the tools/list responses are filtered down
+// to the tools the callers are allowed to invoke.
+type Proxy struct {
+	upstream     *url.URL
+	publicURL    string
+	client       gateway.IdentityClient
+	app          *apptypes.App
+	maxBodySize  int64
+	pending      *pendingToolsLists
+	reverseProxy *httputil.ReverseProxy
+}
+
+// NewProxy creates a proxy for the MCP server served at upstream.
+// The publicURL, when provided, is the URL at which the callers reach the gateway.
+func NewProxy(
+	upstream *url.URL,
+	publicURL string,
+	client gateway.IdentityClient,
+	app *apptypes.App,
+	maxBodySize int64,
+) *Proxy {
+	p := &Proxy{
+		upstream:    upstream,
+		publicURL:   publicURL,
+		client:      client,
+		app:         app,
+		maxBodySize: maxBodySize,
+		pending:     newPendingToolsLists(),
+	}
+
+	p.reverseProxy = &httputil.ReverseProxy{
+		Rewrite:        p.rewriteRequest,
+		ModifyResponse: p.modifyResponse,
+		ErrorHandler:   p.handleUpstreamError,
+	}
+
+	return p
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	caller, err := gateway.CallerFromRequest(r, p.publicURL)
+	if err != nil {
+		gateway.WriteError(ctx, w, http.StatusUnauthorized, nil, gateway.JSONRPCUnauthorized, err)
+		return
+	}
+
+	err = caller.Authenticate(ctx, p.client, p.app)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("unable to authenticate the caller")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), nil, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return
+	}
+
+	exch := &exchange{
+		caller:    caller,
+		sessionID: r.URL.Query().Get(sessionIDParam),
+	}
+
+	// The requests without messages, such as the GET opening a stream
+	// or the DELETE terminating a session, are authenticated as well
+	var authorized bool
+	if r.Method == http.MethodPost {
+		authorized = p.authorizeMessages(w, r, exch)
+	} else {
+		authorized = p.authenticate(w, r, exch)
+	}
+
+	if !authorized {
+		return
+	}
+
+	p.reverseProxy.ServeHTTP(w, r.WithContext(context.WithValue(ctx, exchangeKey{}, exch)))
+}
+
+// authenticate validates the access token of a request without body.
+func (p *Proxy) authenticate(w http.ResponseWriter, r *http.Request, exch *exchange) bool {
+	ctx := r.Context()
+
+	var err error
+
+	exch.caller.Identity, err = p.client.ExtAuthzMcp(
+		ctx,
+		exch.caller.AccessToken,
+		nil,
+		"",
+		exch.caller.TransactionToken,
+		exch.caller.DPoP,
+	)
+
+	gateway.WriteDPoPNonce(w.Header(), exch.caller, err)
+
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("the MCP request was not authenticated")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), nil, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return false
+	}
+
+	return true
+}
+
+// authorizeMessages authorizes the MCP messages in the body of the request
+// and records the tools/list requests whose responses need to be filtered.
+func (p *Proxy) authorizeMessages(w http.ResponseWriter, r *http.Request, exch *exchange) bool {
+	ctx := r.Context()
+
+	body, statusCode, err := gateway.ReadBody(w, r, p.maxBodySize)
+	if err != nil {
+		gateway.WriteError(ctx, w, statusCode, nil, gateway.JSONRPCInvalidRequest, err)
+		return false
+	}
+
+	contentType := r.Header.Get("Content-Type")
+
+	messages, err := jsonrpc.Parse(body, contentType)
+	if err != nil {
+		gateway.WriteError(ctx, w, http.StatusBadRequest, nil, gateway.JSONRPCParseError, err)
+		return false
+	}
+
+	exch.caller.Identity, err = p.client.ExtAuthzMcp(
+		ctx,
+		exch.caller.AccessToken,
+		body,
+		contentType,
+		exch.caller.TransactionToken,
+		exch.caller.DPoP,
+	)
+
+	gateway.WriteDPoPNonce(w.Header(), exch.caller, err)
+
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("the MCP request was not authorized")
+		gateway.WriteError(
+			ctx, w, gateway.HTTPStatusFromError(err), messages, gateway.JSONRPCUnauthorized, err,
+		)
+
+		return false
+	}
+
+	toolsLists := make(map[string]string)
+
+	for _, msg := range messages {
+		switch {
+		case authmcp.IsToolCall(msg):
+			// The tool name header is only set for single tool calls,
+			// batches can target several tools
+			if exch.toolName == "" && len(messages) == 1 {
+				if params, err := authmcp.ParseToolCall(msg); err == nil {
+					exch.toolName = params.Name
+				}
+			}
+		case msg.Method == toolsListMethod && msg.IsRequest():
+			toolsLists[jsonrpc.IDKey(msg.ID)] = exch.caller.AccessToken
+		}
+	}
+
+	sessionID := exch.getSessionID()
+	if sessionID == "" {
+		exch.toolsLists = toolsLists
+		return true
+	}
+
+	// Without identity the messages only call methods allowed without authentication
+	if exch.caller.Identity == nil && len(toolsLists) == 0 {
+		return true
+	}
+
+	// With the SSE transport the responses are sent on the stream opened by the client,
+	// not in the response of the POST request, so only that client can post to the session
+	err = p.pending.add(sessionID, exch.callerAppID(), toolsLists)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("the MCP session belongs to another caller")
+		gateway.WriteError(ctx, w, http.StatusForbidden, messages, gateway.JSONRPCUnauthorized, err)
+
+		return false
+	}
+
+	return true
+}
+
+func (p *Proxy) rewriteRequest(pr *httputil.ProxyRequest) {
+	pr.SetURL(p.upstream)
+	pr.SetXForwarded()
+
+	exch, _ := pr.In.Context().Value(exchangeKey{}).(*exchange)
+	if exch == nil {
+		return
+	}
+
+	gateway.InjectIdentityHeaders(pr.Out.Header, exch.caller, p.app)
+
+	if exch.toolName != "" {
+		pr.Out.Header.Set(gateway.HeaderToolName, exch.toolName)
+	}
+}
+
+func (p *Proxy) modifyResponse(resp *http.Response) error {
+	ctx := resp.Request.Context()
+
+	exch, _ := ctx.Value(exchangeKey{}).(*exchange)
+	if exch == nil {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+
+	switch mediaType {
+	case jsonrpc.ContentTypeEventStream:
+		stream := gateway.RewriteEventStream(resp.Body, func(event *gateway.Event) {
+			p.rewriteEvent(ctx, exch, event)
+		})
+
+		resp.Body = &sessionStream{
+			ReadCloser: stream,
+			close: func() {
+				if sessionID := exch.getSessionID(); sessionID != "" && resp.Request.Method == http.MethodGet {
+					p.pending.drop(sessionID)
+				}
+			},
+		}
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+	case jsonrpc.ContentTypeJSON:
+		// The responses are inspected even without tracked tools/list
+		// requests so the untracked ones are withheld
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		if err != nil {
+			return err
+		}
+
+		body = p.filterToolsLists(ctx, exch, body)
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	return nil
+}
+
+func (p *Proxy) rewriteEvent(ctx context.Context, exch *exchange, event *gateway.Event) {
+	if event.Name == endpointEvent {
+		var sessionID string
+
+		event.Data, sessionID = p.rewriteEndpoint(event.Data)
+		if sessionID != "" {
+			p.pending.open(sessionID, exch.callerAppID())
+		}
+
+		exch.setSessionID(sessionID)
+
+		return
+	}
+
+	if event.Data != "" {
+		event.Data = string(p.filterToolsLists(ctx, exch, []byte(event.Data)))
+	}
+}
+
+// rewriteEndpoint turns the endpoint advertised by the upstream SSE server
+// into a path relative to the gateway so the clients post their messages
+// through the gateway and not directly to the upstream server.
+func (p *Proxy) rewriteEndpoint(data string) (string, string) {
+	endpoint, err := p.upstream.Parse(strings.TrimSpace(data))
+	if err != nil {
+		return data, ""
+	}
+
+	path := endpoint.Path
+	if prefix := strings.TrimSuffix(p.upstream.Path, "/"); prefix != "" {
+		path = strings.TrimPrefix(path, prefix)
+	}
+
+	relative := url.URL{
+		Path:     path,
+		RawQuery: endpoint.RawQuery,
+	}
+
+	return relative.String(), endpoint.Query().Get(sessionIDParam)
+}
+
+func (p *Proxy) handleUpstreamError(w http.ResponseWriter, r *http.Request, err error) {
+	ctx := r.Context()
+
+	log.FromContext(ctx).WithError(err).Error("unable to reach the MCP server")
+
+	gateway.WriteError(
+		ctx,
+		w,
+		http.StatusBadGateway,
+		nil,
+		gateway.JSONRPCInternalError,
+		errors.New("unable to reach the MCP server"),
+	)
+}
+
+func (e *exchange) getSessionID() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.sessionID
+}
+
+func (e *exchange) setSessionID(sessionID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.sessionID = sessionID
+}
+
+// callerAppID returns the ID of the caller app once authenticated.
+func (e *exchange) callerAppID() string {
+	if e.caller.Identity != nil {
+		return e.caller.Identity.AppID
+	}
+
+	if e.caller.App != nil {
+		return e.caller.App.ID
+	}
+
+	return ""
+}
+
+type sessionStream struct {
+	io.ReadCloser
+
+	close func()
+}
+
+func (s *sessionStream) Close() error {
+	s.close()
+	return s.ReadCloser.Close()
+}
@@ -0,0 +1,472 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	authtypes "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/gateway"
+	gatewaymcp "github.com/agntcy/identity-service/internal/gateway/mcp"
+	gatewaymocks "github.com/agntcy/identity-service/internal/gateway/mocks"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBodySize = 1024 * 1024
+
+var toolNames = []string{"tool_a", "tool_b", "tool_c"}
+
+type headersKey struct{}
+
+func newMCPServer() *server.MCPServer {
+	srv := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(false))
+
+	for _, name := range toolNames {
+		srv.AddTool(mcp.NewTool(name), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			headers, _ := ctx.Value(headersKey{}).(http.Header)
+
+			// Echo the identity headers received by the MCP server
+			return mcp.NewToolResultText(strings.Join([]string{
+				headers.Get(gateway.HeaderCallerAppID),
+				headers.Get(gateway.HeaderCalleeAppID),
+				headers.Get(gateway.HeaderToolName),
+				headers.Get(gateway.HeaderAuthorization),
+				headers.Get(gateway.HeaderApiKey),
+			}, "|")), nil
+		})
+	}
+
+	return srv
+}
+
+func withHeaders(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, headersKey{}, r.Header.Clone())
+}
+
+func newIdentityClient(
+	t *testing.T,
+	calleeApp *apptypes.App,
+	callerApp *apptypes.App,
+	callerApiKey string,
+	accessToken string,
+) *gatewaymocks.IdentityClient {
+	t.Helper()
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().AppInfo(mock.Anything, callerApiKey).Return(callerApp, nil).Maybe()
+	identityClient.EXPECT().
+		IssueAccessToken(mock.Anything, callerApiKey, calleeApp.ResolverMetadataID).
+		Return(accessToken, nil).
+		Maybe()
+	identityClient.EXPECT().
+		ExtAuthzMcp(
+			mock.Anything,
+			accessToken,
+			mock.MatchedBy(func(body []byte) bool { return !strings.Contains(string(body), "tool_c") }),
+			mock.Anything,
+			"",
+			mock.Anything,
+		).
+		Return(&authtypes.CallerIdentity{AppID: callerApp.ID}, nil).
+		Maybe()
+	identityClient.EXPECT().
+		ExtAuthzMcp(
+			mock.Anything,
+			accessToken,
+			mock.MatchedBy(func(body []byte) bool { return strings.Contains(string(body), "tool_c") }),
+			mock.Anything,
+			"",
+			mock.Anything,
+		).
+		Return(nil, status.Error(codes.PermissionDenied, "denied")).
+		Maybe()
+	identityClient.EXPECT().
+		FilterMcpTools(mock.Anything, accessToken, toolNames).
+		Return([]string{"tool_a", "tool_b"}, nil).
+		Maybe()
+
+	return identityClient
+}
+
+func TestProxy_should_authorize_requests_and_filter_tools(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		newUpstream func(srv *server.MCPServer) *httptest.Server
+		newClient   func(baseURL string, headers map[string]string) (*client.Client, error)
+		path        string
+		useApiKey   bool
+	}{
+		"streamable http with api key": {
+			newUpstream: func(srv *server.MCPServer) *httptest.Server {
+				return server.NewTestStreamableHTTPServer(srv, server.WithHTTPContextFunc(withHeaders))
+			},
+			newClient: func(baseURL string, headers map[string]string) (*client.Client, error) {
+				return client.NewStreamableHttpClient(baseURL, transport.WithHTTPHeaders(headers))
+			},
+			path:      "/mcp",
+			useApiKey: true,
+		},
+		"streamable http with access token": {
+			newUpstream: func(srv *server.MCPServer) *httptest.Server {
+				return server.NewTestStreamableHTTPServer(srv, server.WithHTTPContextFunc(withHeaders))
+			},
+			newClient: func(baseURL string, headers map[string]string) (*client.Client, error) {
+				return client.NewStreamableHttpClient(baseURL, transport.WithHTTPHeaders(headers))
+			},
+			path: "/mcp",
+		},
+		"sse with api key": {
+			newUpstream: func(srv *server.MCPServer) *httptest.Server {
+				return server.NewTestServer(srv, server.WithSSEContextFunc(withHeaders))
+			},
+			newClient: func(baseURL string, headers map[string]string) (*client.Client, error) {
+				return client.NewSSEMCPClient(baseURL, client.WithHeaders(headers))
+			},
+			path:      "/sse",
+			useApiKey: true,
+		},
+		"sse with access token": {
+			newUpstream: func(srv *server.MCPServer) *httptest.Server {
+				return server.NewTestServer(srv, server.WithSSEContextFunc(withHeaders))
+			},
+			newClient: func(baseURL string, headers map[string]string) (*client.Client, error) {
+				return client.NewSSEMCPClient(baseURL, client.WithHeaders(headers))
+			},
+			path: "/sse",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			calleeApp := &apptypes.App{
+				ID:                 uuid.NewString(),
+				Type:               apptypes.APP_TYPE_MCP_SERVER,
+				ResolverMetadataID: uuid.NewString(),
+			}
+			callerApp := &apptypes.App{ID: uuid.NewString()}
+			callerApiKey := uuid.NewString()
+			accessToken := uuid.NewString()
+
+			upstream := tc.newUpstream(newMCPServer())
+			defer upstream.Close()
+
+			upstreamURL, _ := url.Parse(upstream.URL)
+			identityClient := newIdentityClient(t, calleeApp, callerApp, callerApiKey, accessToken)
+
+			gw := httptest.NewServer(gatewaymcp.NewProxy(upstreamURL, "", identityClient, calleeApp, maxBodySize))
+			defer gw.Close()
+
+			headers := map[string]string{gateway.HeaderAuthorization: "Bearer " + accessToken}
+			if tc.useApiKey {
+				headers = map[string]string{gateway.HeaderApiKey: callerApiKey}
+			}
+
+			mcpClient, err := tc.newClient(gw.URL+tc.path, headers)
+			require.NoError(t, err)
+
+			defer mcpClient.Close()
+
+			require.NoError(t, mcpClient.Start(ctx))
+
+			_, err = mcpClient.Initialize(ctx, mcp.InitializeRequest{})
+			require.NoError(t, err)
+
+			tools, err := mcpClient.ListTools(ctx, mcp.ListToolsRequest{})
+			require.NoError(t, err)
+
+			listedTools := make([]string, 0, len(tools.Tools))
+			for _, tool := range tools.Tools {
+				listedTools = append(listedTools, tool.Name)
+			}
+
+			assert.ElementsMatch(t, []string{"tool_a", "tool_b"}, listedTools)
+
+			result, err := mcpClient.CallTool(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Name: "tool_a"},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Content, 1)
+
+			text, ok := mcp.AsTextContent(result.Content[0])
+			require.True(t, ok)
+			assert.Equal(t, strings.Join([]string{callerApp.ID, calleeApp.ID, "tool_a", "", ""}, "|"), text.Text)
+
+			_, err = mcpClient.CallTool(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Name: "tool_c"},
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProxy_should_return_err_when_credentials_are_missing(t *testing.T) {
+	t.Parallel()
+
+	upstream := server.NewTestStreamableHTTPServer(newMCPServer())
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	gw := httptest.NewServer(
+		gatewaymcp.NewProxy(upstreamURL, "", gatewaymocks.NewIdentityClient(t), calleeApp, maxBodySize),
+	)
+	defer gw.Close()
+
+	resp, err := http.Post( //nolint:noctx // test request
+		gw.URL+"/mcp",
+		jsonrpc.ContentTypeJSON,
+		strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`),
+	)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.Equal(t, gateway.JSONRPCUnauthorized, msg.Error.Code)
+}
+
+func TestProxy_should_return_err_when_request_is_denied(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"tool_c"}}`
+
+	upstream := server.NewTestStreamableHTTPServer(newMCPServer())
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, accessToken, []byte(body), jsonrpc.ContentTypeJSON, "", mock.Anything).
+		Return(nil, status.Error(codes.PermissionDenied, "denied"))
+
+	gw := httptest.NewServer(gatewaymcp.NewProxy(upstreamURL, "", identityClient, calleeApp, maxBodySize))
+	defer gw.Close()
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost, gw.URL+"/mcp", strings.NewReader(body))
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var msg jsonrpc.Message
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.JSONEq(t, "7", string(msg.ID))
+	assert.Equal(t, "denied", msg.Error.Message)
+}
+
+func TestProxy_should_forward_the_dpop_nonce(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+	body := `{"jsonrpc":"2.0","id":7,"method":"tools/call","params":{"name":"tool_c"}}`
+
+	upstream := server.NewTestStreamableHTTPServer(newMCPServer())
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, accessToken, []byte(body), jsonrpc.ContentTypeJSON, "", mock.Anything).
+		RunAndReturn(func(
+			_ context.Context,
+			_ string,
+			_ []byte,
+			_ string,
+			_ string,
+			proof *gateway.DPoPProof,
+		) (*authtypes.CallerIdentity, error) {
+			assert.Equal(t, "https://gateway.example.com/mcp", proof.URL)
+			proof.Nonce = "nonce"
+
+			return nil, status.Error(codes.Unauthenticated, "missing nonce")
+		})
+
+	gw := httptest.NewServer(
+		gatewaymcp.NewProxy(upstreamURL, "https://gateway.example.com", identityClient, calleeApp, maxBodySize),
+	)
+	defer gw.Close()
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost, gw.URL+"/mcp", strings.NewReader(body))
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+	req.Header.Set(gateway.HeaderAuthorization, "DPoP "+accessToken)
+	req.Header.Set(gateway.HeaderDPoP, "proof")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "nonce", resp.Header.Get("DPoP-Nonce"))
+}
+
+func TestProxy_should_authenticate_requests_without_body(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+
+	upstream := server.NewTestServer(newMCPServer())
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, accessToken, []byte(nil), "", "", mock.Anything).
+		Return(nil, status.Error(codes.Unauthenticated, "invalid token"))
+
+	gw := httptest.NewServer(gatewaymcp.NewProxy(upstreamURL, "", identityClient, calleeApp, maxBodySize))
+	defer gw.Close()
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, gw.URL+"/sse", http.NoBody)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestProxy_should_return_err_when_sse_session_belongs_to_another_caller(t *testing.T) {
+	t.Parallel()
+
+	ownerToken := uuid.NewString()
+	otherToken := uuid.NewString()
+
+	upstream := server.NewTestServer(newMCPServer())
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, ownerToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, otherToken, mock.Anything, mock.Anything, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+
+	gw := httptest.NewServer(gatewaymcp.NewProxy(upstreamURL, "", identityClient, calleeApp, maxBodySize))
+	defer gw.Close()
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, gw.URL+"/sse", http.NoBody)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+ownerToken)
+
+	stream, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer stream.Body.Close()
+
+	// The first event of the stream advertises the endpoint of the session
+	var endpoint string
+
+	scanner := bufio.NewScanner(stream.Body)
+	for endpoint == "" && scanner.Scan() {
+		if data, ok := strings.CutPrefix(scanner.Text(), "data:"); ok {
+			endpoint = strings.TrimSpace(data)
+		}
+	}
+
+	require.Contains(t, endpoint, "sessionId=")
+
+	req, _ = http.NewRequestWithContext(
+		t.Context(),
+		http.MethodPost,
+		gw.URL+endpoint,
+		strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`),
+	)
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+otherToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestProxy_should_withhold_tools_listed_for_unknown_requests(t *testing.T) {
+	t.Parallel()
+
+	accessToken := uuid.NewString()
+
+	// The upstream answers with an ID of another type than the request
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", jsonrpc.ContentTypeJSON)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"tools":[{"name":"tool_c"}]}}`))
+	}))
+	defer upstream.Close()
+
+	upstreamURL, _ := url.Parse(upstream.URL)
+	calleeApp := &apptypes.App{ID: uuid.NewString(), Type: apptypes.APP_TYPE_MCP_SERVER}
+
+	identityClient := gatewaymocks.NewIdentityClient(t)
+	identityClient.EXPECT().
+		ExtAuthzMcp(mock.Anything, accessToken, mock.Anything, jsonrpc.ContentTypeJSON, "", mock.Anything).
+		Return(&authtypes.CallerIdentity{AppID: uuid.NewString()}, nil)
+
+	gw := httptest.NewServer(gatewaymcp.NewProxy(upstreamURL, "", identityClient, calleeApp, maxBodySize))
+	defer gw.Close()
+
+	req, _ := http.NewRequestWithContext(
+		t.Context(),
+		http.MethodPost,
+		gw.URL+"/mcp",
+		strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`),
+	)
+	req.Header.Set("Content-Type", jsonrpc.ContentTypeJSON)
+	req.Header.Set(gateway.HeaderAuthorization, "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	var msg jsonrpc.Message
+
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&msg))
+	assert.Empty(t, msg.Result)
+	assert.NotNil(t, msg.Error)
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	toolsListMethod = string(mcp.MethodToolsList)
+	toolsField      = "tools"
+	endpointEvent   = "endpoint"
+)
+
+var errToolsFilter = errors.New("unable to filter the tools")
+
+type toolName struct {
+	Name string `json:"name"`
+}
+
+// filterToolsLists filters the tools of the tools/list responses contained
+// in the payload. The payload is returned unchanged when it does not contain
+// any tools/list response. When the filtering fails the response is replaced
+// by an error so no tool is disclosed.
+func (p *Proxy) filterToolsLists(ctx context.Context, exch *exchange, payload []byte) []byte {
+	messages, err := jsonrpc.Parse(payload, jsonrpc.ContentTypeJSON)
+	if err != nil {
+		return payload
+	}
+
+	changed := false
+
+	for idx, msg := range messages {
+		if !msg.IsResponse() || len(msg.Result) == 0 {
+			continue
+		}
+
+		accessToken, ok := p.toolsListToken(exch, msg.ID)
+		if !ok {
+			// A response listing tools to a request that was not tracked,
+			// e.g. sent through another replica or before a restart,
+			// cannot be filtered so it is withheld
+			if hasTools(msg.Result) {
+				log.FromContext(ctx).Warn("withholding the MCP tools listed for an unknown request")
+
+				messages[idx] = toolsFilterError(msg.ID)
+				changed = true
+			}
+
+			continue
+		}
+
+		result, err := p.filterTools(ctx, accessToken, msg.Result)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("unable to filter the MCP tools")
+
+			messages[idx] = toolsFilterError(msg.ID)
+		} else {
+			msg.Result = result
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return payload
+	}
+
+	filtered, err := jsonrpc.Encode(messages, jsonrpc.IsBatch(payload))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("unable to encode the filtered MCP tools")
+		return payload
+	}
+
+	return filtered
+}
+
+// toolsListToken returns the access token to filter the response with
+// when the response answers a tools/list request.
+func (p *Proxy) toolsListToken(exch *exchange, id json.RawMessage) (string, bool) {
+	key := jsonrpc.IDKey(id)
+
+	if accessToken, ok := exch.toolsLists[key]; ok {
+		return accessToken, true
+	}
+
+	if sessionID := exch.getSessionID(); sessionID != "" {
+		return p.pending.take(sessionID, key)
+	}
+
+	return "", false
+}
+
+func hasTools(raw json.RawMessage) bool {
+	var result map[string]json.RawMessage
+
+	err := json.Unmarshal(raw, &result)
+	if err != nil {
+		return false
+	}
+
+	_, ok := result[toolsField]
+
+	return ok
+}
+
+func toolsFilterError(id json.RawMessage) *jsonrpc.Message {
+	return &jsonrpc.Message{
+		JSONRPC: jsonrpc.Version,
+		ID:      id,
+		Error: &jsonrpc.Error{
+			Code:    gateway.JSONRPCInternalError,
+			Message: errToolsFilter.Error(),
+		},
+	}
+}
+
+func (p *Proxy) filterTools(
+	ctx context.Context,
+	accessToken string,
+	raw json.RawMessage,
+) (json.RawMessage, error) {
+	var result map[string]json.RawMessage
+
+	err := json.Unmarshal(raw, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	var tools []json.RawMessage
+
+	if rawTools, ok := result[toolsField]; ok {
+		err = json.Unmarshal(rawTools, &tools)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(tools) == 0 {
+		return raw, nil
+	}
+
+	names := make([]string, len(tools))
+
+	for idx, tool := range tools {
+		var name toolName
+
+		err = json.Unmarshal(tool, &name)
+		if err != nil {
+			return nil, err
+		}
+
+		names[idx] = name.Name
+	}
+
+	allowedNames, err := p.client.FilterMcpTools(ctx, accessToken, names)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]struct{}, len(allowedNames))
+	for _, name := range allowedNames {
+		allowed[name] = struct{}{}
+	}
+
+	filtered := make([]json.RawMessage, 0, len(allowed))
+
+	for idx, tool := range tools {
+		if _, ok := allowed[names[idx]]; ok {
+			filtered = append(filtered, tool)
+		}
+	}
+
+	result[toolsField], err = json.Marshal(filtered)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,513 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/core/app/types"
+	types0 "github.com/agntcy/identity-service/internal/core/auth/types/int"
+	"github.com/agntcy/identity-service/internal/gateway"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewIdentityClient creates a new instance of IdentityClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdentityClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdentityClient {
+	mock := &IdentityClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// IdentityClient is an autogenerated mock type for the IdentityClient type
+type IdentityClient struct {
+	mock.Mock
+}
+
+type IdentityClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdentityClient) EXPECT() *IdentityClient_Expecter {
+	return &IdentityClient_Expecter{mock: &_m.Mock}
+}
+
+// AppInfo provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) AppInfo(ctx context.Context, apiKey string) (*types.App, error) {
+	ret := _mock.Called(ctx, apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppInfo")
+	}
+
+	var r0 *types.App
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*types.App, error)); ok {
+		return returnFunc(ctx, apiKey)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *types.App); ok {
+		r0 = returnFunc(ctx, apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.App)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_AppInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppInfo'
+type IdentityClient_AppInfo_Call struct {
+	*mock.Call
+}
+
+// AppInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey string
+func (_e *IdentityClient_Expecter) AppInfo(ctx interface{}, apiKey interface{}) *IdentityClient_AppInfo_Call {
+	return &IdentityClient_AppInfo_Call{Call: _e.mock.On("AppInfo", ctx, apiKey)}
+}
+
+func (_c *IdentityClient_AppInfo_Call) Run(run func(ctx context.Context, apiKey string)) *IdentityClient_AppInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_AppInfo_Call) Return(app *types.App, err error) *IdentityClient_AppInfo_Call {
+	_c.Call.Return(app, err)
+	return _c
+}
+
+func (_c *IdentityClient_AppInfo_Call) RunAndReturn(run func(ctx context.Context, apiKey string) (*types.App, error)) *IdentityClient_AppInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtAuthzA2A provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) ExtAuthzA2A(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof) (*types0.CallerIdentity, error) {
+	ret := _mock.Called(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtAuthzA2A")
+	}
+
+	var r0 *types0.CallerIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) (*types0.CallerIdentity, error)); ok {
+		return returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) *types0.CallerIdentity); ok {
+		r0 = returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types0.CallerIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) error); ok {
+		r1 = returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_ExtAuthzA2A_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtAuthzA2A'
+type IdentityClient_ExtAuthzA2A_Call struct {
+	*mock.Call
+}
+
+// ExtAuthzA2A is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - body []byte
+//   - contentType string
+//   - transactionToken string
+//   - dpopProof *gateway.DPoPProof
+func (_e *IdentityClient_Expecter) ExtAuthzA2A(ctx interface{}, accessToken interface{}, body interface{}, contentType interface{}, transactionToken interface{}, dpopProof interface{}) *IdentityClient_ExtAuthzA2A_Call {
+	return &IdentityClient_ExtAuthzA2A_Call{Call: _e.mock.On("ExtAuthzA2A", ctx, accessToken, body, contentType, transactionToken, dpopProof)}
+}
+
+func (_c *IdentityClient_ExtAuthzA2A_Call) Run(run func(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof)) *IdentityClient_ExtAuthzA2A_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 *gateway.DPoPProof
+		if args[5] != nil {
+			arg5 = args[5].(*gateway.DPoPProof)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_ExtAuthzA2A_Call) Return(callerIdentity *types0.CallerIdentity, err error) *IdentityClient_ExtAuthzA2A_Call {
+	_c.Call.Return(callerIdentity, err)
+	return _c
+}
+
+func (_c *IdentityClient_ExtAuthzA2A_Call) RunAndReturn(run func(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof) (*types0.CallerIdentity, error)) *IdentityClient_ExtAuthzA2A_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExtAuthzMcp provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) ExtAuthzMcp(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof) (*types0.CallerIdentity, error) {
+	ret := _mock.Called(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtAuthzMcp")
+	}
+
+	var r0 *types0.CallerIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) (*types0.CallerIdentity, error)); ok {
+		return returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) *types0.CallerIdentity); ok {
+		r0 = returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types0.CallerIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte, string, string, *gateway.DPoPProof) error); ok {
+		r1 = returnFunc(ctx, accessToken, body, contentType, transactionToken, dpopProof)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_ExtAuthzMcp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExtAuthzMcp'
+type IdentityClient_ExtAuthzMcp_Call struct {
+	*mock.Call
+}
+
+// ExtAuthzMcp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - body []byte
+//   - contentType string
+//   - transactionToken string
+//   - dpopProof *gateway.DPoPProof
+func (_e *IdentityClient_Expecter) ExtAuthzMcp(ctx interface{}, accessToken interface{}, body interface{}, contentType interface{}, transactionToken interface{}, dpopProof interface{}) *IdentityClient_ExtAuthzMcp_Call {
+	return &IdentityClient_ExtAuthzMcp_Call{Call: _e.mock.On("ExtAuthzMcp", ctx, accessToken, body, contentType, transactionToken, dpopProof)}
+}
+
+func (_c *IdentityClient_ExtAuthzMcp_Call) Run(run func(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof)) *IdentityClient_ExtAuthzMcp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 *gateway.DPoPProof
+		if args[5] != nil {
+			arg5 = args[5].(*gateway.DPoPProof)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_ExtAuthzMcp_Call) Return(callerIdentity *types0.CallerIdentity, err error) *IdentityClient_ExtAuthzMcp_Call {
+	_c.Call.Return(callerIdentity, err)
+	return _c
+}
+
+func (_c *IdentityClient_ExtAuthzMcp_Call) RunAndReturn(run func(ctx context.Context, accessToken string, body []byte, contentType string, transactionToken string, dpopProof *gateway.DPoPProof) (*types0.CallerIdentity, error)) *IdentityClient_ExtAuthzMcp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterA2ASkills provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) FilterA2ASkills(ctx context.Context, accessToken string, skillIDs []string) ([]string, error) {
+	ret := _mock.Called(ctx, accessToken, skillIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterA2ASkills")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return returnFunc(ctx, accessToken, skillIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = returnFunc(ctx, accessToken, skillIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, accessToken, skillIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_FilterA2ASkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterA2ASkills'
+type IdentityClient_FilterA2ASkills_Call struct {
+	*mock.Call
+}
+
+// FilterA2ASkills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - skillIDs []string
+func (_e *IdentityClient_Expecter) FilterA2ASkills(ctx interface{}, accessToken interface{}, skillIDs interface{}) *IdentityClient_FilterA2ASkills_Call {
+	return &IdentityClient_FilterA2ASkills_Call{Call: _e.mock.On("FilterA2ASkills", ctx, accessToken, skillIDs)}
+}
+
+func (_c *IdentityClient_FilterA2ASkills_Call) Run(run func(ctx context.Context, accessToken string, skillIDs []string)) *IdentityClient_FilterA2ASkills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_FilterA2ASkills_Call) Return(strings []string, err error) *IdentityClient_FilterA2ASkills_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *IdentityClient_FilterA2ASkills_Call) RunAndReturn(run func(ctx context.Context, accessToken string, skillIDs []string) ([]string, error)) *IdentityClient_FilterA2ASkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterMcpTools provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) FilterMcpTools(ctx context.Context, accessToken string, toolNames []string) ([]string, error) {
+	ret := _mock.Called(ctx, accessToken, toolNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterMcpTools")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return returnFunc(ctx, accessToken, toolNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = returnFunc(ctx, accessToken, toolNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, accessToken, toolNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_FilterMcpTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterMcpTools'
+type IdentityClient_FilterMcpTools_Call struct {
+	*mock.Call
+}
+
+// FilterMcpTools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - accessToken string
+//   - toolNames []string
+func (_e *IdentityClient_Expecter) FilterMcpTools(ctx interface{}, accessToken interface{}, toolNames interface{}) *IdentityClient_FilterMcpTools_Call {
+	return &IdentityClient_FilterMcpTools_Call{Call: _e.mock.On("FilterMcpTools", ctx, accessToken, toolNames)}
+}
+
+func (_c *IdentityClient_FilterMcpTools_Call) Run(run func(ctx context.Context, accessToken string, toolNames []string)) *IdentityClient_FilterMcpTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_FilterMcpTools_Call) Return(strings []string, err error) *IdentityClient_FilterMcpTools_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *IdentityClient_FilterMcpTools_Call) RunAndReturn(run func(ctx context.Context, accessToken string, toolNames []string) ([]string, error)) *IdentityClient_FilterMcpTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueAccessToken provides a mock function for the type IdentityClient
+func (_mock *IdentityClient) IssueAccessToken(ctx context.Context, callerApiKey string, resolverMetadataID string) (string, error) {
+	ret := _mock.Called(ctx, callerApiKey, resolverMetadataID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueAccessToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, callerApiKey, resolverMetadataID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, callerApiKey, resolverMetadataID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, callerApiKey, resolverMetadataID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// IdentityClient_IssueAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueAccessToken'
+type IdentityClient_IssueAccessToken_Call struct {
+	*mock.Call
+}
+
+// IssueAccessToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callerApiKey string
+//   - resolverMetadataID string
+func (_e *IdentityClient_Expecter) IssueAccessToken(ctx interface{}, callerApiKey interface{}, resolverMetadataID interface{}) *IdentityClient_IssueAccessToken_Call {
+	return &IdentityClient_IssueAccessToken_Call{Call: _e.mock.On("IssueAccessToken", ctx, callerApiKey, resolverMetadataID)}
+}
+
+func (_c *IdentityClient_IssueAccessToken_Call) Run(run func(ctx context.Context, callerApiKey string, resolverMetadataID string)) *IdentityClient_IssueAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *IdentityClient_IssueAccessToken_Call) Return(s string, err error) *IdentityClient_IssueAccessToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *IdentityClient_IssueAccessToken_Call) RunAndReturn(run func(ctx context.Context, callerApiKey string, resolverMetadataID string) (string, error)) *IdentityClient_IssueAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"time"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	apptypes "github.com/agntcy/identity-service/internal/core/app/types"
+	"github.com/agntcy/identity-service/pkg/cmd"
+	"github.com/agntcy/identity-service/pkg/log"
+)
+
+// The Configuration of the gateways, read from the environment
+type Configuration struct {
+	ServerHttpHost              string `split_words:"true" default:":8080"`
+	GoEnv                       string `split_words:"true" default:"production"`
+	LogLevel                    string `split_words:"true" default:"InfoLevel"`
+	UpstreamUrl                 string `split_words:"true"                      required:"true"`
+	PublicUrl                   string `split_words:"true"`
+	IdentityGrpcHost            string `split_words:"true"                      required:"true"`
+	IdentityUseSsl              bool   `split_words:"true" default:"false"`
+	ApiKey                      string `split_words:"true"                      required:"true"`
+	MaxRequestBodySize          int64  `split_words:"true" default:"10485760"`
+	IssueReceipts               bool   `split_words:"true" default:"true"`
+	HttpServerIdleTimeout       int    `split_words:"true" default:"100"`
+	HttpServerReadTimeout       int    `split_words:"true" default:"100"`
+	HttpServerReadHeaderTimeout int    `split_words:"true" default:"100"`
+}
+
+func (c *Configuration) IsProd() bool {
+	return c.GoEnv == "production"
+}
+
+func (c *Configuration) IsDev() bool {
+	return c.GoEnv == "development"
+}
+
+// The NewProxyFunc creates the proxy of a gateway in front of the protected app
+type NewProxyFunc func(
+	upstream *url.URL,
+	publicURL string,
+	client IdentityClient,
+	app *apptypes.App,
+	maxBodySize int64,
+) http.Handler
+
+// Run starts a gateway protecting the app identified by the configured API key,
+// which must be of the given type, and serves it until interrupted.
+func Run(name string, appType apptypes.AppType, newProxy NewProxyFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	config, err := cmd.GetConfiguration[Configuration]()
+	if err != nil {
+		log.WithError(err).Fatal("failed to start")
+	}
+
+	// Configure log level
+	log.Init(config.IsDev())
+	log.SetLogLevel(config.LogLevel)
+
+	log.Info("Starting in env:", config.GoEnv)
+
+	upstream, err := url.Parse(config.UpstreamUrl)
+	if err != nil {
+		log.Fatal("invalid upstream URL ", err)
+	}
+
+	conn, err := NewClientConn(config.IdentityGrpcHost, config.IdentityUseSsl)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	identityClient := NewIdentityClient(
+		identity_service_sdk_go.NewAuthServiceClient(conn),
+		config.ApiKey,
+		config.IssueReceipts,
+	)
+
+	// The API key identifies the app protected by the gateway
+	app, err := identityClient.AppInfo(ctx, config.ApiKey)
+	if err != nil {
+		log.Fatal("unable to fetch the protected app ", err)
+	}
+
+	if app.Type != appType {
+		log.Fatal("the API key does not belong to an app of type ", appType)
+	}
+
+	log.Info("Protecting the app:", app.ID)
+
+	server := &http.Server{
+		Addr: config.ServerHttpHost,
+		Handler: newProxy(
+			upstream,
+			config.PublicUrl,
+			identityClient,
+			app,
+			config.MaxRequestBodySize,
+		),
+		IdleTimeout:       time.Duration(config.HttpServerIdleTimeout) * time.Second,
+		ReadTimeout:       time.Duration(config.HttpServerReadTimeout) * time.Second,
+		ReadHeaderTimeout: time.Duration(config.HttpServerReadHeaderTimeout) * time.Second,
+	}
+
+	defer func() {
+		_ = server.Shutdown(ctx)
+	}()
+
+	go func() {
+		log.Info("Serving the ", name, " on:", config.ServerHttpHost)
+
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	interrupChannel := make(chan os.Signal, 1)
+	signal.Notify(interrupChannel, os.Interrupt)
+	<-interrupChannel
+
+	log.Info("Exiting the gateway")
+
+	cancel()
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	sseEventField = "event:"
+	sseDataField  = "data:"
+)
+
+// An Event is a Server-Sent Event. The fields other than
+// the event name and the data (id, retry, comments) are kept as is.
+type Event struct {
+	Name string
+	Data string
+
+	fields []string
+}
+
+func (e *Event) empty() bool {
+	return e.Name == "" && e.Data == "" && len(e.fields) == 0
+}
+
+func (e *Event) writeTo(w io.Writer) error {
+	var sb strings.Builder
+
+	for _, field := range e.fields {
+		sb.WriteString(field)
+		sb.WriteString("\n")
+	}
+
+	if e.Name != "" {
+		sb.WriteString(sseEventField)
+		sb.WriteString(" ")
+		sb.WriteString(e.Name)
+		sb.WriteString("\n")
+	}
+
+	if e.Data != "" {
+		for line := range strings.SplitSeq(e.Data, "\n") {
+			sb.WriteString(sseDataField)
+			sb.WriteString(" ")
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("\n")
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+type eventStream struct {
+	*io.PipeReader
+
+	body io.ReadCloser
+}
+
+func (s *eventStream) Close() error {
+	return errors.Join(s.body.Close(), s.PipeReader.Close())
+}
+
+// RewriteEventStream returns a stream that passes each event of the body
+// through rewrite as soon as it is fully received, the events are not buffered
+// so long-lived streams keep working.
+func RewriteEventStream(body io.ReadCloser, rewrite func(event *Event)) io.ReadCloser {
+	reader, writer := io.Pipe()
+
+	go func() {
+		defer body.Close()
+
+		err := rewriteEvents(bufio.NewReader(body), writer, rewrite)
+		_ = writer.CloseWithError(err)
+	}()
+
+	return &eventStream{
+		PipeReader: reader,
+		body:       body,
+	}
+}
+
+func rewriteEvents(reader *bufio.Reader, w io.Writer, rewrite func(event *Event)) error {
+	event := &Event{}
+
+	var data []string
+
+	flush := func() error {
+		event.Data = strings.Join(data, "\n")
+		data = nil
+
+		if event.empty() {
+			return nil
+		}
+
+		if event.Data != "" || event.Name != "" {
+			rewrite(event)
+		}
+
+		err := event.writeTo(w)
+		event = &Event{}
+
+		return err
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if line != "" {
+			line = strings.TrimRight(line, "\r\n")
+
+			var werr error
+
+			switch {
+			case line == "":
+				werr = flush()
+			case strings.HasPrefix(line, sseEventField):
+				event.Name = strings.TrimSpace(strings.TrimPrefix(line, sseEventField))
+			case strings.HasPrefix(line, sseDataField):
+				data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, sseDataField), " "))
+			default:
+				event.fields = append(event.fields, line)
+			}
+
+			if werr != nil {
+				return werr
+			}
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return flush()
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteEventStream_should_rewrite_each_event(t *testing.T) {
+	t.Parallel()
+
+	body := ": keep-alive\n\n" +
+		"event: endpoint\r\ndata: http://upstream/message?sessionId=1\r\n\r\n" +
+		"id: 2\nevent: message\ndata:{\"a\":1}\n\n" +
+		"data: last"
+
+	stream := gateway.RewriteEventStream(io.NopCloser(strings.NewReader(body)), func(event *gateway.Event) {
+		if event.Name == "endpoint" {
+			event.Data = "/message?sessionId=1"
+		} else {
+			event.Data = strings.ToUpper(event.Data)
+		}
+	})
+	defer stream.Close()
+
+	rewritten, err := io.ReadAll(stream)
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		": keep-alive\n\n"+
+			"event: endpoint\ndata: /message?sessionId=1\n\n"+
+			"id: 2\nevent: message\ndata: {\"A\":1}\n\n"+
+			"data: LAST\n\n",
+		string(rewritten),
+	)
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/core/auth/sessiontoken"
+)
+
+// The access tokens are no longer used this long before they expire,
+// so they do not expire while a request is being authorized
+const accessTokenExpiryLeeway = 30 * time.Second
+
+type accessTokenKey struct {
+	apiKey             string
+	resolverMetadataID string
+}
+
+type cachedAccessToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+// accessTokenCache keeps the access tokens issued for the callers presenting
+// their API key, so the Authorize and Token flow only runs once per caller
+// and callee until the token expires, instead of on every request.
+// Only the self-contained session tokens, whose expiration can be read,
+// are cached.
+type accessTokenCache struct {
+	mu     sync.Mutex
+	tokens map[accessTokenKey]*cachedAccessToken
+}
+
+func newAccessTokenCache() *accessTokenCache {
+	return &accessTokenCache{
+		tokens: make(map[accessTokenKey]*cachedAccessToken),
+	}
+}
+
+func (c *accessTokenCache) get(key accessTokenKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.tokens[key]
+	if !ok || !isUsable(cached, time.Now()) {
+		return "", false
+	}
+
+	return cached.token, true
+}
+
+func (c *accessTokenCache) put(key accessTokenKey, token string) {
+	expiresAt, err := sessiontoken.Expiration(token)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	for k, cached := range c.tokens {
+		if !isUsable(cached, now) {
+			delete(c.tokens, k)
+		}
+	}
+
+	c.tokens[key] = &cachedAccessToken{
+		token:     token,
+		expiresAt: expiresAt,
+	}
+}
+
+// forget removes a token rejected by the Identity Service,
+// e.g. because its session was revoked.
+func (c *accessTokenCache) forget(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, cached := range c.tokens {
+		if cached.token == token {
+			delete(c.tokens, k)
+		}
+	}
+}
+
+func isUsable(cached *cachedAccessToken, now time.Time) bool {
+	return now.Before(cached.expiresAt.Add(-accessTokenExpiryLeeway))
+}
@@ -16,6 +16,7 @@ const (
 	ErrorReasonValidationFailed ErrorReason = "validation_failed"
 	ErrorReasonInvalidRequest   ErrorReason = "invalid_request"
 	ErrorReasonUnauthorized     ErrorReason = "unauthorized"
+	ErrorReasonTooManyRequests  ErrorReason = "too_many_requests"
 )
 
 type DomainError struct {
@@ -79,6 +80,10 @@ func Unauthorized(id, format string, args ...any) error {
 	return newDomainErrorf(id, ErrorReasonUnauthorized, format, args...)
 }
 
+func TooManyRequests(id, format string, args ...any) error {
+	return newDomainErrorf(id, ErrorReasonTooManyRequests, format, args...)
+}
+
 func IsDomainError(err error) bool {
 	var derr *DomainError
 	return errors.As(err, &derr)
@@ -39,6 +39,10 @@ func TestGrpcError(t *testing.T) {
 			inErr:  errutil.Unauthorized("d", "d"),
 			outErr: grpcutil.UnauthorizedError(errutil.Unauthorized("d", "d")),
 		},
+		"should return resource exhausted status error for ErrorReasonTooManyRequests": {
+			inErr:  errutil.TooManyRequests("e", "e"),
+			outErr: grpcutil.ResourceExhaustedError(errutil.TooManyRequests("e", "e"), 0),
+		},
 		"should return the same error as input when it's not DomainError": {
 			inErr:  generalErr,
 			outErr: generalErr,
@@ -6,11 +6,13 @@ package grpcutil
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/agntcy/identity-service/internal/pkg/errutil"
 	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func NotFoundError(err error) error {
@@ -29,6 +31,20 @@ func InternalError(err error) error {
 	return newStatusWithDetails(codes.Internal, err)
 }
 
+// ResourceExhaustedError returns a status error telling the client
+// to retry after the given delay, when it is not zero.
+func ResourceExhaustedError(err error, retryAfter time.Duration) error {
+	st, _ := status.FromError(newStatusWithDetails(codes.ResourceExhausted, err))
+
+	if retryAfter > 0 {
+		st, _ = st.WithDetails(&epb.RetryInfo{
+			RetryDelay: durationpb.New(retryAfter),
+		})
+	}
+
+	return st.Err()
+}
+
 func Error(err error) error {
 	domainErr := &errutil.DomainError{}
 	if errors.As(err, &domainErr) {
@@ -41,6 +57,8 @@ func Error(err error) error {
 			return BadRequestError(err)
 		case errutil.ErrorReasonUnauthorized:
 			return UnauthorizedError(err)
+		case errutil.ErrorReasonTooManyRequests:
+			return ResourceExhaustedError(err, 0)
 		}
 	}
 
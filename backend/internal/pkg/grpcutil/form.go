@@ -0,0 +1,77 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcutil
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const MIMEFormURLEncoded = "application/x-www-form-urlencoded"
+
+// formMarshaler decodes the application/x-www-form-urlencoded bodies sent by
+// the standard OAuth clients (e.g. RFC 7662 and RFC 7009) into the request
+// messages, the fields being matched by name. The responses are encoded in JSON.
+// Only the allowed messages can be decoded from a form, the browsers sending
+// forms across origins without a CORS preflight.
+type formMarshaler struct {
+	runtime.Marshaler
+
+	allowed map[protoreflect.FullName]struct{}
+}
+
+// NewFormMarshaler returns a marshaler decoding the forms into the given messages,
+// to register for the MIMEFormURLEncoded content type.
+func NewFormMarshaler(messages ...proto.Message) runtime.Marshaler {
+	allowed := make(map[protoreflect.FullName]struct{}, len(messages))
+	for _, msg := range messages {
+		allowed[msg.ProtoReflect().Descriptor().FullName()] = struct{}{}
+	}
+
+	return &formMarshaler{
+		Marshaler: &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		},
+		allowed: allowed,
+	}
+}
+
+func (m *formMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		msg, ok := v.(proto.Message)
+		if !ok {
+			return fmt.Errorf("unable to decode a form into %T", v)
+		}
+
+		if _, ok := m.allowed[msg.ProtoReflect().Descriptor().FullName()]; !ok {
+			return fmt.Errorf("the %s content type is not supported", MIMEFormURLEncoded)
+		}
+
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return err
+		}
+
+		return runtime.PopulateQueryParameters(msg, values, utilities.NewDoubleArray(nil))
+	})
+}
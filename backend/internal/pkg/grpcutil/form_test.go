@@ -0,0 +1,47 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcutil_test
+
+import (
+	"strings"
+	"testing"
+
+	identity_service_sdk_go "github.com/agntcy/identity-service/api/server/agntcy/identity/service/v1alpha1"
+	"github.com/agntcy/identity-service/internal/pkg/grpcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormMarshaler_should_decode_the_allowed_messages(t *testing.T) {
+	t.Parallel()
+
+	sut := grpcutil.NewFormMarshaler(&identity_service_sdk_go.IntrospectRequest{})
+	req := &identity_service_sdk_go.IntrospectRequest{}
+
+	err := sut.NewDecoder(strings.NewReader("token=abc%2Bdef&token_type_hint=access_token")).Decode(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc+def", req.GetToken())
+}
+
+func TestFormMarshaler_should_return_err_when_the_message_is_not_allowed(t *testing.T) {
+	t.Parallel()
+
+	sut := grpcutil.NewFormMarshaler(&identity_service_sdk_go.IntrospectRequest{})
+	req := &identity_service_sdk_go.RevokeRequest{}
+
+	err := sut.NewDecoder(strings.NewReader("token=abc")).Decode(req)
+
+	assert.Error(t, err)
+	assert.Empty(t, req.GetToken())
+}
+
+func TestFormMarshaler_should_return_err_when_the_form_is_malformed(t *testing.T) {
+	t.Parallel()
+
+	sut := grpcutil.NewFormMarshaler(&identity_service_sdk_go.IntrospectRequest{})
+
+	err := sut.NewDecoder(strings.NewReader("token=%zz")).Decode(&identity_service_sdk_go.IntrospectRequest{})
+
+	assert.Error(t, err)
+}
@@ -15,3 +15,16 @@ func CustomMatcher(key string) (string, bool) {
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
+
+// CustomOutgoingMatcher forwards the Retry-After and DPoP-Nonce headers as is
+// and prefixes the other gRPC headers like the default matcher.
+func CustomOutgoingMatcher(key string) (string, bool) {
+	switch key {
+	case "retry-after":
+		return "Retry-After", true
+	case "dpop-nonce":
+		return "DPoP-Nonce", true
+	default:
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
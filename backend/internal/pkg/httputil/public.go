@@ -0,0 +1,78 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package httputil
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrNotHTTPS         = errors.New("only https URLs are allowed")
+	ErrNonPublicAddress = errors.New("the host resolves to a non-public address")
+)
+
+// NewPublicClient returns a client for the URLs provided by third parties. It only
+// requests https URLs, redirects included, and only connects to public addresses.
+// The addresses are checked once resolved so that a host cannot be rebound
+// to an internal address.
+func NewPublicClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: Timeout * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !IsPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, addrPort.Addr())
+			}
+
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: Timeout * time.Second,
+		Transport: &httpsOnlyTransport{
+			base: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: Timeout * time.Second,
+			},
+		},
+	}
+}
+
+type httpsOnlyTransport struct {
+	base http.RoundTripper
+}
+
+func (t *httpsOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "https" {
+		return nil, ErrNotHTTPS
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// IsPublicAddr returns false for the loopback, private, link-local,
+// multicast and unspecified addresses
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified()
+}
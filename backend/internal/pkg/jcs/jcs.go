@@ -0,0 +1,193 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jcs implements the JSON Canonicalization Scheme defined in [RFC 8785]
+//
+// [RFC 8785]: https://www.rfc-editor.org/rfc/rfc8785
+package jcs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// The numbers outside of this range are serialized with an exponent
+	minDecimal = 1e-6
+	maxDecimal = 1e21
+)
+
+var ErrInvalidNumber = errors.New("the number cannot be represented in JSON")
+
+// Marshal returns the canonical JSON encoding of v
+func Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return Transform(data)
+}
+
+// Transform returns the canonical form of a JSON document
+func Transform(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the JSON document: %w", err)
+	}
+
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+
+	var buf bytes.Buffer
+
+	err = writeValue(&buf, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeValue(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		return writeNumber(buf, v)
+	case string:
+		writeString(buf, v)
+	case []any:
+		buf.WriteByte('[')
+
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			err := writeValue(buf, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	case map[string]any:
+		return writeObject(buf, v)
+	default:
+		return fmt.Errorf("unexpected JSON value %T", value)
+	}
+
+	return nil
+}
+
+// writeObject writes the properties sorted by the UTF-16 code units of their names
+func writeObject(buf *bytes.Buffer, object map[string]any) error {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+	})
+
+	buf.WriteByte('{')
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		writeString(buf, key)
+		buf.WriteByte(':')
+
+		err := writeValue(buf, object[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return nil
+}
+
+// writeNumber serializes the number like the ECMAScript Number.prototype.toString
+func writeNumber(buf *bytes.Buffer, number json.Number) error {
+	value, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil || math.IsInf(value, 0) || math.IsNaN(value) {
+		return fmt.Errorf("%w: %s", ErrInvalidNumber, number)
+	}
+
+	if value == 0 {
+		// Negative zero is serialized as zero
+		buf.WriteByte('0')
+		return nil
+	}
+
+	abs := math.Abs(value)
+	if abs >= minDecimal && abs < maxDecimal {
+		buf.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+		return nil
+	}
+
+	// Go writes the exponents with at least two digits (1e-07),
+	// ECMAScript without leading zeros (1e-7)
+	formatted := strconv.FormatFloat(value, 'e', -1, 64)
+	mantissa, exponent, _ := strings.Cut(formatted, "e")
+
+	buf.WriteString(mantissa)
+	buf.WriteByte('e')
+	buf.WriteByte(exponent[0])
+	buf.WriteString(strings.TrimLeft(exponent[1:], "0"))
+
+	return nil
+}
+
+// writeString escapes the quotation mark, the reverse solidus and the control characters only
+func writeString(buf *bytes.Buffer, value string) {
+	buf.WriteByte('"')
+
+	for _, r := range value {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < ' ' {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+
+	buf.WriteByte('"')
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jcs_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/pkg/jcs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		input    string
+		expected string
+	}{
+		"sorts the properties": {
+			input:    `{ "b": 1, "a": { "d": true, "c": null } }`,
+			expected: `{"a":{"c":null,"d":true},"b":1}`,
+		},
+		"sorts the properties by utf-16 code units": {
+			input:    `{"\ufb33": "Hebrew", "\r": "CR", "\ud83d\ude00": "Emoji", "1": "One"}`,
+			expected: "{\"\\r\":\"CR\",\"1\":\"One\",\"\U0001F600\":\"Emoji\",\"\ufb33\":\"Hebrew\"}",
+		},
+		"keeps the order of arrays": {
+			input:    `[3, "b", [2, 1]]`,
+			expected: `[3,"b",[2,1]]`,
+		},
+		"serializes the numbers": {
+			input:    `[333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001, -0, 1e-7, 1e21]`,
+			expected: `[333333333.3333333,1e+30,4.5,0.002,1e-27,0,1e-7,1e+21]`,
+		},
+		"escapes the strings": {
+			input:    `"\u20ac$\u000f\u000aA'\u0042\u0022\u005c\\\"\/"`,
+			expected: "\"\u20ac$\\u000f\\nA'B\\\"\\\\\\\\\\\"/\"",
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := jcs.Transform([]byte(tc.input))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestTransform_should_return_err_for_invalid_documents(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"malformed document":  `{"a":`,
+		"number out of range": `1e999`,
+		"trailing data":       `{} {}`,
+	}
+
+	for tn, input := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jcs.Transform([]byte(input))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	actual, err := jcs.Marshal(map[string]any{"url": "https://agent?a=1&b=2", "id": 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1,"url":"https://agent?a=1&b=2"}`, string(actual))
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const Version = "2.0"
+
+// A JSON-RPC 2.0 message. Depending on the populated fields
+// it represents a request, a notification or a response.
+type Message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type Error struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// A request carries a method and an ID.
+func (m *Message) IsRequest() bool {
+	return m.Method != "" && len(m.ID) > 0
+}
+
+// A notification carries a method but no ID.
+func (m *Message) IsNotification() bool {
+	return m.Method != "" && len(m.ID) == 0
+}
+
+// A response carries either a result or an error, but no method.
+func (m *Message) IsResponse() bool {
+	return m.Method == "" && (len(m.Result) > 0 || m.Error != nil)
+}
+
+// Unmarshals the params of the message into v.
+func (m *Message) DecodeParams(v any) error {
+	if len(m.Params) == 0 {
+		return ErrMissingParams
+	}
+
+	return json.Unmarshal(m.Params, v)
+}
+
+// IDKey returns a representation of a message ID that can be used
+// as a map key, regardless of the formatting of the ID.
+func IDKey(id json.RawMessage) string {
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, id); err != nil {
+		return string(id)
+	}
+
+	return compact.String()
+}
@@ -0,0 +1,300 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonrpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"unicode"
+)
+
+const (
+	ContentTypeJSON        = "application/json"
+	ContentTypeEventStream = "text/event-stream"
+
+	sseDataField = "data:"
+	sseMaxLine   = 4 * 1024 * 1024
+)
+
+var (
+	ErrEmptyBody     = errors.New("the body is empty")
+	ErrInvalidBatch  = errors.New("the batch is empty")
+	ErrMissingParams = errors.New("the message has no params")
+	ErrDuplicateKey  = errors.New("the message has duplicate keys")
+)
+
+// Parse extracts all the JSON-RPC messages contained in a body.
+// The body can either be a JSON document (a single message or a batch)
+// or a Server-Sent Events stream where each event carries
+// a message or a batch in its data field.
+func Parse(body []byte, contentType string) ([]*Message, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, ErrEmptyBody
+	}
+
+	if isEventStream(body, contentType) {
+		return parseEventStream(body)
+	}
+
+	return parseJSON(body)
+}
+
+// IsBatch tells whether a JSON body holds a batch of messages.
+func IsBatch(body []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))
+}
+
+// Encode marshals the messages either as a batch or as a single message.
+func Encode(messages []*Message, batch bool) ([]byte, error) {
+	if batch || len(messages) != 1 {
+		return json.Marshal(messages)
+	}
+
+	return json.Marshal(messages[0])
+}
+
+func isEventStream(body []byte, contentType string) bool {
+	if contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err == nil && mediaType == ContentTypeEventStream {
+			return true
+		}
+	}
+
+	// Without a content type we look at the first field of the body,
+	// a JSON document cannot start with an SSE field or comment
+	trimmed := bytes.TrimSpace(body)
+
+	return bytes.HasPrefix(trimmed, []byte(":")) ||
+		bytes.HasPrefix(trimmed, []byte(sseDataField)) ||
+		bytes.HasPrefix(trimmed, []byte("event:")) ||
+		bytes.HasPrefix(trimmed, []byte("id:"))
+}
+
+func parseJSON(body []byte) ([]*Message, error) {
+	trimmed := bytes.TrimSpace(body)
+
+	if IsBatch(trimmed) {
+		var batch []json.RawMessage
+
+		err := json.Unmarshal(trimmed, &batch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC batch: %w", err)
+		}
+
+		if len(batch) == 0 {
+			return nil, ErrInvalidBatch
+		}
+
+		messages := make([]*Message, 0, len(batch))
+
+		for _, raw := range batch {
+			msg, err := decodeMessage(raw)
+			if err != nil {
+				return nil, err
+			}
+
+			messages = append(messages, msg)
+		}
+
+		return messages, nil
+	}
+
+	msg, err := decodeMessage(trimmed)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Message{msg}, nil
+}
+
+// decodeMessage unmarshals and validates a single message.
+// encoding/json matches the keys case-insensitively and keeps the last
+// duplicate, while the upstream may not: a message with duplicate or
+// case-variant keys could be authorized as something else than what
+// the upstream runs, so it is rejected.
+func decodeMessage(raw []byte) (*Message, error) {
+	var msg *Message
+
+	err := json.Unmarshal(raw, &msg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON-RPC message: %w", err)
+	}
+
+	err = validate(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkKeys(raw, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON-RPC message: %w", err)
+	}
+
+	if len(msg.Params) > 0 {
+		err = checkKeys(msg.Params, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC params: %w", err)
+		}
+	}
+
+	return msg, nil
+}
+
+// checkKeys fails when an object of the value holds two keys that
+// encoding/json would match to the same field. With nested the
+// objects inside the value are checked as well.
+func checkKeys(data []byte, nested bool) error {
+	return checkValueKeys(json.NewDecoder(bytes.NewReader(data)), nested)
+}
+
+func checkValueKeys(dec *json.Decoder, nested bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]struct{})
+
+	for dec.More() {
+		if delim == '{' {
+			tok, err = dec.Token()
+			if err != nil {
+				return err
+			}
+
+			key, _ := tok.(string)
+			folded := foldKey(key)
+
+			if _, ok := seen[folded]; ok {
+				return fmt.Errorf("%w: %q", ErrDuplicateKey, key)
+			}
+
+			seen[folded] = struct{}{}
+		}
+
+		if nested {
+			err = checkValueKeys(dec, nested)
+		} else {
+			var skipped json.RawMessage
+			err = dec.Decode(&skipped)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// The closing delimiter
+	_, err = dec.Token()
+
+	return err
+}
+
+// foldKey maps every rune of a key to the smallest rune of its case
+// folding orbit, the same equivalence encoding/json uses to match keys.
+func foldKey(key string) string {
+	var folded strings.Builder
+
+	for _, r := range key {
+		smallest := r
+
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			smallest = min(smallest, f)
+		}
+
+		folded.WriteRune(smallest)
+	}
+
+	return folded.String()
+}
+
+func parseEventStream(body []byte) ([]*Message, error) {
+	messages := make([]*Message, 0)
+
+	var data []string
+
+	flush := func() error {
+		if len(data) == 0 {
+			return nil
+		}
+
+		payload := strings.Join(data, "\n")
+		data = nil
+
+		if strings.TrimSpace(payload) == "" {
+			return nil
+		}
+
+		parsed, err := parseJSON([]byte(payload))
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, parsed...)
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), sseMaxLine)
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		switch {
+		case line == "":
+			err := flush()
+			if err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, sseDataField):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, sseDataField), " "))
+		default:
+			// Other fields (event, id, retry) and comments are not relevant
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("invalid event stream: %w", err)
+	}
+
+	err = flush()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, ErrEmptyBody
+	}
+
+	return messages, nil
+}
+
+func validate(msg *Message) error {
+	if msg == nil {
+		return errors.New("invalid JSON-RPC message: null message")
+	}
+
+	if msg.JSONRPC != Version {
+		return fmt.Errorf("invalid JSON-RPC message: unsupported version %q", msg.JSONRPC)
+	}
+
+	if msg.Method == "" && len(msg.Result) == 0 && msg.Error == nil {
+		return errors.New("invalid JSON-RPC message: neither a request nor a response")
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonrpc_test
+
+import (
+	"testing"
+
+	"github.com/agntcy/identity-service/internal/pkg/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_should_parse_json_envelopes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body            string
+		expectedMethods []string
+	}{
+		"single request": {
+			body:            `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"tool"}}`,
+			expectedMethods: []string{"tools/call"},
+		},
+		"notification": {
+			body:            `{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+			expectedMethods: []string{"notifications/initialized"},
+		},
+		"batch": {
+			body: `[
+				{"jsonrpc":"2.0","id":1,"method":"tools/list"},
+				{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"tool"}},
+				{"jsonrpc":"2.0","id":3,"result":{}}
+			]`,
+			expectedMethods: []string{"tools/list", "tools/call", ""},
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			messages, err := jsonrpc.Parse([]byte(tc.body), jsonrpc.ContentTypeJSON)
+
+			assert.NoError(t, err)
+			assert.Len(t, messages, len(tc.expectedMethods))
+
+			for idx, msg := range messages {
+				assert.Equal(t, tc.expectedMethods[idx], msg.Method)
+			}
+		})
+	}
+}
+
+func TestParse_should_parse_sse_envelopes(t *testing.T) {
+	t.Parallel()
+
+	body := ": keep-alive\n\n" +
+		"event: message\n" +
+		"id: 1\n" +
+		"data: {\"jsonrpc\":\"2.0\",\"id\":1,\n" +
+		"data: \"method\":\"tools/call\",\"params\":{\"name\":\"tool\"}}\r\n" +
+		"\r\n" +
+		"event: message\n" +
+		"data: [{\"jsonrpc\":\"2.0\",\"id\":2,\"method\":\"ping\"},{\"jsonrpc\":\"2.0\",\"id\":3,\"method\":\"tools/list\"}]\n"
+
+	testCases := map[string]string{
+		"with content type":    "text/event-stream; charset=utf-8",
+		"without content type": "",
+	}
+
+	for tn, contentType := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			messages, err := jsonrpc.Parse([]byte(body), contentType)
+
+			assert.NoError(t, err)
+			assert.Len(t, messages, 3)
+			assert.Equal(t, "tools/call", messages[0].Method)
+			assert.True(t, messages[0].IsRequest())
+			assert.Equal(t, "ping", messages[1].Method)
+			assert.Equal(t, "tools/list", messages[2].Method)
+		})
+	}
+}
+
+func TestParse_should_return_err_when_body_is_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*struct {
+		body        string
+		contentType string
+	}{
+		"empty body": {
+			body: "  ",
+		},
+		"invalid json": {
+			body: `{"jsonrpc":`,
+		},
+		"empty batch": {
+			body: `[]`,
+		},
+		"invalid version": {
+			body: `{"jsonrpc":"1.0","id":1,"method":"ping"}`,
+		},
+		"neither request nor response": {
+			body: `{"jsonrpc":"2.0","id":1}`,
+		},
+		"sse without data": {
+			body:        "event: message\n\n",
+			contentType: jsonrpc.ContentTypeEventStream,
+		},
+		"sse with invalid data": {
+			body:        "data: not json\n\n",
+			contentType: jsonrpc.ContentTypeEventStream,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonrpc.Parse([]byte(tc.body), tc.contentType)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParse_should_return_err_when_keys_are_duplicated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"case variant method": `{"jsonrpc":"2.0","id":1,"method":"tools/call",` +
+			`"params":{"name":"secret"},"Method":"ping"}`,
+		"duplicate method": `{"jsonrpc":"2.0","id":1,"method":"tools/call","method":"ping"}`,
+		"case variant name": `{"jsonrpc":"2.0","id":1,"method":"tools/call",` +
+			`"params":{"name":"secret","NAME":"allowed"}}`,
+		"folded key": `{"jsonrpc":"2.0","id":1,"method":"message/send",` +
+			`"params":{"skill":"a","\u017fkill":"b"}}`,
+		"nested params": `{"jsonrpc":"2.0","id":1,"method":"message/send","params":{"message":{"a":1,"A":2}}}`,
+		"in a batch": `[
+			{"jsonrpc":"2.0","id":1,"method":"ping"},
+			{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"secret","Name":"allowed"}}
+		]`,
+	}
+
+	for tn, body := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := jsonrpc.Parse([]byte(body), jsonrpc.ContentTypeJSON)
+
+			assert.ErrorIs(t, err, jsonrpc.ErrDuplicateKey)
+		})
+	}
+}
+
+func TestMessage_should_classify_messages(t *testing.T) {
+	t.Parallel()
+
+	messages, err := jsonrpc.Parse([]byte(`[
+		{"jsonrpc":"2.0","id":"a","method":"tools/list"},
+		{"jsonrpc":"2.0","method":"notifications/initialized"},
+		{"jsonrpc":"2.0","id":"b","error":{"code":-32601,"message":"not found"}}
+	]`), "")
+
+	assert.NoError(t, err)
+	assert.True(t, messages[0].IsRequest())
+	assert.True(t, messages[1].IsNotification())
+	assert.True(t, messages[2].IsResponse())
+	assert.ErrorIs(t, messages[0].DecodeParams(&struct{}{}), jsonrpc.ErrMissingParams)
+}
@@ -0,0 +1,115 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewVerifier creates a new instance of Verifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Verifier {
+	mock := &Verifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Verifier is an autogenerated mock type for the Verifier type
+type Verifier struct {
+	mock.Mock
+}
+
+type Verifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Verifier) EXPECT() *Verifier_Expecter {
+	return &Verifier_Expecter{mock: &_m.Mock}
+}
+
+// Verify provides a mock function for the type Verifier
+func (_mock *Verifier) Verify(ctx context.Context, token string, opts ...jwtutil.VerifyOption) error {
+	// jwtutil.VerifyOption
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, token)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...jwtutil.VerifyOption) error); ok {
+		r0 = returnFunc(ctx, token, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Verifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type Verifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - opts ...jwtutil.VerifyOption
+func (_e *Verifier_Expecter) Verify(ctx interface{}, token interface{}, opts ...interface{}) *Verifier_Verify_Call {
+	return &Verifier_Verify_Call{Call: _e.mock.On("Verify",
+		append([]interface{}{ctx, token}, opts...)...)}
+}
+
+func (_c *Verifier_Verify_Call) Run(run func(ctx context.Context, token string, opts ...jwtutil.VerifyOption)) *Verifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []jwtutil.VerifyOption
+		variadicArgs := make([]jwtutil.VerifyOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(jwtutil.VerifyOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *Verifier_Verify_Call) Return(err error) *Verifier_Verify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Verifier_Verify_Call) RunAndReturn(run func(ctx context.Context, token string, opts ...jwtutil.VerifyOption) error) *Verifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
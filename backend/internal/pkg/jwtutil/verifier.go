@@ -0,0 +1,389 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jwtutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/pkg/httputil"
+	"github.com/agntcy/identity-service/pkg/log"
+	"github.com/agntcy/identity/pkg/jwk"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// minRefreshInterval is the minimum interval between two refreshes
+	// triggered by unknown key IDs, preventing them from causing
+	// a JWKS fetch on every verification.
+	minRefreshInterval = 30 * time.Second
+
+	// acceptableSkew is the clock skew tolerated when validating the time claims.
+	acceptableSkew = 30 * time.Second
+
+	// maxResponseSize is the maximum size of the OpenID configurations and JWKS.
+	maxResponseSize = 1 << 20
+)
+
+// DefaultAlgorithms are the asymmetric signature algorithms accepted by default.
+// Symmetric algorithms and "none" are never accepted.
+var DefaultAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// clientIDClaims are the claims used by the supported IdPs to identify
+// the client a token was issued to (sub for Ory and self-issued tokens,
+// cid for Okta, azp for Keycloak and Entra, client_id for Ping and Duo).
+var clientIDClaims = []string{"sub", "client_id", "azp", "cid", "appid"}
+
+var (
+	ErrInvalidToken     = errors.New("invalid JWT")
+	ErrUnknownKey       = errors.New("no key found for the JWT key ID")
+	ErrAlgNotAllowed    = errors.New("the JWT signature algorithm is not allowed")
+	ErrIssuerMismatch   = errors.New("the JWT issuer does not match")
+	ErrAudienceMismatch = errors.New("the JWT audience does not match")
+	ErrClientMismatch   = errors.New("the JWT was not issued to the client")
+	ErrResponseTooLarge = errors.New("the response exceeds the maximum size")
+)
+
+// KeyFunc returns the public key with the specified ID.
+type KeyFunc func(ctx context.Context, keyID string) (*jwk.Jwk, error)
+
+type verifyOptions struct {
+	issuer   string
+	clientID string
+	keyFunc  KeyFunc
+}
+
+type VerifyOption func(opts *verifyOptions)
+
+// WithIssuer requires the iss claim to match the issuer. Unless a KeyFunc
+// is provided, the signing keys are fetched from the JWKS of the issuer.
+func WithIssuer(issuer string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.issuer = issuer
+	}
+}
+
+// WithClientID requires the token to be issued to the client.
+func WithClientID(clientID string) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.clientID = clientID
+	}
+}
+
+// WithKeyFunc resolves the signing keys with the function instead of the JWKS
+// of the issuer. It is used for self-issued tokens, which carry no audience.
+func WithKeyFunc(keyFunc KeyFunc) VerifyOption {
+	return func(opts *verifyOptions) {
+		opts.keyFunc = keyFunc
+	}
+}
+
+// Verifier verifies the signature and the claims of JWTs issued by IdPs.
+type Verifier interface {
+	Verify(ctx context.Context, token string, opts ...VerifyOption) error
+}
+
+type keySet struct {
+	set         jwxjwk.Set
+	fetchedAt   time.Time
+	refreshedAt time.Time
+}
+
+type verifier struct {
+	audiences    []string
+	algorithms   []string
+	cacheTTL     time.Duration
+	maxStaleness time.Duration
+	mu           sync.Mutex
+	keySets      map[string]*keySet
+	fetches      singleflight.Group
+}
+
+// NewVerifier returns a Verifier caching the JWKS of each issuer for the TTL.
+// The JWKS is also refreshed when a token references an unknown key ID, which
+// supports key rotation. The cached JWKS is used while the issuer is unreachable,
+// until maxStaleness after it was fetched. The tokens verified against a JWKS must
+// be intended for one of the audiences, the audience is not checked when none is provided.
+func NewVerifier(audiences, algorithms []string, cacheTTL, maxStaleness time.Duration) Verifier {
+	if len(algorithms) == 0 {
+		algorithms = DefaultAlgorithms
+	}
+
+	return &verifier{
+		audiences:    audiences,
+		algorithms:   algorithms,
+		cacheTTL:     cacheTTL,
+		maxStaleness: max(maxStaleness, cacheTTL),
+		keySets:      make(map[string]*keySet),
+	}
+}
+
+func (v *verifier) Verify(ctx context.Context, token string, opts ...VerifyOption) error {
+	options := verifyOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if token == "" || options.issuer == "" {
+		return ErrInvalidToken
+	}
+
+	msg, err := jws.Parse([]byte(token))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return ErrInvalidToken
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+
+	alg, ok := headers.Algorithm()
+	if !ok || !slices.Contains(v.algorithms, alg.String()) {
+		return ErrAlgNotAllowed
+	}
+
+	kid, _ := headers.KeyID()
+
+	key, err := v.lookupKey(ctx, &options, kid)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := jwt.Parse(
+		[]byte(token),
+		jwt.WithKey(alg, key),
+		jwt.WithValidate(true),
+		jwt.WithAcceptableSkew(acceptableSkew),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return v.validateClaims(parsed, &options)
+}
+
+func (v *verifier) validateClaims(token jwt.Token, options *verifyOptions) error {
+	if iss, _ := token.Issuer(); iss != options.issuer {
+		return ErrIssuerMismatch
+	}
+
+	if options.keyFunc == nil && len(v.audiences) > 0 {
+		aud, _ := token.Audience()
+		if !slices.ContainsFunc(aud, func(a string) bool { return slices.Contains(v.audiences, a) }) {
+			return ErrAudienceMismatch
+		}
+	}
+
+	if options.clientID != "" && !issuedTo(token, options.clientID) {
+		return ErrClientMismatch
+	}
+
+	return nil
+}
+
+func issuedTo(token jwt.Token, clientID string) bool {
+	for _, claim := range clientIDClaims {
+		var value string
+		if err := token.Get(claim, &value); err == nil && value == clientID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *verifier) lookupKey(ctx context.Context, options *verifyOptions, kid string) (jwxjwk.Key, error) {
+	if options.keyFunc != nil {
+		if kid == "" {
+			return nil, ErrUnknownKey
+		}
+
+		key, err := options.keyFunc(ctx, kid)
+		if err != nil || key == nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnknownKey, err)
+		}
+
+		return toJwx(key)
+	}
+
+	set, err := v.getKeySet(ctx, options.issuer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := lookupKeyID(set, kid)
+	if !ok {
+		// The issuer may have rotated its keys
+		set, err = v.getKeySet(ctx, options.issuer, true)
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok = lookupKeyID(set, kid)
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+	}
+
+	return key, nil
+}
+
+// lookupKeyID returns the key with the ID, or the only key
+// of the set when the token does not specify any.
+func lookupKeyID(set jwxjwk.Set, kid string) (jwxjwk.Key, bool) {
+	if kid == "" {
+		if set.Len() != 1 {
+			return nil, false
+		}
+
+		return set.Key(0)
+	}
+
+	return set.LookupKeyID(kid)
+}
+
+// getKeySet returns the cached JWKS of the issuer, or fetches it. The JWKS is
+// fetched without holding the lock and the concurrent fetches of the same issuer
+// are shared, so a slow issuer does not block the verification of the others.
+func (v *verifier) getKeySet(ctx context.Context, issuer string, refresh bool) (jwxjwk.Set, error) {
+	v.mu.Lock()
+	cached, ok := v.keySets[issuer]
+	v.mu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < v.cacheTTL {
+		if !refresh || time.Since(cached.refreshedAt) < minRefreshInterval {
+			return cached.set, nil
+		}
+	}
+
+	fetched, err, _ := v.fetches.Do(issuer, func() (any, error) {
+		set, err := fetchKeySet(ctx, issuer)
+		if err != nil {
+			return nil, err
+		}
+
+		v.mu.Lock()
+		defer v.mu.Unlock()
+
+		fetched := &keySet{set: set, fetchedAt: time.Now()}
+		if refresh {
+			fetched.refreshedAt = fetched.fetchedAt
+		} else if previous, ok := v.keySets[issuer]; ok {
+			fetched.refreshedAt = previous.refreshedAt
+		}
+
+		v.keySets[issuer] = fetched
+
+		return set, nil
+	})
+	if err != nil {
+		if ok && time.Since(cached.fetchedAt) < v.maxStaleness {
+			// Keep serving the cached keys while the issuer is unreachable
+			log.FromContext(ctx).WithError(err).Warn("unable to refresh the JWKS of ", issuer)
+			return cached.set, nil
+		}
+
+		return nil, err
+	}
+
+	set, _ := fetched.(jwxjwk.Set)
+
+	return set, nil
+}
+
+func fetchKeySet(ctx context.Context, issuer string) (jwxjwk.Set, error) {
+	var discovery struct {
+		JwksURI string `json:"jwks_uri"`
+	}
+
+	body, err := fetch(ctx, strings.TrimSuffix(issuer, "/")+discoveryPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the OpenID configuration of %s: %w", issuer, err)
+	}
+
+	err = json.Unmarshal(body, &discovery)
+	if err != nil || discovery.JwksURI == "" {
+		return nil, fmt.Errorf("invalid OpenID configuration for %s: %w", issuer, err)
+	}
+
+	body, err = fetch(ctx, discovery.JwksURI)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch the JWKS of %s: %w", issuer, err)
+	}
+
+	set, err := jwxjwk.Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS for %s: %w", issuer, err)
+	}
+
+	return set, nil
+}
+
+// fetch reads the response within the timeout, up to maxResponseSize,
+// unlike httputil.Get which cancels its context before the body is read.
+func fetch(ctx context.Context, uri string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, httputil.Timeout*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxResponseSize {
+		return nil, ErrResponseTooLarge
+	}
+
+	return body, nil
+}
+
+func toJwx(key *jwk.Jwk) (jwxjwk.Key, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnknownKey, err)
+	}
+
+	parsed, err := jwxjwk.ParseKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnknownKey, err)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,415 @@
+// Copyright 2025 AGNTCY Contributors (https://github.com/agntcy)
+// SPDX-License-Identifier: Apache-2.0
+
+package jwtutil_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agntcy/identity-service/internal/pkg/jwtutil"
+	"github.com/agntcy/identity/pkg/joseutil"
+	"github.com/agntcy/identity/pkg/jwk"
+	"github.com/agntcy/identity/pkg/oidc"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	jwxjwk "github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "client-id"
+
+// jwksServer is a local stand-in for the discovery and JWKS endpoints of an IdP.
+type jwksServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	keys       []jwxjwk.Key
+	jwksCalled atomic.Int32
+}
+
+func newJwksServer(t *testing.T) *jwksServer {
+	t.Helper()
+
+	srv := &jwksServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		srv.jwksCalled.Add(1)
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		set := jwxjwk.NewSet()
+		for _, key := range srv.keys {
+			pub, _ := key.PublicKey()
+			_ = set.AddKey(pub)
+		}
+
+		_ = json.NewEncoder(w).Encode(set)
+	})
+
+	srv.Server = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// rotate generates a new signing key and publishes it in the JWKS.
+func (s *jwksServer) rotate(t *testing.T) jwxjwk.Key {
+	t.Helper()
+
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := jwxjwk.Import(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwxjwk.KeyIDKey, uuid.NewString()))
+	require.NoError(t, key.Set(jwxjwk.AlgorithmKey, jwa.RS256()))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = append(s.keys, key)
+
+	return key
+}
+
+func (s *jwksServer) sign(t *testing.T, key jwxjwk.Key, claims map[string]any) string {
+	t.Helper()
+
+	builder := jwt.NewBuilder().
+		Issuer(s.URL).
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+	for k, v := range claims {
+		builder = builder.Claim(k, v)
+	}
+
+	token, err := builder.Build()
+	require.NoError(t, err)
+
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256(), key))
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+func TestVerifier_should_verify_the_tokens_of_each_idp(t *testing.T) {
+	t.Parallel()
+
+	srv := newJwksServer(t)
+	key := srv.rotate(t)
+
+	testCases := map[string]*struct {
+		claims map[string]any
+	}{
+		"Okta": {
+			claims: map[string]any{"cid": testClientID, "aud": "api://default"},
+		},
+		"Duo": {
+			claims: map[string]any{"client_id": testClientID, "aud": "api://default"},
+		},
+		"Ory": {
+			claims: map[string]any{"sub": testClientID, "aud": []string{"api://default"}},
+		},
+		"Keycloak": {
+			claims: map[string]any{"azp": testClientID, "aud": "api://default"},
+		},
+		"Ping": {
+			claims: map[string]any{"client_id": testClientID, "aud": []string{"other", "api://default"}},
+		},
+		"Entra": {
+			claims: map[string]any{"azp": testClientID, "aud": "api://default"},
+		},
+	}
+
+	sut := jwtutil.NewVerifier([]string{"api://default"}, nil, time.Hour, time.Hour)
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			token := srv.sign(t, key, tc.claims)
+
+			err := sut.Verify(
+				context.Background(),
+				token,
+				jwtutil.WithIssuer(srv.URL),
+				jwtutil.WithClientID(testClientID),
+			)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifier_should_verify_self_issued_tokens_with_the_key_func(t *testing.T) {
+	t.Parallel()
+
+	priv, _ := joseutil.GenerateJWK("RS256", "sig", "key-id")
+	token, _ := oidc.SelfIssueJWT("self-issuer", testClientID, priv)
+	keyFunc := func(_ context.Context, keyID string) (*jwk.Jwk, error) {
+		assert.Equal(t, "key-id", keyID)
+		return priv.PublicKey(), nil
+	}
+
+	// The audiences are not required for self-issued tokens
+	sut := jwtutil.NewVerifier([]string{"api://default"}, nil, time.Hour, time.Hour)
+
+	err := sut.Verify(
+		context.Background(),
+		token,
+		jwtutil.WithIssuer("self-issuer"),
+		jwtutil.WithClientID(testClientID),
+		jwtutil.WithKeyFunc(keyFunc),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestVerifier_should_cache_the_jwks_and_refresh_it_on_rotation(t *testing.T) {
+	t.Parallel()
+
+	srv := newJwksServer(t)
+	oldKey := srv.rotate(t)
+	sut := jwtutil.NewVerifier([]string{"api"}, nil, time.Hour, time.Hour)
+	ctx := context.Background()
+
+	for range 3 {
+		err := sut.Verify(ctx, srv.sign(t, oldKey, map[string]any{"aud": "api"}), jwtutil.WithIssuer(srv.URL))
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), srv.jwksCalled.Load())
+
+	newKey := srv.rotate(t)
+
+	err := sut.Verify(ctx, srv.sign(t, newKey, map[string]any{"aud": "api"}), jwtutil.WithIssuer(srv.URL))
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), srv.jwksCalled.Load())
+
+	// Another unknown key ID does not trigger a refresh right away
+	unknownKey := srv.rotate(t)
+
+	err = sut.Verify(ctx, srv.sign(t, unknownKey, map[string]any{"aud": "api"}), jwtutil.WithIssuer(srv.URL))
+
+	assert.ErrorIs(t, err, jwtutil.ErrUnknownKey)
+	assert.Equal(t, int32(2), srv.jwksCalled.Load())
+}
+
+func TestVerifier_should_not_check_the_audience_without_audiences(t *testing.T) {
+	t.Parallel()
+
+	srv := newJwksServer(t)
+	key := srv.rotate(t)
+	sut := jwtutil.NewVerifier(nil, nil, time.Hour, time.Hour)
+
+	err := sut.Verify(
+		context.Background(),
+		srv.sign(t, key, map[string]any{"sub": testClientID, "aud": "api"}),
+		jwtutil.WithIssuer(srv.URL),
+		jwtutil.WithClientID(testClientID),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestVerifier_should_stop_serving_the_stale_jwks_after_the_max_staleness(t *testing.T) {
+	t.Parallel()
+
+	var unavailable atomic.Bool
+
+	srv := newJwksServer(t)
+	key := srv.rotate(t)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	sut := jwtutil.NewVerifier([]string{"api"}, nil, 100*time.Millisecond, 300*time.Millisecond)
+	token := srv.sign(t, key, map[string]any{"iss": proxy.URL, "aud": "api"})
+	ctx := context.Background()
+
+	assert.NoError(t, sut.Verify(ctx, token, jwtutil.WithIssuer(proxy.URL)))
+
+	unavailable.Store(true)
+	time.Sleep(150 * time.Millisecond)
+
+	assert.NoError(t, sut.Verify(ctx, token, jwtutil.WithIssuer(proxy.URL)))
+
+	time.Sleep(200 * time.Millisecond)
+
+	assert.Error(t, sut.Verify(ctx, token, jwtutil.WithIssuer(proxy.URL)))
+}
+
+func TestVerifier_should_return_err_when_the_jwks_is_too_large(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"jwks_uri": "` + strings.Repeat("a", 2<<20) + `"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	key := newJwksServer(t).rotate(t)
+	token, err := jwt.NewBuilder().Issuer(srv.URL).Audience([]string{"api"}).Build()
+	require.NoError(t, err)
+
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256(), key))
+	require.NoError(t, err)
+
+	sut := jwtutil.NewVerifier([]string{"api"}, nil, time.Hour, time.Hour)
+
+	err = sut.Verify(context.Background(), string(signed), jwtutil.WithIssuer(srv.URL))
+
+	assert.ErrorIs(t, err, jwtutil.ErrResponseTooLarge)
+}
+
+func TestVerifier_should_not_block_the_other_issuers_while_fetching_a_jwks(t *testing.T) {
+	t.Parallel()
+
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	slowSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-release
+	}))
+	t.Cleanup(slowSrv.Close)
+	t.Cleanup(func() { close(release) })
+
+	srv := newJwksServer(t)
+	key := srv.rotate(t)
+	sut := jwtutil.NewVerifier([]string{"api"}, nil, time.Hour, time.Hour)
+
+	go func() {
+		_ = sut.Verify(
+			context.Background(),
+			srv.sign(t, key, map[string]any{"iss": slowSrv.URL, "aud": "api"}),
+			jwtutil.WithIssuer(slowSrv.URL),
+		)
+	}()
+
+	<-requested
+
+	done := make(chan error)
+
+	go func() {
+		done <- sut.Verify(
+			context.Background(),
+			srv.sign(t, key, map[string]any{"aud": "api"}),
+			jwtutil.WithIssuer(srv.URL),
+		)
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "the verification was blocked by the fetch of another issuer")
+	}
+}
+
+func TestVerifier_should_return_err_for_invalid_tokens(t *testing.T) {
+	t.Parallel()
+
+	srv := newJwksServer(t)
+	key := srv.rotate(t)
+
+	unpublished, _ := jwxjwk.Import([]byte("a-shared-secret-of-at-least-32-bytes"))
+	_ = unpublished.Set(jwxjwk.KeyIDKey, "hmac")
+	hmacToken, _ := jwt.Sign(
+		jwt.New(),
+		jwt.WithKey(jwa.HS256(), unpublished),
+	)
+
+	otherRaw, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherKey, _ := jwxjwk.Import(otherRaw)
+	_ = otherKey.Set(jwxjwk.KeyIDKey, "unknown")
+
+	expired, _ := jwt.NewBuilder().
+		Issuer(srv.URL).
+		Subject(testClientID).
+		Expiration(time.Now().Add(-time.Hour)).
+		Build()
+	expiredToken, _ := jwt.Sign(expired, jwt.WithKey(jwa.RS256(), key))
+
+	testCases := map[string]*struct {
+		token       string
+		issuer      string
+		expectedErr error
+	}{
+		"symmetric algorithm": {
+			token:       string(hmacToken),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrAlgNotAllowed,
+		},
+		"unknown key": {
+			token:       srv.sign(t, otherKey, map[string]any{"sub": testClientID, "aud": "api"}),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrUnknownKey,
+		},
+		"expired token": {
+			token:       string(expiredToken),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrInvalidToken,
+		},
+		"other issuer": {
+			token:       srv.sign(t, key, map[string]any{"iss": "https://other", "sub": testClientID, "aud": "api"}),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrIssuerMismatch,
+		},
+		"other audience": {
+			token:       srv.sign(t, key, map[string]any{"sub": testClientID, "aud": "other"}),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrAudienceMismatch,
+		},
+		"other client": {
+			token:       srv.sign(t, key, map[string]any{"sub": "other-client", "aud": "api"}),
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrClientMismatch,
+		},
+		"malformed token": {
+			token:       "not-a-jwt",
+			issuer:      srv.URL,
+			expectedErr: jwtutil.ErrInvalidToken,
+		},
+	}
+
+	sut := jwtutil.NewVerifier([]string{"api"}, nil, time.Hour, time.Hour)
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			err := sut.Verify(
+				context.Background(),
+				tc.token,
+				jwtutil.WithIssuer(tc.issuer),
+				jwtutil.WithClientID(testClientID),
+			)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -28,3 +28,18 @@ func Verify(
 
 	return nil
 }
+
+// Expiration returns the exp claim of the JWT without verifying it.
+func Expiration(jwtString string) (int64, bool) {
+	parsed, err := jwt.ParseInsecure([]byte(jwtString))
+	if err != nil {
+		return 0, false
+	}
+
+	exp, ok := parsed.Expiration()
+	if !ok {
+		return 0, false
+	}
+
+	return exp.Unix(), true
+}